@@ -32,6 +32,15 @@ func TestClusterUpdates(t *testing.T) {
 	assertNodeUpdates(t, []NodeUpdate{makeUpdate("node1", NodeAdded), makeUpdate("node3", NodeAdded), makeUpdate("node4", NodeAdded)}, nodeUpdatesCh, wait)
 }
 
+func TestStateRelabeledNodeIsRemovedAndAdded(t *testing.T) {
+	s := makeState([]Node{NewLabeledNode("node1", map[string]string{"rack": "r1"}), NewIdNode("node2")})
+	relabeled := NewLabeledNode("node1", map[string]string{"rack": "r2"})
+	updates := s.setAndDiff([]Node{relabeled, NewIdNode("node2"), NewIdNode("node3")})
+	expected := []NodeUpdate{NewAdd(NewIdNode("node3")), NewRemove("node1"), NewAdd(relabeled)}
+	assert.Equal(t, expected, updates)
+	assert.Empty(t, s.setAndDiff([]Node{relabeled, NewIdNode("node2"), NewIdNode("node3")}))
+}
+
 // Below here are utility functions that make it easy to write more fluent tests.
 
 func assertNodeUpdates(t *testing.T, expectedUpdates []NodeUpdate, nodeUpdateCh chan []NodeUpdate, maxWait time.Duration) {
@@ -0,0 +1,94 @@
+package membership
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Heartbeater registers a worker with registries and keeps it registered by sending
+// heartbeats until it leaves.
+//
+// Each scheduler serves its own registry, so the worker sends its heartbeats to the registry of
+// every scheduler electing a leader.  A standby scheduler's registry then already has every worker
+// when it's elected, instead of being empty until the workers learn of the new leader.
+type Heartbeater struct {
+	registryAddrs []string // 'host:port' of the http servers the registries' handlers are served on
+	hb            Heartbeat
+	interval      time.Duration
+	client        *http.Client
+	stopCh        chan struct{}
+	doneCh        chan struct{}
+}
+
+// StartHeartbeats sends the heartbeat to the registries at registryAddrs right away and then every interval,
+// interval <= 0 uses the DefaultHeartbeatInterval.  Failed heartbeats are logged and retried at the next interval.
+func StartHeartbeats(registryAddrs []string, hb Heartbeat, interval time.Duration) *Heartbeater {
+	if interval <= 0 {
+		interval = DefaultHeartbeatInterval
+	}
+	h := &Heartbeater{
+		registryAddrs: registryAddrs,
+		hb:            hb,
+		interval:      interval,
+		client:        &http.Client{Timeout: interval},
+		stopCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+	log.Infof("Sending heartbeats for %s to %v every %s", hb.Addr, registryAddrs, interval)
+	go h.loop()
+	return h
+}
+
+func (h *Heartbeater) loop() {
+	defer close(h.doneCh)
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		for _, addr := range h.registryAddrs {
+			if err := h.post(addr, HeartbeatPath); err != nil {
+				log.Errorf("Heartbeat for %s to %s failed: %s", h.hb.Addr, addr, err)
+			}
+		}
+		select {
+		case <-h.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Leave stops the heartbeats and deregisters the worker from every registry, so it's removed
+// from the cluster without waiting for it to expire.  Returns the last error if any registry failed.
+func (h *Heartbeater) Leave() error {
+	close(h.stopCh)
+	<-h.doneCh
+	var lastErr error
+	for _, addr := range h.registryAddrs {
+		if err := h.post(addr, LeavePath); err != nil {
+			log.Errorf("Leaving %s for %s failed: %s", addr, h.hb.Addr, err)
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func (h *Heartbeater) post(registryAddr, path string) error {
+	body, err := json.Marshal(h.hb)
+	if err != nil {
+		return err
+	}
+	resp, err := h.client.Post("http://"+registryAddr+path, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s", path, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+// Package membership provides a cluster Fetcher implementation for
+// workers that register themselves with a registry, typically served by the
+// scheduler, and stay members as long as they keep sending heartbeats.
+package membership
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/twitter/scoot/cloud/cluster"
+)
+
+// Paths of the registry's http handlers, see Registry.Handlers().
+const (
+	HeartbeatPath = "/membership/heartbeat"
+	LeavePath     = "/membership/leave"
+)
+
+// How often workers send heartbeats by default.
+const DefaultHeartbeatInterval = 5 * time.Second
+
+// How many heartbeats a worker can miss by default before it's expired.
+const DefaultMissedHeartbeats = 3
+
+// Heartbeat is sent by a worker to register with the registry and to stay registered.
+type Heartbeat struct {
+	// The worker's thrift address, used as its node id.
+	Addr string `json:"addr"`
+	// Key/value metadata describing the worker, reported as its node's labels.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type member struct {
+	node          cluster.Node
+	lastHeartbeat time.Time
+}
+
+// Registry keeps the workers that sent a heartbeat within the expiry duration.  It implements
+// cluster.Fetcher, so a cluster built on it produces NodeUpdates as workers register, leave or expire.
+type Registry struct {
+	expiry  time.Duration
+	mu      sync.Mutex
+	members map[cluster.NodeId]*member
+}
+
+// NewRegistry creates a Registry expiring workers that didn't send a heartbeat for the expiry duration,
+// expiry <= 0 expires them after DefaultMissedHeartbeats heartbeats at the DefaultHeartbeatInterval.
+func NewRegistry(expiry time.Duration) *Registry {
+	if expiry <= 0 {
+		expiry = DefaultMissedHeartbeats * DefaultHeartbeatInterval
+	}
+	return &Registry{expiry: expiry, members: map[cluster.NodeId]*member{}}
+}
+
+// Heartbeat registers the worker if it isn't registered yet and renews its membership.
+// The labels of the latest heartbeat replace the ones the worker registered with.
+func (r *Registry) Heartbeat(hb Heartbeat) error {
+	if hb.Addr == "" {
+		return errors.New("a heartbeat must have the worker's address")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id := cluster.NodeId(hb.Addr)
+	if _, ok := r.members[id]; !ok {
+		log.Infof("Registering worker %s, labels: %v", hb.Addr, hb.Labels)
+	}
+	r.members[id] = &member{node: cluster.NewLabeledNode(hb.Addr, hb.Labels), lastHeartbeat: time.Now()}
+	return nil
+}
+
+// Leave deregisters the worker, for workers that shut down without waiting to expire.
+func (r *Registry) Leave(addr string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.members[cluster.NodeId(addr)]; ok {
+		log.Infof("Worker %s left", addr)
+		delete(r.members, cluster.NodeId(addr))
+	}
+}
+
+// Implements cluster.Fetcher, returns the registered workers ordered by address after
+// dropping the ones that missed their heartbeats.
+func (r *Registry) Fetch() ([]cluster.Node, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	nodes := []cluster.Node{}
+	for id, m := range r.members {
+		if since := time.Since(m.lastHeartbeat); since > r.expiry {
+			log.Infof("Expiring worker %s, no heartbeat for %s", id, since)
+			delete(r.members, id)
+			continue
+		}
+		nodes = append(nodes, m.node)
+	}
+	sort.Sort(cluster.NodeSorter(nodes))
+	return nodes, nil
+}
+
+// Handlers returns the http handlers workers send their heartbeats to, keyed by path.
+// Both take a POST with a JSON Heartbeat, only the address is used when leaving.
+func (r *Registry) Handlers() map[string]http.Handler {
+	return map[string]http.Handler{
+		HeartbeatPath: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			r.serve(w, req, r.Heartbeat)
+		}),
+		LeavePath: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			r.serve(w, req, func(hb Heartbeat) error {
+				r.Leave(hb.Addr)
+				return nil
+			})
+		}),
+	}
+}
+
+func (r *Registry) serve(w http.ResponseWriter, req *http.Request, handle func(Heartbeat) error) {
+	if req.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("%s requires a POST", req.URL.Path), http.StatusMethodNotAllowed)
+		return
+	}
+	hb := Heartbeat{}
+	if err := json.NewDecoder(req.Body).Decode(&hb); err != nil {
+		http.Error(w, fmt.Sprintf("couldn't parse heartbeat: %s", err), http.StatusBadRequest)
+		return
+	}
+	if err := handle(hb); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
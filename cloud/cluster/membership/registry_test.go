@@ -0,0 +1,87 @@
+package membership
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/twitter/scoot/cloud/cluster"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry(100 * time.Millisecond)
+	if err := r.Heartbeat(Heartbeat{}); err == nil {
+		t.Errorf("Expected an error for a heartbeat without an address")
+	}
+	r.Heartbeat(Heartbeat{Addr: "host2:9091"})
+	r.Heartbeat(Heartbeat{Addr: "host1:9091", Labels: map[string]string{"rack": "r1"}})
+
+	nodes, err := r.Fetch()
+	if err != nil || len(nodes) != 2 || nodes[0].Id() != "host1:9091" || nodes[0].Labels()["rack"] != "r1" {
+		t.Fatalf("Expected both workers ordered by address, got %v, err: %v", nodes, err)
+	}
+
+	// host1 keeps sending heartbeats, host2 misses them and expires
+	time.Sleep(60 * time.Millisecond)
+	r.Heartbeat(Heartbeat{Addr: "host1:9091", Labels: map[string]string{"rack": "r2"}})
+	time.Sleep(60 * time.Millisecond)
+	nodes, _ = r.Fetch()
+	if len(nodes) != 1 || nodes[0].Id() != "host1:9091" || nodes[0].Labels()["rack"] != "r2" {
+		t.Fatalf("Expected only host1 with its latest labels, got %v", nodes)
+	}
+
+	r.Leave("host1:9091")
+	if nodes, _ = r.Fetch(); len(nodes) != 0 {
+		t.Errorf("Expected no workers after host1 left, got %v", nodes)
+	}
+}
+
+func TestHeartbeater(t *testing.T) {
+	// the registries of a leader and a standby scheduler
+	registries := []*Registry{NewRegistry(time.Minute), NewRegistry(time.Minute)}
+	registryAddrs := []string{}
+	var server *httptest.Server
+	for _, r := range registries {
+		mux := http.NewServeMux()
+		for path, handler := range r.Handlers() {
+			mux.Handle(path, handler)
+		}
+		server = httptest.NewServer(mux)
+		defer server.Close()
+		registryAddrs = append(registryAddrs, strings.TrimPrefix(server.URL, "http://"))
+	}
+
+	h := StartHeartbeats(registryAddrs, Heartbeat{Addr: "host1:9091", Labels: map[string]string{"rack": "r1"}}, 10*time.Millisecond)
+	for _, r := range registries {
+		var nodes []cluster.Node
+		for start := time.Now(); len(nodes) == 0; nodes, _ = r.Fetch() {
+			if time.Since(start) > 5*time.Second {
+				t.Fatalf("Timed out waiting for the worker to register with every registry")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		if nodes[0].Id() != "host1:9091" || nodes[0].Labels()["rack"] != "r1" {
+			t.Errorf("Unexpected registered worker %v", nodes[0])
+		}
+	}
+
+	if err := h.Leave(); err != nil {
+		t.Fatalf("Unexpected error leaving: %v", err)
+	}
+	for _, r := range registries {
+		if nodes, _ := r.Fetch(); len(nodes) != 0 {
+			t.Errorf("Expected no workers after leaving, got %v", nodes)
+		}
+	}
+
+	resp, err := http.Get(server.URL + HeartbeatPath)
+	if err != nil || resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("Expected heartbeats to require a POST, got %v, err: %v", resp, err)
+	}
+	resp, err = http.Post(server.URL+HeartbeatPath, "application/json", strings.NewReader("{"))
+	if err != nil || resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected a bad request for an invalid heartbeat, got %v, err: %v", resp, err)
+	}
+}
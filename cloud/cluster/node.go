@@ -11,11 +11,15 @@ type NodeId string
 type Node interface {
 	// A unique node identifier, like 'host:thriftPort'
 	Id() NodeId
+	// Key/value metadata describing the node, like its rack or installed tools.
+	// Tasks can require or prefer nodes with certain labels, see scheduler/domain.LabelSelector.
+	Labels() map[string]string
 	String() string
 }
 
 type idNode struct {
-	id NodeId
+	id     NodeId
+	labels map[string]string
 }
 
 func (n *idNode) String() string {
@@ -26,6 +30,11 @@ func NewIdNode(id string) Node {
 	return &idNode{id: NodeId(id)}
 }
 
+// Creates a Node carrying the given labels.
+func NewLabeledNode(id string, labels map[string]string) Node {
+	return &idNode{id: NodeId(id), labels: labels}
+}
+
 func NewIdNodes(num int) []Node {
 	r := []Node{}
 	for i := 0; i < num; i++ {
@@ -39,6 +48,11 @@ func (n *idNode) Id() NodeId {
 	return n.id
 }
 
+// Implements interface Node.Labels()
+func (n *idNode) Labels() map[string]string {
+	return n.labels
+}
+
 type NodeSorter []Node
 
 func (n NodeSorter) Len() int           { return len(n) }
@@ -60,6 +74,7 @@ type NodeUpdate struct {
 	Id            NodeId
 	Node          Node // Only set for adds
 	UserInitiated bool
+	Requestor     string // Who asked for a user initiated update, if known
 }
 
 func (u *NodeUpdate) String() string {
@@ -3,6 +3,7 @@ package cluster
 import (
 	log "github.com/sirupsen/logrus"
 
+	"reflect"
 	"sort"
 )
 
@@ -21,12 +22,17 @@ func makeState(nodes []Node) *state {
 }
 
 // SetAndDiff takes the new state as an argument and creates
-// node updates based on the diff
+// node updates based on the diff.  A node whose labels changed is
+// removed and added again, after the nodes that were added or removed.
 func (s *state) setAndDiff(newState []Node) []NodeUpdate {
 	added := []Node{}
+	relabeled := []Node{}
 	oldStateLen := len(s.nodes)
 	for _, n := range newState {
-		if _, exists := s.nodes[n.Id()]; exists {
+		if old, exists := s.nodes[n.Id()]; exists {
+			if !reflect.DeepEqual(old.Labels(), n.Labels()) {
+				relabeled = append(relabeled, n)
+			}
 			// remove from s.nodes so that s.nodes only contains nodes removed in this diff
 			delete(s.nodes, n.Id())
 		} else {
@@ -39,6 +45,7 @@ func (s *state) setAndDiff(newState []Node) []NodeUpdate {
 	}
 	sort.Sort(NodeSorter(added))
 	sort.Sort(NodeSorter(removed))
+	sort.Sort(NodeSorter(relabeled))
 	outgoing := []NodeUpdate{}
 	for _, n := range added {
 		log.Infof("NodeAdded update: %s", n)
@@ -55,14 +62,18 @@ func (s *state) setAndDiff(newState []Node) []NodeUpdate {
 			Id:         n.Id(),
 		})
 	}
+	for _, n := range relabeled {
+		log.Infof("Node relabeled update: %s, labels: %v", n, n.Labels())
+		outgoing = append(outgoing, NewRemove(n.Id()), NewAdd(n))
+	}
 
 	// debugging scheduler performance issues: record when we see nodes being added removed
 	// also record how many times we've checked and didn't see any changes (we're wondering if
 	// this go routine is being swapped out for long periods of time).
-	if len(added) > 0 || len(removed) > 0 {
-		log.Infof("Number of nodes added: %d\nNumber of nodes removed: %d\n"+
+	if len(added) > 0 || len(removed) > 0 || len(relabeled) > 0 {
+		log.Infof("Number of nodes added: %d\nNumber of nodes removed: %d\nNumber of nodes relabeled: %d\n"+
 			"Number of nodes in newState: %d\nNumber of nodes in old state: %d\n"+
-			"(%d cluster checks with no change)", len(added), len(removed), len(newState), oldStateLen, s.nopCheckCnt)
+			"(%d cluster checks with no change)", len(added), len(removed), len(relabeled), len(newState), oldStateLen, s.nopCheckCnt)
 		s.nopCheckCnt = 0
 	} else {
 		s.nopCheckCnt++
@@ -6,7 +6,14 @@ import (
 
 const DefaultClientTimeout = time.Minute
 
+// The longest a WatchJob request waits for a change, kept under DefaultClientTimeout.
+const MaxWatchJobTimeout = 30 * time.Second
+
 const DefaultFetchFreqMin = 3 * time.Minute
 const DefaultClusterChanSize = 100
 
 const DefaultSagaUpdateChSize = 10000
+
+// Number of messages logged for a saga before its state is checkpointed,
+// when the SagaLog supports checkpoints.
+const DefaultSagaCheckpointInterval = 1000
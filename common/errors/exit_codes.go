@@ -22,4 +22,6 @@ const (
 	CouldNotExecExitCode = 240
 
 	PostExecFailureExitCode = 250
+
+	OutputIngestFailureExitCode = 260
 )
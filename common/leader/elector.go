@@ -0,0 +1,202 @@
+package leader
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Default duration of a lease, the leader renews it every third of this duration.
+const DefaultLeaseTTL = 10 * time.Second
+
+// Elector campaigns for the lease in a Lock on behalf of one process.  The process is
+// elected when it acquires the lease and is deposed, for good, when it loses the lease or
+// fails to renew it before it expires.  A deposed process must stop acting as the leader
+// and start a new Elector, with fresh state, to campaign again.
+//
+// The leader is deposed when its lease expires even if the Lock doesn't return, e.g. a flock
+// hanging on an unresponsive NFS mount, and a Lock call taking longer than the renew interval
+// is given up on until the next attempt.
+type Elector struct {
+	lock          Lock
+	id            string
+	ttl           time.Duration
+	renewInterval time.Duration
+
+	leader      bool
+	deposed     bool
+	expires     time.Time   // expiration of the lease while leader
+	expiryTimer *time.Timer // deposes the leader at expires
+	mutex       sync.RWMutex
+
+	// an attempt to acquire the lease that timed out, the next attempt waits for it
+	// instead of calling the Lock again.  Only used by the run() goroutine.
+	pending chan acquireResult
+
+	electedCh chan struct{}
+	deposedCh chan struct{}
+	stopCh    chan struct{}
+	stopOnce  sync.Once
+}
+
+type acquireResult struct {
+	started time.Time
+	lease   Lease
+	err     error
+}
+
+// Creates an Elector campaigning as id, which should be the address clients use to reach this
+// process so that a Resolver can find the leader.  The lease expires ttl after it was last renewed.
+// A zero ttl is interpreted as DefaultLeaseTTL.
+func NewElector(lock Lock, id string, ttl time.Duration) *Elector {
+	if ttl <= 0 {
+		ttl = DefaultLeaseTTL
+	}
+	return &Elector{
+		lock:          lock,
+		id:            id,
+		ttl:           ttl,
+		renewInterval: ttl / 3,
+		electedCh:     make(chan struct{}),
+		deposedCh:     make(chan struct{}),
+		stopCh:        make(chan struct{}),
+	}
+}
+
+// Starts campaigning for the lease, and renewing it once elected, in its own goroutine.
+func (e *Elector) Start() {
+	go e.run()
+}
+
+// Stops campaigning.  A leader releases the lease so a follower can take over
+// without waiting for it to expire, and is deposed.
+func (e *Elector) Stop() {
+	e.stopOnce.Do(func() {
+		close(e.stopCh)
+	})
+}
+
+// Elected returns a channel that is closed once this process is elected.
+func (e *Elector) Elected() <-chan struct{} {
+	return e.electedCh
+}
+
+// Deposed returns a channel that is closed once this process stops being the leader.
+func (e *Elector) Deposed() <-chan struct{} {
+	return e.deposedCh
+}
+
+// IsLeader returns true if this process currently holds the lease.
+func (e *Elector) IsLeader() bool {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	return e.leader && time.Now().Before(e.expires)
+}
+
+// Leader returns the id of the current leader, or "" if there is none.
+func (e *Elector) Leader() (string, error) {
+	lease, err := e.lock.Get()
+	return lease.Holder, err
+}
+
+func (e *Elector) run() {
+	ticker := time.NewTicker(e.renewInterval)
+	defer ticker.Stop()
+	for e.campaign() {
+		select {
+		case <-e.stopCh:
+			e.mutex.Lock()
+			defer e.mutex.Unlock()
+			if e.leader {
+				if err := e.lock.Release(e.id); err != nil {
+					log.Errorf("Error releasing leader lease held by %s: %v", e.id, err)
+				}
+				e.depose()
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tries to acquire or renew the lease.  Returns false once this process has been deposed.
+func (e *Elector) campaign() bool {
+	result := e.tryAcquire()
+	lease, err := result.lease, result.err
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.deposed {
+		return false
+	}
+	switch {
+	case err == nil && lease.Holder == e.id:
+		// the lease expires ttl after it was written, so measure from before the attempt
+		e.expires = result.started.Add(e.ttl)
+		if e.expiryTimer == nil {
+			e.expiryTimer = time.AfterFunc(time.Until(e.expires), e.expire)
+		} else {
+			e.expiryTimer.Reset(time.Until(e.expires))
+		}
+		if !e.leader {
+			log.Infof("%s elected leader", e.id)
+			e.leader = true
+			close(e.electedCh)
+		}
+	case err == nil && e.leader:
+		log.Errorf("%s lost the leader lease to %s", e.id, lease.Holder)
+		e.depose()
+		return false
+	case err != nil && e.leader && !time.Now().Before(e.expires):
+		log.Errorf("%s could not renew the leader lease before it expired: %v", e.id, err)
+		e.depose()
+		return false
+	case err != nil:
+		log.Errorf("Error acquiring leader lease for %s: %v", e.id, err)
+	}
+	return true
+}
+
+// Calls TryAcquire on the Lock, giving up after the renew interval.  A call that timed out
+// keeps running, and the next attempt waits for its result rather than calling the Lock again.
+func (e *Elector) tryAcquire() acquireResult {
+	if e.pending == nil {
+		e.pending = make(chan acquireResult, 1)
+		started := time.Now()
+		go func(resultCh chan acquireResult) {
+			lease, err := e.lock.TryAcquire(e.id, e.ttl)
+			resultCh <- acquireResult{started: started, lease: lease, err: err}
+		}(e.pending)
+	}
+	timer := time.NewTimer(e.renewInterval)
+	defer timer.Stop()
+	select {
+	case result := <-e.pending:
+		e.pending = nil
+		return result
+	case <-timer.C:
+		return acquireResult{err: fmt.Errorf("timed out after %s", e.renewInterval)}
+	}
+}
+
+// Deposes the leader once its lease expired, whether or not an attempt to renew it returned.
+func (e *Elector) expire() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.leader && !time.Now().Before(e.expires) {
+		log.Errorf("%s could not renew the leader lease before it expired", e.id)
+		e.depose()
+	}
+}
+
+// Must be called with the mutex held for writing.
+func (e *Elector) depose() {
+	e.leader = false
+	e.deposed = true
+	if e.expiryTimer != nil {
+		e.expiryTimer.Stop()
+	}
+	close(e.deposedCh)
+}
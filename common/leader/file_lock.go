@@ -0,0 +1,113 @@
+package leader
+
+import (
+	"encoding/json"
+	"os"
+	"syscall"
+	"time"
+)
+
+// Lock storing the lease as JSON in a file.  Every call holds an flock on the file while it
+// reads or writes the lease, so processes sharing the file, e.g. on a shared filesystem that
+// supports flock, agree on the holder.  Lease expiration uses the wall clock of the process
+// writing it, so the clocks of processes sharing the lock must be kept in sync.
+type fileLock struct {
+	fileName string
+}
+
+// Creates a Lock stored in fileName, the file is created when the lease is first acquired.
+func NewFileLock(fileName string) Lock {
+	return &fileLock{fileName: fileName}
+}
+
+func (l *fileLock) TryAcquire(holder string, ttl time.Duration) (Lease, error) {
+	var lease Lease
+	err := l.withFile(os.O_RDWR|os.O_CREATE, syscall.LOCK_EX, func(f *os.File) error {
+		current, err := readLease(f)
+		if err != nil {
+			return err
+		}
+		lease = acquire(current, holder, ttl, time.Now())
+		if lease == current {
+			return nil
+		}
+		return writeLease(f, lease)
+	})
+	return lease, err
+}
+
+func (l *fileLock) Release(holder string) error {
+	err := l.withFile(os.O_RDWR, syscall.LOCK_EX, func(f *os.File) error {
+		current, err := readLease(f)
+		if err != nil || current.Holder != holder {
+			return err
+		}
+		return writeLease(f, Lease{})
+	})
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (l *fileLock) Get() (Lease, error) {
+	var lease Lease
+	err := l.withFile(os.O_RDONLY, syscall.LOCK_SH, func(f *os.File) error {
+		current, err := readLease(f)
+		if err == nil && current.Held(time.Now()) {
+			lease = current
+		}
+		return err
+	})
+	if os.IsNotExist(err) {
+		return Lease{}, nil
+	}
+	return lease, err
+}
+
+// Opens the lease file and calls fn with the file flocked in the specified mode.
+func (l *fileLock) withFile(flag int, how int, fn func(f *os.File) error) error {
+	f, err := os.OpenFile(l.fileName, flag, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := syscall.Flock(int(f.Fd()), how); err != nil {
+		return err
+	}
+	defer syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+	return fn(f)
+}
+
+// Reads the lease from the file.  An empty file holds the zero Lease, as does a file
+// whose holder died while writing it, so the lease can be taken by the next holder.
+func readLease(f *os.File) (Lease, error) {
+	info, err := f.Stat()
+	if err != nil || info.Size() == 0 {
+		return Lease{}, err
+	}
+	buf := make([]byte, info.Size())
+	if _, err := f.ReadAt(buf, 0); err != nil {
+		return Lease{}, err
+	}
+	var lease Lease
+	if err := json.Unmarshal(buf, &lease); err != nil {
+		return Lease{}, nil
+	}
+	return lease, nil
+}
+
+func writeLease(f *os.File, lease Lease) error {
+	buf, err := json.Marshal(lease)
+	if err != nil {
+		return err
+	}
+	if err := f.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := f.WriteAt(buf, 0); err != nil {
+		return err
+	}
+	return f.Sync()
+}
@@ -0,0 +1,142 @@
+package leader
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+func TestFileLockLeaseIsExclusiveUntilExpired(t *testing.T) {
+	dirName, err := ioutil.TempDir("", "leaderlock")
+	if err != nil {
+		t.Fatalf("Unexpected Error creating temp dir %v", err)
+	}
+	defer os.RemoveAll(dirName)
+	fileName := path.Join(dirName, "lease")
+
+	// separate instances behave like separate processes sharing the file
+	a, b := NewFileLock(fileName), NewFileLock(fileName)
+	if lease, err := b.Get(); err != nil || lease.Holder != "" {
+		t.Fatalf("Expected no lease before the file exists, got %+v, %v", lease, err)
+	}
+
+	if lease, err := a.TryAcquire("a", time.Minute); err != nil || lease.Holder != "a" {
+		t.Fatalf("Expected a to acquire the lease, got %+v, %v", lease, err)
+	}
+	if lease, err := b.TryAcquire("b", time.Minute); err != nil || lease.Holder != "a" {
+		t.Errorf("Expected b to be refused the lease held by a, got %+v, %v", lease, err)
+	}
+	if lease, _ := b.Get(); lease.Holder != "a" {
+		t.Errorf("Expected a to hold the lease, got %+v", lease)
+	}
+
+	// renewing with a tiny ttl expires the lease without racing a short ttl against a slow test
+	if lease, err := a.TryAcquire("a", time.Nanosecond); err != nil || lease.Holder != "a" {
+		t.Fatalf("Expected a to renew the lease, got %+v, %v", lease, err)
+	}
+	time.Sleep(time.Millisecond)
+	if lease, _ := b.Get(); lease.Holder != "" {
+		t.Errorf("Expected the lease to have expired, got %+v", lease)
+	}
+	if lease, err := b.TryAcquire("b", time.Minute); err != nil || lease.Holder != "b" {
+		t.Fatalf("Expected b to acquire the expired lease, got %+v, %v", lease, err)
+	}
+
+	if err := a.Release("a"); err != nil {
+		t.Errorf("Unexpected Error releasing a lease that isn't held %v", err)
+	}
+	if lease, _ := a.Get(); lease.Holder != "b" {
+		t.Errorf("Expected releasing by another holder to leave the lease with b, got %+v", lease)
+	}
+	if err := b.Release("b"); err != nil {
+		t.Errorf("Unexpected Error releasing lease %v", err)
+	}
+	if lease, _ := a.Get(); lease.Holder != "" {
+		t.Errorf("Expected the released lease to be free, got %+v", lease)
+	}
+}
+
+func TestElectorTakeover(t *testing.T) {
+	lock := NewLocalLock()
+	resolver := NewResolver(lock)
+	a := NewElector(lock, "a:9090", 30*time.Millisecond)
+	b := NewElector(lock, "b:9090", 30*time.Millisecond)
+	a.Start()
+	waitFor(t, a.Elected(), "a to be elected")
+	b.Start()
+	defer b.Stop()
+
+	time.Sleep(50 * time.Millisecond)
+	if !a.IsLeader() || b.IsLeader() {
+		t.Fatalf("Expected a to remain the only leader while renewing its lease")
+	}
+	if addr, _ := resolver.Resolve(); addr != "a:9090" {
+		t.Errorf("Expected to resolve the leader a:9090, got %s", addr)
+	}
+
+	a.Stop()
+	waitFor(t, a.Deposed(), "a to be deposed")
+	waitFor(t, b.Elected(), "b to take over")
+	if addr, _ := resolver.Resolve(); addr != "b:9090" {
+		t.Errorf("Expected to resolve the new leader b:9090, got %s", addr)
+	}
+	if leader, _ := a.Leader(); a.IsLeader() || leader != "b:9090" {
+		t.Errorf("Expected a to follow b, IsLeader: %t, Leader: %s", a.IsLeader(), leader)
+	}
+}
+
+func TestElectorDeposedWhenLeaseIsLost(t *testing.T) {
+	lock := NewLocalLock().(*localLock)
+	e := NewElector(lock, "a", 30*time.Millisecond)
+	e.Start()
+	defer e.Stop()
+	waitFor(t, e.Elected(), "a to be elected")
+
+	lock.mutex.Lock()
+	lock.lease = Lease{Holder: "b", Expires: time.Now().Add(time.Minute)}
+	lock.mutex.Unlock()
+
+	waitFor(t, e.Deposed(), "a to be deposed")
+	if e.IsLeader() {
+		t.Errorf("Expected a deposed elector not to be the leader")
+	}
+}
+
+// Lock whose calls hang once hung is closed, like a flock on an unresponsive NFS mount.
+type hangingLock struct {
+	Lock
+	hung chan struct{}
+}
+
+func (l *hangingLock) TryAcquire(holder string, ttl time.Duration) (Lease, error) {
+	select {
+	case <-l.hung:
+		select {}
+	default:
+		return l.Lock.TryAcquire(holder, ttl)
+	}
+}
+
+func TestElectorDeposedWhenLockHangs(t *testing.T) {
+	lock := &hangingLock{Lock: NewLocalLock(), hung: make(chan struct{})}
+	e := NewElector(lock, "a", 30*time.Millisecond)
+	e.Start()
+	defer e.Stop()
+	waitFor(t, e.Elected(), "a to be elected")
+
+	close(lock.hung)
+	waitFor(t, e.Deposed(), "a to be deposed when its lease expired")
+	if e.IsLeader() {
+		t.Errorf("Expected a deposed elector not to be the leader")
+	}
+}
+
+func waitFor(t *testing.T, ch <-chan struct{}, what string) {
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		t.Fatalf("Timed out waiting for %s", what)
+	}
+}
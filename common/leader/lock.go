@@ -0,0 +1,79 @@
+// Package leader elects one of several processes as the leader by having them
+// compete for a lease stored in a Lock shared by all of the processes.
+package leader
+
+import (
+	"sync"
+	"time"
+)
+
+// Lease is held by the leader until it expires, unless it is renewed.
+type Lease struct {
+	Holder  string
+	Expires time.Time
+}
+
+// Returns true if the lease is held by someone at time t.
+func (l Lease) Held(t time.Time) bool {
+	return l.Holder != "" && t.Before(l.Expires)
+}
+
+// Lock stores a lease that at most one holder owns at a time.  Implementations must
+// make each call atomic with respect to calls made by other processes sharing the lock.
+type Lock interface {
+	// TryAcquire takes the lease for holder if it is not held, or renews it if
+	// holder already owns it, so that it expires after ttl.
+	// Returns the lease as of the end of the call, holder is the leader if it owns the lease.
+	TryAcquire(holder string, ttl time.Duration) (Lease, error)
+
+	// Release gives up the lease if it is owned by holder, so another holder can take it
+	// without waiting for it to expire.
+	Release(holder string) error
+
+	// Get returns the current lease, or the zero Lease if it is not held.
+	Get() (Lease, error)
+}
+
+// In memory Lock, used to elect a leader among goroutines in a single process.
+type localLock struct {
+	lease Lease
+	mutex sync.Mutex
+}
+
+// Creates a Lock that is only shared within this process, intended for tests.
+func NewLocalLock() Lock {
+	return &localLock{}
+}
+
+func (l *localLock) TryAcquire(holder string, ttl time.Duration) (Lease, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.lease = acquire(l.lease, holder, ttl, time.Now())
+	return l.lease, nil
+}
+
+func (l *localLock) Release(holder string) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.lease.Holder == holder {
+		l.lease = Lease{}
+	}
+	return nil
+}
+
+func (l *localLock) Get() (Lease, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if !l.lease.Held(time.Now()) {
+		return Lease{}, nil
+	}
+	return l.lease, nil
+}
+
+// Returns the lease after holder tries to acquire the current lease at time now.
+func acquire(current Lease, holder string, ttl time.Duration, now time.Time) Lease {
+	if current.Held(now) && current.Holder != holder {
+		return current
+	}
+	return Lease{Holder: holder, Expires: now.Add(ttl)}
+}
@@ -0,0 +1,29 @@
+package leader
+
+// Resolver implements dialer.Resolver, resolving the id of the current leader
+// as the address of the service.  Clients that resolve the address again after
+// an error follow the leader when it changes.
+type Resolver struct {
+	lock Lock
+}
+
+// Creates a Resolver for the leader elected with lock.
+func NewResolver(lock Lock) *Resolver {
+	return &Resolver{lock: lock}
+}
+
+// Resolve returns the current leader, or "" if there is none.
+func (r *Resolver) Resolve() (string, error) {
+	lease, err := r.lock.Get()
+	return lease.Holder, err
+}
+
+// ResolveMany returns the current leader in a slice, there is at most one.
+func (r *Resolver) ResolveMany(n int) ([]string, error) {
+	all := []string{}
+	s, err := r.Resolve()
+	if s != "" {
+		all = append(all, s)
+	}
+	return all, err
+}
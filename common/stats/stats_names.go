@@ -12,11 +12,15 @@ const (
 		Free - available, not running
 		Running - running tasks
 		Lost - not responding to status requests
+		Draining - shutting down, not taking new tasks
+		Drained - counter of nodes that left the cluster after draining
 	*/
 	ClusterAvailableNodes = "availableNodes"
 	ClusterFreeNodes      = "freeNodes"
 	ClusterRunningNodes   = "runningNodes"
 	ClusterLostNodes      = "lostNodes"
+	ClusterDrainingNodes  = "drainingNodes"
+	ClusterDrainedNodes   = "drainedNodes"
 
 	ClusterNodeUpdateFreqMs = "clusterSetNodeUpdatesFreq_ms"
 	ClusterFetchFreqMs      = "clusterFetchFreq_ms"
@@ -145,6 +149,47 @@ const (
 	*/
 	SchedCompletedTaskCounter = "completedTaskCounter"
 
+	/*
+		the number of tasks that were never run because one of their dependencies failed
+	*/
+	SchedSkippedTaskCounter = "skippedTaskCounter"
+
+	/*
+		the number of jobs that were killed or had a task fail and were rolled back by running their cleanup tasks
+	*/
+	SchedRolledBackJobsCounter = "rolledBackJobsCounter"
+
+	/*
+		the number of cleanup tasks (compensating tasks) run while rolling back jobs
+	*/
+	SchedCleanupTaskCounter = "cleanupTaskCounter"
+
+	/*
+		the number of failed task runs that were retried, per the task's retry policy
+	*/
+	SchedRetriedTaskCounter = "retriedTaskCounter"
+
+	/*
+		the number of task runs that failed because of the platform, e.g. checkout or output upload failures,
+		or the worker not responding
+	*/
+	SchedInfraFailureTaskCounter = "infraFailureTaskCounter"
+
+	/*
+		the number of task runs whose command failed, by exiting with a nonzero exit code or timing out
+	*/
+	SchedUserFailureTaskCounter = "userFailureTaskCounter"
+
+	/*
+		the number of speculative attempts started for straggling tasks
+	*/
+	SchedSpeculativeTaskCounter = "speculativeTaskCounter"
+
+	/*
+		the number of speculative attempts that finished before the straggling run they duplicated
+	*/
+	SchedSpeculativeWinsCounter = "speculativeTaskWinsCounter"
+
 	/*
 		The number of times any of the following conditions occurred:
 		- the task's command errored while running
@@ -179,6 +224,11 @@ const (
 	*/
 	SchedJobRequestsCounter = "schedJobRequestsCounter"
 
+	/*
+		the number of times the scheduler was elected leader and took over scheduling
+	*/
+	SchedLeaderTakeoverCounter = "schedLeaderTakeoverCounter"
+
 	/*
 		the number of async runners still waiting on task completion
 	*/
@@ -200,6 +250,12 @@ const (
 	*/
 	SchedNumWaitingTasksGauge = "schedNumWaitingTasksGauge"
 
+	/*
+		the number of waiting tasks that no node can run because none matches their required labels.
+		Collected each time through the scheduler's job handling loop.
+	*/
+	SchedNumUnschedulableTasksGauge = "schedNumUnschedulableTasksGauge"
+
 	/*
 		the number of active tasks that stopped because they were preempted by the scheduler
 	*/
@@ -241,6 +297,46 @@ const (
 	*/
 	SchedServerJobStatusLatency_ms = "jobStatusLatency_ms"
 
+	/*
+		the number of watch job requests the thrift server received
+	*/
+	SchedServerWatchJobCounter = "watchJobRpmCounter"
+
+	/*
+		the amount of time a watch job request waited before returning (from the server)
+	*/
+	SchedServerWatchJobLatency_ms = "watchJobLatency_ms"
+
+	/*
+		the number of list jobs requests the thrift server received
+	*/
+	SchedServerListJobsCounter = "listJobsRpmCounter"
+
+	/*
+		the amount of time it takes to list jobs (from the server)
+	*/
+	SchedServerListJobsLatency_ms = "listJobsLatency_ms"
+
+	/*
+		the number of explain job requests the thrift server received
+	*/
+	SchedServerExplainJobCounter = "explainJobRpmCounter"
+
+	/*
+		the amount of time it takes to explain a job (from the server)
+	*/
+	SchedServerExplainJobLatency_ms = "explainJobLatency_ms"
+
+	/*
+		the number of cluster state requests the thrift server received
+	*/
+	SchedServerGetClusterStateCounter = "getClusterStateRpmCounter"
+
+	/*
+		the amount of time it takes to get the cluster state (from the server)
+	*/
+	SchedServerGetClusterStateLatency_ms = "getClusterStateLatency_ms"
+
 	/*
 		the number of job run requests the thrift server received
 	*/
@@ -404,6 +500,22 @@ const (
 	*/
 	WorkerDownloadLatency_ms = "workerDownloadLatency_ms"
 
+	/*
+		the number of times the worker ingested a task's outputs into a new snapshot
+	*/
+	WorkerIngests = "workerIngests"
+
+	/*
+		the number of times ingesting a task's outputs into a new snapshot failed
+	*/
+	WorkerIngestFailures = "workerIngestFailures"
+
+	/*
+		the amount of time spent ingesting a task's outputs into a new snapshot.  This includes time for
+		successful as well as erroring ingests
+	*/
+	WorkerIngestLatency_ms = "workerIngestLatency_ms"
+
 	/*
 		The number of runs in the worker's statusAll() response that are not currently running
 		TODO - this includes runs that are waiting to start - will not be accurate if we go to a
@@ -166,8 +166,8 @@ func (st *SchedulingAlgTester) RunTest() error {
 		nil,
 		nil,
 	)
-	s.SetClassLoadPercents(st.classLoadPercents)
-	s.SetRequestorToClassMap(st.requestorToClassMap)
+	s.SetClassLoadPercents(st.classLoadPercents, "")
+	s.SetRequestorToClassMap(st.requestorToClassMap, "")
 
 	sc := s.GetSagaCoord()
 
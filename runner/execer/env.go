@@ -0,0 +1,72 @@
+package execer
+
+import (
+	"sort"
+	"strings"
+)
+
+// How a command's environment is built from the execer's, the command's EnvVars are always added last.
+type EnvMode int
+
+const (
+	// The execer's whole environment.
+	EnvInherit EnvMode = iota
+	// Only the execer's variables named in the command's allowlist.
+	EnvAllowlist
+	// None of the execer's variables, the command only sees the base environment and its EnvVars.
+	EnvClean
+)
+
+func (m EnvMode) String() string {
+	switch m {
+	case EnvInherit:
+		return "INHERIT"
+	case EnvAllowlist:
+		return "ALLOWLIST"
+	case EnvClean:
+		return "CLEAN"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// The value reported in place of a secret env var's.
+const RedactedValue = "<redacted>"
+
+// Execers that can tell the environment they'd run a command with, so it can be recorded.
+type EnvBuilder interface {
+	// Env returns the command's whole environment as sorted key=value pairs, with the values
+	// of its SecretEnv replaced by RedactedValue.
+	Env(command Command) []string
+}
+
+// BuildEnv returns the variables of inherited, key=value pairs like os.Environ() returns, that the
+// mode keeps, overridden by base then vars, as sorted key=value pairs.
+func BuildEnv(inherited []string, mode EnvMode, allowlist []string, base, vars map[string]string) []string {
+	env := map[string]string{}
+	if mode != EnvClean {
+		allowed := map[string]bool{}
+		for _, k := range allowlist {
+			allowed[k] = true
+		}
+		for _, kv := range inherited {
+			parts := strings.SplitN(kv, "=", 2)
+			if len(parts) != 2 || (mode == EnvAllowlist && !allowed[parts[0]]) {
+				continue
+			}
+			env[parts[0]] = parts[1]
+		}
+	}
+	for k, v := range base {
+		env[k] = v
+	}
+	for k, v := range vars {
+		env[k] = v
+	}
+	result := make([]string, 0, len(env))
+	for k, v := range env {
+		result = append(result, k+"="+v)
+	}
+	sort.Strings(result)
+	return result
+}
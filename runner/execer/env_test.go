@@ -0,0 +1,30 @@
+package execer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildEnv(t *testing.T) {
+	inherited := []string{"PATH=/bin", "HOME=/home/worker", "SECRET=s3cr3t", "EQ=a=b", "MALFORMED"}
+	base := map[string]string{"PATH": "/usr/bin:/bin", "LANG": "C"}
+	vars := map[string]string{"LANG": "en_US.UTF-8", "FOO": "bar"}
+
+	for _, test := range []struct {
+		mode      EnvMode
+		allowlist []string
+		expected  []string
+	}{
+		{EnvInherit, nil, []string{"EQ=a=b", "FOO=bar", "HOME=/home/worker", "LANG=en_US.UTF-8", "PATH=/usr/bin:/bin", "SECRET=s3cr3t"}},
+		{EnvAllowlist, []string{"HOME", "EQ", "MISSING"}, []string{"EQ=a=b", "FOO=bar", "HOME=/home/worker", "LANG=en_US.UTF-8", "PATH=/usr/bin:/bin"}},
+		{EnvAllowlist, nil, []string{"FOO=bar", "LANG=en_US.UTF-8", "PATH=/usr/bin:/bin"}},
+		{EnvClean, []string{"HOME"}, []string{"FOO=bar", "LANG=en_US.UTF-8", "PATH=/usr/bin:/bin"}},
+	} {
+		if env := BuildEnv(inherited, test.mode, test.allowlist, base, vars); !reflect.DeepEqual(env, test.expected) {
+			t.Errorf("%s %v: expected %v, got %v", test.mode, test.allowlist, test.expected, env)
+		}
+	}
+	if env := BuildEnv(nil, EnvClean, nil, nil, nil); len(env) != 0 {
+		t.Errorf("Expected an empty environment, got %v", env)
+	}
+}
@@ -2,6 +2,7 @@ package execer
 
 import (
 	"io"
+	"time"
 
 	"github.com/twitter/scoot/common/errors"
 	"github.com/twitter/scoot/common/log/tags"
@@ -21,7 +22,54 @@ type Command struct {
 	Stdout  io.Writer
 	Stderr  io.Writer
 	MemCh   chan ProcessStatus
+	Limits  Limits // Lowers the execer's limits for this command, if it enforces limits.
 	tags.LogTags
+
+	// How the environment EnvVars are added to is built, EnvAllowlist is used by EnvAllowlist.
+	EnvMode      EnvMode
+	EnvAllowlist []string
+	// Env vars set to the value of the named secret, which the execer resolves and never reports.
+	SecretEnv map[string]string
+}
+
+// Limits on the resources a command and its children can use, zero values aren't limited.
+type Limits struct {
+	MemoryBytes int64
+	CPUMillis   int64 // thousandths of a CPU core
+	Pids        int64 // number of processes and threads
+}
+
+// Min returns the lower of the limits in each dimension, a zero value is higher than any other.
+func (l Limits) Min(o Limits) Limits {
+	min := func(a, b int64) int64 {
+		if a == 0 || (b != 0 && b < a) {
+			return b
+		}
+		return a
+	}
+	return Limits{
+		MemoryBytes: min(l.MemoryBytes, o.MemoryBytes),
+		CPUMillis:   min(l.CPUMillis, o.CPUMillis),
+		Pids:        min(l.Pids, o.Pids),
+	}
+}
+
+// Why an execer killed a process.
+type KillReason string
+
+const (
+	// The process wasn't killed by the execer.
+	NotKilled KillReason = ""
+	// The process exceeded the execer's memory cap, as measured by polling its memory usage.
+	KilledMemCap KillReason = "MemCap"
+	// The process exceeded its cgroup's memory limit and the kernel's OOM killer killed it.
+	KilledOOM KillReason = "OOM"
+)
+
+// Resources used by a process and its children, as measured by execers that can.
+type Usage struct {
+	PeakMemoryBytes int64
+	CPUTime         time.Duration
 }
 
 type ProcessState int
@@ -69,4 +117,9 @@ type ProcessStatus struct {
 	State    ProcessState
 	ExitCode errors.ExitCode
 	Error    string
+
+	// Set if the execer killed the process for exceeding its limits.
+	KillReason KillReason
+	// Set by execers that measure the resources their processes use.
+	Usage *Usage
 }
@@ -20,6 +20,18 @@ func (e *InterceptExecer) Exec(command execer.Command) (execer.Process, error) {
 	}
 }
 
+// Env returns the environment of the execer the command is sent to, nil if it can't tell.
+func (e *InterceptExecer) Env(command execer.Command) []string {
+	ex := e.Default
+	if e.Condition(command) {
+		ex = e.Interceptor
+	}
+	if b, ok := ex.(execer.EnvBuilder); ok {
+		return b.Env(command)
+	}
+	return nil
+}
+
 // Returns whether cmd's first arg starts with UseSimExecerArg
 func StartsWithSimExecer(cmd execer.Command) bool {
 	return len(cmd.Argv) > 0 && cmd.Argv[0] == UseSimExecerArg
@@ -0,0 +1,263 @@
+package os
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	scootexecer "github.com/twitter/scoot/runner/execer"
+)
+
+// The cgroup v2 controllers a command's cgroup is limited with.
+var cgroupControllers = []string{"memory", "cpu", "pids"}
+
+// The cpu.max period, cpu limits are the share of it a command's cgroup can use.
+const cgroupCPUPeriodUsec = 100000
+
+// How long to wait for the processes of a cgroup to exit after killing them, before giving up removing it.
+const cgroupRemoveTimeout = 5 * time.Second
+
+// The argv[0] the worker is re-executed with to start a command in its cgroup, see MaybeRunExecerInit().
+const cgroupInitArg0 = "scoot-cgroup-init"
+
+// The exit code of a cgroup init that wasn't moved into its cgroup or couldn't exec the command.
+const cgroupInitFailedExitCode = 125
+
+// CgroupConfig configures an execer to run each command in its own cgroup v2 leaf, where the
+// kernel enforces the command's limits instead of the execer polling its memory usage.
+// Commands are held by a re-execution of the binary running the execer until they're in their
+// cgroup, so the binary must call MaybeRunExecerInit() first thing in its main().
+type CgroupConfig struct {
+	// The cgroup under which the commands' cgroups are created, like /sys/fs/cgroup/scoot.
+	// It must be in a cgroup v2 hierarchy, writable by the worker, with the memory, cpu and pids
+	// controllers available to it.
+	Parent string
+	// Limits of every command, lowered by a command's own limits.
+	Limits scootexecer.Limits
+}
+
+// Creates a cgroup per command under the parent cgroup.
+type cgroupManager struct {
+	parent string
+	limits scootexecer.Limits
+	seq    uint64
+}
+
+// Checks that the parent is a usable cgroup v2 and enables the controllers for its children.
+func newCgroupManager(config CgroupConfig) (*cgroupManager, error) {
+	if config.Parent == "" {
+		return nil, errors.New("no parent cgroup")
+	}
+	if _, err := os.Stat(config.Parent); os.IsNotExist(err) {
+		// create the parent in an existing cgroup, not in a directory that happens to be there
+		if _, err := os.Stat(filepath.Join(filepath.Dir(config.Parent), "cgroup.controllers")); err != nil {
+			return nil, fmt.Errorf("%s isn't in a cgroup v2: %s", config.Parent, err)
+		}
+		if err := os.Mkdir(config.Parent, 0755); err != nil {
+			return nil, err
+		}
+	}
+	content, err := ioutil.ReadFile(filepath.Join(config.Parent, "cgroup.controllers"))
+	if err != nil {
+		return nil, fmt.Errorf("%s isn't a cgroup v2: %s", config.Parent, err)
+	}
+	available := strings.Fields(string(content))
+	enable := []string{}
+	for _, c := range cgroupControllers {
+		if !stringInSlice(c, available) {
+			return nil, fmt.Errorf("cgroup %s doesn't have the %s controller, it has %v", config.Parent, c, available)
+		}
+		enable = append(enable, "+"+c)
+	}
+	// the controllers are only enabled in a cgroup without processes of its own
+	if err := writeCgroupFile(config.Parent, "cgroup.subtree_control", strings.Join(enable, " ")); err != nil {
+		return nil, err
+	}
+	m := &cgroupManager{parent: config.Parent, limits: config.Limits}
+	// make sure the limits can be set before relying on them
+	probe, err := m.create(scootexecer.Limits{})
+	if err != nil {
+		return nil, err
+	}
+	probe.remove()
+	return m, nil
+}
+
+// Creates a cgroup with the manager's limits lowered by the given limits.
+func (m *cgroupManager) create(limits scootexecer.Limits) (*cgroup, error) {
+	name := fmt.Sprintf("cmd-%d-%d", os.Getpid(), atomic.AddUint64(&m.seq, 1))
+	cg := &cgroup{path: filepath.Join(m.parent, name), limits: m.limits.Min(limits)}
+	if err := os.Mkdir(cg.path, 0755); err != nil {
+		return nil, err
+	}
+	if err := cg.setLimits(); err != nil {
+		cg.remove()
+		return nil, err
+	}
+	return cg, nil
+}
+
+// A command's cgroup.
+type cgroup struct {
+	path       string
+	limits     scootexecer.Limits
+	removeOnce sync.Once
+}
+
+func (cg *cgroup) setLimits() error {
+	memoryMax, pidsMax, cpuMax := "max", "max", "max"
+	if cg.limits.MemoryBytes > 0 {
+		memoryMax = strconv.FormatInt(cg.limits.MemoryBytes, 10)
+	}
+	if cg.limits.Pids > 0 {
+		pidsMax = strconv.FormatInt(cg.limits.Pids, 10)
+	}
+	if cg.limits.CPUMillis > 0 {
+		cpuMax = strconv.FormatInt(cg.limits.CPUMillis*cgroupCPUPeriodUsec/1000, 10)
+	}
+	for file, value := range map[string]string{
+		"memory.max": memoryMax,
+		"pids.max":   pidsMax,
+		"cpu.max":    fmt.Sprintf("%s %d", cpuMax, cgroupCPUPeriodUsec),
+		// kill the whole command when one of its processes runs out of memory, as the memory cap does
+		"memory.oom.group": "1",
+	} {
+		if err := writeCgroupFile(cg.path, file, value); err != nil {
+			return err
+		}
+	}
+	// without swap the memory limit is what the command can use, not all kernels account for swap
+	if cg.limits.MemoryBytes > 0 {
+		writeCgroupFile(cg.path, "memory.swap.max", "0")
+	}
+	return nil
+}
+
+// Returns true if the kernel killed processes for exceeding the memory limit.
+func (cg *cgroup) oomKilled() bool {
+	events, err := readCgroupKeyValues(cg.path, "memory.events")
+	return err == nil && events["oom_kill"] > 0
+}
+
+// Returns the peak memory and the cpu time used so far, nil if they couldn't be read.
+func (cg *cgroup) usage() *scootexecer.Usage {
+	peak, err := readCgroupInt(cg.path, "memory.peak")
+	if err != nil {
+		// memory.peak is only in newer kernels, settle for the current usage
+		if peak, err = readCgroupInt(cg.path, "memory.current"); err != nil {
+			return nil
+		}
+	}
+	cpu, err := readCgroupKeyValues(cg.path, "cpu.stat")
+	if err != nil {
+		return nil
+	}
+	return &scootexecer.Usage{
+		PeakMemoryBytes: peak,
+		CPUTime:         time.Duration(cpu["usage_usec"]) * time.Microsecond,
+	}
+}
+
+// Returns the ids of the processes in the cgroup.
+func (cg *cgroup) procs() ([]int, error) {
+	content, err := ioutil.ReadFile(filepath.Join(cg.path, "cgroup.procs"))
+	if err != nil {
+		return nil, err
+	}
+	pids := []int{}
+	for _, field := range strings.Fields(string(content)) {
+		if pid, err := strconv.Atoi(field); err == nil {
+			pids = append(pids, pid)
+		}
+	}
+	return pids, nil
+}
+
+// Kills all processes in the cgroup, including the ones that left the command's process group.
+func (cg *cgroup) kill() {
+	// cgroup.kill is only in newer kernels, else the processes are killed one by one
+	if err := writeCgroupFile(cg.path, "cgroup.kill", "1"); err == nil {
+		return
+	}
+	pids, _ := cg.procs()
+	for _, pid := range pids {
+		syscall.Kill(pid, syscall.SIGKILL)
+	}
+}
+
+// Kills the processes left in the cgroup and removes it, once.
+func (cg *cgroup) remove() {
+	cg.removeOnce.Do(func() {
+		cg.kill()
+		var err error
+		for deadline := time.Now().Add(cgroupRemoveTimeout); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+			// the cgroup is busy until its killed processes exited
+			if err = os.Remove(cg.path); err == nil || os.IsNotExist(err) {
+				return
+			} else if !errors.Is(err, syscall.EBUSY) {
+				break
+			}
+		}
+		log.WithFields(
+			log.Fields{
+				"cgroup": cg.path,
+				"error":  err,
+			}).Error("Error removing cgroup")
+	})
+}
+
+func writeCgroupFile(dir, file, value string) error {
+	if err := ioutil.WriteFile(filepath.Join(dir, file), []byte(value), 0644); err != nil {
+		return fmt.Errorf("couldn't write %q to %s: %s", value, filepath.Join(dir, file), err)
+	}
+	return nil
+}
+
+func readCgroupInt(dir, file string) (int64, error) {
+	content, err := ioutil.ReadFile(filepath.Join(dir, file))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+}
+
+// Reads a file of 'key value' lines, like memory.events and cpu.stat.
+func readCgroupKeyValues(dir, file string) (map[string]int64, error) {
+	f, err := os.Open(filepath.Join(dir, file))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	values := map[string]int64{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		if v, err := strconv.ParseInt(fields[1], 10, 64); err == nil {
+			values[fields[0]] = v
+		}
+	}
+	return values, scanner.Err()
+}
+
+func stringInSlice(s string, slice []string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
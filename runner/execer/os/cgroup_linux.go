@@ -0,0 +1,76 @@
+package os
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"syscall"
+)
+
+// Makes cmd start as the cgroup init, the worker re-executed to wait until the execer moved it into
+// the cgroup before it execs the command, so neither the command nor its children run before
+// they're limited.  The returned gate must be released once cmd started, and closed.
+func (cg *cgroup) startIn(cmd *exec.Cmd) (*cgroupGate, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	fd := 3 + len(cmd.ExtraFiles)
+	cmd.ExtraFiles = append(cmd.ExtraFiles, r)
+	cmd.Args = append([]string{cgroupInitArg0, strconv.Itoa(fd), cmd.Path}, cmd.Args...)
+	cmd.Path = "/proc/self/exe"
+	return &cgroupGate{cgroup: cg, r: r, w: w}, nil
+}
+
+// Holds a command started by the cgroup init until it's in its cgroup.
+type cgroupGate struct {
+	cgroup *cgroup
+	r, w   *os.File
+}
+
+// Moves the started cgroup init into the cgroup and lets it exec the command.
+func (g *cgroupGate) release(pid int) error {
+	g.r.Close()
+	if err := writeCgroupFile(g.cgroup.path, "cgroup.procs", strconv.Itoa(pid)); err != nil {
+		return err
+	}
+	_, err := g.w.Write([]byte{0})
+	return err
+}
+
+// Closes the pipe, a cgroup init that wasn't released exits without running the command.
+func (g *cgroupGate) close() {
+	g.r.Close()
+	g.w.Close()
+}
+
+// Runs in the cgroup init: waits for the execer to release it, then execs the command
+// in place of the init and returns only if it couldn't.
+func runCgroupInit(args []string) int {
+	if len(args) < 3 {
+		reportInitFailure(cgroupInitArg0, "no command")
+		return cgroupInitFailedExitCode
+	}
+	fd, err := strconv.Atoi(args[0])
+	if err != nil {
+		reportInitFailure(cgroupInitArg0, "invalid gate fd %q", args[0])
+		return cgroupInitFailedExitCode
+	}
+	gate := os.NewFile(uintptr(fd), "cgroup-gate")
+	if _, err := io.ReadFull(gate, make([]byte, 1)); err != nil {
+		reportInitFailure(cgroupInitArg0, "the execer couldn't move the command into its cgroup")
+		return cgroupInitFailedExitCode
+	}
+	gate.Close()
+
+	path, argv := args[1], args[2:]
+	if argv[0] == sandboxInitArg0 {
+		passInitStatus()
+	} else {
+		closeInitStatusOnExec()
+	}
+	err = syscall.Exec(path, argv, os.Environ())
+	reportInitFailure(cgroupInitArg0, "couldn't exec %v: %s", argv, err)
+	return cgroupInitFailedExitCode
+}
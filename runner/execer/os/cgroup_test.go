@@ -0,0 +1,185 @@
+package os
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	scootexecer "github.com/twitter/scoot/runner/execer"
+)
+
+// The cgroup files are plain files in a temp dir, the kernel isn't involved.
+func TestCgroupLimitsAndUsage(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "cgroup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	parent := filepath.Join(tmpDir, "scoot")
+
+	if _, err := newCgroupManager(CgroupConfig{Parent: parent}); err == nil {
+		t.Fatalf("Expected an error for a parent that isn't a cgroup")
+	}
+	if err := os.Mkdir(parent, 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, parent, "cgroup.controllers", "cpuset cpu io memory")
+	if _, err := newCgroupManager(CgroupConfig{Parent: parent}); err == nil {
+		t.Fatalf("Expected an error for a parent without the pids controller")
+	}
+	writeFile(t, parent, "cgroup.controllers", "cpuset cpu io memory pids")
+	m, err := newCgroupManager(CgroupConfig{Parent: parent, Limits: scootexecer.Limits{MemoryBytes: 1 << 30, Pids: 100}})
+	if err != nil {
+		t.Fatalf("Unexpected error creating the cgroup manager: %v", err)
+	}
+	if subtree := readFile(t, parent, "cgroup.subtree_control"); subtree != "+memory +cpu +pids" {
+		t.Errorf("Expected the controllers to be enabled, got %q", subtree)
+	}
+
+	// the command's limits lower the manager's
+	cg, err := m.create(scootexecer.Limits{MemoryBytes: 1 << 20, CPUMillis: 1500, Pids: 1000})
+	if err != nil {
+		t.Fatalf("Unexpected error creating a cgroup: %v", err)
+	}
+	for file, expected := range map[string]string{
+		"memory.max":       "1048576",
+		"memory.swap.max":  "0",
+		"memory.oom.group": "1",
+		"cpu.max":          "150000 100000",
+		"pids.max":         "100",
+	} {
+		if value := readFile(t, cg.path, file); value != expected {
+			t.Errorf("Expected %s to be %q, got %q", file, expected, value)
+		}
+	}
+	unlimited, err := m.create(scootexecer.Limits{})
+	if err != nil {
+		t.Fatalf("Unexpected error creating a cgroup: %v", err)
+	}
+	if value := readFile(t, unlimited.path, "cpu.max"); value != "max 100000" {
+		t.Errorf("Expected no cpu limit, got %q", value)
+	}
+
+	if cg.oomKilled() || cg.usage() != nil {
+		t.Errorf("Expected no OOM kill or usage before the cgroup files exist")
+	}
+	writeFile(t, cg.path, "memory.events", "low 0\nhigh 0\nmax 3\noom 1\noom_kill 1\n")
+	writeFile(t, cg.path, "memory.current", "4096\n")
+	writeFile(t, cg.path, "cpu.stat", "usage_usec 2500000\nuser_usec 2000000\nsystem_usec 500000\n")
+	if !cg.oomKilled() {
+		t.Errorf("Expected an OOM kill")
+	}
+	if usage := cg.usage(); usage == nil || usage.PeakMemoryBytes != 4096 || usage.CPUTime != 2500*time.Millisecond {
+		t.Errorf("Unexpected usage %+v", usage)
+	}
+	writeFile(t, cg.path, "memory.peak", "8192\n")
+	if usage := cg.usage(); usage == nil || usage.PeakMemoryBytes != 8192 {
+		t.Errorf("Expected the peak memory, got %+v", usage)
+	}
+}
+
+// The cgroup init re-executes the test binary, which moves into a plain file instead of a cgroup.
+func TestCgroupInitStartsCommandOnceInCgroup(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "cgroup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	parent := filepath.Join(tmpDir, "scoot")
+	if err := os.Mkdir(parent, 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, parent, "cgroup.controllers", "cpu memory pids")
+	m, err := newCgroupManager(CgroupConfig{Parent: parent})
+	if err != nil {
+		t.Fatalf("Unexpected error creating the cgroup manager: %v", err)
+	}
+
+	e := NewBoundedExecer(0, nil, nil)
+	e.cgroups = m
+	var stdout, stderr bytes.Buffer
+	p, err := e.Exec(scootexecer.Command{
+		Argv:   []string{"sh", "-c", "cat cgroup.procs; echo; echo $$"},
+		Dir:    filepath.Join(parent, "cmd-"+strconv.Itoa(os.Getpid())+"-2"),
+		Stdout: &stdout,
+		Stderr: &stderr,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error starting the command: %v", err)
+	}
+	if st := p.Wait(); st.ExitCode != 0 {
+		t.Fatalf("Expected the command to succeed, got %+v, stdout %q, stderr %q", st, stdout.String(), stderr.String())
+	}
+	// the command replaced the cgroup init, which was in the cgroup before the command ran
+	lines := strings.Fields(stdout.String())
+	pid := strconv.Itoa(p.(*process).cmd.Process.Pid)
+	if len(lines) != 2 || lines[0] != pid || lines[1] != pid {
+		t.Errorf("Expected the command to run as pid %s in the cgroup, got %q", pid, stdout.String())
+	}
+}
+
+// A cgroup init that can't run the command fails the run, a command exiting with the init's exit code doesn't.
+func TestCgroupInitFailureIsReported(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "cgroup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	parent := filepath.Join(tmpDir, "scoot")
+	if err := os.Mkdir(parent, 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, parent, "cgroup.controllers", "cpu memory pids")
+	m, err := newCgroupManager(CgroupConfig{Parent: parent})
+	if err != nil {
+		t.Fatalf("Unexpected error creating the cgroup manager: %v", err)
+	}
+	e := NewBoundedExecer(0, nil, nil)
+	e.cgroups = m
+
+	var stdout, stderr bytes.Buffer
+	p, err := e.Exec(scootexecer.Command{
+		Argv:   []string{filepath.Join(tmpDir, "missing")},
+		Dir:    filepath.Join(parent, "cmd-"+strconv.Itoa(os.Getpid())+"-1"),
+		Stdout: &stdout,
+		Stderr: &stderr,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error starting the cgroup init: %v", err)
+	}
+	if st := p.Wait(); st.State != scootexecer.FAILED || !strings.Contains(st.Error, "couldn't exec") {
+		t.Errorf("Expected the run to fail with the init's error, got %+v", st)
+	}
+
+	p, err = e.Exec(scootexecer.Command{
+		Argv:   []string{"sh", "-c", "exit " + strconv.Itoa(cgroupInitFailedExitCode)},
+		Dir:    filepath.Join(parent, "cmd-"+strconv.Itoa(os.Getpid())+"-2"),
+		Stdout: &stdout,
+		Stderr: &stderr,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error starting the command: %v", err)
+	}
+	if st := p.Wait(); st.State != scootexecer.COMPLETE || st.ExitCode != cgroupInitFailedExitCode {
+		t.Errorf("Expected the command's own exit code, got %+v", st)
+	}
+}
+
+func writeFile(t *testing.T, dir, file, content string) {
+	if err := ioutil.WriteFile(filepath.Join(dir, file), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readFile(t *testing.T, dir, file string) string {
+	content, err := ioutil.ReadFile(filepath.Join(dir, file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.TrimSpace(string(content))
+}
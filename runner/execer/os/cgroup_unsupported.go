@@ -0,0 +1,27 @@
+//go:build !linux
+// +build !linux
+
+package os
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+func (cg *cgroup) startIn(cmd *exec.Cmd) (*cgroupGate, error) {
+	return nil, fmt.Errorf("cgroups aren't supported on %s", runtime.GOOS)
+}
+
+type cgroupGate struct{}
+
+func (g *cgroupGate) release(pid int) error {
+	return fmt.Errorf("cgroups aren't supported on %s", runtime.GOOS)
+}
+
+func (g *cgroupGate) close() {}
+
+func runCgroupInit(args []string) int {
+	reportInitFailure(cgroupInitArg0, "cgroups aren't supported on %s", runtime.GOOS)
+	return cgroupInitFailedExitCode
+}
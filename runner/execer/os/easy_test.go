@@ -84,6 +84,30 @@ func TestOutput(t *testing.T) {
 	}
 }
 
+func TestEnvMode(t *testing.T) {
+	e := NewBoundedExecer(0, nil, nil)
+	e.SetBaseEnv(map[string]string{"BASE": "base", "FOO": "base"})
+
+	var stdout bytes.Buffer
+	cmd := scootexecer.Command{
+		Argv:    []string{"env"},
+		EnvVars: map[string]string{"FOO": "bar"},
+		Stdout:  &stdout,
+		Stderr:  &bytes.Buffer{},
+		EnvMode: scootexecer.EnvClean,
+	}
+	p, err := e.Exec(cmd)
+	if err != nil {
+		t.Fatalf("Couldn't run env %v", err)
+	}
+	if status := p.Wait(); status.State != scootexecer.COMPLETE || status.ExitCode != 0 {
+		t.Fatalf("Got unexpected status running env %v", status)
+	}
+	if stdout.String() != "BASE=base\nFOO=bar\n" {
+		t.Fatalf("Expected only the base env and the command's env vars, got %q", stdout.String())
+	}
+}
+
 func TestMemUsage(t *testing.T) {
 	// Command to increase memory by 1MB every .1s until we hit 50MB after 5s.
 	// Creates a bash process and under that a python process. They should both contribute to MemUsage.
@@ -128,6 +152,24 @@ func TestMemUsage(t *testing.T) {
 	}
 }
 
+// Commands run at the same time, as by a packing runner, have their memory monitored concurrently.
+func TestConcurrentMemMonitoring(t *testing.T) {
+	e := NewBoundedExecer(scootexecer.Memory(1<<40), nil, stats.NilStatsReceiver())
+	procs := []scootexecer.Process{}
+	for i := 0; i < 3; i++ {
+		p, err := e.Exec(scootexecer.Command{Argv: []string{"sleep", "1.2"}})
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+		procs = append(procs, p)
+	}
+	for _, p := range procs {
+		if st := p.Wait(); st.State != scootexecer.COMPLETE || st.ExitCode != 0 {
+			t.Errorf("Expected the command to complete, got %+v", st)
+		}
+	}
+}
+
 func TestMemCap(t *testing.T) {
 	// Command to increase memory by 1MB every .1s up to 5s.
 	// Creates a bash process and under that a python process. They should both contribute to MemUsage.
@@ -34,11 +34,23 @@ type execer struct {
 	getMemUtilization func(int) (scootexecer.Memory, error)
 	stat              stats.StatsReceiver
 	pw                ProcessWatcher
+	// Held while reading the processes and summing a command's memory usage, as pw is shared by
+	// the commands a packing runner runs at the same time.
+	pwMutex sync.Mutex
+
+	// If set, each command runs in its own cgroup where the kernel enforces its limits, see cgroup.go.
+	cgroups *cgroupManager
+	// If set, each command runs in its own namespaces, see sandbox.go.
+	sandbox *SandboxConfig
+	// Set in every command's environment, over the variables its EnvMode inherits.
+	baseEnv map[string]string
+	// The file commands' SecretEnv are resolved from, see secrets.go.
+	secretsFile string
 }
 
 // NewBoundedExecer returns an execer with a ProcGetter and, if non-zero values are provided, a memCap, overriding memory utilization function, and a StatsReceiver
 func NewBoundedExecer(memCap scootexecer.Memory, getMemUtilization func() (int64, error), stat stats.StatsReceiver) *execer {
-	oe := &execer{pw: NewProcWatcher()}
+	oe := &execer{pw: NewDefaultProcessWatcher()}
 	if memCap != 0 {
 		oe.memCap = memCap
 	}
@@ -61,6 +73,76 @@ func NewBoundedExecer(memCap scootexecer.Memory, getMemUtilization func() (int64
 	return oe
 }
 
+// NewCgroupExecer returns an execer running each command in its own cgroup v2 leaf under config.Parent,
+// limited to config.Limits and memCap, lowered by the command's own limits.  Falls back to the
+// NewBoundedExecer, which polls the memory usage of commands, if the cgroups can't be used.
+// The binary running the execer must call MaybeRunExecerInit() first thing in its main().
+func NewCgroupExecer(memCap scootexecer.Memory, config CgroupConfig, getMemUtilization func() (int64, error), stat stats.StatsReceiver) *execer {
+	oe := NewBoundedExecer(memCap, getMemUtilization, stat)
+	if memCap != 0 {
+		config.Limits = config.Limits.Min(scootexecer.Limits{MemoryBytes: int64(memCap)})
+	}
+	cgroups, err := newCgroupManager(config)
+	if err != nil {
+		log.Errorf("Couldn't use cgroups, falling back to polling the memory usage of commands: %s", err)
+		return oe
+	}
+	log.Infof("Running commands in cgroups under %s, limits: %+v", config.Parent, config.Limits)
+	oe.cgroups = cgroups
+	return oe
+}
+
+// EnableSandbox makes the execer run each command in a sandbox set up as configured, the binary
+// running the execer must call MaybeRunExecerInit() first thing in its main().
+func (e *execer) EnableSandbox(config SandboxConfig) error {
+	if err := checkSandboxSupport(); err != nil {
+		return err
+	}
+	if err := config.validate(); err != nil {
+		return err
+	}
+	log.Infof("Running commands in sandboxes, config: %+v", config)
+	e.sandbox = &config
+	return nil
+}
+
+// MaybeRunExecerInit must be called at the start of the main() of binaries running a cgroup or
+// sandboxed execer, before anything else: when the binary was re-executed to start a command in
+// its cgroup or sandbox it sets it up and runs the command, exiting with its exit code, otherwise
+// it returns right away.
+func MaybeRunExecerInit() {
+	if len(os.Args) == 0 {
+		return
+	}
+	switch os.Args[0] {
+	case cgroupInitArg0:
+		openInitStatus()
+		os.Exit(runCgroupInit(os.Args[1:]))
+	case sandboxInitArg0:
+		openInitStatus()
+		os.Exit(runSandboxInitFromEnv(os.Args[1:]))
+	}
+}
+
+// SetBaseEnv sets variables in every command's environment, even one that inherits none of the
+// execer's, commands can override them in their EnvVars.
+func (e *execer) SetBaseEnv(env map[string]string) {
+	e.baseEnv = env
+}
+
+// SetSecretsFile sets the file of named secrets commands reference in their SecretEnv, a JSON
+// object of names to values which should only be readable by the worker.
+func (e *execer) SetSecretsFile(path string) {
+	e.secretsFile = path
+}
+
+// Env returns the environment the command runs with: the execer's variables its EnvMode keeps,
+// overridden by the base environment then its EnvVars and SecretEnv, whose values are redacted.
+func (e *execer) Env(command scootexecer.Command) []string {
+	vars, _ := e.envVars(command, true)
+	return scootexecer.BuildEnv(os.Environ(), command.EnvMode, command.EnvAllowlist, e.baseEnv, vars)
+}
+
 // Start a command, monitor its memory, and return an &process wrapper for it
 func (e *execer) Exec(command scootexecer.Command) (scootexecer.Process, error) {
 	if len(command.Argv) == 0 {
@@ -70,15 +152,22 @@ func (e *execer) Exec(command scootexecer.Command) (scootexecer.Process, error)
 	cmd := exec.Command(command.Argv[0], command.Argv[1:]...)
 	cmd.Dir = command.Dir
 
-	// Use the parent environment plus whatever additional env vars are provided.
-	cmd.Env = os.Environ()
-	for k, v := range command.EnvVars {
-		cmd.Env = append(cmd.Env, k+"="+v)
+	// Use the parent environment, as much of it as the EnvMode keeps, plus the base and the command's env vars.
+	vars, err := e.envVars(command, false)
+	if err != nil {
+		return nil, err
 	}
+	cmd.Env = scootexecer.BuildEnv(os.Environ(), command.EnvMode, command.EnvAllowlist, e.baseEnv, vars)
 
 	// Sets pgid of all child processes to cmd's pid
 	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
 
+	if e.sandbox != nil {
+		if err := e.sandbox.wrap(cmd); err != nil {
+			return nil, fmt.Errorf("couldn't sandbox the command: %s", err)
+		}
+	}
+
 	// Make sure to get the best possible Writer, so if possible os/exec can connect
 	// the command's stdout/stderr directly to a file, instead of having to go through
 	// our delegation
@@ -110,14 +199,56 @@ func (e *execer) Exec(command scootexecer.Command) (scootexecer.Process, error)
 		io.Copy(command.Stdout, stdOutPipe)
 	}()
 
+	var cg *cgroup
+	var gate *cgroupGate
+	if e.cgroups != nil {
+		if cg, err = e.cgroups.create(command.Limits); err != nil {
+			return nil, fmt.Errorf("couldn't create a cgroup for the command: %s", err)
+		}
+		// the kernel enforces the limits from the start, the command is held until it's in its cgroup
+		if gate, err = cg.startIn(cmd); err != nil {
+			cg.remove()
+			return nil, fmt.Errorf("couldn't start the command in its cgroup: %s", err)
+		}
+		defer gate.close()
+	}
+
+	// the cgroup or sandbox init reports when it can't run the command
+	var status *initStatus
+	if cg != nil || e.sandbox != nil {
+		if status, err = newInitStatus(cmd); err != nil {
+			if cg != nil {
+				cg.remove()
+			}
+			return nil, err
+		}
+	}
+
 	// Async start of the command.
 	err = cmd.Start()
 	if err != nil {
+		if cg != nil {
+			cg.remove()
+		}
+		if status != nil {
+			status.close()
+		}
 		return nil, err
 	}
+	if status != nil {
+		status.started()
+	}
+	if gate != nil {
+		if err := gate.release(cmd.Process.Pid); err != nil {
+			cmd.Process.Kill()
+			cmd.Wait()
+			cg.remove()
+			return nil, fmt.Errorf("couldn't move the command into its cgroup: %s", err)
+		}
+	}
 
-	proc := &process{cmd: cmd, wg: &wg, ats: AbortTimeoutSec, LogTags: command.LogTags}
-	if e.memCap > 0 {
+	proc := &process{cmd: cmd, wg: &wg, ats: AbortTimeoutSec, LogTags: command.LogTags, cgroup: cg, initStatus: status}
+	if cg == nil && e.memCap > 0 {
 		go e.monitorMem(proc, command.MemCh)
 	}
 
@@ -168,10 +299,12 @@ func (e *execer) monitorMem(p *process, memCh chan scootexecer.ProcessStatus) {
 					}).Info("Finished monitoring memory")
 				return
 			}
+			e.pwMutex.Lock()
 			if _, err := e.pw.GetProcs(); err != nil {
 				log.Error(err)
 			}
 			mem, err := e.getMemUtilization(pid)
+			e.pwMutex.Unlock()
 			if err != nil {
 				p.mutex.Unlock()
 				log.Debugf("Error getting memory utilization: %s", err)
@@ -193,9 +326,10 @@ func (e *execer) monitorMem(p *process, memCh chan scootexecer.ProcessStatus) {
 						"taskID": p.TaskID,
 					}).Info(msg)
 				p.result = &scootexecer.ProcessStatus{
-					State:    scootexecer.COMPLETE,
-					Error:    msg,
-					ExitCode: 1,
+					State:      scootexecer.COMPLETE,
+					Error:      msg,
+					ExitCode:   1,
+					KillReason: scootexecer.KilledMemCap,
 				}
 				if memCh != nil {
 					memCh <- *p.result
@@ -3,6 +3,7 @@ package os
 import (
 	"bytes"
 	"fmt"
+	"os"
 	"strings"
 	"testing"
 	"time"
@@ -19,6 +20,12 @@ func init() {
 	log.SetLevel(logrusLevel)
 }
 
+func TestMain(m *testing.M) {
+	// the cgroup and sandbox inits re-execute the test binary
+	MaybeRunExecerInit()
+	os.Exit(m.Run())
+}
+
 func NewBoundedTestExecer(memCap scootexecer.Memory, pw ProcessWatcher) *execer {
 	return &execer{memCap: memCap, pw: pw}
 }
@@ -0,0 +1,94 @@
+package os
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// The env var with the fd an execer init reports why it failed on, see initStatus.
+const initStatusFdEnvVar = "SCOOT_INIT_STATUS_FD"
+
+// The status pipe the running execer init reports its failure on, nil if it has none.
+var initStatusW *os.File
+
+// A pipe the cgroup or sandbox init writes why it failed to, as its exit code can't be told apart
+// from the command's.  The init doesn't pass the write end on to the command, so the pipe is empty
+// once the init ran the command.
+type initStatus struct {
+	r, w *os.File
+	msg  []byte
+	done chan struct{}
+}
+
+// Passes the write end of a new status pipe to the init cmd starts.
+func newInitStatus(cmd *exec.Cmd) (*initStatus, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	fd := 3 + len(cmd.ExtraFiles)
+	cmd.ExtraFiles = append(cmd.ExtraFiles, w)
+	cmd.Env = append(cmd.Env, initStatusFdEnvVar+"="+strconv.Itoa(fd))
+	return &initStatus{r: r, w: w, done: make(chan struct{})}, nil
+}
+
+// Closes the execer's write end once the init started, and reads what the init reports until it
+// ran the command or exited.
+func (s *initStatus) started() {
+	s.w.Close()
+	go func() {
+		s.msg, _ = ioutil.ReadAll(s.r)
+		s.r.Close()
+		close(s.done)
+	}()
+}
+
+// Closes the pipe when the init couldn't be started.
+func (s *initStatus) close() {
+	s.r.Close()
+	s.w.Close()
+}
+
+// Returns why the init failed, empty if it ran the command.  Must only be called once the init exited.
+func (s *initStatus) failure() string {
+	<-s.done
+	return strings.TrimSpace(string(s.msg))
+}
+
+// Opens the status pipe in the init and takes its fd out of the environment of the command.
+func openInitStatus() {
+	fd, err := strconv.Atoi(os.Getenv(initStatusFdEnvVar))
+	if err != nil {
+		return
+	}
+	initStatusW = os.NewFile(uintptr(fd), "init-status")
+	os.Unsetenv(initStatusFdEnvVar)
+}
+
+// Keeps the status pipe open across the exec of another init, which reports on it in turn.
+func passInitStatus() {
+	if initStatusW != nil {
+		os.Setenv(initStatusFdEnvVar, strconv.Itoa(int(initStatusW.Fd())))
+	}
+}
+
+// Keeps the command the init runs from inheriting the status pipe.
+func closeInitStatusOnExec() {
+	if initStatusW != nil {
+		syscall.CloseOnExec(int(initStatusW.Fd()))
+	}
+}
+
+// Reports why the init failed to the execer and on stderr.
+func reportInitFailure(arg0, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	fmt.Fprintf(os.Stderr, "%s: %s\n", arg0, msg)
+	if initStatusW != nil {
+		initStatusW.WriteString(msg)
+	}
+}
@@ -0,0 +1,156 @@
+package os
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Where the Linux proc filesystem is mounted.
+const DefaultProcDir = "/proc"
+
+// The unit of the cpu times in /proc/<pid>/stat, USER_HZ, which is 100 on all the platforms Linux runs on.
+const clockTicksPerSec = 100
+
+// A ProcessWatcher reading the processes from /proc instead of running ps, sharing the
+// ps based watcher's memory usage summation.
+type procFSWatcher struct {
+	procWatcher
+	procDir string
+}
+
+// NewProcFSWatcher returns a ProcessWatcher reading the processes from procDir, it must be a
+// Linux proc filesystem.
+func NewProcFSWatcher(procDir string) (*procFSWatcher, error) {
+	if runtime.GOOS != "linux" && procDir == DefaultProcDir {
+		return nil, fmt.Errorf("no %s on %s", DefaultProcDir, runtime.GOOS)
+	}
+	if _, err := os.Stat(filepath.Join(procDir, "self", "stat")); err != nil {
+		return nil, fmt.Errorf("%s isn't a proc filesystem: %s", procDir, err)
+	}
+	return &procFSWatcher{procDir: procDir}, nil
+}
+
+// Get a full list of processes running, including their pid, pgid, ppid, and memory usage, and set
+// procWatcher's fields.  Processes that exit while they're read are skipped.  Their cpu time and open
+// fds are left out to keep memory checks cheap, see GetProcDetails.
+func (w *procFSWatcher) GetProcs() (map[int]ProcInfo, error) {
+	dir, err := os.Open(w.procDir)
+	if err != nil {
+		return nil, err
+	}
+	names, err := dir.Readdirnames(-1)
+	dir.Close()
+	if err != nil {
+		return nil, err
+	}
+	procs := []ProcInfo{}
+	for _, name := range names {
+		pid, err := strconv.Atoi(name)
+		if err != nil {
+			// not a process, like /proc/meminfo
+			continue
+		}
+		if p, err := w.readProc(pid, false); err == nil {
+			procs = append(procs, p)
+		}
+	}
+	w.allProcesses, w.processGroups, w.parentProcesses = groupProcs(procs)
+	return w.allProcesses, nil
+}
+
+// GetProcDetails returns the process with pid, including its cpu time and open fds.
+func (w *procFSWatcher) GetProcDetails(pid int) (ProcInfo, error) {
+	return w.readProc(pid, true)
+}
+
+// Reads the process with pid, with its cpu time and open fds if detailed.
+func (w *procFSWatcher) readProc(pid int, detailed bool) (ProcInfo, error) {
+	p := ProcInfo{pid: pid}
+	pidDir := filepath.Join(w.procDir, strconv.Itoa(pid))
+	stat, err := ioutil.ReadFile(filepath.Join(pidDir, "stat"))
+	if err != nil {
+		return p, err
+	}
+	if err := parseProcStat(string(stat), &p, detailed); err != nil {
+		return p, err
+	}
+	if p.rss, err = readProcStatusRSS(filepath.Join(pidDir, "status")); err != nil {
+		return p, err
+	}
+	if detailed {
+		// the fds of other users' processes can't be read, their count is left at 0
+		p.numFds, _ = countDirEntries(filepath.Join(pidDir, "fd"))
+	}
+	return p, nil
+}
+
+// Returns the number of entries in dir without stat'ing them.
+func countDirEntries(dir string) (int, error) {
+	f, err := os.Open(dir)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	names, err := f.Readdirnames(-1)
+	return len(names), err
+}
+
+// Parses the ppid, pgid and, if cpuTime, the cpu time out of /proc/<pid>/stat: 'pid (comm) state ppid pgrp ...'.
+// comm can contain spaces and parentheses, so the fields are counted from the last ')'.
+func parseProcStat(stat string, p *ProcInfo, cpuTime bool) error {
+	end := strings.LastIndex(stat, ")")
+	if end < 0 {
+		return errors.New("no command in stat")
+	}
+	// fields from state, the stat's third field, on
+	fields := strings.Fields(stat[end+1:])
+	if len(fields) < 13 {
+		return fmt.Errorf("expected at least 13 fields after the command in stat, got %d", len(fields))
+	}
+	var err error
+	if p.ppid, err = strconv.Atoi(fields[1]); err != nil {
+		return err
+	}
+	if p.pgid, err = strconv.Atoi(fields[2]); err != nil {
+		return err
+	}
+	if !cpuTime {
+		return nil
+	}
+	utime, err := strconv.ParseInt(fields[11], 10, 64)
+	if err != nil {
+		return err
+	}
+	stime, err := strconv.ParseInt(fields[12], 10, 64)
+	if err != nil {
+		return err
+	}
+	p.cpuTime = time.Duration(utime+stime) * time.Second / clockTicksPerSec
+	return nil
+}
+
+// Returns the VmRSS of /proc/<pid>/status in KB, as ps reports it, 0 for kernel threads which have none.
+func readProcStatusRSS(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// 'VmRSS:	    1234 kB'
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "VmRSS:" {
+			return strconv.Atoi(fields[1])
+		}
+	}
+	return 0, scanner.Err()
+}
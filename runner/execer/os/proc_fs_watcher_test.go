@@ -0,0 +1,82 @@
+package os
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	scootexecer "github.com/twitter/scoot/runner/execer"
+)
+
+// Writes the stat, status and fds of a process to a fake proc dir.
+func writeTestProc(t *testing.T, procDir string, pid, pgid, ppid, rss, utime, stime, fds int) {
+	pidDir := filepath.Join(procDir, strconv.Itoa(pid))
+	if err := os.MkdirAll(filepath.Join(pidDir, "fd"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	stat := fmt.Sprintf("%d (my (odd) cmd) S %d %d %d 0 -1 4194560 100 0 0 0 %d %d 0 0 20 0 1 0 12345 1000000 %d\n",
+		pid, ppid, pgid, pgid, utime, stime, rss/4)
+	writeFile(t, pidDir, "stat", stat)
+	writeFile(t, pidDir, "status", fmt.Sprintf("Name:\tcmd\nState:\tS (sleeping)\nVmRSS:\t    %d kB\nThreads:\t1\n", rss))
+	for i := 0; i < fds; i++ {
+		writeFile(t, filepath.Join(pidDir, "fd"), strconv.Itoa(i), "")
+	}
+}
+
+func TestProcFSWatcher(t *testing.T) {
+	procDir, err := ioutil.TempDir("", "proc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(procDir)
+
+	if _, err := NewProcFSWatcher(procDir); err == nil {
+		t.Fatalf("Expected an error for a dir that isn't a proc filesystem")
+	}
+	writeTestProc(t, procDir, 1, 1, 0, 10, 0, 0, 0)
+	if err := os.Symlink(filepath.Join(procDir, "1"), filepath.Join(procDir, "self")); err != nil {
+		t.Fatal(err)
+	}
+	// 2 runs in its own process group with a child in the group and a grandchild that left it
+	writeTestProc(t, procDir, 2, 2, 1, 20, 150, 50, 3)
+	writeTestProc(t, procDir, 3, 2, 2, 30, 0, 0, 0)
+	writeTestProc(t, procDir, 4, 4, 3, 40, 0, 0, 0)
+	writeTestProc(t, procDir, 100, 100, 1, 1000, 0, 0, 0)
+	writeFile(t, procDir, "meminfo", "MemTotal: 1000 kB\n")
+	// a kernel thread without VmRSS
+	writeTestProc(t, procDir, 5, 0, 0, 0, 0, 0, 0)
+	writeFile(t, filepath.Join(procDir, "5"), "status", "Name:\tkthreadd\n")
+
+	w, err := NewProcFSWatcher(procDir)
+	if err != nil {
+		t.Fatalf("Unexpected error creating the watcher: %v", err)
+	}
+	procs, err := w.GetProcs()
+	if err != nil {
+		t.Fatalf("Unexpected error getting the processes: %v", err)
+	}
+	if len(procs) != 6 {
+		t.Fatalf("Expected 6 processes, got %v", procs)
+	}
+	if p := procs[2]; p.pgid != 2 || p.ppid != 1 || p.rss != 20 || p.CPUTime() != 0 || p.NumFds() != 0 {
+		t.Errorf("Unexpected process %+v", p)
+	}
+	if p, err := w.GetProcDetails(2); err != nil || p.pgid != 2 || p.CPUTime() != 2*time.Second || p.NumFds() != 3 {
+		t.Errorf("Expected the cpu time and fds of the process, got %+v, err: %v", p, err)
+	}
+	if _, err := w.GetProcDetails(6); err == nil {
+		t.Errorf("Expected an error getting a process that doesn't exist")
+	}
+	if p := procs[5]; p.rss != 0 {
+		t.Errorf("Expected no rss for a kernel thread, got %+v", p)
+	}
+
+	mem, err := w.MemUsage(2)
+	if mem != scootexecer.Memory((20+30+40)*bytesToKB) || err != nil {
+		t.Errorf("Expected the memory of the process group and its children, got %v, err: %v", mem, err)
+	}
+}
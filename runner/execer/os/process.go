@@ -26,6 +26,11 @@ type process struct {
 	mutex   sync.Mutex
 	ats     int // Abort Timeout before sigkill, in Seconds
 	tags.LogTags
+
+	// The cgroup the process runs in, if the execer uses cgroups.
+	cgroup *cgroup
+	// Why the cgroup or sandbox init couldn't run the command, if the process is one.
+	initStatus *initStatus
 }
 
 // Wait for the process to finish.
@@ -33,6 +38,9 @@ type process struct {
 // If the command fails, and we can get the exit code from the command, return COMPLETE with the failing exit code.
 // if the command fails and we cannot get the exit code from the command, return FAILED and the error
 // that prevented getting the exit code.
+// If the process ran in a cgroup, the status has its usage, and it's COMPLETE with exit code 1 if the
+// kernel killed it for exceeding the cgroup's memory limit.
+// If the cgroup or sandbox init couldn't run the command, return FAILED and the init's error.
 func (p *process) Wait() (result scootexecer.ProcessStatus) {
 	p.mutex.Lock()
 	p.waiting = true
@@ -51,9 +59,38 @@ func (p *process) Wait() (result scootexecer.ProcessStatus) {
 			"taskID": p.TaskID,
 		}).Infof("Finished waiting for process")
 
+	// read what the cgroup measured before it's removed, killing the processes the command left behind
+	var usage *scootexecer.Usage
+	oomKilled := false
+	if p.cgroup != nil {
+		oomKilled, usage = p.cgroup.oomKilled(), p.cgroup.usage()
+		p.cgroup.remove()
+	}
+
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
 	p.waiting = false
+	if p.cgroup != nil {
+		aborted := p.result != nil
+		defer func() {
+			result.Usage = usage
+			if oomKilled && !aborted {
+				result.State = scootexecer.COMPLETE
+				result.ExitCode = 1
+				result.KillReason = scootexecer.KilledOOM
+				result.Error = fmt.Sprintf("Cmd exceeded its cgroup's memory limit of %d bytes and was killed by the kernel (%v)",
+					p.cgroup.limits.MemoryBytes, p.cmd.Args)
+				log.WithFields(
+					log.Fields{
+						"pid":    pid,
+						"usage":  usage,
+						"tag":    p.Tag,
+						"jobID":  p.JobID,
+						"taskID": p.TaskID,
+					}).Info(result.Error)
+			}
+		}()
+	}
 
 	// Trace output with timeout since it seems CombinedOutput() sometimes fails to return.
 	if log.IsLevelEnabled(log.TraceLevel) {
@@ -77,6 +114,14 @@ func (p *process) Wait() (result scootexecer.ProcessStatus) {
 	} else {
 		p.result = &result
 	}
+	if p.initStatus != nil {
+		if failure := p.initStatus.failure(); failure != "" {
+			result.State = scootexecer.FAILED
+			result.ExitCode = scooterror.CouldNotExecExitCode
+			result.Error = fmt.Sprintf("Couldn't run the command: %s", failure)
+			return result
+		}
+	}
 	if err == nil {
 		// the command finished without an error
 		result.State = scootexecer.COMPLETE
@@ -152,6 +197,10 @@ func (p *process) Abort() scootexecer.ProcessStatus {
 	// if cmd.Wait() was already called, calling it again is an immediate error.
 	// If already called, just poll periodically if the process has exited
 	if !p.waiting {
+		if p.cgroup != nil {
+			// nothing else will remove the cgroup once the process is waited on here
+			defer p.cgroup.remove()
+		}
 		go func() {
 			// p.wg ignored
 			cmdDoneCh <- p.cmd.Wait()
@@ -253,6 +302,10 @@ func (p *process) KillAndWait(resultError string) {
 	} else {
 		defer cleanupProcs(pgid)
 	}
+	if p.cgroup != nil {
+		// also kill the processes that left the process group
+		defer p.cgroup.kill()
+	}
 	p.result.Error += fmt.Sprintf(" %s", resultError)
 	err = p.cmd.Process.Kill()
 	if err != nil {
@@ -2,6 +2,7 @@ package os
 
 import (
 	"fmt"
+	"time"
 
 	"os/exec"
 	"strings"
@@ -20,22 +21,46 @@ type ProcInfo struct {
 	pgid int
 	ppid int
 	rss  int
+
+	// Only known to the /proc based watcher's GetProcDetails, see proc_fs_watcher.go.
+	cpuTime time.Duration
+	numFds  int
 }
 
 func (p ProcInfo) Pid() int {
 	return p.pid
 }
 
+// User and system CPU time used by the process.
+func (p ProcInfo) CPUTime() time.Duration {
+	return p.cpuTime
+}
+
+// Number of open file descriptors of the process.
+func (p ProcInfo) NumFds() int {
+	return p.numFds
+}
+
 type procWatcher struct {
 	allProcesses    map[int]ProcInfo
 	processGroups   map[int][]ProcInfo
 	parentProcesses map[int][]ProcInfo
 }
 
+// NewProcWatcher returns a ProcessWatcher that runs ps to get the processes.
 func NewProcWatcher() *procWatcher {
 	return &procWatcher{}
 }
 
+// NewDefaultProcessWatcher returns a ProcessWatcher reading /proc on Linux, where it's cheaper than
+// running ps for every memory check, and one running ps elsewhere.
+func NewDefaultProcessWatcher() ProcessWatcher {
+	if w, err := NewProcFSWatcher(DefaultProcDir); err == nil {
+		return w
+	}
+	return NewProcWatcher()
+}
+
 // Get a full list of processes running, including their pid, pgid, ppid, and memory usage, and set procWatcher's fields
 func (opw *procWatcher) GetProcs() (map[int]ProcInfo, error) {
 	cmd := "ps -e -o pid= -o pgid= -o ppid= -o rss= | tr '\n' ';' | sed 's,;$,,'"
@@ -95,12 +120,10 @@ func (opw *procWatcher) MemUsage(pid int) (scootexecer.Memory, error) {
 	return scootexecer.Memory(total * bytesToKB), nil
 }
 
-// Format processes into pgid and ppid groups for summation of memory usage
+// Parse the ps output and format processes into pgid and ppid groups for summation of memory usage
 func parseProcs(procs []string) (allProcesses map[int]ProcInfo, processGroups map[int][]ProcInfo,
 	parentProcesses map[int][]ProcInfo, err error) {
-	allProcesses = make(map[int]ProcInfo)
-	processGroups = make(map[int][]ProcInfo)
-	parentProcesses = make(map[int][]ProcInfo)
+	infos := make([]ProcInfo, 0, len(procs))
 	for idx := 0; idx < len(procs); idx++ {
 		var p ProcInfo
 		n, err := fmt.Sscanf(procs[idx], "%d %d %d %d", &p.pid, &p.pgid, &p.ppid, &p.rss)
@@ -110,9 +133,22 @@ func parseProcs(procs []string) (allProcesses map[int]ProcInfo, processGroups ma
 		if n != 4 {
 			return nil, nil, nil, fmt.Errorf("Error parsing output, expected 4 assigments, but only received %d. %v", n, procs)
 		}
+		infos = append(infos, p)
+	}
+	allProcesses, processGroups, parentProcesses = groupProcs(infos)
+	return allProcesses, processGroups, parentProcesses, nil
+}
+
+// Format processes into pgid and ppid groups for summation of memory usage
+func groupProcs(procs []ProcInfo) (allProcesses map[int]ProcInfo, processGroups map[int][]ProcInfo,
+	parentProcesses map[int][]ProcInfo) {
+	allProcesses = make(map[int]ProcInfo)
+	processGroups = make(map[int][]ProcInfo)
+	parentProcesses = make(map[int][]ProcInfo)
+	for _, p := range procs {
 		allProcesses[p.pid] = p
 		processGroups[p.pgid] = append(processGroups[p.pgid], p)
 		parentProcesses[p.ppid] = append(parentProcesses[p.ppid], p)
 	}
-	return allProcesses, processGroups, parentProcesses, nil
+	return allProcesses, processGroups, parentProcesses
 }
@@ -0,0 +1,121 @@
+package os
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Host paths mounted read-only in a sandbox by default, the ones that don't exist are skipped.
+var DefaultSandboxReadOnlyPaths = []string{"/bin", "/etc", "/lib", "/lib32", "/lib64", "/sbin", "/usr"}
+
+// The argv[0] the worker is re-executed with to set up a sandbox, see MaybeRunExecerInit().
+const sandboxInitArg0 = "scoot-sandbox-init"
+
+// The env var passing the sandboxSpec to the sandbox init.
+const sandboxSpecEnvVar = "SCOOT_SANDBOX_SPEC"
+
+// The host devices bind mounted in a sandbox's /dev, the ones that don't exist are skipped.
+var sandboxDevices = []string{"null", "zero", "full", "random", "urandom", "tty"}
+
+// SandboxConfig configures an execer to run each command in new mount, PID, IPC and UTS namespaces,
+// and optionally a new network namespace.  The command sees an empty root filesystem with its
+// directory mounted writable at the same path, the read-only host paths, a /dev with only the
+// usual devices, a new /proc and an empty /tmp, and can't see or signal the worker's or other
+// commands' processes.
+type SandboxConfig struct {
+	// Host paths mounted read-only, DefaultSandboxReadOnlyPaths if nil.
+	ReadOnlyPaths []string
+	// Runs the command in a new network namespace, without any network interface up.
+	IsolateNetwork bool
+	// The host dir the sandbox's root filesystem is mounted on in each command's mount namespace,
+	// a dir in the temp dir if empty.  It stays empty on the host.
+	RootDir string
+}
+
+// What the sandbox init sets up for a command.
+type sandboxSpec struct {
+	Root   string         `json:"root"`
+	Dir    string         `json:"dir"`
+	Mounts []sandboxMount `json:"mounts"`
+}
+
+// A host path bind mounted at the same path in the sandbox.
+type sandboxMount struct {
+	Path     string `json:"path"`
+	Writable bool   `json:"writable"`
+}
+
+// Checks the config and fills in its defaults.
+func (c *SandboxConfig) validate() error {
+	if c.ReadOnlyPaths == nil {
+		c.ReadOnlyPaths = DefaultSandboxReadOnlyPaths
+	}
+	for _, p := range c.ReadOnlyPaths {
+		if !filepath.IsAbs(p) {
+			return fmt.Errorf("sandbox read-only path %s isn't absolute", p)
+		}
+	}
+	if c.RootDir == "" {
+		c.RootDir = filepath.Join(os.TempDir(), "scoot-sandbox-root")
+	}
+	return os.MkdirAll(c.RootDir, 0755)
+}
+
+// Returns what the sandbox init sets up to run a command in dir: the existing read-only paths
+// parents first, then dir, which is writable.
+func (c *SandboxConfig) spec(dir string) (*sandboxSpec, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("a sandboxed command must have a dir")
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	paths := []string{}
+	for _, p := range c.ReadOnlyPaths {
+		if _, err := os.Stat(p); err == nil {
+			paths = append(paths, filepath.Clean(p))
+		}
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		return strings.Count(paths[i], "/") < strings.Count(paths[j], "/") ||
+			(strings.Count(paths[i], "/") == strings.Count(paths[j], "/") && paths[i] < paths[j])
+	})
+	s := &sandboxSpec{Root: c.RootDir, Dir: dir}
+	for _, p := range paths {
+		s.Mounts = append(s.Mounts, sandboxMount{Path: p})
+	}
+	s.Mounts = append(s.Mounts, sandboxMount{Path: dir, Writable: true})
+	return s, nil
+}
+
+func (s *sandboxSpec) toEnv() (string, error) {
+	asJSON, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return sandboxSpecEnvVar + "=" + string(asJSON), nil
+}
+
+// Runs in the sandbox init: reads the sandbox spec, sets up the sandbox, runs the command
+// and returns its exit code.  args are the command's path followed by its argv.
+func runSandboxInitFromEnv(args []string) int {
+	if len(args) < 2 {
+		reportInitFailure(sandboxInitArg0, "no command")
+		return sandboxInitFailedExitCode
+	}
+	s := &sandboxSpec{}
+	if err := json.Unmarshal([]byte(os.Getenv(sandboxSpecEnvVar)), s); err != nil {
+		reportInitFailure(sandboxInitArg0, "couldn't parse the sandbox spec: %s", err)
+		return sandboxInitFailedExitCode
+	}
+	os.Unsetenv(sandboxSpecEnvVar)
+	return runSandboxInit(s, args[0], args[1:])
+}
+
+// The exit code of a sandbox init that couldn't set up the sandbox or start the command.
+const sandboxInitFailedExitCode = 125
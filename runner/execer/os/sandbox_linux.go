@@ -0,0 +1,239 @@
+package os
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+)
+
+// The statfs flags a read-only bind mount must keep, a user namespace can't clear them.
+var lockedMountFlags = map[int64]uintptr{
+	0x2:    syscall.MS_NOSUID,     // ST_NOSUID
+	0x4:    syscall.MS_NODEV,      // ST_NODEV
+	0x8:    syscall.MS_NOEXEC,     // ST_NOEXEC
+	0x400:  syscall.MS_NOATIME,    // ST_NOATIME
+	0x800:  syscall.MS_NODIRATIME, // ST_NODIRATIME
+	0x1000: syscall.MS_RELATIME,   // ST_RELATIME
+}
+
+func checkSandboxSupport() error {
+	if _, err := os.Stat("/proc/self/ns/pid"); err != nil {
+		return fmt.Errorf("namespaces aren't supported: %s", err)
+	}
+	return nil
+}
+
+// Makes cmd re-execute the worker as the sandbox init in new namespaces, which runs the
+// command once it set up its sandbox.  Unless the worker runs as root, the namespaces are
+// owned by a new user namespace where the worker's user is root.
+func (c *SandboxConfig) wrap(cmd *exec.Cmd) error {
+	s, err := c.spec(cmd.Dir)
+	if err != nil {
+		return err
+	}
+	specEnv, err := s.toEnv()
+	if err != nil {
+		return err
+	}
+	// the init runs the command exec.Command found with the worker's PATH, as the execer does unsandboxed
+	argv := cmd.Args
+	cmd.Args = append([]string{sandboxInitArg0, cmd.Path}, argv...)
+	cmd.Path = "/proc/self/exe"
+	cmd.Env = append(cmd.Env, specEnv)
+
+	attr := cmd.SysProcAttr
+	if attr == nil {
+		attr = &syscall.SysProcAttr{}
+		cmd.SysProcAttr = attr
+	}
+	attr.Cloneflags = syscall.CLONE_NEWNS | syscall.CLONE_NEWPID | syscall.CLONE_NEWIPC | syscall.CLONE_NEWUTS
+	if c.IsolateNetwork {
+		attr.Cloneflags |= syscall.CLONE_NEWNET
+	}
+	if uid, gid := os.Geteuid(), os.Getegid(); uid != 0 {
+		attr.Cloneflags |= syscall.CLONE_NEWUSER
+		attr.UidMappings = []syscall.SysProcIDMap{{ContainerID: 0, HostID: uid, Size: 1}}
+		attr.GidMappings = []syscall.SysProcIDMap{{ContainerID: 0, HostID: gid, Size: 1}}
+		attr.GidMappingsEnableSetgroups = false
+	}
+	return nil
+}
+
+// Runs in the sandbox init, PID 1 of the new PID namespace: sets up the sandbox, runs the command
+// at path with argv and returns its exit code.  When the init exits, the kernel kills the processes
+// left in the namespace.
+func runSandboxInit(s *sandboxSpec, path string, argv []string) int {
+	if err := setupSandbox(s); err != nil {
+		reportInitFailure(sandboxInitArg0, "couldn't set up the sandbox: %s", err)
+		return sandboxInitFailedExitCode
+	}
+
+	cmd := &exec.Cmd{Path: path, Args: argv, Dir: s.Dir}
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	// PID 1 ignores the signals it doesn't handle, forward the worker's aborts to the command
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGTERM, syscall.SIGINT)
+	closeInitStatusOnExec()
+	if err := cmd.Start(); err != nil {
+		reportInitFailure(sandboxInitArg0, "couldn't start %v: %s", argv, err)
+		return sandboxInitFailedExitCode
+	}
+	go func() {
+		for sig := range sigCh {
+			syscall.Kill(-cmd.Process.Pid, sig.(syscall.Signal))
+		}
+	}()
+
+	err := cmd.Wait()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+			if status.Signaled() {
+				return 128 + int(status.Signal())
+			}
+			return status.ExitStatus()
+		}
+	}
+	if err != nil {
+		reportInitFailure(sandboxInitArg0, "waiting for %v failed: %s", argv, err)
+		return sandboxInitFailedExitCode
+	}
+	return 0
+}
+
+// Builds the sandbox's root filesystem on a tmpfs and pivots into it.
+func setupSandbox(s *sandboxSpec) error {
+	// don't let the sandbox's mounts propagate to the host
+	if err := syscall.Mount("", "/", "", syscall.MS_REC|syscall.MS_PRIVATE, ""); err != nil {
+		return fmt.Errorf("making the mounts private: %s", err)
+	}
+	if err := syscall.Mount("tmpfs", s.Root, "tmpfs", 0, "mode=0755"); err != nil {
+		return fmt.Errorf("mounting the root tmpfs on %s: %s", s.Root, err)
+	}
+	for _, dir := range []string{"proc", "tmp", ".oldroot"} {
+		if err := os.MkdirAll(filepath.Join(s.Root, dir), 0755); err != nil {
+			return err
+		}
+	}
+	// mounted first so it doesn't hide a command dir in the host's /tmp
+	if err := syscall.Mount("tmpfs", filepath.Join(s.Root, "tmp"), "tmpfs", syscall.MS_NOSUID|syscall.MS_NODEV, "mode=1777"); err != nil {
+		return fmt.Errorf("mounting /tmp: %s", err)
+	}
+	for _, m := range s.Mounts {
+		if err := bindMount(m, filepath.Join(s.Root, m.Path)); err != nil {
+			return err
+		}
+	}
+	if err := mountDev(filepath.Join(s.Root, "dev")); err != nil {
+		return err
+	}
+	// a /proc showing only the sandbox's processes
+	if err := syscall.Mount("proc", filepath.Join(s.Root, "proc"), "proc", syscall.MS_NOSUID|syscall.MS_NODEV|syscall.MS_NOEXEC, ""); err != nil {
+		return fmt.Errorf("mounting /proc: %s", err)
+	}
+
+	oldRoot := filepath.Join(s.Root, ".oldroot")
+	if err := syscall.PivotRoot(s.Root, oldRoot); err != nil {
+		return fmt.Errorf("pivoting to %s: %s", s.Root, err)
+	}
+	if err := os.Chdir("/"); err != nil {
+		return err
+	}
+	if err := syscall.Unmount("/.oldroot", syscall.MNT_DETACH); err != nil {
+		return fmt.Errorf("unmounting the host's root: %s", err)
+	}
+	if err := os.Remove("/.oldroot"); err != nil {
+		return err
+	}
+	return syscall.Sethostname([]byte("scoot-sandbox"))
+}
+
+// Mounts a read-only /dev at target with only the sandboxDevices bound from the host, a new
+// devpts instance and an empty /dev/shm, rather than the host's /dev.
+func mountDev(target string) error {
+	if err := os.MkdirAll(target, 0755); err != nil {
+		return err
+	}
+	if err := syscall.Mount("tmpfs", target, "tmpfs", syscall.MS_NOSUID|syscall.MS_NOEXEC, "mode=0755"); err != nil {
+		return fmt.Errorf("mounting /dev: %s", err)
+	}
+	for _, name := range sandboxDevices {
+		device := filepath.Join("/dev", name)
+		if _, err := os.Stat(device); err != nil {
+			continue
+		}
+		if err := bindMount(sandboxMount{Path: device, Writable: true}, filepath.Join(target, name)); err != nil {
+			return err
+		}
+	}
+	for _, dir := range []string{"pts", "shm"} {
+		if err := os.MkdirAll(filepath.Join(target, dir), 0755); err != nil {
+			return err
+		}
+	}
+	if err := syscall.Mount("devpts", filepath.Join(target, "pts"), "devpts", syscall.MS_NOSUID|syscall.MS_NOEXEC,
+		"newinstance,ptmxmode=0666,mode=0620"); err != nil {
+		return fmt.Errorf("mounting /dev/pts: %s", err)
+	}
+	if err := syscall.Mount("tmpfs", filepath.Join(target, "shm"), "tmpfs", syscall.MS_NOSUID|syscall.MS_NODEV, "mode=1777"); err != nil {
+		return fmt.Errorf("mounting /dev/shm: %s", err)
+	}
+	links := map[string]string{
+		"ptmx":   "pts/ptmx",
+		"fd":     "/proc/self/fd",
+		"stdin":  "/proc/self/fd/0",
+		"stdout": "/proc/self/fd/1",
+		"stderr": "/proc/self/fd/2",
+	}
+	for name, dest := range links {
+		if err := os.Symlink(dest, filepath.Join(target, name)); err != nil {
+			return err
+		}
+	}
+	// the devices, /dev/pts and /dev/shm are separate mounts and stay writable
+	if err := syscall.Mount("", target, "", syscall.MS_REMOUNT|syscall.MS_RDONLY|syscall.MS_NOSUID|syscall.MS_NOEXEC, "mode=0755"); err != nil {
+		return fmt.Errorf("remounting /dev read-only: %s", err)
+	}
+	return nil
+}
+
+// Bind mounts the host path at target, remounting it read-only unless it's writable.
+func bindMount(m sandboxMount, target string) error {
+	info, err := os.Stat(m.Path)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		err = os.MkdirAll(target, 0755)
+	} else if err = os.MkdirAll(filepath.Dir(target), 0755); err == nil {
+		var f *os.File
+		if f, err = os.OpenFile(target, os.O_CREATE|os.O_RDONLY, 0644); err == nil {
+			f.Close()
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("creating the mount point for %s: %s", m.Path, err)
+	}
+	if err := syscall.Mount(m.Path, target, "", syscall.MS_BIND|syscall.MS_REC, ""); err != nil {
+		return fmt.Errorf("bind mounting %s: %s", m.Path, err)
+	}
+	if m.Writable {
+		return nil
+	}
+	flags := uintptr(syscall.MS_BIND | syscall.MS_REMOUNT | syscall.MS_RDONLY)
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(m.Path, &st); err == nil {
+		for stFlag, msFlag := range lockedMountFlags {
+			if int64(st.Flags)&stFlag != 0 {
+				flags |= msFlag
+			}
+		}
+	}
+	if err := syscall.Mount("", target, "", flags, ""); err != nil {
+		return fmt.Errorf("remounting %s read-only: %s", m.Path, err)
+	}
+	return nil
+}
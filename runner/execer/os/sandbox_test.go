@@ -0,0 +1,145 @@
+package os
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	scootexecer "github.com/twitter/scoot/runner/execer"
+)
+
+func TestSandboxSpec(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "sandbox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	for _, dir := range []string{"usr/lib", "usr", "bin", "checkout"} {
+		if err := os.MkdirAll(filepath.Join(tmpDir, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := (&SandboxConfig{ReadOnlyPaths: []string{"usr"}}).validate(); err == nil {
+		t.Errorf("Expected an error for a relative read-only path")
+	}
+	c := &SandboxConfig{
+		ReadOnlyPaths: []string{
+			filepath.Join(tmpDir, "usr/lib"),
+			filepath.Join(tmpDir, "missing"),
+			filepath.Join(tmpDir, "usr") + "/",
+			filepath.Join(tmpDir, "bin"),
+		},
+		RootDir: filepath.Join(tmpDir, "root"),
+	}
+	if err := c.validate(); err != nil {
+		t.Fatalf("Unexpected error validating the config: %v", err)
+	}
+	if _, err := os.Stat(c.RootDir); err != nil {
+		t.Errorf("Expected the root dir to be created, got %v", err)
+	}
+	if _, err := c.spec(""); err == nil {
+		t.Errorf("Expected an error for a command without a dir")
+	}
+
+	s, err := c.spec(filepath.Join(tmpDir, "checkout"))
+	if err != nil {
+		t.Fatalf("Unexpected error getting the spec: %v", err)
+	}
+	// the missing path is skipped and parents are mounted before their children
+	expected := &sandboxSpec{
+		Root: c.RootDir,
+		Dir:  filepath.Join(tmpDir, "checkout"),
+		Mounts: []sandboxMount{
+			{Path: filepath.Join(tmpDir, "bin")},
+			{Path: filepath.Join(tmpDir, "usr")},
+			{Path: filepath.Join(tmpDir, "usr/lib")},
+			{Path: filepath.Join(tmpDir, "checkout"), Writable: true},
+		},
+	}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Expected spec %+v, got %+v", expected, s)
+	}
+
+	defaults := &SandboxConfig{RootDir: c.RootDir}
+	if err := defaults.validate(); err != nil || !reflect.DeepEqual(defaults.ReadOnlyPaths, DefaultSandboxReadOnlyPaths) {
+		t.Errorf("Expected the default read-only paths, got %v, err: %v", defaults.ReadOnlyPaths, err)
+	}
+}
+
+// Runs commands in sandboxes, the sandbox init re-executes the test binary, see TestMain.
+func TestSandboxIsolatesCommand(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "sandbox")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	dir := filepath.Join(tmpDir, "checkout")
+	if err := os.Mkdir(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	e := NewBoundedExecer(0, nil, nil)
+	if err := e.EnableSandbox(SandboxConfig{IsolateNetwork: true, RootDir: filepath.Join(tmpDir, "root")}); err != nil {
+		t.Skipf("Sandboxes aren't supported: %v", err)
+	}
+	start := func(script string) (scootexecer.Process, *bytes.Buffer, error) {
+		var out bytes.Buffer
+		// the command is found with the worker's PATH, as it isn't in the command's clean env
+		p, err := e.Exec(scootexecer.Command{
+			Argv:    []string{"sh", "-c", script},
+			Dir:     dir,
+			EnvMode: scootexecer.EnvClean,
+			Stdout:  &out,
+			Stderr:  ioutil.Discard,
+		})
+		return p, &out, err
+	}
+
+	// namespaces can't be created in some containers
+	p, _, err := start("true")
+	if err != nil {
+		t.Skipf("Couldn't create the sandbox's namespaces: %v", err)
+	}
+	if st := p.Wait(); strings.Contains(st.Error, "couldn't set up the sandbox") {
+		t.Skipf("Couldn't set up a sandbox: %s", st.Error)
+	} else if st.State != scootexecer.COMPLETE || st.ExitCode != 0 {
+		t.Fatalf("Expected a sandboxed command to succeed, got %+v", st)
+	}
+
+	// the command is the child of the sandbox init, PID 1 of the new PID namespace
+	p, stdout, err := start(`echo "ppid $PPID $(tr '\0' '\n' < /proc/1/cmdline | head -n 1)"
+		echo "host pid visible $(test -d /proc/` + strconv.Itoa(os.Getpid()) + ` && echo yes || echo no)"
+		echo "hostname $(cat /proc/sys/kernel/hostname)"
+		touch /usr/scoot-sandbox-test 2>/dev/null && echo "usr writable"
+		touch out && echo "dir writable"
+		echo "interfaces $(tail -n +3 /proc/net/dev | cut -d: -f1 | tr -d ' ' | tr '\n' ' ')"
+		echo "dev $(ls /dev | tr '\n' ' ')"`)
+	if err != nil {
+		t.Fatalf("Unexpected error starting the sandboxed command: %v", err)
+	}
+	st, out := p.Wait(), stdout.String()
+	if st.State != scootexecer.COMPLETE || st.ExitCode != 0 {
+		t.Fatalf("Expected the sandboxed command to succeed, got %+v, output %q", st, out)
+	}
+	for _, expected := range []string{"ppid 1 " + sandboxInitArg0 + "\n", "host pid visible no\n", "hostname scoot-sandbox\n", "dir writable\n", "interfaces lo \n"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("Expected %q in the output %q", expected, out)
+		}
+	}
+	if strings.Contains(out, "usr writable") {
+		t.Errorf("Expected the host's /usr to be read-only, output %q", out)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "out")); err != nil {
+		t.Errorf("Expected the command's file in its dir on the host, got %v", err)
+	}
+	// the host's disks aren't in the sandbox's /dev
+	if !strings.Contains(out, "null") || strings.Contains(out, " sda") || strings.Contains(out, " vda") {
+		t.Errorf("Expected only the sandbox's devices in /dev, output %q", out)
+	}
+}
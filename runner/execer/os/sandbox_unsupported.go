@@ -0,0 +1,23 @@
+//go:build !linux
+// +build !linux
+
+package os
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+func checkSandboxSupport() error {
+	return fmt.Errorf("sandboxing isn't supported on %s", runtime.GOOS)
+}
+
+func (c *SandboxConfig) wrap(cmd *exec.Cmd) error {
+	return checkSandboxSupport()
+}
+
+func runSandboxInit(s *sandboxSpec, path string, argv []string) int {
+	reportInitFailure(sandboxInitArg0, "%s", checkSandboxSupport())
+	return sandboxInitFailedExitCode
+}
@@ -0,0 +1,59 @@
+package os
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	scootexecer "github.com/twitter/scoot/runner/execer"
+)
+
+// Reads a secrets file, a JSON object of secret names to values, ex: {"deploy-token": "..."}.
+// It's read for each command that references secrets, so secrets can be rotated without a restart.
+func readSecrets(path string) (map[string]string, error) {
+	if path == "" {
+		return nil, errors.New("no secrets file configured")
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	secrets := map[string]string{}
+	if err := json.Unmarshal(data, &secrets); err != nil {
+		// the error can quote the file, and so a secret
+		return nil, fmt.Errorf("secrets file %s isn't a JSON object of names to values", path)
+	}
+	return secrets, nil
+}
+
+// Returns the command's EnvVars with its SecretEnv set to the secrets' values, or to
+// scootexecer.RedactedValue if redact is set, in which case the secrets file isn't read.
+func (e *execer) envVars(command scootexecer.Command, redact bool) (map[string]string, error) {
+	if len(command.SecretEnv) == 0 {
+		return command.EnvVars, nil
+	}
+	var secrets map[string]string
+	if !redact {
+		var err error
+		if secrets, err = readSecrets(e.secretsFile); err != nil {
+			return nil, fmt.Errorf("couldn't read the secrets: %s", err)
+		}
+	}
+	vars := make(map[string]string, len(command.EnvVars)+len(command.SecretEnv))
+	for k, v := range command.EnvVars {
+		vars[k] = v
+	}
+	for k, name := range command.SecretEnv {
+		if redact {
+			vars[k] = scootexecer.RedactedValue
+			continue
+		}
+		value, ok := secrets[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown secret %q for env var %s", name, k)
+		}
+		vars[k] = value
+	}
+	return vars, nil
+}
@@ -0,0 +1,54 @@
+package os
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	scootexecer "github.com/twitter/scoot/runner/execer"
+)
+
+func TestSecretEnv(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	writeFile(t, tmpDir, "secrets.json", `{"deploy-token": "s3cr3t"}`)
+
+	e := NewBoundedExecer(0, nil, nil)
+	cmd := scootexecer.Command{
+		EnvVars:   map[string]string{"FOO": "bar"},
+		EnvMode:   scootexecer.EnvClean,
+		SecretEnv: map[string]string{"TOKEN": "deploy-token"},
+	}
+	if _, err := e.envVars(cmd, false); err == nil {
+		t.Errorf("Expected an error without a secrets file")
+	}
+	if env := e.Env(cmd); strings.Join(env, " ") != "FOO=bar TOKEN="+scootexecer.RedactedValue {
+		t.Errorf("Expected the secret to be redacted, got %v", env)
+	}
+
+	e.SetSecretsFile(filepath.Join(tmpDir, "secrets.json"))
+	vars, err := e.envVars(cmd, false)
+	if err != nil || vars["TOKEN"] != "s3cr3t" || vars["FOO"] != "bar" {
+		t.Errorf("Expected the secret to be resolved, got %v, err: %v", vars, err)
+	}
+	if cmd.EnvVars["TOKEN"] != "" {
+		t.Errorf("Expected the command's EnvVars to be left alone, got %v", cmd.EnvVars)
+	}
+	if env := e.Env(cmd); strings.Contains(strings.Join(env, " "), "s3cr3t") {
+		t.Errorf("Expected the secret to be redacted, got %v", env)
+	}
+
+	cmd.SecretEnv["OTHER"] = "missing"
+	if _, err := e.envVars(cmd, false); err == nil {
+		t.Errorf("Expected an error for an unknown secret")
+	}
+	writeFile(t, tmpDir, "secrets.json", `["s3cr3t"]`)
+	if _, err := e.envVars(cmd, false); err == nil || strings.Contains(err.Error(), "s3cr3t") {
+		t.Errorf("Expected an error without the secret for a malformed secrets file, got %v", err)
+	}
+}
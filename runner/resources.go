@@ -0,0 +1,55 @@
+package runner
+
+import (
+	"fmt"
+)
+
+// Resources a Command needs to run, or the capacity a worker provides to the Commands it runs.
+// A Command with zero Resources needs a whole worker, and a worker with zero capacity
+// runs one Command at a time, which is how every worker and task used to be treated.
+type Resources struct {
+	CPUMillis   int64 // thousandths of a CPU core
+	MemoryBytes int64
+	DiskBytes   int64
+}
+
+func (r Resources) IsZero() bool {
+	return r == Resources{}
+}
+
+// Add returns the sum of the resources.
+func (r Resources) Add(o Resources) Resources {
+	return Resources{
+		CPUMillis:   r.CPUMillis + o.CPUMillis,
+		MemoryBytes: r.MemoryBytes + o.MemoryBytes,
+		DiskBytes:   r.DiskBytes + o.DiskBytes,
+	}
+}
+
+// Sub returns the resources left after o is taken from r.
+func (r Resources) Sub(o Resources) Resources {
+	return Resources{
+		CPUMillis:   r.CPUMillis - o.CPUMillis,
+		MemoryBytes: r.MemoryBytes - o.MemoryBytes,
+		DiskBytes:   r.DiskBytes - o.DiskBytes,
+	}
+}
+
+// Fits returns true if r fits in the available resources in every dimension.
+func (r Resources) Fits(available Resources) bool {
+	return r.CPUMillis <= available.CPUMillis &&
+		r.MemoryBytes <= available.MemoryBytes &&
+		r.DiskBytes <= available.DiskBytes
+}
+
+// Validate checks that no resource is negative.
+func (r Resources) Validate() error {
+	if r.CPUMillis < 0 || r.MemoryBytes < 0 || r.DiskBytes < 0 {
+		return fmt.Errorf("invalid resources %s. Must not be negative", r)
+	}
+	return nil
+}
+
+func (r Resources) String() string {
+	return fmt.Sprintf("{cpuMillis: %d, memoryBytes: %d, diskBytes: %d}", r.CPUMillis, r.MemoryBytes, r.DiskBytes)
+}
@@ -9,9 +9,12 @@ package runner
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/twitter/scoot/common/log/tags"
+	"github.com/twitter/scoot/runner/execer"
 	"github.com/twitter/scoot/snapshot"
 )
 
@@ -27,6 +30,9 @@ const (
 	RunTypeScoot RunType = "Scoot"
 )
 
+// OutputAll can be given as the only Command.OutputPaths entry to ingest the entire checkout.
+const OutputAll = "."
+
 type RunTypeMap map[RunType]snapshot.FilerAndInitDoneCh
 
 // A command, execution environment, and timeout.
@@ -44,8 +50,26 @@ type Command struct {
 	// Runner can optionally use this to run against a particular snapshot. Empty value is ignored.
 	SnapshotID string
 
+	// Paths relative to the checkout that are ingested into a new output Snapshot once the command
+	// completes, whose ID is returned in RunStatus.SnapshotID. OutputAll ingests the whole checkout.
+	// Empty value is ignored.
+	OutputPaths []string
+
+	// Resources the command needs, used to run several commands on a worker at once.
+	// Zero value means the command needs the whole worker.
+	Resources Resources
+
 	// Runner is given JobID, TaskID, and Tag to help trace tasks throughout their lifecycle
 	tags.LogTags
+
+	// How much of the worker's environment the command inherits before the worker's base environment
+	// and EnvVars are added. EnvAllowlist names the inherited variables in the EnvAllowlist mode.
+	EnvMode      execer.EnvMode
+	EnvAllowlist []string
+
+	// Env vars set to the value of a named secret, which the worker resolves from its secrets file.
+	// Only the names are sent to and stored by Scoot, and the values are redacted from the task's logs.
+	SecretEnv map[string]string
 }
 
 func (c Command) String() string {
@@ -57,6 +81,22 @@ func (c Command) String() string {
 		c.TaskID,
 		c.Tag)
 
+	if len(c.OutputPaths) > 0 {
+		s += fmt.Sprintf(" # OutputPaths: %q", c.OutputPaths)
+	}
+
+	if !c.Resources.IsZero() {
+		s += fmt.Sprintf(" # Resources: %s", c.Resources)
+	}
+
+	if c.EnvMode != execer.EnvInherit {
+		s += fmt.Sprintf(" # EnvMode: %s", c.EnvMode)
+	}
+
+	if len(c.EnvAllowlist) > 0 {
+		s += fmt.Sprintf(" # EnvAllowlist: %q", c.EnvAllowlist)
+	}
+
 	if len(c.EnvVars) > 0 {
 		s += fmt.Sprintf(" # Env:")
 		for k, v := range c.EnvVars {
@@ -64,9 +104,63 @@ func (c Command) String() string {
 		}
 	}
 
+	if len(c.SecretEnv) > 0 {
+		s += fmt.Sprintf(" # SecretEnv:")
+		for k, name := range c.SecretEnv {
+			s += fmt.Sprintf("  %s=<secret %s>", k, name)
+		}
+	}
+
 	return s
 }
 
+// ValidateOutputPaths checks that each output path stays within the checkout.
+func ValidateOutputPaths(paths []string) error {
+	for _, p := range paths {
+		if p == "" || filepath.IsAbs(p) {
+			return fmt.Errorf("invalid output path %q. Must be relative to the checkout", p)
+		}
+		if clean := filepath.Clean(p); clean == ".." || strings.HasPrefix(clean, "../") {
+			return fmt.Errorf("invalid output path %q. Must not refer outside the checkout", p)
+		}
+		if p == OutputAll && len(paths) > 1 {
+			return fmt.Errorf("invalid output paths %q. %q must be the only output path", paths, OutputAll)
+		}
+	}
+	return nil
+}
+
+// ValidateEnv checks the command's EnvMode, that it only has an allowlist in the EnvAllowlist mode,
+// and that its SecretEnv name secrets for variables not in its EnvVars.
+func (c Command) ValidateEnv() error {
+	switch c.EnvMode {
+	case execer.EnvInherit, execer.EnvClean:
+		if len(c.EnvAllowlist) > 0 {
+			return fmt.Errorf("invalid env allowlist %q. Only allowed with the %s env mode", c.EnvAllowlist, execer.EnvAllowlist)
+		}
+	case execer.EnvAllowlist:
+		for _, k := range c.EnvAllowlist {
+			if k == "" || strings.Contains(k, "=") {
+				return fmt.Errorf("invalid env allowlist entry %q. Must be a variable name", k)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid env mode %d", c.EnvMode)
+	}
+	for k, name := range c.SecretEnv {
+		if k == "" || strings.Contains(k, "=") {
+			return fmt.Errorf("invalid secret env var %q. Must be a variable name", k)
+		}
+		if name == "" {
+			return fmt.Errorf("invalid secret env var %s. Must name a secret", k)
+		}
+		if _, ok := c.EnvVars[k]; ok {
+			return fmt.Errorf("invalid secret env var %s. Also set in the env vars", k)
+		}
+	}
+	return nil
+}
+
 func MakeRunTypeMap() RunTypeMap {
 	return make(map[RunType]snapshot.FilerAndInitDoneCh)
 }
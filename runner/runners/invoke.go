@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"path/filepath"
 	"time"
 
 	uuid "github.com/nu7hatch/gouuid"
@@ -249,9 +250,24 @@ func (inv *Invoker) run(cmd *runner.Command, id runner.RunID, abortCh chan struc
 	}
 	defer stdlog.Close()
 
+	execCmd := execer.Command{
+		Argv:    cmd.Argv,
+		EnvVars: cmd.EnvVars,
+		Dir:     co.Path(),
+		Stdout:  io.MultiWriter(stdout, stdlog),
+		Stderr:  io.MultiWriter(stderr, stdlog),
+		MemCh:   memCh,
+		Limits:  execer.Limits{MemoryBytes: cmd.Resources.MemoryBytes, CPUMillis: cmd.Resources.CPUMillis},
+		LogTags: cmd.LogTags,
+
+		EnvMode:      cmd.EnvMode,
+		EnvAllowlist: cmd.EnvAllowlist,
+		SecretEnv:    cmd.SecretEnv,
+	}
+
 	marker := "###########################################\n###########################################\n"
-	format := "%s\n\nDate: %v\nOut: %s\tErr: %s\tOutErr: %s\tCmd:\n%v\n\n%s\n\n\nSCOOT_CMD_LOG\n"
-	header := fmt.Sprintf(format, marker, time.Now(), stdout.URI(), stderr.URI(), stdlog.URI(), cmd, marker)
+	format := "%s\n\nDate: %v\nOut: %s\tErr: %s\tOutErr: %s\tCmd:\n%v\n\n%s%s\n\n\nSCOOT_CMD_LOG\n"
+	header := fmt.Sprintf(format, marker, time.Now(), stdout.URI(), stderr.URI(), stdlog.URI(), cmd, envHeader(inv.exec, execCmd), marker)
 	// If we wanted to allow optionally, a switch for this would come either at the Worker level
 	// (via Invoker -> QueueRunner construction), or the Command level (job requestor specifies in e.g. a PlatformProperty)
 
@@ -277,15 +293,7 @@ func (inv *Invoker) run(cmd *runner.Command, id runner.RunID, abortCh chan struc
 			"stdlog": stdlog.AsFile(),
 		}).Debug("Stdout/Stderr output")
 	rts.execStart = stamp() // candidate for availability via Execer
-	p, err := inv.exec.Exec(execer.Command{
-		Argv:    cmd.Argv,
-		EnvVars: cmd.EnvVars,
-		Dir:     co.Path(),
-		Stdout:  io.MultiWriter(stdout, stdlog),
-		Stderr:  io.MultiWriter(stderr, stdlog),
-		MemCh:   memCh,
-		LogTags: cmd.LogTags,
-	})
+	p, err := inv.exec.Exec(execCmd)
 	if err != nil {
 		msg := fmt.Sprintf("could not exec: %s", err)
 		failedStatus := runner.FailedStatus(id, errors.NewError(e.New(msg), errors.CouldNotExecExitCode),
@@ -372,6 +380,33 @@ func (inv *Invoker) run(cmd *runner.Command, id runner.RunID, abortCh chan struc
 	// the command is no longer running, post process the results
 	rts.execEnd = stamp()
 	rts.outputStart = stamp()
+
+	// capture the command's declared outputs as a new snapshot
+	if runStatus.State == runner.COMPLETE && len(cmd.OutputPaths) > 0 {
+		snapshotID, isAborted, err := inv.ingestOutputs(cmd, co, runType, abortCh)
+		if isAborted {
+			return runner.AbortStatus(id, tags.LogTags{JobID: cmd.JobID, TaskID: cmd.TaskID, Tag: cmd.Tag})
+		}
+		if err != nil {
+			msg := fmt.Sprintf("could not ingest outputs %q: %s", cmd.OutputPaths, err)
+			stdout.Write([]byte(fmt.Sprintf("\n\n%s\n\nFAILED\n\n%s", marker, msg)))
+			stderr.Write([]byte(fmt.Sprintf("\n\n%s\n\nFAILED\n\n%s", marker, msg)))
+			stdlog.Write([]byte(fmt.Sprintf("\n\n%s\n\nFAILED\n\n%s", marker, msg)))
+			runStatus = runner.FailedStatus(id, errors.NewError(e.New(msg), errors.OutputIngestFailureExitCode),
+				tags.LogTags{JobID: cmd.JobID, TaskID: cmd.TaskID, Tag: cmd.Tag})
+		} else {
+			log.WithFields(
+				log.Fields{
+					"runID":      id,
+					"tag":        cmd.Tag,
+					"jobID":      cmd.JobID,
+					"taskID":     cmd.TaskID,
+					"snapshotID": snapshotID,
+				}).Info("Ingested outputs")
+			runStatus.SnapshotID = snapshotID
+		}
+	}
+
 	var stderrUrl, stdoutUrl string
 	// only upload logs to a permanent location if a log uploader is initialized
 	if inv.uploader != nil {
@@ -446,6 +481,68 @@ func (inv *Invoker) uploadLog(logId, filepath string, abortCh chan struct{}) (st
 	}
 }
 
+// Returns the effective environment of the command for the log header, empty if the execer can't tell.
+func envHeader(ex execer.Execer, cmd execer.Command) string {
+	b, ok := ex.(execer.EnvBuilder)
+	if !ok {
+		return ""
+	}
+	env := b.Env(cmd)
+	if env == nil {
+		return ""
+	}
+	header := fmt.Sprintf("Env (%s):\n", cmd.EnvMode)
+	for _, kv := range env {
+		header += fmt.Sprintf("  %s\n", kv)
+	}
+	return header + "\n"
+}
+
+// ingestOutputs stores the command's OutputPaths from the checkout in a new snapshot and returns its id.
+// Paths keep their checkout-relative location in the new snapshot.
+// Returns isAborted if abortCh is signaled before the ingest finishes.
+func (inv *Invoker) ingestOutputs(cmd *runner.Command, co snapshot.Checkout, runType runner.RunType,
+	abortCh chan struct{}) (snapshotID string, isAborted bool, err error) {
+	if err := runner.ValidateOutputPaths(cmd.OutputPaths); err != nil {
+		return "", false, err
+	}
+	defer inv.stat.Latency(stats.WorkerIngestLatency_ms).Time().Stop()
+
+	filer := inv.filerMap[runType].Filer
+	ingestCh := make(chan error)
+	go func() {
+		var err error
+		if len(cmd.OutputPaths) == 1 && cmd.OutputPaths[0] == runner.OutputAll {
+			snapshotID, err = filer.Ingest(co.Path())
+		} else {
+			srcToDest := make(map[string]string)
+			for _, p := range cmd.OutputPaths {
+				srcToDest[filepath.Join(co.Path(), p)] = filepath.Clean(p)
+			}
+			snapshotID, err = filer.IngestMap(srcToDest)
+		}
+		ingestCh <- err
+	}()
+
+	select {
+	case <-abortCh:
+		if err := filer.CancelIngest(); err != nil {
+			log.Errorf("Error canceling ingest: %s", err)
+		}
+		if err := <-ingestCh; err != nil {
+			log.Errorf("Ingest errored: %s", err)
+		}
+		return "", true, nil
+	case err := <-ingestCh:
+		if err != nil {
+			inv.stat.Counter(stats.WorkerIngestFailures).Inc(1)
+			return "", false, err
+		}
+		inv.stat.Counter(stats.WorkerIngests).Inc(1)
+		return snapshotID, false, nil
+	}
+}
+
 func getPostExecRunStatus(st execer.ProcessStatus, id runner.RunID, cmd *runner.Command) (runStatus runner.RunStatus) {
 	switch st.State {
 	case execer.COMPLETE:
@@ -469,21 +566,15 @@ func getPostExecRunStatus(st execer.ProcessStatus, id runner.RunID, cmd *runner.
 // Tracking timestamps for stages of an invoker run.
 // Values are only set with non-zero Time when stage has completed successfully.
 type runTimes struct {
-	invokeStart           time.Time
-	invokeEnd             time.Time
-	inputStart            time.Time
-	actionCacheCheckStart time.Time
-	actionCacheCheckEnd   time.Time
-	actionFetchStart      time.Time
-	actionFetchEnd        time.Time
-	commandFetchStart     time.Time
-	commandFetchEnd       time.Time
-	inputEnd              time.Time
-	execStart             time.Time
-	execEnd               time.Time
-	outputStart           time.Time
-	outputEnd             time.Time
-	queuedTime            time.Time // set by scheduler and must be populated e.g. by task metadata
+	invokeStart time.Time
+	invokeEnd   time.Time
+	inputStart  time.Time
+	inputEnd    time.Time
+	execStart   time.Time
+	execEnd     time.Time
+	outputStart time.Time
+	outputEnd   time.Time
+	queuedTime  time.Time // set by scheduler and must be populated e.g. by task metadata
 }
 
 // Wrapper around time values to encourage "stamp()" usage so it's harder to lose track of runTimes fields.
@@ -57,6 +57,12 @@ type cmdAndID struct {
 	id  runner.RunID
 }
 
+type runningCmd struct {
+	cmd       *runner.Command
+	resources runner.Resources
+	abortCh   chan<- struct{}
+}
+
 /*
 NewQueueRunner creates a new Service that uses a Queue
 If the worker has an initialization step (indicated by non-nil in idc) the queue will wait for the
@@ -86,10 +92,6 @@ func NewQueueRunner(
 	postprocessors []func() error,
 	uploader LogUploader,
 ) runner.Service {
-	if stat == nil {
-		stat = stats.NilStatsReceiver()
-	}
-
 	//FIXME(jschiller): proper history config rather than keying off of capacity and if this is a SingleRunner.
 	history := 1
 	if capacity > 0 {
@@ -97,6 +99,27 @@ func NewQueueRunner(
 	} else if capacity == 0 {
 		capacity = 1 // singleRunner, override capacity so it can actually run a command.
 	}
+	return newQueueRunner(exec, filerMap, output, capacity, history, runner.Resources{},
+		stat, dirMonitor, rID, preprocessors, postprocessors, uploader)
+}
+
+func newQueueRunner(
+	exec execer.Execer,
+	filerMap runner.RunTypeMap,
+	output runner.OutputCreator,
+	capacity int,
+	history int,
+	resources runner.Resources,
+	stat stats.StatsReceiver,
+	dirMonitor *stats.DirsMonitor,
+	rID runner.RunnerID,
+	preprocessors []func() error,
+	postprocessors []func() error,
+	uploader LogUploader,
+) runner.Service {
+	if stat == nil {
+		stat = stats.NilStatsReceiver()
+	}
 
 	statusManager := NewStatusManager(history)
 	inv := NewInvoker(exec, filerMap, output, stat, dirMonitor, rID, preprocessors, postprocessors, uploader)
@@ -107,8 +130,11 @@ func NewQueueRunner(
 		filerMap:      filerMap,
 		updateReq:     make(map[runner.RunType]bool),
 		capacity:      capacity,
+		resources:     resources,
+		running:       make(map[runner.RunID]runningCmd),
 		reqCh:         make(chan interface{}),
 		updateCh:      make(chan interface{}),
+		doneCh:        make(chan runner.RunStatus),
 		cancelTimerCh: make(chan interface{}),
 	}
 	run := &Service{controller, statusManager}
@@ -142,14 +168,14 @@ func NewQueueRunner(
 			wg.Wait()
 			if initErr != nil {
 				stat.Counter(stats.WorkerDownloadInitFailure).Inc(1)
-				statusManager.UpdateService(runner.ServiceStatus{Initialized: false, Error: initErr})
+				statusManager.UpdateService(runner.ServiceStatus{Initialized: false, Error: initErr, Capacity: resources})
 			} else {
-				statusManager.UpdateService(runner.ServiceStatus{Initialized: true})
+				statusManager.UpdateService(runner.ServiceStatus{Initialized: true, Capacity: resources})
 				controller.startUpdateTickers()
 			}
 		}()
 	} else {
-		statusManager.UpdateService(runner.ServiceStatus{Initialized: true})
+		statusManager.UpdateService(runner.ServiceStatus{Initialized: true, Capacity: resources})
 		controller.startUpdateTickers()
 	}
 
@@ -173,7 +199,31 @@ func NewSingleRunner(
 	return NewQueueRunner(exec, filerMap, output, 0, stat, dirMonitor, rID, preprocessors, postprocessors, uploader)
 }
 
-// QueueController maintains a queue of commands to run (up to capacity).
+// NewPackingRunner creates a runner that runs commands concurrently while the sum of their
+// Resources fits in the given capacity, which it advertises in its ServiceStatus so the
+// scheduler can pack tasks onto the worker.  Commands without Resources run alone, and
+// commands that don't fit yet wait in an unbounded queue, which only fills up if the
+// scheduler's view of the worker is stale.
+// Concurrent commands need a filer that can check out more than one snapshot at a time,
+// the gitdb filer serializes its checkouts.
+func NewPackingRunner(
+	exec execer.Execer,
+	filerMap runner.RunTypeMap,
+	output runner.OutputCreator,
+	capacity runner.Resources,
+	stat stats.StatsReceiver,
+	dirMonitor *stats.DirsMonitor,
+	rID runner.RunnerID,
+	preprocessors []func() error,
+	postprocessors []func() error,
+	uploader LogUploader,
+) runner.Service {
+	return newQueueRunner(exec, filerMap, output, 0, 0, capacity,
+		stat, dirMonitor, rID, preprocessors, postprocessors, uploader)
+}
+
+// QueueController maintains a queue of commands to run (up to capacity, or unbounded if zero).
+// Runs one command at a time, or as many as fit in its resources if they're non-zero.
 // Manages updates to underlying Filer via Filer's Update interface,
 // if a non-zero update interval is defined (updates and tasks cannot run concurrently)
 type QueueController struct {
@@ -183,11 +233,11 @@ type QueueController struct {
 	updateLock    sync.Mutex
 	statusManager *StatusManager
 	capacity      int
+	resources     runner.Resources
 
-	queue        []cmdAndID
-	runningID    runner.RunID
-	runningCmd   *runner.Command
-	runningAbort chan<- struct{}
+	queue     []cmdAndID // commands waiting to run
+	running   map[runner.RunID]runningCmd
+	allocated runner.Resources // sum of the resources of running commands
 
 	// used to track if there is a recurring infrastructure issue
 	lastExitCode errors.ExitCode
@@ -196,6 +246,8 @@ type QueueController struct {
 	reqCh chan interface{}
 	// used to signal a request to update the Filer
 	updateCh chan interface{}
+	// used to signal that a run finished
+	doneCh chan runner.RunStatus
 	// used to cancel the timer goroutine if started.
 	cancelTimerCh chan interface{}
 }
@@ -246,9 +298,9 @@ func (c *QueueController) enqueue(cmd *runner.Command) (runner.RunStatus, error)
 		log.Fields{
 			"ready":          svcStatus.Initialized,
 			"err":            svcStatus.Error,
-			"availableSlots": c.capacity - len(c.queue),
+			"availableSlots": c.capacity - len(c.queue) - len(c.running),
 			"totalSlots":     c.capacity,
-			"currentRun":     c.runningID,
+			"numRunning":     len(c.running),
 			"jobID":          cmd.JobID,
 			"taskID":         cmd.TaskID,
 			"tag":            cmd.Tag,
@@ -261,9 +313,12 @@ func (c *QueueController) enqueue(cmd *runner.Command) (runner.RunStatus, error)
 		}
 		return runner.RunStatus{Error: errStr}, fmt.Errorf(QueueInitingMsg)
 	}
-	if len(c.queue) >= c.capacity {
+	if c.capacity > 0 && len(c.queue)+len(c.running) >= c.capacity {
 		return runner.RunStatus{}, fmt.Errorf(QueueFullMsg)
 	}
+	if err := cmd.Resources.Validate(); err != nil {
+		return runner.RunStatus{}, err
+	}
 
 	st, err := c.statusManager.NewRun()
 	if err != nil {
@@ -283,17 +338,18 @@ func (c *QueueController) Abort(run runner.RunID) (runner.RunStatus, error) {
 }
 
 func (c *QueueController) abort(run runner.RunID) (runner.RunStatus, error) {
-	if run == c.runningID {
-		if c.runningAbort != nil {
+	if r, ok := c.running[run]; ok {
+		if r.abortCh != nil {
 			log.WithFields(
 				log.Fields{
-					"currentRun": c.runningID,
-					"jobID":      c.runningCmd.JobID,
-					"taskID":     c.runningCmd.TaskID,
-					"tag":        c.runningCmd.Tag,
+					"currentRun": run,
+					"jobID":      r.cmd.JobID,
+					"taskID":     r.cmd.TaskID,
+					"tag":        r.cmd.Tag,
 				}).Info("Aborting")
-			close(c.runningAbort)
-			c.runningAbort = nil
+			close(r.abortCh)
+			r.abortCh = nil
+			c.running[run] = r
 		}
 	} else {
 		for i, cmdID := range c.queue {
@@ -330,7 +386,6 @@ func (c *QueueController) Release() {
 // Handle requests to run and update, to provide concurrency management between the two.
 // Although we can still receive run requests, runs and updates are done blocking.
 func (c *QueueController) loop() {
-	var watchCh chan runner.RunStatus
 	var updateDoneCh chan interface{}
 	updateRequested := false
 
@@ -338,7 +393,7 @@ func (c *QueueController) loop() {
 	idleLatency.Time()
 
 	tryUpdate := func() {
-		if watchCh == nil && updateDoneCh == nil {
+		if len(c.running) == 0 && updateDoneCh == nil {
 			updateRequested = false
 			updateDoneCh = make(chan interface{})
 			go func() {
@@ -357,14 +412,7 @@ func (c *QueueController) loop() {
 				for _, t := range typesToUpdate {
 					log.Infof("Running filer update for type %v", t)
 					if err := c.filerMap[t].Filer.Update(); err != nil {
-						fields := log.Fields{"err": err}
-						if c.runningCmd != nil {
-							fields["runType"] = t
-							fields["tag"] = c.runningCmd.Tag
-							fields["jobID"] = c.runningCmd.JobID
-							fields["taskID"] = c.runningCmd.TaskID
-						}
-						log.WithFields(fields).Error("Error running Filer Update")
+						log.WithFields(log.Fields{"err": err, "runType": t}).Error("Error running Filer Update")
 					}
 				}
 				updateDoneCh <- nil
@@ -374,11 +422,15 @@ func (c *QueueController) loop() {
 		}
 	}
 
+	// Starts queued commands in order while they fit.
 	tryRun := func() {
-		if watchCh == nil && updateDoneCh == nil && len(c.queue) > 0 {
+		for updateDoneCh == nil && len(c.queue) > 0 && c.fits(c.queue[0].cmd) {
+			if len(c.running) == 0 {
+				idleLatency.Stop()
+			}
 			cmdID := c.queue[0]
-			watchCh = c.runAndWatch(cmdID)
-			idleLatency.Stop()
+			c.queue = c.queue[1:]
+			c.runAndWatch(cmdID)
 		}
 	}
 
@@ -421,21 +473,45 @@ func (c *QueueController) loop() {
 				r.resultCh <- result{st, err}
 			}
 
-		case <-watchCh:
-			// Handle finished run by resetting state.
-			watchCh = nil
-			c.runningID = ""
-			c.runningCmd = nil
-			c.runningAbort = nil
-			c.queue = c.queue[1:]
-			idleLatency.Time()
+		case st := <-c.doneCh:
+			// Handle finished run by releasing its resources.
+			if c.killForPersistenError(st.ExitCode) {
+				// not incrementing the worker kill stat, since we kill the worker immediately, before the increment
+				// can be reported
+				log.Fatalf("Errors (%d) occurred multiple times in a row recorded. Killing worker.", st.ExitCode)
+			}
+			c.lastExitCode = errors.ExitCode(st.ExitCode)
+			c.allocated = c.allocated.Sub(c.running[st.RunID].resources)
+			delete(c.running, st.RunID)
+			if len(c.running) == 0 {
+				idleLatency.Time()
+			}
 		}
 	}
 }
 
-// Run cmd and then start a new goroutine to watch the cmd.
-// Returns a watchCh for goroutine completion.
-func (c *QueueController) runAndWatch(cmdID cmdAndID) chan runner.RunStatus {
+// Returns the resources cmd takes from this worker, all of them unless it declares its own.
+func (c *QueueController) resourcesFor(cmd *runner.Command) runner.Resources {
+	if cmd.Resources.IsZero() {
+		return c.resources
+	}
+	return cmd.Resources
+}
+
+// Returns true if cmd can start now.  An idle worker runs any command, even one that needs
+// more than its resources, otherwise commands must fit in the resources left by running ones.
+func (c *QueueController) fits(cmd *runner.Command) bool {
+	if len(c.running) == 0 {
+		return true
+	}
+	if c.resources.IsZero() || cmd.Resources.IsZero() {
+		return false
+	}
+	return cmd.Resources.Fits(c.resources.Sub(c.allocated))
+}
+
+// Run cmd and then start a new goroutine to watch the cmd, which sends its final status to doneCh.
+func (c *QueueController) runAndWatch(cmdID cmdAndID) {
 	log.WithFields(
 		log.Fields{
 			"jobID":  cmdID.cmd.JobID,
@@ -444,11 +520,10 @@ func (c *QueueController) runAndWatch(cmdID cmdAndID) chan runner.RunStatus {
 			"newLen": len(c.queue),
 			"tag":    cmdID.cmd.Tag,
 		}).Info("Running")
-	watchCh := make(chan runner.RunStatus)
 	abortCh, statusUpdateCh := c.inv.Run(cmdID.cmd, cmdID.id)
-	c.runningAbort = abortCh
-	c.runningID = cmdID.id
-	c.runningCmd = cmdID.cmd
+	resources := c.resourcesFor(cmdID.cmd)
+	c.running[cmdID.id] = runningCmd{cmd: cmdID.cmd, resources: resources, abortCh: abortCh}
+	c.allocated = c.allocated.Add(resources)
 	go func() {
 		for st := range statusUpdateCh {
 			log.WithFields(
@@ -466,18 +541,11 @@ func (c *QueueController) runAndWatch(cmdID cmdAndID) chan runner.RunStatus {
 				}).Info("Queue received status update")
 			c.statusManager.Update(st)
 			if st.State.IsDone() {
-				if c.killForPersistenError(st.ExitCode) {
-					// not incrementing the worker kill stat, since we kill the worker immediately, before the increment
-					// can be reported
-					log.Fatalf("Errors (%d) occurred multiple times in a row recorded. Killing worker.", st.ExitCode)
-				}
-				c.lastExitCode = errors.ExitCode(st.ExitCode)
-				watchCh <- st
+				c.doneCh <- st
 				return
 			}
 		}
 	}()
-	return watchCh
 }
 
 /*
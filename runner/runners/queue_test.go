@@ -202,6 +202,50 @@ func TestAbortQueuedCommand(t *testing.T) {
 	assertWait(t, env.r, run1, aborted())
 }
 
+// Run commands on a packing runner, verify that commands run concurrently while their resources
+// fit, and that commands that don't fit, or that need the whole worker, wait in the queue.
+func TestPackingRunner(t *testing.T) {
+	sim := execers.NewSimExecer()
+	filerMap := runner.MakeRunTypeMap()
+	filerMap[runner.RunTypeScoot] = snapshot.FilerAndInitDoneCh{Filer: snapshots.MakeInvalidFiler(), IDC: nil}
+	capacity := runner.Resources{CPUMillis: 1000, MemoryBytes: 1000}
+	r := NewPackingRunner(sim, filerMap, NewNullOutputCreator(), capacity, nil, stats.NopDirsMonitor, runner.EmptyID, []func() error{}, []func() error{}, nil)
+
+	runWithResources := func(resources runner.Resources, args ...string) runner.RunID {
+		st, err := r.Run(&runner.Command{Argv: args, Resources: resources})
+		if err != nil {
+			t.Fatalf("Couldn't run: %v %v", args, err)
+		}
+		return st.RunID
+	}
+
+	half := runner.Resources{CPUMillis: 500, MemoryBytes: 200}
+	run1 := runWithResources(half, "pause", "complete 0")
+	run2 := runWithResources(half, "pause", "complete 1")
+	assertWait(t, r, run1, running(), "pause", "complete 0")
+	assertWait(t, r, run2, running(), "pause", "complete 1")
+
+	run3 := runWithResources(half, "complete 2")
+	run4 := runWithResources(runner.Resources{}, "complete 3")
+	assertWait(t, r, run3, pending(), "complete 2")
+	assertWait(t, r, run4, pending(), "complete 3")
+
+	_, svc, err := r.StatusAll()
+	if err != nil || svc.Capacity != capacity {
+		t.Fatalf("Expected the runner to advertise its capacity %s, got %s, %v", capacity, svc.Capacity, err)
+	}
+	if _, err := r.Run(&runner.Command{Argv: []string{"complete 0"}, Resources: runner.Resources{CPUMillis: -1}}); err == nil {
+		t.Fatalf("Expected negative resources to be rejected")
+	}
+
+	sim.Resume()
+	sim.Resume()
+	assertWait(t, r, run1, complete(0), "n/a")
+	assertWait(t, r, run2, complete(1), "n/a")
+	assertWait(t, r, run3, complete(2), "n/a")
+	assertWait(t, r, run4, complete(3), "n/a")
+}
+
 func setup(capacity int, interval time.Duration, t *testing.T) *env {
 	log.AddHook(hooks.NewContextHook())
 	logrusLevel, _ := log.ParseLevel("debug")
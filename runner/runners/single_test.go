@@ -3,6 +3,7 @@ package runners
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
@@ -10,6 +11,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/twitter/scoot/common/errors"
 	"github.com/twitter/scoot/common/log/hooks"
 	"github.com/twitter/scoot/common/stats"
 	"github.com/twitter/scoot/runner"
@@ -160,6 +162,75 @@ func TestMemCap(t *testing.T) {
 	}
 }
 
+func TestOutputSnapshot(t *testing.T) {
+	defer teardown(t)
+	tmp, _ := ioutil.TempDir("", "")
+	defer os.RemoveAll(tmp)
+	filer := snapshots.MakeTempFiler(tmp)
+	cmd := &runner.Command{
+		Argv:        []string{"sh", "-c", "mkdir out && echo hello > out/result.txt && echo ignored > scratch.txt"},
+		OutputPaths: []string{"out"},
+	}
+	e := os_execer.NewBoundedExecer(0, nil, stats.NilStatsReceiver())
+	filerMap := runner.MakeRunTypeMap()
+	filerMap[runner.RunTypeScoot] = snapshot.FilerAndInitDoneCh{Filer: filer, IDC: nil}
+	r := NewSingleRunner(e, filerMap, NewNullOutputCreator(), nil, stats.NopDirsMonitor, runner.EmptyID, []func() error{}, []func() error{}, nil)
+	if _, err := r.Run(cmd); err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	query := runner.Query{
+		AllRuns: true,
+		States:  runner.DONE_MASK,
+	}
+	runs, _, err := r.Query(query, runner.Wait{Timeout: 10 * time.Second})
+	if err != nil {
+		t.Fatalf(err.Error())
+	} else if len(runs) != 1 {
+		t.Fatalf("Expected a single finished run, got %v", len(runs))
+	} else if runs[0].State != runner.COMPLETE || runs[0].SnapshotID == "" {
+		t.Fatalf("Expected a COMPLETE run with an output snapshot, got %v", runs[0])
+	}
+
+	co, err := filer.Checkout(runs[0].SnapshotID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer co.Release()
+	if b, err := ioutil.ReadFile(filepath.Join(co.Path(), "out", "result.txt")); err != nil || string(b) != "hello\n" {
+		t.Fatalf("Expected output snapshot to contain out/result.txt, got %q, err=%v", b, err)
+	}
+	if _, err := os.Stat(filepath.Join(co.Path(), "scratch.txt")); !os.IsNotExist(err) {
+		t.Fatalf("Expected output snapshot to exclude undeclared paths, got err=%v", err)
+	}
+}
+
+func TestOutputSnapshotInvalidPath(t *testing.T) {
+	defer teardown(t)
+	tmp, _ := ioutil.TempDir("", "")
+	defer os.RemoveAll(tmp)
+	cmd := &runner.Command{Argv: []string{"complete 0"}, OutputPaths: []string{"../escape"}}
+	filerMap := runner.MakeRunTypeMap()
+	filerMap[runner.RunTypeScoot] = snapshot.FilerAndInitDoneCh{Filer: snapshots.MakeTempFiler(tmp), IDC: nil}
+	r := NewSingleRunner(execers.NewSimExecer(), filerMap, NewNullOutputCreator(), nil, stats.NopDirsMonitor, runner.EmptyID, []func() error{}, []func() error{}, nil)
+	if _, err := r.Run(cmd); err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	query := runner.Query{
+		AllRuns: true,
+		States:  runner.DONE_MASK,
+	}
+	runs, _, err := r.Query(query, runner.Wait{Timeout: 5 * time.Second})
+	if err != nil {
+		t.Fatalf(err.Error())
+	} else if len(runs) != 1 {
+		t.Fatalf("Expected a single finished run, got %v", len(runs))
+	} else if runs[0].State != runner.FAILED || runs[0].ExitCode != errors.OutputIngestFailureExitCode {
+		t.Fatalf("Expected a FAILED run with exit code %d, got %v", errors.OutputIngestFailureExitCode, runs[0])
+	}
+}
+
 func TestStats(t *testing.T) {
 	defer teardown(t)
 	stat, statsReg := setupTest()
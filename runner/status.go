@@ -152,10 +152,15 @@ func CompleteStatus(runID RunID, snapshotID string, exitCode errors.ExitCode, ta
 	return r
 }
 
-// This is for overall runner status, just 'initialized' status and error for now.
+// This is for overall runner status, 'initialized' status, error, the capacity
+// available to the runs (zero if the service runs one command at a time),
+// and labels the worker serving the runs is configured with.
 type ServiceStatus struct {
 	Initialized bool
 	Error       error
+	Capacity    Resources
+	Labels      map[string]string
+	Draining    bool // The service is shutting down, it takes no new runs.
 }
 
 func (s ServiceStatus) String() string {
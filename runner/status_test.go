@@ -27,6 +27,26 @@ func TestCommandStringSimple(t *testing.T) {
 	}
 }
 
+func TestCommandStringSecretEnv(t *testing.T) {
+	c := Command{
+		Argv:      []string{"./deploy"},
+		SecretEnv: map[string]string{"TOKEN": "deploy-token"},
+	}
+
+	expected := `runner.Command -- SnapshotID:  # Argv: ["./deploy"] # Timeout: 0s` +
+		` # JobID:  # TaskID:  # Tag:  # SecretEnv:  TOKEN=<secret deploy-token>`
+	if s := c.String(); s != expected {
+		t.Errorf("\nGot:\n%s\nExpected:\n%s\n", s, expected)
+	}
+	if err := c.ValidateEnv(); err != nil {
+		t.Errorf("Unexpected error validating the env: %v", err)
+	}
+	c.EnvVars = map[string]string{"TOKEN": "s3cr3t"}
+	if err := c.ValidateEnv(); err == nil {
+		t.Errorf("Expected an error for a secret env var also in the env vars")
+	}
+}
+
 func TestProcStatusStringCompleted(t *testing.T) {
 	ps := RunStatus{
 		RunID:      RunID("12"),
@@ -25,6 +25,16 @@ type Saga struct {
 	chMutex  sync.RWMutex // controls send and close of channel
 	closed   bool
 	stat     stats.StatsReceiver
+
+	// Fields used to watch for updates, guarded by mutex.
+	version      int64            // version of the last applied update
+	taskVersions map[string]int64 // version of the last update to each task
+	updatedCh    chan struct{}    // closed and replaced every time an update is applied
+	active       *activeSagas     // registry to leave once the saga ends, nil if not tracked
+
+	// Fields used to checkpoint the saga's state, guarded by mutex.
+	checkpointInterval int // number of logged messages between checkpoints, 0 disables checkpoints
+	uncheckpointed     int // number of messages logged since the last checkpoint
 }
 
 // Start a New Saga.  Logs a Start Saga Message to the SagaLog
@@ -43,13 +53,18 @@ func newSaga(sagaId string, job []byte, log SagaLog, stat stats.StatsReceiver) (
 	updateCh := make(chan sagaUpdate, common.DefaultSagaUpdateChSize)
 
 	s := &Saga{
-		id:       sagaId,
-		log:      log,
-		state:    state,
-		updateCh: updateCh,
-		mutex:    sync.RWMutex{},
-		chMutex:  sync.RWMutex{},
-		stat:     stat,
+		id:           sagaId,
+		log:          log,
+		state:        state,
+		updateCh:     updateCh,
+		mutex:        sync.RWMutex{},
+		chMutex:      sync.RWMutex{},
+		stat:         stat,
+		version:      nextVersion(0),
+		taskVersions: make(map[string]int64),
+		updatedCh:    make(chan struct{}),
+
+		checkpointInterval: common.DefaultSagaCheckpointInterval,
 	}
 
 	go s.updateSagaStateLoop()
@@ -62,13 +77,23 @@ func newSaga(sagaId string, job []byte, log SagaLog, stat stats.StatsReceiver) (
 func rehydrateSaga(sagaId string, state *SagaState, log SagaLog, stat stats.StatsReceiver) *Saga {
 	updateCh := make(chan sagaUpdate, common.DefaultSagaUpdateChSize)
 	s := &Saga{
-		id:       sagaId,
-		log:      log,
-		state:    state,
-		updateCh: updateCh,
-		mutex:    sync.RWMutex{},
-		chMutex:  sync.RWMutex{},
-		stat:     stat,
+		id:           sagaId,
+		log:          log,
+		state:        state,
+		updateCh:     updateCh,
+		mutex:        sync.RWMutex{},
+		chMutex:      sync.RWMutex{},
+		stat:         stat,
+		version:      nextVersion(0),
+		taskVersions: make(map[string]int64),
+		updatedCh:    make(chan struct{}),
+
+		checkpointInterval: common.DefaultSagaCheckpointInterval,
+	}
+
+	// we don't know when recovered tasks last changed, report them all as changed now.
+	for _, taskId := range state.GetTaskIds() {
+		s.taskVersions[taskId] = s.version
 	}
 
 	if !state.IsSagaCompleted() {
@@ -185,13 +210,22 @@ func (s *Saga) updateSagaState(msgs []SagaMessage) error {
 	result := <-resultCh
 
 	// after we successfully log an EndSaga message close the channel
-	// no more messages should be logged
+	// no more messages should be logged. If logging failed the saga hasn't
+	// ended, keep it open and active so EndSaga can be retried.
+	if result != nil {
+		return result
+	}
 	for _, msg := range msgs {
 		if msg.MsgType == EndSaga {
 			s.chMutex.Lock()
-			close(s.updateCh)
-			s.closed = true
+			if !s.closed {
+				close(s.updateCh)
+				s.closed = true
+			}
 			s.chMutex.Unlock()
+			if s.active != nil {
+				s.active.remove(s)
+			}
 			break
 		}
 	}
@@ -267,6 +301,9 @@ func (s *Saga) updateSaga(updates []sagaUpdate) {
 	err = logMessages(validUpdateMsgs, s.log)
 	if err != nil {
 		s.state = oldState
+	} else {
+		s.recordUpdate(validUpdateMsgs)
+		s.maybeCheckpoint(len(validUpdateMsgs))
 	}
 
 	// forward result to all the update result channels
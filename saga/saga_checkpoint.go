@@ -0,0 +1,108 @@
+package saga
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Serializable copy of a SagaState, used as the data of Checkpoint messages.
+type sagaStateCheckpoint struct {
+	SagaId        string
+	Job           []byte
+	TaskState     map[string]flag
+	TaskData      map[string]taskDataCheckpoint
+	SagaAborted   bool
+	SagaCompleted bool
+}
+
+type taskDataCheckpoint struct {
+	TaskStart     []byte
+	TaskEnd       []byte
+	CompTaskStart []byte
+	CompTaskEnd   []byte
+}
+
+// Serializes the state so it can be logged as a Checkpoint message.
+func serializeSagaState(state *SagaState) ([]byte, error) {
+	cp := sagaStateCheckpoint{
+		SagaId:        state.sagaId,
+		Job:           state.job,
+		TaskState:     state.taskState,
+		TaskData:      make(map[string]taskDataCheckpoint, len(state.taskData)),
+		SagaAborted:   state.sagaAborted,
+		SagaCompleted: state.sagaCompleted,
+	}
+	for taskId, data := range state.taskData {
+		cp.TaskData[taskId] = taskDataCheckpoint{
+			TaskStart:     data.taskStart,
+			TaskEnd:       data.taskEnd,
+			CompTaskStart: data.compTaskStart,
+			CompTaskEnd:   data.compTaskEnd,
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&cp); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Deserializes the SagaState logged in a Checkpoint message.
+func deserializeSagaState(data []byte) (*SagaState, error) {
+	var cp sagaStateCheckpoint
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&cp); err != nil {
+		return nil, err
+	}
+
+	state, err := makeSagaState(cp.SagaId, cp.Job)
+	if err != nil {
+		return nil, err
+	}
+	state.sagaAborted = cp.SagaAborted
+	state.sagaCompleted = cp.SagaCompleted
+	for taskId, flags := range cp.TaskState {
+		state.taskState[taskId] = flags
+	}
+	for taskId, data := range cp.TaskData {
+		state.taskData[taskId] = &taskData{
+			taskStart:     data.TaskStart,
+			taskEnd:       data.TaskEnd,
+			compTaskStart: data.CompTaskStart,
+			compTaskEnd:   data.CompTaskEnd,
+		}
+	}
+	return state, nil
+}
+
+// Checkpoints the saga's state once checkpointInterval messages have been logged
+// since the last checkpoint, if its SagaLog supports checkpoints.  The messages are
+// already durable, so a failed checkpoint is only logged and retried after the next update.
+// Must be called with the saga's mutex held for writing.
+func (s *Saga) maybeCheckpoint(numLogged int) {
+	cpLog, ok := s.log.(SagaCheckpointLog)
+	if !ok || s.checkpointInterval <= 0 {
+		return
+	}
+	// an ended saga isn't updated anymore, and checkpointing it would replace its EndSaga
+	// message, which SagaLogs rely on to know it can be GCd.
+	if s.state.IsSagaCompleted() {
+		return
+	}
+	s.uncheckpointed += numLogged
+	if s.uncheckpointed < s.checkpointInterval {
+		return
+	}
+
+	data, err := serializeSagaState(s.state)
+	if err == nil {
+		err = cpLog.LogCheckpoint(MakeCheckpointMessage(s.id, data))
+	}
+	if err != nil {
+		log.Errorf("Error checkpointing saga %s: %v", s.id, err)
+		return
+	}
+	s.uncheckpointed = 0
+}
@@ -0,0 +1,203 @@
+package saga
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+
+	"github.com/twitter/scoot/common/stats"
+)
+
+func Test_CheckpointReplay(t *testing.T) {
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 1000
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("Serialized SagaState deserializes to an equal SagaState", prop.ForAll(
+		func(state *SagaState) bool {
+			data, err := serializeSagaState(state)
+			if err != nil {
+				return false
+			}
+			newState, err := deserializeSagaState(data)
+			return err == nil && sagaStatesEqual(state, newState)
+		},
+		GenSagaState(true),
+	))
+
+	properties.Property("Replay from a checkpoint equals full replay", prop.ForAll(
+		func(state *SagaState, split uint) bool {
+			msgs := sagaStateToMessages(state)
+			fullState, err := recoverStateFromMessages(state.SagaId(), msgs)
+			if err != nil {
+				return false
+			}
+
+			// checkpoint the state after the first n messages, and replay the rest
+			n := int(split%uint(len(msgs))) + 1
+			cpState, err := recoverStateFromMessages(state.SagaId(), msgs[:n])
+			if err != nil {
+				return false
+			}
+			data, err := serializeSagaState(cpState)
+			if err != nil {
+				return false
+			}
+			cpMsgs := append([]SagaMessage{MakeCheckpointMessage(state.SagaId(), data)}, msgs[n:]...)
+			replayedState, err := recoverStateFromMessages(state.SagaId(), cpMsgs)
+
+			return err == nil && sagaStatesEqual(fullState, replayedState)
+		},
+		GenSagaState(true),
+		gen.UInt(),
+	))
+
+	properties.TestingRun(t)
+}
+
+func TestSagaCheckpointsState(t *testing.T) {
+	cpLog := &checkpointSagaLog{sagas: make(map[string][]SagaMessage)}
+	sc := MakeSagaCoordinator(cpLog, stats.NilStatsReceiver())
+	s, _ := sc.MakeSaga("testSaga", []byte("job"))
+	s.mutex.Lock()
+	s.checkpointInterval = 2
+	s.mutex.Unlock()
+
+	s.StartTask("task1", []byte("start1"))
+	s.EndTask("task1", []byte("end1"))
+	s.StartTask("task2", nil)
+
+	msgs, _ := cpLog.GetMessages("testSaga")
+	if len(msgs) != 2 || msgs[0].MsgType != Checkpoint || msgs[1].MsgType != StartTask {
+		t.Fatalf("Expected a checkpoint followed by the StartTask message, got %v", msgs)
+	}
+
+	state, err := sc.GetSagaState("testSaga")
+	if err != nil {
+		t.Fatalf("Unexpected error recovering saga state %v", err)
+	}
+	if !sagaStatesEqual(s.GetState(), state) {
+		t.Errorf("Expected recovered state %v to equal the saga's state %v", state, s.GetState())
+	}
+}
+
+func TestSagaEndedNotCheckpointed(t *testing.T) {
+	cpLog := &checkpointSagaLog{sagas: make(map[string][]SagaMessage)}
+	sc := MakeSagaCoordinator(cpLog, stats.NilStatsReceiver())
+	s, _ := sc.MakeSaga("testSaga", []byte("job"))
+	s.mutex.Lock()
+	s.checkpointInterval = 1
+	s.mutex.Unlock()
+
+	s.StartTask("task1", nil)
+	s.EndTask("task1", nil)
+	s.EndSaga()
+
+	msgs, _ := cpLog.GetMessages("testSaga")
+	if len(msgs) != 2 || msgs[0].MsgType != Checkpoint || msgs[1].MsgType != EndSaga {
+		t.Fatalf("Expected a checkpoint followed by the EndSaga message, got %v", msgs)
+	}
+}
+
+// Returns valid messages that produce the state when logged in order.
+func sagaStateToMessages(state *SagaState) []SagaMessage {
+	id := state.SagaId()
+	taskIds := state.GetTaskIds()
+	sort.Strings(taskIds)
+
+	msgs := []SagaMessage{MakeStartSagaMessage(id, state.Job())}
+	for _, taskId := range taskIds {
+		msgs = append(msgs, MakeStartTaskMessage(id, taskId, state.GetStartTaskData(taskId)))
+	}
+	for _, taskId := range taskIds {
+		if state.IsTaskCompleted(taskId) {
+			msgs = append(msgs, MakeEndTaskMessage(id, taskId, state.GetEndTaskData(taskId)))
+		}
+	}
+	if state.IsSagaAborted() {
+		msgs = append(msgs, MakeAbortSagaMessage(id))
+		for _, taskId := range taskIds {
+			if state.IsCompTaskStarted(taskId) {
+				msgs = append(msgs, MakeStartCompTaskMessage(id, taskId, state.GetStartCompTaskData(taskId)))
+			}
+			if state.IsCompTaskCompleted(taskId) {
+				msgs = append(msgs, MakeEndCompTaskMessage(id, taskId, state.GetEndCompTaskData(taskId)))
+			}
+		}
+	}
+	if state.IsSagaCompleted() {
+		msgs = append(msgs, MakeEndSagaMessage(id))
+	}
+	return msgs
+}
+
+// Compares saga states, treating nil and empty data as equal.
+func sagaStatesEqual(a, b *SagaState) bool {
+	if a.SagaId() != b.SagaId() || !bytes.Equal(a.Job(), b.Job()) ||
+		a.IsSagaAborted() != b.IsSagaAborted() || a.IsSagaCompleted() != b.IsSagaCompleted() ||
+		!reflect.DeepEqual(a.taskState, b.taskState) {
+		return false
+	}
+	for taskId := range a.taskState {
+		if !bytes.Equal(a.GetStartTaskData(taskId), b.GetStartTaskData(taskId)) ||
+			!bytes.Equal(a.GetEndTaskData(taskId), b.GetEndTaskData(taskId)) ||
+			!bytes.Equal(a.GetStartCompTaskData(taskId), b.GetStartCompTaskData(taskId)) ||
+			!bytes.Equal(a.GetEndCompTaskData(taskId), b.GetEndCompTaskData(taskId)) {
+			return false
+		}
+	}
+	return true
+}
+
+// In memory SagaLog supporting checkpoints, sagalogs can't be imported by the saga package tests.
+type checkpointSagaLog struct {
+	sagas map[string][]SagaMessage
+	mutex sync.Mutex
+}
+
+func (l *checkpointSagaLog) StartSaga(sagaId string, job []byte) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.sagas[sagaId] = []SagaMessage{MakeStartSagaMessage(sagaId, job)}
+	return nil
+}
+
+func (l *checkpointSagaLog) LogMessage(message SagaMessage) error {
+	return l.LogBatchMessages([]SagaMessage{message})
+}
+
+func (l *checkpointSagaLog) LogBatchMessages(messages []SagaMessage) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.sagas[messages[0].SagaId] = append(l.sagas[messages[0].SagaId], messages...)
+	return nil
+}
+
+func (l *checkpointSagaLog) LogCheckpoint(message SagaMessage) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.sagas[message.SagaId] = []SagaMessage{message}
+	return nil
+}
+
+func (l *checkpointSagaLog) GetMessages(sagaId string) ([]SagaMessage, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return append([]SagaMessage{}, l.sagas[sagaId]...), nil
+}
+
+func (l *checkpointSagaLog) GetActiveSagas() ([]string, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	ids := []string{}
+	for id := range l.sagas {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
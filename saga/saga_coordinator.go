@@ -1,6 +1,8 @@
 package saga
 
 import (
+	"time"
+
 	log "github.com/sirupsen/logrus"
 	"github.com/twitter/scoot/common/stats"
 )
@@ -11,8 +13,9 @@ import (
 // which returns a saga based on its implementation.
 //
 type SagaCoordinator struct {
-	log  SagaLog
-	stat stats.StatsReceiver
+	log    SagaLog
+	stat   stats.StatsReceiver
+	active *activeSagas
 }
 
 //
@@ -24,15 +27,30 @@ func MakeSagaCoordinator(log SagaLog, stat stats.StatsReceiver) SagaCoordinator
 	}
 
 	return SagaCoordinator{
-		log:  log,
-		stat: stat,
+		log:    log,
+		stat:   stat,
+		active: newActiveSagas(),
 	}
 }
 
 // Make a Saga add it to the SagaCoordinator, if a Saga Already exists
 // with the same id, it will overwrite the already existing one.
 func (s SagaCoordinator) MakeSaga(sagaId string, job []byte) (*Saga, error) {
-	return newSaga(sagaId, job, s.log, s.stat)
+	saga, err := newSaga(sagaId, job, s.log, s.stat)
+	if err != nil {
+		return nil, err
+	}
+	s.active.add(saga)
+	return saga, nil
+}
+
+// Loads the SagaLog if it implements SagaLoadLog, so it includes the sagas logged by
+// others, e.g. by the previous leader when a standby scheduler takes over.
+func (s SagaCoordinator) LoadLog() error {
+	if loadLog, ok := s.log.(SagaLoadLog); ok {
+		return loadLog.Load()
+	}
+	return nil
 }
 
 // Read the Current SagaState from the Log, intended for status queries does not check for recovery.
@@ -74,6 +92,9 @@ func (sc SagaCoordinator) RecoverSagaState(sagaId string, recoveryType SagaRecov
 
 	// now that we've recovered the saga initialize its update path
 	saga := rehydrateSaga(sagaId, state, sc.log, sc.stat)
+	if !state.IsSagaCompleted() {
+		sc.active.add(saga)
+	}
 
 	// Check if we can safely proceed forward based on recovery method
 	// RollbackRecovery must check if in a SafeState,
@@ -98,6 +119,56 @@ func (sc SagaCoordinator) RecoverSagaState(sagaId string, recoveryType SagaRecov
 	return saga, err
 }
 
+// WatchSaga blocks until the specified saga has been updated after sinceVersion or the timeout
+// expires, and returns the changes made after sinceVersion.
+//
+// Only in progress sagas made or recovered by this coordinator can be watched.  A saga that isn't
+// made or recovered yet is waited for until the timeout expires, unless sinceVersion is 0.  For a
+// saga that ended, or is still not in progress once the timeout expired, the state is read from the
+// SagaLog and returned with every task reported as changed, and with sinceVersion as its version,
+// or InactiveSagaVersion if sinceVersion is 0.  If no saga exists for the requested id, nil is returned.
+func (sc SagaCoordinator) WatchSaga(sagaId string, sinceVersion int64, timeout time.Duration) (*SagaUpdate, error) {
+	start := time.Now()
+	if saga := sc.active.get(sagaId); saga != nil {
+		return saga.WaitForUpdate(sinceVersion, timeout), nil
+	}
+
+	state, err := recoverState(sagaId, sc)
+	if err != nil {
+		return nil, err
+	}
+	if sinceVersion > 0 && (state == nil || !state.IsSagaCompleted()) {
+		// wait for the saga to be made or recovered rather than have the watcher poll until it is
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		if saga := sc.active.waitFor(sagaId, timer.C); saga != nil {
+			return saga.WaitForUpdate(sinceVersion, timeout-time.Since(start)), nil
+		}
+		if state, err = recoverState(sagaId, sc); err != nil {
+			return nil, err
+		}
+	}
+	if state == nil {
+		return nil, nil
+	}
+
+	version := sinceVersion
+	if version == 0 {
+		version = InactiveSagaVersion
+	}
+	return &SagaUpdate{
+		State:          state,
+		Version:        version,
+		ChangedTaskIds: state.GetTaskIds(),
+	}, nil
+}
+
+// ListSagas returns the ids of all sagas in the SagaLog, including completed
+// sagas the log still retains.
+func (sc SagaCoordinator) ListSagas() ([]string, error) {
+	return sc.log.GetActiveSagas()
+}
+
 // GetNumSagas get the number of sagas currently being managed in memory
 func (sc SagaCoordinator) GetNumSagas() int {
 	var sagas []string
@@ -14,6 +14,7 @@ const (
 	EndTask
 	StartCompTask
 	EndCompTask
+	Checkpoint
 )
 
 func (s SagaMessageType) String() string {
@@ -32,6 +33,8 @@ func (s SagaMessageType) String() string {
 		return "Start Comp Task"
 	case EndCompTask:
 		return "End Comp Task"
+	case Checkpoint:
+		return "Checkpoint"
 	default:
 		return "unknown"
 	}
@@ -152,3 +155,17 @@ func MakeEndCompTaskMessage(sagaId string, taskId string, results []byte) SagaMe
 		Data:    results,
 	}
 }
+
+/*
+ * Checkpoint SagaMessageType
+ *  - sagaId - id of the Saga
+ *  - state  - the serialized SagaState, replaces all
+ *             messages logged before the checkpoint
+ */
+func MakeCheckpointMessage(sagaId string, state []byte) SagaMessage {
+	return SagaMessage{
+		SagaId:  sagaId,
+		MsgType: Checkpoint,
+		Data:    state,
+	}
+}
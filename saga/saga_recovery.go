@@ -33,25 +33,36 @@ func recoverState(sagaId string, saga SagaCoordinator) (*SagaState, error) {
 		return nil, nil
 	}
 
-	// Reconstruct Saga State from Logged Messages
-	startMsg := msgs[0]
-	if startMsg.MsgType != StartSaga {
-		return nil, fmt.Errorf("InvalidMessages: first message must be StartSaga")
-	}
+	return recoverStateFromMessages(sagaId, msgs)
+}
 
-	state, err := makeSagaState(sagaId, startMsg.Data)
-	if err != nil {
-		return nil, err
+// Reconstructs SagaState from logged messages.  The first message must be
+// StartSaga or a Checkpoint, replay starts from the state in the latest Checkpoint.
+func recoverStateFromMessages(sagaId string, msgs []SagaMessage) (*SagaState, error) {
+	startMsg := msgs[0]
+	if startMsg.MsgType != StartSaga && startMsg.MsgType != Checkpoint {
+		return nil, fmt.Errorf("InvalidMessages: first message must be StartSaga or Checkpoint")
 	}
 
+	var state *SagaState
+	var err error
 	for _, msg := range msgs {
-		// skip applying StartSaga message we already did this
-		// duplicate messages are just ignored since msgs are idempotent
-		if msg.MsgType == StartSaga {
-			continue
+		switch msg.MsgType {
+		case StartSaga:
+			// duplicate messages are just ignored since msgs are idempotent
+			if state == nil {
+				state, err = makeSagaState(sagaId, msg.Data)
+			}
+		case Checkpoint:
+			// the checkpoint includes the effect of all preceding messages
+			state, err = deserializeSagaState(msg.Data)
+			if err != nil {
+				err = NewCorruptedSagaLogError(sagaId, fmt.Sprintf("Error deserializing checkpoint: %v", err))
+			}
+		default:
+			err = updateSagaState(state, msg)
 		}
 
-		err = updateSagaState(state, msg)
 		if err != nil {
 			return nil, err
 		}
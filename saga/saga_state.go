@@ -277,6 +277,9 @@ func updateSagaState(state *SagaState, msg SagaMessage) error {
 	case StartSaga:
 		return NewInvalidSagaStateError("Cannot apply a StartSaga Message to an already existing Saga")
 
+	case Checkpoint:
+		return NewInvalidSagaMessageError("Checkpoint Messages are only logged by the Saga itself")
+
 	case EndSaga:
 		//A Successfully Completed Saga must have StartTask/EndTask pairs for all messages or
 		//an aborted Saga must have StartTask/StartCompTask/EndCompTask pairs for all messages
@@ -38,8 +38,10 @@ func TestEndSagaLogError(t *testing.T) {
 	sagaLogMock := NewMockSagaLog(mockCtrl)
 	sagaLogMock.EXPECT().StartSaga("testSaga", nil)
 	sagaLogMock.EXPECT().LogMessage(entry).Return(errors.New("Failed to Log EndSaga Message"))
+	sagaLogMock.EXPECT().LogMessage(entry)
 
-	s, err := newSaga("testSaga", nil, sagaLogMock, stats.NilStatsReceiver())
+	sc := MakeSagaCoordinator(sagaLogMock, stats.NilStatsReceiver())
+	s, err := sc.MakeSaga("testSaga", nil)
 	err = s.EndSaga()
 
 	if err == nil {
@@ -49,6 +51,17 @@ func TestEndSagaLogError(t *testing.T) {
 	if s.GetState().IsSagaCompleted() {
 		t.Error("Expected saga to not be completed")
 	}
+
+	// the saga is still active and can be ended once the log recovers
+	if sc.active.get("testSaga") == nil {
+		t.Error("Expected saga to still be active")
+	}
+	if err := s.EndSaga(); err != nil {
+		t.Error("Expected retrying EndSaga to not return an error", err)
+	}
+	if sc.active.get("testSaga") != nil {
+		t.Error("Expected saga to not be active once ended")
+	}
 }
 
 func TestAbortSaga(t *testing.T) {
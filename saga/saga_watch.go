@@ -0,0 +1,152 @@
+package saga
+
+import (
+	"sync"
+	"time"
+)
+
+// SagaUpdate describes how a Saga changed since a given version.
+type SagaUpdate struct {
+	// Copy of the current SagaState
+	State *SagaState
+
+	// Version of the returned state, pass it to the next watch to only get newer changes
+	Version int64
+
+	// Ids of the tasks that had messages logged after the requested version
+	ChangedTaskIds []string
+}
+
+// Version returned for a saga that isn't in progress in this coordinator when watched without a
+// version.  It's older than the version of any saga that is, so watching it again with this
+// version waits for the saga to be made or recovered instead of returning right away.
+const InactiveSagaVersion int64 = 1
+
+// Tracks the in progress Sagas made or recovered by a SagaCoordinator so
+// their updates can be watched without re-reading the SagaLog.
+// A nil *activeSagas tracks nothing.
+type activeSagas struct {
+	mutex   sync.RWMutex
+	sagas   map[string]*Saga
+	addedCh chan struct{} // closed and replaced whenever a saga is added
+}
+
+func newActiveSagas() *activeSagas {
+	return &activeSagas{sagas: make(map[string]*Saga), addedCh: make(chan struct{})}
+}
+
+func (a *activeSagas) add(s *Saga) {
+	if a == nil {
+		return
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	s.active = a
+	a.sagas[s.id] = s
+	close(a.addedCh)
+	a.addedCh = make(chan struct{})
+}
+
+// Removes the saga, unless it has already been replaced by a newer saga with the same id.
+func (a *activeSagas) remove(s *Saga) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.sagas[s.id] == s {
+		delete(a.sagas, s.id)
+	}
+}
+
+func (a *activeSagas) get(sagaId string) *Saga {
+	if a == nil {
+		return nil
+	}
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	return a.sagas[sagaId]
+}
+
+// Blocks until a saga with the id is added, and returns it, or returns nil once timeoutCh fires.
+func (a *activeSagas) waitFor(sagaId string, timeoutCh <-chan time.Time) *Saga {
+	if a == nil {
+		return nil
+	}
+	for {
+		a.mutex.RLock()
+		s, addedCh := a.sagas[sagaId], a.addedCh
+		a.mutex.RUnlock()
+		if s != nil {
+			return s
+		}
+
+		select {
+		case <-addedCh:
+		case <-timeoutCh:
+			return nil
+		}
+	}
+}
+
+// Versions are derived from the wall clock so they keep increasing when
+// a saga is recovered by a new process, and are strictly increasing otherwise.
+func nextVersion(prev int64) int64 {
+	if v := time.Now().UnixNano(); v > prev {
+		return v
+	}
+	return prev + 1
+}
+
+// Records that msgs were applied to the saga and wakes up any watchers.
+// Must be called with the saga's mutex held for writing.
+func (s *Saga) recordUpdate(msgs []SagaMessage) {
+	s.version = nextVersion(s.version)
+	for _, msg := range msgs {
+		if msg.TaskId != "" {
+			s.taskVersions[msg.TaskId] = s.version
+		}
+	}
+	close(s.updatedCh)
+	s.updatedCh = make(chan struct{})
+}
+
+// Returns the changes made to the saga after sinceVersion.
+// Must be called with the saga's mutex held.
+func (s *Saga) makeUpdate(sinceVersion int64) *SagaUpdate {
+	update := &SagaUpdate{
+		State:          copySagaState(s.state),
+		Version:        s.version,
+		ChangedTaskIds: []string{},
+	}
+	for taskId, version := range s.taskVersions {
+		if version > sinceVersion {
+			update.ChangedTaskIds = append(update.ChangedTaskIds, taskId)
+		}
+	}
+	return update
+}
+
+// Blocks until an update newer than sinceVersion has been applied to the saga, or
+// the timeout expires.  Returns the changes made after sinceVersion, which are empty
+// if the timeout expired first.
+func (s *Saga) WaitForUpdate(sinceVersion int64, timeout time.Duration) *SagaUpdate {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		s.mutex.RLock()
+		if s.version > sinceVersion {
+			update := s.makeUpdate(sinceVersion)
+			s.mutex.RUnlock()
+			return update
+		}
+		updatedCh := s.updatedCh
+		s.mutex.RUnlock()
+
+		select {
+		case <-updatedCh:
+		case <-timer.C:
+			s.mutex.RLock()
+			defer s.mutex.RUnlock()
+			return s.makeUpdate(sinceVersion)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package saga
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestWatchSagaReturnsChangedTasks(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	sagaLogMock := NewMockSagaLog(mockCtrl)
+	sagaLogMock.EXPECT().StartSaga("testSaga", nil)
+	sagaLogMock.EXPECT().LogMessage(gomock.Any()).AnyTimes()
+
+	sc := MakeSagaCoordinator(sagaLogMock, nil)
+	s, _ := sc.MakeSaga("testSaga", nil)
+	s.StartTask("task1", nil)
+
+	update, err := sc.WatchSaga("testSaga", 0, time.Second)
+	if err != nil {
+		t.Fatalf("Unexpected error watching saga %v", err)
+	}
+	if !reflect.DeepEqual(update.ChangedTaskIds, []string{"task1"}) {
+		t.Errorf("Expected task1 to be changed, got %v", update.ChangedTaskIds)
+	}
+
+	// a watch from the latest version waits for the next update
+	resultCh := make(chan *SagaUpdate)
+	go func() {
+		update, _ := sc.WatchSaga("testSaga", update.Version, time.Minute)
+		resultCh <- update
+	}()
+	s.StartTask("task2", nil)
+
+	next := <-resultCh
+	if next.Version <= update.Version {
+		t.Errorf("Expected version to increase past %d, got %d", update.Version, next.Version)
+	}
+	if !reflect.DeepEqual(next.ChangedTaskIds, []string{"task2"}) {
+		t.Errorf("Expected only task2 to be changed, got %v", next.ChangedTaskIds)
+	}
+	if !next.State.IsTaskStarted("task1") || !next.State.IsTaskStarted("task2") {
+		t.Errorf("Expected the full current state to be returned, got %v", next.State)
+	}
+}
+
+func TestWatchSagaTimeout(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	sagaLogMock := NewMockSagaLog(mockCtrl)
+	sagaLogMock.EXPECT().StartSaga("testSaga", nil)
+
+	sc := MakeSagaCoordinator(sagaLogMock, nil)
+	s, _ := sc.MakeSaga("testSaga", nil)
+	version := s.WaitForUpdate(0, 0).Version
+
+	update, _ := sc.WatchSaga("testSaga", version, 10*time.Millisecond)
+	if update.Version != version || len(update.ChangedTaskIds) != 0 {
+		t.Errorf("Expected an empty update after timing out, got %+v", update)
+	}
+}
+
+func TestWatchSagaEndedReadsLog(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	msgs := []SagaMessage{
+		MakeStartSagaMessage("testSaga", nil),
+		MakeStartTaskMessage("testSaga", "task1", nil),
+		MakeEndTaskMessage("testSaga", "task1", nil),
+		MakeEndSagaMessage("testSaga"),
+	}
+	sagaLogMock := NewMockSagaLog(mockCtrl)
+	sagaLogMock.EXPECT().StartSaga("testSaga", nil)
+	sagaLogMock.EXPECT().LogMessage(gomock.Any()).AnyTimes()
+	sagaLogMock.EXPECT().GetMessages("testSaga").Return(msgs, nil)
+
+	sc := MakeSagaCoordinator(sagaLogMock, nil)
+	s, _ := sc.MakeSaga("testSaga", nil)
+	s.StartTask("task1", nil)
+	s.EndTask("task1", nil)
+	s.EndSaga()
+
+	update, err := sc.WatchSaga("testSaga", 5, time.Minute)
+	if err != nil {
+		t.Fatalf("Unexpected error watching saga %v", err)
+	}
+	if update.Version != 5 || !update.State.IsSagaCompleted() {
+		t.Errorf("Expected the completed state from the log, got %+v", update)
+	}
+	if !reflect.DeepEqual(update.ChangedTaskIds, []string{"task1"}) {
+		t.Errorf("Expected all tasks to be reported as changed, got %v", update.ChangedTaskIds)
+	}
+}
+
+func TestWatchSagaWaitsForSagaToBeMade(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	sagaLogMock := NewMockSagaLog(mockCtrl)
+	sagaLogMock.EXPECT().GetMessages("testSaga").Return(nil, nil).AnyTimes()
+	sagaLogMock.EXPECT().StartSaga("testSaga", nil)
+
+	sc := MakeSagaCoordinator(sagaLogMock, nil)
+	update, err := sc.WatchSaga("testSaga", 0, time.Minute)
+	if err != nil || update != nil {
+		t.Fatalf("Expected no update for a saga that doesn't exist, got %+v, %v", update, err)
+	}
+
+	// a watch with a version waits until the timeout instead of returning right away
+	start := time.Now()
+	if update, _ := sc.WatchSaga("testSaga", InactiveSagaVersion, 50*time.Millisecond); update != nil {
+		t.Errorf("Expected no update after timing out, got %+v", update)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Expected the watch to wait for the timeout, returned after %s", elapsed)
+	}
+
+	// and returns the saga's updates once it's made
+	resultCh := make(chan *SagaUpdate)
+	go func() {
+		update, _ := sc.WatchSaga("testSaga", InactiveSagaVersion, time.Minute)
+		resultCh <- update
+	}()
+	sc.MakeSaga("testSaga", nil)
+
+	next := <-resultCh
+	if next == nil || next.Version <= InactiveSagaVersion {
+		t.Errorf("Expected the update of the made saga, got %+v", next)
+	}
+}
@@ -43,6 +43,35 @@ type SagaLog interface {
 	GetActiveSagas() ([]string, error)
 }
 
+/*
+ *  Optional interface for SagaLogs that can compact the messages of a saga.
+ *  Sagas periodically checkpoint their state when their SagaLog implements it,
+ *  so recovering a saga does not replay every message ever logged for it.
+ */
+type SagaCheckpointLog interface {
+	/*
+	 * Durably replace all messages logged so far for the saga with
+	 * the Checkpoint message.  GetMessages must then return the
+	 * checkpoint followed by any messages logged after it.
+	 * Returns an error if it fails, the saga's messages must then be unchanged.
+	 */
+	LogCheckpoint(message SagaMessage) error
+}
+
+/*
+ *  Optional interface for SagaLogs that must be loaded from storage before they are used,
+ *  e.g. because they index the log in memory.  A SagaLog several schedulers share can then
+ *  be left unloaded while a scheduler stands by, and be loaded once it's elected leader.
+ */
+type SagaLoadLog interface {
+	/*
+	 * Loads the log, including everything logged by others before it was loaded.
+	 * Does nothing if the log is already loaded.
+	 * Returns an error if it fails.
+	 */
+	Load() error
+}
+
 // CorruptedSagaLogError this is a critical error specifies
 // that the data stored in the sagalog for a specified saga
 // is corrupted and unrecoverable.
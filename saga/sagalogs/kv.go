@@ -0,0 +1,877 @@
+package sagalogs
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/twitter/scoot/saga"
+)
+
+// Writes the Saga Log to a single append-only file, keyed by sagaId with an in-memory
+// index of the records belonging to each saga.  Unlike the fileSagaLog a saga does not
+// create any files or directories, so the log does not churn inodes.
+// It isn't built on a general purpose embedded KV store like bbolt: the log only appends
+// records, reads a saga's records and deletes whole sagas, which a record file with an
+// index by sagaId does with one write and fsync per batch and without a dependency.
+//
+// Every StartSaga, LogMessage or LogBatchMessages call appends one record with a single
+// write and fsyncs the file before returning, so a batch is either fully logged or not at all.
+// A record that was only partially written when the process died fails its checksum and is
+// truncated when the log is reopened.
+//
+// The log is replicated to the files in the replica directories, which should be on other
+// disks or machines, e.g. network mounts.  A record is only acknowledged once it is fsynced
+// to the log file and every replica, so a write fails if any replica is unavailable.
+// Reads only use the log file.  When the log is opened, the log file and every replica are
+// validated and the copy whose last valid record was written last, the log file on a tie,
+// is copied over the log file, then the replicas are rewritten as copies of the log file.
+// So a log file that lost records, e.g. to a truncated or corrupted tail, or a replaced disk,
+// is restored from a replica instead of overwriting it.
+//
+// Record format, integers are little endian:
+// payload length uint32
+// payload crc32 (IEEE) uint32
+// payload op byte, append or delete
+// payload write time, unix nanos int64
+// payload sagaId, uvarint length prefixed, empty for delete
+// append payload message count, uvarint
+// append payload messages, each a message type byte, then
+// the taskId and data, both uvarint length prefixed
+// delete payload deleted sagaId count, uvarint
+// delete payload deleted sagaIds, each uvarint length prefixed
+//
+// A Checkpoint record replaces the saga's earlier records in the index, like a StartSaga record.
+// Completed sagas that haven't been updated for gcExpiration are deleted by appending a delete
+// record, and the file is rewritten without the deleted records once they make up most of it.
+type kvSagaLog struct {
+	fileName     string
+	replicaNames []string
+	file         *os.File // nil until the log is loaded
+	size         int64    // offset at which the next record is written
+	garbage      int64    // bytes used by records of deleted, restarted or checkpointed sagas
+	sagas        map[string]*kvSagaEntry
+	replicas     []*kvReplica
+	mutex        sync.RWMutex
+
+	gcExpiration      time.Duration
+	gcInterval        time.Duration
+	gcTicker          *time.Ticker
+	compactMinGarbage int64
+}
+
+// index entry for a saga in the kvSagaLog
+type kvSagaEntry struct {
+	records   []kvRecordRef
+	completed bool
+	updated   time.Time
+}
+
+// copy of the log file in a replica directory, records are appended at its own size
+// so a replica that was rewritten separately from the log file stays usable
+type kvReplica struct {
+	fileName string
+	file     *os.File
+	size     int64
+}
+
+// location of a record in the log file, including its header
+type kvRecordRef struct {
+	offset int64
+	size   int64
+}
+
+type kvRecord struct {
+	op         byte
+	written    time.Time
+	sagaId     string
+	messages   []saga.SagaMessage
+	deletedIds []string
+}
+
+const (
+	kvOpAppend byte = 1
+	kvOpDelete byte = 2
+
+	kvHeaderSize = 8
+
+	// name of the log file in the directory given to MakeKVSagaLog
+	kvSagaLogFileName = "sagalog.kv"
+
+	// the log file is only compacted once deleted records use at least this many bytes
+	kvCompactMinGarbage = 64 * 1024 * 1024
+
+	// bytes after a record with an invalid length that are searched for a valid record
+	kvMaxTailScan = 1024 * 1024
+)
+
+var errKVSagaLogNotLoaded = errors.New("Saga log is not loaded, a standby scheduler only loads it once elected leader")
+
+// Creates a kvSagaLog stored in a single file in the specified directory, creating the
+// directory if it does not exist, and loads the index of an existing log.
+// gcExpiration: duration after a completed saga was last updated after which it will be deleted.
+// A zero duration is interpreted as "never gc".
+// gcInterval: duration interval at which GC runs.
+func MakeKVSagaLog(dirName string, gcExpiration time.Duration, gcInterval time.Duration) (*kvSagaLog, error) {
+	return MakeReplicatedKVSagaLog(dirName, nil, gcExpiration, gcInterval)
+}
+
+// Creates a kvSagaLog like MakeKVSagaLog that is replicated to a log file in each of
+// the replica directories, creating them if they do not exist.
+func MakeReplicatedKVSagaLog(
+	dirName string, replicaDirNames []string, gcExpiration time.Duration, gcInterval time.Duration) (*kvSagaLog, error) {
+	slog, err := MakeStandbyKVSagaLog(dirName, replicaDirNames, gcExpiration, gcInterval)
+	if err != nil {
+		return nil, err
+	}
+	if err := slog.Load(); err != nil {
+		return nil, err
+	}
+	return slog, nil
+}
+
+// Creates a kvSagaLog like MakeReplicatedKVSagaLog that isn't loaded until Load is called, for a
+// scheduler standing by while the leader writes to the log.  Loading the log restores, truncates
+// and rewrites its files, and the index would miss the leader's later records, so an unloaded log
+// doesn't touch the files and returns an error for every call.
+func MakeStandbyKVSagaLog(
+	dirName string, replicaDirNames []string, gcExpiration time.Duration, gcInterval time.Duration) (*kvSagaLog, error) {
+	replicaNames := make([]string, 0, len(replicaDirNames))
+	for _, d := range append([]string{dirName}, replicaDirNames...) {
+		if err := os.MkdirAll(d, os.ModePerm); err != nil {
+			return nil, err
+		}
+		replicaNames = append(replicaNames, path.Join(d, kvSagaLogFileName))
+	}
+
+	return &kvSagaLog{
+		fileName:          replicaNames[0],
+		replicaNames:      replicaNames[1:],
+		sagas:             make(map[string]*kvSagaEntry),
+		gcExpiration:      gcExpiration,
+		gcInterval:        gcInterval,
+		compactMinGarbage: kvCompactMinGarbage,
+	}, nil
+}
+
+// Restores the log file from the copy with the latest records, loads its index, syncs the
+// replicas and starts GC.  Does nothing if the log is already loaded.
+func (slog *kvSagaLog) Load() error {
+	slog.mutex.Lock()
+	defer slog.mutex.Unlock()
+	if slog.file != nil {
+		return nil
+	}
+
+	if err := slog.restoreLatestCopy(slog.replicaNames); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(slog.fileName, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	slog.file = file
+	if err := slog.loadIndex(); err != nil {
+		slog.closeFiles()
+		return err
+	}
+	for _, replicaName := range slog.replicaNames {
+		replica, err := copyLogFile(slog.file, slog.size, replicaName)
+		if err != nil {
+			slog.closeFiles()
+			return fmt.Errorf("Error syncing saga log replica %s, Error: %v", replicaName, err)
+		}
+		slog.replicas = append(slog.replicas, replica)
+	}
+
+	if slog.gcExpiration != 0 {
+		slog.gcTicker = time.NewTicker(slog.gcInterval)
+		go func() {
+			for range slog.gcTicker.C {
+				if err := slog.gcSagas(); err != nil {
+					log.Errorf("Error running gcSagas: %s", err)
+				}
+			}
+		}()
+	}
+	return nil
+}
+
+// Stops GC and closes the log file.  The log must not be used after it is closed.
+func (slog *kvSagaLog) Close() error {
+	if slog.gcTicker != nil {
+		slog.gcTicker.Stop()
+	}
+	slog.mutex.Lock()
+	defer slog.mutex.Unlock()
+	return slog.closeFiles()
+}
+
+// Closes the files of a loaded log and resets it to unloaded.
+func (slog *kvSagaLog) closeFiles() error {
+	if slog.file == nil {
+		return nil
+	}
+	for _, replica := range slog.replicas {
+		replica.file.Close()
+	}
+	err := slog.file.Close()
+	slog.file = nil
+	slog.replicas = nil
+	slog.sagas = make(map[string]*kvSagaEntry)
+	slog.size = 0
+	slog.garbage = 0
+	return err
+}
+
+// Log a Start Saga Message message to the log.  Starting a saga that already
+// exists replaces all of its previously logged messages.
+func (slog *kvSagaLog) StartSaga(sagaId string, job []byte) error {
+	slog.mutex.Lock()
+	defer slog.mutex.Unlock()
+
+	if slog.file == nil {
+		return errKVSagaLogNotLoaded
+	}
+
+	now := time.Now()
+	ref, err := slog.append(kvRecord{
+		op:       kvOpAppend,
+		written:  now,
+		sagaId:   sagaId,
+		messages: []saga.SagaMessage{saga.MakeStartSagaMessage(sagaId, job)},
+	})
+	if err != nil {
+		return err
+	}
+	slog.startEntry(sagaId, ref, now)
+	return nil
+}
+
+// Update the State of the Saga by Logging a message.
+// Returns an error if it fails.
+func (slog *kvSagaLog) LogMessage(message saga.SagaMessage) error {
+	return slog.logMessages([]saga.SagaMessage{message})
+}
+
+// Log a batch of messages in one transaction. Assumes messages are for the same saga.
+func (slog *kvSagaLog) LogBatchMessages(msgs []saga.SagaMessage) error {
+	return slog.logMessages(msgs)
+}
+
+func (slog *kvSagaLog) logMessages(msgs []saga.SagaMessage) error {
+	if len(msgs) == 0 {
+		return errors.New("Empty messages slice passed to logMessages")
+	}
+
+	slog.mutex.Lock()
+	defer slog.mutex.Unlock()
+
+	if slog.file == nil {
+		return errKVSagaLogNotLoaded
+	}
+
+	sagaId := msgs[0].SagaId
+	entry, ok := slog.sagas[sagaId]
+	if !ok {
+		return fmt.Errorf("Saga: %s does not exist in the Log", sagaId)
+	}
+
+	now := time.Now()
+	ref, err := slog.append(kvRecord{op: kvOpAppend, written: now, sagaId: sagaId, messages: msgs})
+	if err != nil {
+		return err
+	}
+	entry.records = append(entry.records, ref)
+	entry.updated = now
+	for _, msg := range msgs {
+		if msg.MsgType == saga.EndSaga {
+			entry.completed = true
+		}
+	}
+	return nil
+}
+
+// Replaces all messages logged so far for the saga with the checkpoint.
+// The replaced records are removed from the file when it is compacted.
+func (slog *kvSagaLog) LogCheckpoint(msg saga.SagaMessage) error {
+	slog.mutex.Lock()
+	defer slog.mutex.Unlock()
+
+	if slog.file == nil {
+		return errKVSagaLogNotLoaded
+	}
+
+	entry, ok := slog.sagas[msg.SagaId]
+	if !ok {
+		return fmt.Errorf("Saga: %s does not exist in the Log", msg.SagaId)
+	}
+
+	now := time.Now()
+	ref, err := slog.append(kvRecord{op: kvOpAppend, written: now, sagaId: msg.SagaId, messages: []saga.SagaMessage{msg}})
+	if err != nil {
+		return err
+	}
+	slog.checkpointEntry(entry, ref, now)
+	return nil
+}
+
+// Returns all of the messages logged so far for the
+// specified saga.
+func (slog *kvSagaLog) GetMessages(sagaId string) ([]saga.SagaMessage, error) {
+	slog.mutex.RLock()
+	defer slog.mutex.RUnlock()
+
+	if slog.file == nil {
+		return nil, errKVSagaLogNotLoaded
+	}
+
+	entry, ok := slog.sagas[sagaId]
+	if !ok {
+		return nil, nil
+	}
+
+	msgs := make([]saga.SagaMessage, 0)
+	for _, ref := range entry.records {
+		buf := make([]byte, ref.size)
+		if _, err := slog.file.ReadAt(buf, ref.offset); err != nil {
+			return nil, saga.NewCorruptedSagaLogError(sagaId,
+				fmt.Sprintf("Error reading record at offset %d, Error: %v", ref.offset, err))
+		}
+		record, err := decodeKVRecord(buf)
+		if err != nil {
+			return nil, saga.NewCorruptedSagaLogError(sagaId,
+				fmt.Sprintf("Error decoding record at offset %d, Error: %v", ref.offset, err))
+		}
+		msgs = append(msgs, record.messages...)
+	}
+	return msgs, nil
+}
+
+// Returns the ids of all sagas in the index that haven't been GCd,
+// including completed sagas.
+func (slog *kvSagaLog) GetActiveSagas() ([]string, error) {
+	slog.mutex.RLock()
+	defer slog.mutex.RUnlock()
+
+	if slog.file == nil {
+		return nil, errKVSagaLogNotLoaded
+	}
+
+	sagaIds := make([]string, 0, len(slog.sagas))
+	for sagaId := range slog.sagas {
+		sagaIds = append(sagaIds, sagaId)
+	}
+	return sagaIds, nil
+}
+
+// Deletes completed sagas that haven't been updated for gcExpiration, then
+// compacts the log file if enough of it is used by deleted records.
+func (slog *kvSagaLog) gcSagas() error {
+	slog.mutex.Lock()
+	defer slog.mutex.Unlock()
+
+	expired := make([]string, 0)
+	for sagaId, entry := range slog.sagas {
+		if entry.completed && time.Since(entry.updated) >= slog.gcExpiration {
+			expired = append(expired, sagaId)
+		}
+	}
+	if len(expired) > 0 {
+		ref, err := slog.append(kvRecord{op: kvOpDelete, written: time.Now(), deletedIds: expired})
+		if err != nil {
+			return err
+		}
+		slog.garbage += ref.size
+		for _, sagaId := range expired {
+			slog.deleteEntry(sagaId)
+		}
+	}
+
+	if slog.garbage >= slog.compactMinGarbage && slog.garbage*2 >= slog.size {
+		return slog.compact()
+	}
+	return nil
+}
+
+// Rewrites the log file with only the records of sagas in the index, and atomically
+// replaces the current file with it.  Must be called with the mutex held for writing.
+func (slog *kvSagaLog) compact() error {
+	tmpName := slog.fileName + ".compact"
+	tmp, err := os.OpenFile(tmpName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpName)
+
+	offset := int64(0)
+	newRecords := make(map[string][]kvRecordRef, len(slog.sagas))
+	for sagaId, entry := range slog.sagas {
+		refs := make([]kvRecordRef, 0, len(entry.records))
+		for _, ref := range entry.records {
+			buf := make([]byte, ref.size)
+			if _, err := slog.file.ReadAt(buf, ref.offset); err != nil {
+				tmp.Close()
+				return err
+			}
+			if _, err := tmp.WriteAt(buf, offset); err != nil {
+				tmp.Close()
+				return err
+			}
+			refs = append(refs, kvRecordRef{offset: offset, size: ref.size})
+			offset += ref.size
+		}
+		newRecords[sagaId] = refs
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := os.Rename(tmpName, slog.fileName); err != nil {
+		tmp.Close()
+		return err
+	}
+	syncDir(path.Dir(slog.fileName))
+
+	slog.file.Close()
+	slog.file = tmp
+	slog.size = offset
+	slog.garbage = 0
+	for sagaId, refs := range newRecords {
+		slog.sagas[sagaId].records = refs
+	}
+	log.Infof("Compacted saga log %s to %d bytes", slog.fileName, offset)
+
+	// a replica that fails to be rewritten still holds the same sagas, just uncompacted
+	for i, replica := range slog.replicas {
+		compacted, err := copyLogFile(slog.file, slog.size, replica.fileName)
+		if err != nil {
+			log.Errorf("Error compacting saga log replica %s, Error: %v", replica.fileName, err)
+			continue
+		}
+		replica.file.Close()
+		slog.replicas[i] = compacted
+	}
+	return nil
+}
+
+// Validates the log file and the replicas and copies the one whose last valid record was
+// written last over the log file, unless it's the log file.  A copy with a corrupted record
+// is only used if it's the only one with records, so the error is returned when it's loaded.
+func (slog *kvSagaLog) restoreLatestCopy(replicaNames []string) error {
+	var latest *kvLogCopy
+	for _, fileName := range append([]string{slog.fileName}, replicaNames...) {
+		c, err := readLogCopy(fileName)
+		if _, ok := err.(saga.CorruptedSagaLogError); ok {
+			log.Errorf("Not restoring from corrupted saga log copy %s, Error: %v", fileName, err)
+			continue
+		} else if err != nil {
+			return err
+		}
+		if c.size > 0 && (latest == nil || c.lastWritten.After(latest.lastWritten)) {
+			latest = c
+		}
+	}
+	if latest == nil || latest.fileName == slog.fileName {
+		return nil
+	}
+
+	log.Warnf("Restoring saga log %s from replica %s, which has later records", slog.fileName, latest.fileName)
+	src, err := os.Open(latest.fileName)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	restored, err := copyLogFile(src, latest.size, slog.fileName)
+	if err != nil {
+		return err
+	}
+	return restored.file.Close()
+}
+
+// The valid records of a copy of the log file.
+type kvLogCopy struct {
+	fileName    string
+	size        int64     // bytes of valid records, without a partially written record after them
+	lastWritten time.Time // write time of the last valid record
+}
+
+// Reads the records of a copy of the log file, a missing file has no records.
+func readLogCopy(fileName string) (*kvLogCopy, error) {
+	c := &kvLogCopy{fileName: fileName}
+	file, err := os.Open(fileName)
+	if os.IsNotExist(err) {
+		return c, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	c.size, err = readKVRecords(file, fileName, func(record kvRecord, ref kvRecordRef) {
+		c.lastWritten = record.written
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Atomically replaces dstName with a copy of the first size bytes of src, and
+// returns the copy opened for writing.
+func copyLogFile(src *os.File, size int64, dstName string) (*kvReplica, error) {
+	tmpName := dstName + ".copy"
+	tmp, err := os.OpenFile(tmpName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmpName)
+
+	if _, err = io.Copy(tmp, io.NewSectionReader(src, 0, size)); err == nil {
+		if err = tmp.Sync(); err == nil {
+			err = os.Rename(tmpName, dstName)
+		}
+	}
+	if err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	syncDir(path.Dir(dstName))
+	return &kvReplica{fileName: dstName, file: tmp, size: size}, nil
+}
+
+// Reads every record in the log file to build the index.  A partially written record at
+// the end of the file is truncated, any other invalid record means the log is corrupted.
+func (slog *kvSagaLog) loadIndex() error {
+	info, err := slog.file.Stat()
+	if err != nil {
+		return err
+	}
+	offset, err := readKVRecords(slog.file, slog.fileName, slog.indexRecord)
+	if err != nil {
+		return err
+	}
+
+	if offset < info.Size() {
+		log.Warnf("Truncating partially written record at offset %d in saga log %s", offset, slog.fileName)
+		if err := slog.file.Truncate(offset); err != nil {
+			return err
+		}
+	}
+	slog.size = offset
+	return nil
+}
+
+// Calls fn with every record of the log file in order, and returns the offset after the last
+// valid one.  A partially written record at the end of the file is skipped, any other invalid
+// record means the log is corrupted, including one whose length runs past the end of the file
+// when a valid record follows it.
+func readKVRecords(file *os.File, fileName string, fn func(kvRecord, kvRecordRef)) (int64, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	fileSize := info.Size()
+
+	header := make([]byte, kvHeaderSize)
+	offset := int64(0)
+	for offset < fileSize {
+		if _, err := file.ReadAt(header, offset); err != nil {
+			break
+		}
+		size := kvHeaderSize + int64(binary.LittleEndian.Uint32(header))
+		if offset+size > fileSize {
+			// either the last record was not completely written, or the header is corrupted and
+			// the records after it would be lost by truncating
+			if found, err := hasValidRecord(file, offset+1, fileSize); err != nil {
+				return 0, err
+			} else if found {
+				return 0, saga.NewCorruptedSagaLogError("",
+					fmt.Sprintf("Invalid record length at offset %d in %s", offset, fileName))
+			}
+			break
+		}
+		buf := make([]byte, size)
+		if _, err := file.ReadAt(buf, offset); err != nil {
+			return 0, err
+		}
+		record, err := decodeKVRecord(buf)
+		if err != nil {
+			if offset+size == fileSize {
+				// the last record was not completely written
+				break
+			}
+			return 0, saga.NewCorruptedSagaLogError("",
+				fmt.Sprintf("Error decoding record at offset %d in %s, Error: %v", offset, fileName, err))
+		}
+		fn(record, kvRecordRef{offset: offset, size: size})
+		offset += size
+	}
+	return offset, nil
+}
+
+// Returns true if a valid record is found in the log file between start and end.  Only the
+// first kvMaxTailScan bytes are searched, a torn record is always shorter than that unless
+// it held a very large batch, and only offsets with a valid op are checksummed.
+func hasValidRecord(file *os.File, start, end int64) (bool, error) {
+	if end-start > kvMaxTailScan {
+		end = start + kvMaxTailScan
+	}
+	if end-start < kvHeaderSize+1 {
+		return false, nil
+	}
+	buf := make([]byte, end-start)
+	if _, err := file.ReadAt(buf, start); err != nil {
+		return false, err
+	}
+	for i := 0; i+kvHeaderSize < len(buf); i++ {
+		if op := buf[i+kvHeaderSize]; op != kvOpAppend && op != kvOpDelete {
+			continue
+		}
+		size := kvHeaderSize + int64(binary.LittleEndian.Uint32(buf[i:]))
+		if int64(i)+size > int64(len(buf)) {
+			continue
+		}
+		if _, err := decodeKVRecord(buf[i : int64(i)+size]); err == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Applies a record read from the log file to the index.
+func (slog *kvSagaLog) indexRecord(record kvRecord, ref kvRecordRef) {
+	if record.op == kvOpDelete {
+		slog.garbage += ref.size
+		for _, sagaId := range record.deletedIds {
+			slog.deleteEntry(sagaId)
+		}
+		return
+	}
+
+	entry, ok := slog.sagas[record.sagaId]
+	isStart := len(record.messages) > 0 && record.messages[0].MsgType == saga.StartSaga
+	isCheckpoint := len(record.messages) > 0 && record.messages[0].MsgType == saga.Checkpoint
+	if isStart || (!ok && isCheckpoint) {
+		// compaction drops the records before a checkpoint, including the StartSaga record,
+		// so the checkpoint is the first record of the saga
+		slog.startEntry(record.sagaId, ref, record.written)
+	} else if isCheckpoint {
+		slog.checkpointEntry(entry, ref, record.written)
+	} else if ok {
+		entry.records = append(entry.records, ref)
+		entry.updated = record.written
+	} else {
+		// messages logged for a saga deleted by a later record
+		slog.garbage += ref.size
+		return
+	}
+	for _, msg := range record.messages {
+		if msg.MsgType == saga.EndSaga {
+			slog.sagas[record.sagaId].completed = true
+		}
+	}
+}
+
+// Replaces the saga's index entry with one that only contains its first record, a StartSaga
+// record, or a Checkpoint record once compaction dropped the records before it.
+func (slog *kvSagaLog) startEntry(sagaId string, ref kvRecordRef, written time.Time) {
+	slog.deleteEntry(sagaId)
+	slog.sagas[sagaId] = &kvSagaEntry{records: []kvRecordRef{ref}, updated: written}
+}
+
+// Replaces the records of the saga's index entry with its Checkpoint record.
+func (slog *kvSagaLog) checkpointEntry(entry *kvSagaEntry, ref kvRecordRef, written time.Time) {
+	for _, old := range entry.records {
+		slog.garbage += old.size
+	}
+	entry.records = []kvRecordRef{ref}
+	entry.updated = written
+}
+
+func (slog *kvSagaLog) deleteEntry(sagaId string) {
+	if entry, ok := slog.sagas[sagaId]; ok {
+		for _, ref := range entry.records {
+			slog.garbage += ref.size
+		}
+		delete(slog.sagas, sagaId)
+	}
+}
+
+// Writes the record to the end of the log file and of every replica and fsyncs them.
+// A failed write is truncated from all of the files so the next record starts at a
+// record boundary.  Must be called with the mutex held for writing.
+func (slog *kvSagaLog) append(record kvRecord) (kvRecordRef, error) {
+	buf := encodeKVRecord(record)
+	ref := kvRecordRef{offset: slog.size, size: int64(len(buf))}
+
+	if err := writeSynced(slog.file, buf, ref.offset); err != nil {
+		slog.file.Truncate(ref.offset)
+		return kvRecordRef{}, err
+	}
+	for i, replica := range slog.replicas {
+		if err := writeSynced(replica.file, buf, replica.size); err != nil {
+			for _, written := range slog.replicas[:i+1] {
+				written.file.Truncate(written.size)
+			}
+			slog.file.Truncate(ref.offset)
+			return kvRecordRef{}, fmt.Errorf("Error writing to saga log replica %s, Error: %v", replica.fileName, err)
+		}
+	}
+	for _, replica := range slog.replicas {
+		replica.size += ref.size
+	}
+	slog.size += ref.size
+	return ref, nil
+}
+
+func writeSynced(file *os.File, buf []byte, offset int64) error {
+	if _, err := file.WriteAt(buf, offset); err != nil {
+		return err
+	}
+	return file.Sync()
+}
+
+func encodeKVRecord(record kvRecord) []byte {
+	payload := []byte{record.op}
+	payload = appendUint64(payload, uint64(record.written.UnixNano()))
+	payload = appendBytes(payload, []byte(record.sagaId))
+	if record.op == kvOpAppend {
+		payload = appendUvarint(payload, uint64(len(record.messages)))
+		for _, msg := range record.messages {
+			payload = append(payload, byte(msg.MsgType))
+			payload = appendBytes(payload, []byte(msg.TaskId))
+			payload = appendBytes(payload, msg.Data)
+		}
+	} else {
+		payload = appendUvarint(payload, uint64(len(record.deletedIds)))
+		for _, sagaId := range record.deletedIds {
+			payload = appendBytes(payload, []byte(sagaId))
+		}
+	}
+
+	buf := make([]byte, kvHeaderSize, kvHeaderSize+len(payload))
+	binary.LittleEndian.PutUint32(buf[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(payload))
+	return append(buf, payload...)
+}
+
+// Decodes a record including its header, verifying the payload checksum.
+func decodeKVRecord(buf []byte) (kvRecord, error) {
+	if len(buf) < kvHeaderSize || int(binary.LittleEndian.Uint32(buf[0:4])) != len(buf)-kvHeaderSize {
+		return kvRecord{}, io.ErrUnexpectedEOF
+	}
+	payload := buf[kvHeaderSize:]
+	if crc32.ChecksumIEEE(payload) != binary.LittleEndian.Uint32(buf[4:8]) {
+		return kvRecord{}, errors.New("checksum mismatch")
+	}
+
+	r := &kvReader{buf: payload}
+	record := kvRecord{op: r.byte()}
+	record.written = time.Unix(0, int64(r.uint64()))
+	record.sagaId = string(r.bytes())
+	if record.op == kvOpAppend {
+		for n := r.uvarint(); n > 0 && r.err == nil; n-- {
+			msg := saga.SagaMessage{SagaId: record.sagaId}
+			msg.MsgType = saga.SagaMessageType(r.byte())
+			msg.TaskId = string(r.bytes())
+			msg.Data = r.bytes()
+			record.messages = append(record.messages, msg)
+		}
+	} else if record.op == kvOpDelete {
+		for n := r.uvarint(); n > 0 && r.err == nil; n-- {
+			record.deletedIds = append(record.deletedIds, string(r.bytes()))
+		}
+	} else {
+		return kvRecord{}, fmt.Errorf("unknown record op %d", record.op)
+	}
+	if r.err != nil {
+		return kvRecord{}, r.err
+	}
+	return record, nil
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	var tmp [8]byte
+	binary.LittleEndian.PutUint64(tmp[:], v)
+	return append(buf, tmp[:]...)
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+func appendBytes(buf []byte, b []byte) []byte {
+	return append(appendUvarint(buf, uint64(len(b))), b...)
+}
+
+// Reads the fields of a record payload, recording the first error encountered.
+type kvReader struct {
+	buf []byte
+	err error
+}
+
+func (r *kvReader) byte() byte {
+	if r.err != nil || len(r.buf) < 1 {
+		r.err = io.ErrUnexpectedEOF
+		return 0
+	}
+	b := r.buf[0]
+	r.buf = r.buf[1:]
+	return b
+}
+
+func (r *kvReader) uint64() uint64 {
+	if r.err != nil || len(r.buf) < 8 {
+		r.err = io.ErrUnexpectedEOF
+		return 0
+	}
+	v := binary.LittleEndian.Uint64(r.buf)
+	r.buf = r.buf[8:]
+	return v
+}
+
+func (r *kvReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.buf)
+	if n <= 0 {
+		r.err = io.ErrUnexpectedEOF
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return v
+}
+
+// Returns nil for empty fields, matching the messages made by the saga package.
+func (r *kvReader) bytes() []byte {
+	n := r.uvarint()
+	if r.err != nil || uint64(len(r.buf)) < n {
+		r.err = io.ErrUnexpectedEOF
+		return nil
+	}
+	if n == 0 {
+		return nil
+	}
+	b := make([]byte, n)
+	copy(b, r.buf)
+	r.buf = r.buf[n:]
+	return b
+}
+
+// fsyncs a directory so a rename in it is durable, errors are ignored.
+func syncDir(dirName string) {
+	if dir, err := os.Open(dirName); err == nil {
+		dir.Sync()
+		dir.Close()
+	}
+}
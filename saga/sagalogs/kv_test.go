@@ -0,0 +1,486 @@
+package sagalogs
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/twitter/scoot/saga"
+)
+
+func makeTestKVSagaLog(t *testing.T) (*kvSagaLog, string) {
+	dirName, err := ioutil.TempDir("", "kvsagalog")
+	if err != nil {
+		t.Fatalf("Unexpected Error creating temp dir %v", err)
+	}
+	slog, err := MakeKVSagaLog(dirName, 0, 0)
+	if err != nil {
+		t.Fatalf("Unexpected Error making KV SagaLog %v", err)
+	}
+	return slog, dirName
+}
+
+func fullSagaMessages(sagaId string) []saga.SagaMessage {
+	return []saga.SagaMessage{
+		saga.MakeStartSagaMessage(sagaId, []byte{0, 1, 2, 3, 4, 5}),
+		saga.MakeStartTaskMessage(sagaId, "task1", []byte("run task 1")),
+		saga.MakeEndTaskMessage(sagaId, "task1", []byte("success")),
+		saga.MakeAbortSagaMessage(sagaId),
+		saga.MakeStartCompTaskMessage(sagaId, "task1", []byte("rollingback task1")),
+		saga.MakeEndCompTaskMessage(sagaId, "task1", []byte("finished rollingback task1")),
+		saga.MakeEndSagaMessage(sagaId),
+	}
+}
+
+func TestKVFullSagaSurvivesReopen(t *testing.T) {
+	slog, dirName := makeTestKVSagaLog(t)
+	defer os.RemoveAll(dirName)
+
+	sagaId := "fullsaga"
+	loggedMsgs := fullSagaMessages(sagaId)
+	slog.StartSaga(sagaId, loggedMsgs[0].Data)
+	if err := slog.LogMessage(loggedMsgs[1]); err != nil {
+		t.Fatalf("Unexpected Error Logging Msg: %+v, Error: %v", loggedMsgs[1], err)
+	}
+	if err := slog.LogBatchMessages(loggedMsgs[2:]); err != nil {
+		t.Fatalf("Unexpected Error Logging Batch: %v", err)
+	}
+
+	rtnMsgs, err := slog.GetMessages(sagaId)
+	if err != nil {
+		t.Fatalf("Unexpected Error returned from GetMessages. %v", err)
+	}
+	if !reflect.DeepEqual(loggedMsgs, rtnMsgs) {
+		t.Errorf("Expected Logged Messages %+v, Actual %+v", loggedMsgs, rtnMsgs)
+	}
+
+	slog.Close()
+	slog, err = MakeKVSagaLog(dirName, 0, 0)
+	if err != nil {
+		t.Fatalf("Unexpected Error reopening KV SagaLog %v", err)
+	}
+	defer slog.Close()
+
+	rtnMsgs, err = slog.GetMessages(sagaId)
+	if err != nil {
+		t.Fatalf("Unexpected Error returned from GetMessages after reopening. %v", err)
+	}
+	if !reflect.DeepEqual(loggedMsgs, rtnMsgs) {
+		t.Errorf("Expected Logged Messages after reopening %+v, Actual %+v", loggedMsgs, rtnMsgs)
+	}
+	if !isSagaInActiveList(sagaId, slog) {
+		t.Errorf("Expected Saga to be in active list")
+	}
+}
+
+func TestKVStartSagaTwiceReplacesMessages(t *testing.T) {
+	slog, dirName := makeTestKVSagaLog(t)
+	defer os.RemoveAll(dirName)
+	defer slog.Close()
+
+	sagaId := "start_saga_called_twice"
+	slog.StartSaga(sagaId, []byte{0, 1, 2})
+	slog.LogMessage(saga.MakeStartTaskMessage(sagaId, "task1", nil))
+	slog.StartSaga(sagaId, []byte{3, 4})
+
+	msgs, _ := slog.GetMessages(sagaId)
+	expected := []saga.SagaMessage{saga.MakeStartSagaMessage(sagaId, []byte{3, 4})}
+	if !reflect.DeepEqual(expected, msgs) {
+		t.Errorf("Expected only the last StartSaga message %+v, Actual %+v", expected, msgs)
+	}
+}
+
+func TestKVLogMessageSagaDoesNotExist(t *testing.T) {
+	slog, dirName := makeTestKVSagaLog(t)
+	defer os.RemoveAll(dirName)
+	defer slog.Close()
+
+	if err := slog.LogMessage(saga.MakeEndSagaMessage("does_not_exist")); err == nil {
+		t.Errorf("Expected an error logging to a saga that doesn't exist")
+	}
+	if msgs, err := slog.GetMessages("does_not_exist"); err != nil || msgs != nil {
+		t.Errorf("Expected no messages and no error, got %+v, %v", msgs, err)
+	}
+}
+
+func TestKVPartialRecordTruncatedOnReopen(t *testing.T) {
+	slog, dirName := makeTestKVSagaLog(t)
+	defer os.RemoveAll(dirName)
+
+	sagaId := "torn"
+	slog.StartSaga(sagaId, nil)
+	slog.LogMessage(saga.MakeStartTaskMessage(sagaId, "task1", nil))
+	goodSize := slog.size
+	slog.Close()
+
+	// simulate a crash in the middle of writing the next record
+	torn := encodeKVRecord(kvRecord{op: kvOpAppend, sagaId: sagaId,
+		messages: []saga.SagaMessage{saga.MakeEndTaskMessage(sagaId, "task1", []byte("done"))}})
+	f, _ := os.OpenFile(path.Join(dirName, kvSagaLogFileName), os.O_APPEND|os.O_WRONLY, 0644)
+	f.Write(torn[:len(torn)-3])
+	f.Close()
+
+	slog, err := MakeKVSagaLog(dirName, 0, 0)
+	if err != nil {
+		t.Fatalf("Unexpected Error reopening KV SagaLog %v", err)
+	}
+	defer slog.Close()
+	if slog.size != goodSize {
+		t.Errorf("Expected log to be truncated to %d bytes, got %d", goodSize, slog.size)
+	}
+	msgs, _ := slog.GetMessages(sagaId)
+	if len(msgs) != 2 {
+		t.Errorf("Expected the 2 completely written messages, got %+v", msgs)
+	}
+
+	// the log can be appended to after truncation
+	if err := slog.LogMessage(saga.MakeEndTaskMessage(sagaId, "task1", nil)); err != nil {
+		t.Errorf("Unexpected Error logging after truncation %v", err)
+	}
+	if msgs, _ := slog.GetMessages(sagaId); len(msgs) != 3 {
+		t.Errorf("Expected 3 messages, got %+v", msgs)
+	}
+}
+
+func TestKVCorruptedRecordLengthNotTruncated(t *testing.T) {
+	slog, dirName := makeTestKVSagaLog(t)
+	defer os.RemoveAll(dirName)
+
+	sagaId := "corrupted"
+	slog.StartSaga(sagaId, nil)
+	corruptedOffset := slog.size
+	slog.LogMessage(saga.MakeStartTaskMessage(sagaId, "task1", nil))
+	slog.LogMessage(saga.MakeEndTaskMessage(sagaId, "task1", nil))
+	size := slog.size
+	slog.Close()
+
+	// make the length of a record in the middle of the file run past its end
+	f, _ := os.OpenFile(path.Join(dirName, kvSagaLogFileName), os.O_WRONLY, 0644)
+	f.WriteAt([]byte{0xff, 0xff, 0xff, 0x7f}, corruptedOffset)
+	f.Close()
+
+	if _, err := MakeKVSagaLog(dirName, 0, 0); err == nil {
+		t.Errorf("Expected an error reopening a KV SagaLog with a corrupted record")
+	} else if _, ok := err.(saga.CorruptedSagaLogError); !ok {
+		t.Errorf("Expected a CorruptedSagaLogError, got %v", err)
+	}
+	if info, _ := os.Stat(path.Join(dirName, kvSagaLogFileName)); info.Size() != size {
+		t.Errorf("Expected the log not to be truncated, got %d bytes, expected %d", info.Size(), size)
+	}
+}
+
+func TestKVGCDeletesCompletedSagasAndCompacts(t *testing.T) {
+	slog, dirName := makeTestKVSagaLog(t)
+	defer os.RemoveAll(dirName)
+
+	slog.gcExpiration = time.Nanosecond
+	slog.compactMinGarbage = 0
+	for _, msg := range fullSagaMessages("done") {
+		if msg.MsgType == saga.StartSaga {
+			slog.StartSaga(msg.SagaId, msg.Data)
+		} else {
+			slog.LogMessage(msg)
+		}
+	}
+	slog.StartSaga("running", []byte("job"))
+	slog.LogMessage(saga.MakeStartTaskMessage("running", "task1", nil))
+	sizeBeforeGC := slog.size
+
+	time.Sleep(time.Millisecond)
+	if err := slog.gcSagas(); err != nil {
+		t.Fatalf("Unexpected Error running gcSagas %v", err)
+	}
+	if isSagaInActiveList("done", slog) || !isSagaInActiveList("running", slog) {
+		t.Errorf("Expected only the completed saga to be GCd")
+	}
+	if slog.size >= sizeBeforeGC || slog.garbage != 0 {
+		t.Errorf("Expected the log to be compacted below %d bytes, got size %d with %d garbage bytes",
+			sizeBeforeGC, slog.size, slog.garbage)
+	}
+
+	slog.Close()
+	slog, err := MakeKVSagaLog(dirName, 0, 0)
+	if err != nil {
+		t.Fatalf("Unexpected Error reopening KV SagaLog %v", err)
+	}
+	defer slog.Close()
+	msgs, _ := slog.GetMessages("running")
+	if len(msgs) != 2 || msgs[1].TaskId != "task1" {
+		t.Errorf("Expected the running saga's messages to survive compaction, got %+v", msgs)
+	}
+	if msgs, _ := slog.GetMessages("done"); msgs != nil {
+		t.Errorf("Expected the GCd saga to stay deleted, got %+v", msgs)
+	}
+}
+
+func TestKVCheckpointReplacesMessages(t *testing.T) {
+	slog, dirName := makeTestKVSagaLog(t)
+	defer os.RemoveAll(dirName)
+
+	sagaId := "checkpointed"
+	slog.StartSaga(sagaId, []byte("job"))
+	slog.LogMessage(saga.MakeStartTaskMessage(sagaId, "task1", nil))
+	checkpoint := saga.MakeCheckpointMessage(sagaId, []byte("state"))
+	if err := slog.LogCheckpoint(checkpoint); err != nil {
+		t.Fatalf("Unexpected Error logging checkpoint %v", err)
+	}
+	slog.LogMessage(saga.MakeEndTaskMessage(sagaId, "task1", nil))
+	if slog.garbage == 0 {
+		t.Errorf("Expected the records before the checkpoint to be garbage")
+	}
+
+	slog.Close()
+	slog, err := MakeKVSagaLog(dirName, 0, 0)
+	if err != nil {
+		t.Fatalf("Unexpected Error reopening KV SagaLog %v", err)
+	}
+	defer slog.Close()
+
+	msgs, _ := slog.GetMessages(sagaId)
+	expected := []saga.SagaMessage{checkpoint, saga.MakeEndTaskMessage(sagaId, "task1", nil)}
+	if !reflect.DeepEqual(expected, msgs) {
+		t.Errorf("Expected the checkpoint and later messages %+v, Actual %+v", expected, msgs)
+	}
+}
+
+func TestKVCheckpointedSagaSurvivesCompaction(t *testing.T) {
+	slog, dirName := makeTestKVSagaLog(t)
+	defer os.RemoveAll(dirName)
+
+	sagaId := "checkpointed"
+	slog.StartSaga(sagaId, []byte("job"))
+	slog.LogMessage(saga.MakeStartTaskMessage(sagaId, "task1", nil))
+	checkpoint := saga.MakeCheckpointMessage(sagaId, []byte("state"))
+	if err := slog.LogCheckpoint(checkpoint); err != nil {
+		t.Fatalf("Unexpected Error logging checkpoint %v", err)
+	}
+	slog.LogMessage(saga.MakeEndTaskMessage(sagaId, "task1", nil))
+
+	slog.mutex.Lock()
+	err := slog.compact()
+	slog.mutex.Unlock()
+	if err != nil {
+		t.Fatalf("Unexpected Error compacting %v", err)
+	}
+
+	slog.Close()
+	slog, err = MakeKVSagaLog(dirName, 0, 0)
+	if err != nil {
+		t.Fatalf("Unexpected Error reopening KV SagaLog %v", err)
+	}
+	defer slog.Close()
+
+	if !isSagaInActiveList(sagaId, slog) {
+		t.Errorf("Expected the checkpointed saga to be in active list after compaction")
+	}
+	msgs, _ := slog.GetMessages(sagaId)
+	expected := []saga.SagaMessage{checkpoint, saga.MakeEndTaskMessage(sagaId, "task1", nil)}
+	if !reflect.DeepEqual(expected, msgs) {
+		t.Errorf("Expected the checkpoint and later messages %+v, Actual %+v", expected, msgs)
+	}
+}
+
+func TestKVGCDeletesExpiredSagasInOneRecord(t *testing.T) {
+	slog, dirName := makeTestKVSagaLog(t)
+	defer os.RemoveAll(dirName)
+
+	slog.gcExpiration = time.Nanosecond
+	for _, sagaId := range []string{"done1", "done2", "done3"} {
+		slog.StartSaga(sagaId, nil)
+		slog.LogMessage(saga.MakeEndSagaMessage(sagaId))
+	}
+	sizeBeforeGC := slog.size
+
+	time.Sleep(time.Millisecond)
+	if err := slog.gcSagas(); err != nil {
+		t.Fatalf("Unexpected Error running gcSagas %v", err)
+	}
+	deleted := encodeKVRecord(kvRecord{op: kvOpDelete, deletedIds: []string{"done1", "done2", "done3"}})
+	if slog.size != sizeBeforeGC+int64(len(deleted)) {
+		t.Errorf("Expected one delete record of %d bytes to be appended, log grew by %d bytes",
+			len(deleted), slog.size-sizeBeforeGC)
+	}
+
+	slog.Close()
+	slog, err := MakeKVSagaLog(dirName, 0, 0)
+	if err != nil {
+		t.Fatalf("Unexpected Error reopening KV SagaLog %v", err)
+	}
+	defer slog.Close()
+	if sagas, _ := slog.GetActiveSagas(); len(sagas) != 0 {
+		t.Errorf("Expected the GCd sagas to stay deleted, got %v", sagas)
+	}
+}
+
+func TestKVReplicaRestoresLostLog(t *testing.T) {
+	dirName, err := ioutil.TempDir("", "kvsagalog")
+	if err != nil {
+		t.Fatalf("Unexpected Error creating temp dir %v", err)
+	}
+	defer os.RemoveAll(dirName)
+	primaryDir, replicaDir := path.Join(dirName, "primary"), path.Join(dirName, "replica")
+
+	slog, err := MakeReplicatedKVSagaLog(primaryDir, []string{replicaDir}, 0, 0)
+	if err != nil {
+		t.Fatalf("Unexpected Error making replicated KV SagaLog %v", err)
+	}
+	sagaId := "replicated"
+	loggedMsgs := fullSagaMessages(sagaId)
+	slog.StartSaga(sagaId, loggedMsgs[0].Data)
+	if err := slog.LogBatchMessages(loggedMsgs[1:]); err != nil {
+		t.Fatalf("Unexpected Error Logging Batch: %v", err)
+	}
+	slog.Close()
+
+	// the primary's disk is replaced
+	if err := os.RemoveAll(primaryDir); err != nil {
+		t.Fatalf("Unexpected Error removing the primary log %v", err)
+	}
+	slog, err = MakeReplicatedKVSagaLog(primaryDir, []string{replicaDir}, 0, 0)
+	if err != nil {
+		t.Fatalf("Unexpected Error reopening replicated KV SagaLog %v", err)
+	}
+	rtnMsgs, err := slog.GetMessages(sagaId)
+	if err != nil {
+		t.Fatalf("Unexpected Error returned from GetMessages. %v", err)
+	}
+	if !reflect.DeepEqual(loggedMsgs, rtnMsgs) {
+		t.Errorf("Expected Logged Messages restored from the replica %+v, Actual %+v", loggedMsgs, rtnMsgs)
+	}
+
+	// a replica that missed records catches up when the log is opened
+	slog.Close()
+	if err := os.Truncate(path.Join(replicaDir, kvSagaLogFileName), 0); err != nil {
+		t.Fatalf("Unexpected Error truncating the replica %v", err)
+	}
+	slog, err = MakeReplicatedKVSagaLog(primaryDir, []string{replicaDir}, 0, 0)
+	if err != nil {
+		t.Fatalf("Unexpected Error reopening replicated KV SagaLog %v", err)
+	}
+	slog.LogMessage(saga.MakeStartTaskMessage(sagaId, "task2", nil))
+	slog.Close()
+
+	slog, err = MakeKVSagaLog(replicaDir, 0, 0)
+	if err != nil {
+		t.Fatalf("Unexpected Error opening the replica as a KV SagaLog %v", err)
+	}
+	defer slog.Close()
+	rtnMsgs, _ = slog.GetMessages(sagaId)
+	expected := append(loggedMsgs, saga.MakeStartTaskMessage(sagaId, "task2", nil))
+	if !reflect.DeepEqual(expected, rtnMsgs) {
+		t.Errorf("Expected the replica to hold every message %+v, Actual %+v", expected, rtnMsgs)
+	}
+}
+
+func TestKVReplicaWithLaterRecordsRestoresLog(t *testing.T) {
+	dirName, err := ioutil.TempDir("", "kvsagalog")
+	if err != nil {
+		t.Fatalf("Unexpected Error creating temp dir %v", err)
+	}
+	defer os.RemoveAll(dirName)
+	primaryDir, replicaDir := path.Join(dirName, "primary"), path.Join(dirName, "replica")
+	primaryName := path.Join(primaryDir, kvSagaLogFileName)
+
+	slog, err := MakeReplicatedKVSagaLog(primaryDir, []string{replicaDir}, 0, 0)
+	if err != nil {
+		t.Fatalf("Unexpected Error making replicated KV SagaLog %v", err)
+	}
+	sagaId := "replicated"
+	loggedMsgs := fullSagaMessages(sagaId)[:3]
+	slog.StartSaga(sagaId, loggedMsgs[0].Data)
+	if err := slog.LogBatchMessages(loggedMsgs[1:]); err != nil {
+		t.Fatalf("Unexpected Error Logging Batch: %v", err)
+	}
+	sizeBefore := slog.size
+	lastMsg := saga.MakeStartTaskMessage(sagaId, "task2", nil)
+	if err := slog.LogMessage(lastMsg); err != nil {
+		t.Fatalf("Unexpected Error Logging Message: %v", err)
+	}
+	slog.Close()
+	expected := append(loggedMsgs, lastMsg)
+
+	for _, damage := range []struct {
+		name   string
+		damage func() error
+	}{
+		{"lost its last record", func() error { return os.Truncate(primaryName, sizeBefore) }},
+		{"has a torn last record", func() error { return os.Truncate(primaryName, sizeBefore+3) }},
+		{"has a corrupted record", func() error {
+			f, err := os.OpenFile(primaryName, os.O_RDWR, 0)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			_, err = f.WriteAt([]byte{0xff, 0xff}, kvHeaderSize+10)
+			return err
+		}},
+	} {
+		if err := damage.damage(); err != nil {
+			t.Fatalf("Unexpected Error damaging the primary log %v", err)
+		}
+		slog, err = MakeReplicatedKVSagaLog(primaryDir, []string{replicaDir}, 0, 0)
+		if err != nil {
+			t.Fatalf("Unexpected Error reopening a replicated KV SagaLog whose primary %s: %v", damage.name, err)
+		}
+		rtnMsgs, err := slog.GetMessages(sagaId)
+		slog.Close()
+		if err != nil || !reflect.DeepEqual(expected, rtnMsgs) {
+			t.Errorf("Expected the replica's messages when the primary %s %+v, Actual %+v, %v", damage.name, expected, rtnMsgs, err)
+		}
+
+		// the replica wasn't overwritten by the damaged primary
+		replica, err := MakeKVSagaLog(replicaDir, 0, 0)
+		if err != nil {
+			t.Fatalf("Unexpected Error opening the replica as a KV SagaLog %v", err)
+		}
+		rtnMsgs, _ = replica.GetMessages(sagaId)
+		replica.Close()
+		if !reflect.DeepEqual(expected, rtnMsgs) {
+			t.Errorf("Expected the replica to keep every message when the primary %s %+v, Actual %+v", damage.name, expected, rtnMsgs)
+		}
+	}
+}
+
+func TestKVStandbyLogLoadsLeaderRecords(t *testing.T) {
+	leader, dirName := makeTestKVSagaLog(t)
+	defer os.RemoveAll(dirName)
+
+	sagaId := "leadersaga"
+	loggedMsgs := fullSagaMessages(sagaId)
+	leader.StartSaga(sagaId, loggedMsgs[0].Data)
+
+	standby, err := MakeStandbyKVSagaLog(dirName, nil, 0, 0)
+	if err != nil {
+		t.Fatalf("Unexpected Error making standby KV SagaLog %v", err)
+	}
+	defer standby.Close()
+	if _, err := standby.GetActiveSagas(); err == nil {
+		t.Errorf("Expected an error listing the sagas of a log that isn't loaded")
+	}
+	if err := standby.StartSaga("standbysaga", nil); err == nil {
+		t.Errorf("Expected an error starting a saga in a log that isn't loaded")
+	}
+
+	// the leader keeps logging after the standby was made, until it's deposed
+	if err := leader.LogBatchMessages(loggedMsgs[1:]); err != nil {
+		t.Fatalf("Unexpected Error Logging Batch: %v", err)
+	}
+	leader.Close()
+
+	if err := standby.Load(); err != nil {
+		t.Fatalf("Unexpected Error loading standby KV SagaLog %v", err)
+	}
+	rtnMsgs, err := standby.GetMessages(sagaId)
+	if err != nil {
+		t.Fatalf("Unexpected Error returned from GetMessages. %v", err)
+	}
+	if !reflect.DeepEqual(loggedMsgs, rtnMsgs) {
+		t.Errorf("Expected the messages logged by the leader %+v, Actual %+v", loggedMsgs, rtnMsgs)
+	}
+	if err := standby.StartSaga("standbysaga", nil); err != nil {
+		t.Errorf("Unexpected Error starting a saga in the loaded log %v", err)
+	}
+}
@@ -106,6 +106,20 @@ func (slog *inMemorySagaLog) logMessages(msgs []saga.SagaMessage) error {
 	return nil
 }
 
+// Replaces all SagaMessages of an existing Saga in the log with the checkpoint
+func (slog *inMemorySagaLog) LogCheckpoint(msg saga.SagaMessage) error {
+	slog.mutex.Lock()
+	defer slog.mutex.Unlock()
+
+	ld, ok := slog.sagas[msg.SagaId]
+	if !ok {
+		return errors.New(fmt.Sprintf("Saga: %s does not exist in the Log", msg.SagaId))
+	}
+
+	ld.messages = []saga.SagaMessage{msg}
+	return nil
+}
+
 // Gets all SagaMessages from an existing Saga in the log
 func (slog *inMemorySagaLog) GetMessages(sagaId string) ([]saga.SagaMessage, error) {
 	slog.mutex.RLock()
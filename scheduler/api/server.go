@@ -19,7 +19,7 @@ import (
 // Creates and returns a new server Handler, which combines the scheduler,
 // saga coordinator and stats receivers.
 func NewHandler(scheduler server.Scheduler, sc saga.SagaCoordinator, stat stats.StatsReceiver) scoot.CloudScoot {
-	handler := &Handler{scheduler: scheduler, sagaCoord: sc, stat: stat}
+	handler := &Handler{scheduler: scheduler, sagaCoord: sc, stat: stat, jobSummaries: schedthrift.NewJobSummaryCache()}
 	go stats.StartUptimeReporting(stat, stats.SchedUptime_ms, stats.SchedServerStartedGauge, stats.DefaultStartupGaugeSpikeLen)
 	return handler
 }
@@ -39,6 +39,9 @@ type Handler struct {
 	scheduler server.Scheduler
 	sagaCoord saga.SagaCoordinator
 	stat      stats.StatsReceiver
+
+	// summaries of the ended jobs ListJobs read from the saga log
+	jobSummaries *schedthrift.JobSummaryCache
 }
 
 // Implements RunJob Cloud Scoot API
@@ -52,7 +55,36 @@ func (h *Handler) RunJob(def *scoot.JobDefinition) (*scoot.JobId, error) {
 func (h *Handler) GetStatus(jobId string) (*scoot.JobStatus, error) {
 	defer h.stat.Latency(stats.SchedServerJobStatusLatency_ms).Time().Stop()
 	h.stat.Counter(stats.SchedServerJobStatusCounter).Inc(1)
-	return schedthrift.GetJobStatus(jobId, h.sagaCoord)
+	js, err := schedthrift.GetJobStatus(jobId, h.sagaCoord)
+	if err == nil {
+		schedthrift.AddUnschedulableTasks(js, h.scheduler)
+	}
+	return js, err
+}
+
+// Implements WatchJob Cloud Scoot API
+func (h *Handler) WatchJob(req *scoot.WatchJobRequest) (*scoot.WatchJobResponse, error) {
+	defer h.stat.Latency(stats.SchedServerWatchJobLatency_ms).Time().Stop()
+	h.stat.Counter(stats.SchedServerWatchJobCounter).Inc(1)
+	resp, err := schedthrift.WatchJob(req, h.sagaCoord)
+	if err == nil {
+		schedthrift.AddUnschedulableTasks(resp.JobStatus, h.scheduler)
+	}
+	return resp, err
+}
+
+// Implements ListJobs Cloud Scoot API
+func (h *Handler) ListJobs(req *scoot.ListJobsRequest) (*scoot.ListJobsResponse, error) {
+	defer h.stat.Latency(stats.SchedServerListJobsLatency_ms).Time().Stop()
+	h.stat.Counter(stats.SchedServerListJobsCounter).Inc(1)
+	return schedthrift.ListJobs(req, h.scheduler, h.sagaCoord, h.jobSummaries)
+}
+
+// Implements ExplainJob Cloud Scoot API
+func (h *Handler) ExplainJob(jobId string) (*scoot.JobExplanation, error) {
+	defer h.stat.Latency(stats.SchedServerExplainJobLatency_ms).Time().Stop()
+	h.stat.Counter(stats.SchedServerExplainJobCounter).Inc(1)
+	return schedthrift.ExplainJob(jobId, h.scheduler, h.sagaCoord)
 }
 
 // Implements KillJob Cloud Scoot API
@@ -72,14 +104,21 @@ func (h *Handler) ReinstateWorker(req *scoot.ReinstateWorkerReq) error {
 	return schedthrift.ReinstateWorker(req, h.scheduler)
 }
 
+// Implements GetClusterState Cloud Scoot API
+func (h *Handler) GetClusterState() (*scoot.ClusterState, error) {
+	defer h.stat.Latency(stats.SchedServerGetClusterStateLatency_ms).Time().Stop()
+	h.stat.Counter(stats.SchedServerGetClusterStateCounter).Inc(1)
+	return schedthrift.GetClusterState(h.scheduler)
+}
+
 // Implements GetSchedulerStatus Cloud Scoot API
 func (h *Handler) GetSchedulerStatus() (*scoot.SchedulerStatus, error) {
 	return schedthrift.GetSchedulerStatus(h.scheduler)
 }
 
 // Implements SetSchedulerStatus Cloud Scoot API
-func (h *Handler) SetSchedulerStatus(maxNumTasks int32) error {
-	return schedthrift.SetSchedulerStatus(h.scheduler, maxNumTasks)
+func (h *Handler) SetSchedulerStatus(maxNumTasks int32, requestor string) error {
+	return schedthrift.SetSchedulerStatus(h.scheduler, maxNumTasks, requestor)
 }
 
 // GetClassLoadPercents Implements GetClassLoadPercents Cloud Scoot API
@@ -90,9 +129,9 @@ func (h *Handler) GetClassLoadPercents() (map[string]int32, error) {
 }
 
 // SetClassLoadPercents Implements SetClassLoadPercents Cloud Scoot API
-func (h *Handler) SetClassLoadPercents(classLoadPercents map[string]int32) error {
-	log.Infof("SetClassLoadPercents to %v", classLoadPercents)
-	return schedthrift.SetClassLoadPercents(h.scheduler, classLoadPercents)
+func (h *Handler) SetClassLoadPercents(classLoadPercents map[string]int32, requestor string) error {
+	log.Infof("SetClassLoadPercents to %v for %s", classLoadPercents, requestor)
+	return schedthrift.SetClassLoadPercents(h.scheduler, classLoadPercents, requestor)
 }
 
 // GetRequestorToClassMap Implements GetRequestorToClassMap Cloud Scoot API
@@ -103,9 +142,9 @@ func (h *Handler) GetRequestorToClassMap() (map[string]string, error) {
 }
 
 // SetRequestorToClassMap Implements SetRequestorToClassMap Cloud Scoot API
-func (h *Handler) SetRequestorToClassMap(requestToClassMap map[string]string) error {
-	log.Infof("SetRequestorToClassMap to %v", requestToClassMap)
-	return schedthrift.SetRequestorToClassMap(h.scheduler, requestToClassMap)
+func (h *Handler) SetRequestorToClassMap(requestToClassMap map[string]string, requestor string) error {
+	log.Infof("SetRequestorToClassMap to %v for %s", requestToClassMap, requestor)
+	return schedthrift.SetRequestorToClassMap(h.scheduler, requestToClassMap, requestor)
 }
 
 // GetRebalanceMinimumDuration get the duration(minutes) that the scheduler needs to be exceeding
@@ -118,10 +157,10 @@ func (h *Handler) GetRebalanceMinimumDuration() (int32, error) {
 
 // SetRebalanceMinimumDuration set the duration(minutes) that the scheduler needs to be exceeding
 // the rebalance threshold before rebalancing.  <= 0 implies no rebalancing
-func (h *Handler) SetRebalanceMinimumDuration(durationMinimum int32) error {
-	log.Infof("SetRebalanceMinimumDuration to %d", durationMinimum)
+func (h *Handler) SetRebalanceMinimumDuration(durationMinimum int32, requestor string) error {
+	log.Infof("SetRebalanceMinimumDuration to %d for %s", durationMinimum, requestor)
 	d := time.Duration(durationMinimum) * time.Minute
-	return schedthrift.SetRebalanceMinimumDuration(h.scheduler, d)
+	return schedthrift.SetRebalanceMinimumDuration(h.scheduler, d, requestor)
 }
 
 // GetRebalanceThreshold the % spread threshold that must be exceeded to trigger rebalance
@@ -134,7 +173,18 @@ func (h *Handler) GetRebalanceThreshold() (int32, error) {
 
 // SetRebalanceThreshold the % spread threshold that must be exceeded to trigger rebalance
 // <= 0 implies no rebalancing
-func (h *Handler) SetRebalanceThreshold(threshold int32) error {
-	log.Infof("SetRebalanceThreshold to %d", threshold)
-	return schedthrift.SetRebalanceThreshold(h.scheduler, threshold)
+func (h *Handler) SetRebalanceThreshold(threshold int32, requestor string) error {
+	log.Infof("SetRebalanceThreshold to %d for %s", threshold, requestor)
+	return schedthrift.SetRebalanceThreshold(h.scheduler, threshold, requestor)
+}
+
+// Implements GetSettingsHistory Cloud Scoot API
+func (h *Handler) GetSettingsHistory() (*scoot.SettingsHistory, error) {
+	return schedthrift.GetSettingsHistory(h.scheduler)
+}
+
+// Implements RollbackSettings Cloud Scoot API
+func (h *Handler) RollbackSettings(req *scoot.RollbackSettingsReq) error {
+	log.Infof("RollbackSettings to version %d for %s", req.GetVersion(), req.GetRequestor())
+	return schedthrift.RollbackSettings(req, h.scheduler)
 }
@@ -0,0 +1,67 @@
+package thrift
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	s "github.com/twitter/scoot/saga"
+	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
+	"github.com/twitter/scoot/scheduler/domain"
+	"github.com/twitter/scoot/scheduler/server"
+)
+
+var domainWaitReasonToThrift = map[domain.WaitReason]scoot.WaitReason{
+	domain.WaitNotEvaluated:     scoot.WaitReason_NOT_EVALUATED,
+	domain.WaitDependencies:     scoot.WaitReason_DEPENDENCIES,
+	domain.WaitRetryBackoff:     scoot.WaitReason_RETRY_BACKOFF,
+	domain.WaitUnschedulable:    scoot.WaitReason_UNSCHEDULABLE,
+	domain.WaitNotSelected:      scoot.WaitReason_NOT_SELECTED,
+	domain.WaitNoIdleNodes:      scoot.WaitReason_NO_IDLE_NODES,
+	domain.WaitNoAvailableNodes: scoot.WaitReason_NO_AVAILABLE_NODES,
+	domain.WaitNoFittingNode:    scoot.WaitReason_NO_FITTING_NODE,
+}
+
+// Implementation of the ExplainJob API.  The reasons the job's tasks are waiting are taken from
+// the scheduler, a job that is no longer being scheduled has no waiting tasks, its status is
+// read from the saga log.
+func ExplainJob(jobId string, scheduler server.Scheduler, sc s.SagaCoordinator) (*scoot.JobExplanation, error) {
+	explanation, err := scheduler.ExplainJob(jobId)
+	if err != nil {
+		log.Errorf("ExplainJob %s failed: %v", jobId, err)
+		return nil, scoot.NewScootServerError()
+	}
+	if explanation == nil {
+		status, err := GetJobStatus(jobId, sc)
+		if err != nil {
+			return nil, err
+		}
+		resp := scoot.NewJobExplanation()
+		resp.JobId = jobId
+		resp.Status = status.GetStatus()
+		resp.WaitingTasks = []*scoot.TaskExplanation{}
+		return resp, nil
+	}
+	return domainJobExplanationToThrift(explanation), nil
+}
+
+func domainJobExplanationToThrift(explanation *domain.JobExplanation) *scoot.JobExplanation {
+	resp := scoot.NewJobExplanation()
+	resp.JobId = explanation.ID
+	resp.Status = domainStatusToThrift[explanation.Status]
+	resp.WaitingTasks = make([]*scoot.TaskExplanation, 0, len(explanation.Tasks))
+	for _, task := range explanation.Tasks {
+		te := scoot.NewTaskExplanation()
+		te.TaskId = task.TaskID
+		te.Reason = domainWaitReasonToThrift[task.Reason]
+		if task.Detail != "" {
+			detail := task.Detail
+			te.Detail = &detail
+		}
+		resp.WaitingTasks = append(resp.WaitingTasks, te)
+	}
+	resp.Notes = explanation.Notes
+	if !explanation.ExplainedAt.IsZero() {
+		explainedAtMs := explanation.ExplainedAt.UnixNano() / int64(1e6)
+		resp.ExplainedAtMs = &explainedAtMs
+	}
+	return resp
+}
@@ -0,0 +1,89 @@
+package thrift
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/twitter/scoot/saga/sagalogs"
+	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
+	"github.com/twitter/scoot/scheduler/domain"
+	"github.com/twitter/scoot/scheduler/server"
+	"github.com/twitter/scoot/tests/testhelpers"
+)
+
+func Test_ExplainJob(t *testing.T) {
+	rng := testhelpers.NewRand()
+	sagaCoord := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+
+	// a live job with a task waiting on its dependency
+	live := domain.GenJob(testhelpers.GenJobId(rng), 2)
+	explainedAt := time.Unix(100, 0)
+	liveExplanation := &domain.JobExplanation{
+		ID:     live.Id,
+		Status: domain.InProgress,
+		Tasks: []domain.TaskExplanation{
+			{TaskID: "task1", Reason: domain.WaitDependencies, Detail: "waiting on tasks [task0]"},
+		},
+		Notes:       []string{"a note"},
+		ExplainedAt: explainedAt,
+	}
+
+	// a finished job that is only in the saga log
+	done := domain.GenJob(testhelpers.GenJobId(rng), 1)
+	doneBytes, _ := done.Serialize()
+	saga, _ := sagaCoord.MakeSaga(done.Id, doneBytes)
+	for _, task := range done.Def.Tasks {
+		saga.StartTask(task.TaskID, nil)
+		saga.EndTask(task.TaskID, nil)
+	}
+	saga.EndSaga()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	scheduler := server.NewMockScheduler(mockCtrl)
+	scheduler.EXPECT().ExplainJob(live.Id).Return(liveExplanation, nil)
+	scheduler.EXPECT().ExplainJob(done.Id).Return(nil, nil)
+	scheduler.EXPECT().ExplainJob("unknown").Return(nil, nil)
+	scheduler.EXPECT().ExplainJob("follower").Return(nil, errors.New("not the leader"))
+
+	resp, err := ExplainJob(live.Id, scheduler, sagaCoord)
+	if err != nil {
+		t.Fatalf("Unexpected error explaining job: %v", err)
+	}
+	if resp.Status != scoot.Status_IN_PROGRESS || len(resp.WaitingTasks) != 1 || len(resp.Notes) != 1 {
+		t.Fatalf("Expected the live job's explanation, got %v", resp)
+	}
+	task := resp.WaitingTasks[0]
+	if task.TaskId != "task1" || task.Reason != scoot.WaitReason_DEPENDENCIES || task.GetDetail() != "waiting on tasks [task0]" {
+		t.Errorf("Unexpected task explanation %v", task)
+	}
+	if resp.GetExplainedAtMs() != 100000 {
+		t.Errorf("Expected explainedAtMs 100000, got %d", resp.GetExplainedAtMs())
+	}
+
+	resp, err = ExplainJob(done.Id, scheduler, sagaCoord)
+	if err != nil {
+		t.Fatalf("Unexpected error explaining finished job: %v", err)
+	}
+	if resp.Status != scoot.Status_COMPLETED || len(resp.WaitingTasks) != 0 || resp.ExplainedAtMs != nil {
+		t.Errorf("Expected a completed job without waiting tasks, got %v", resp)
+	}
+
+	// a job that isn't in the saga log hasn't started
+	resp, err = ExplainJob("unknown", scheduler, sagaCoord)
+	if err != nil {
+		t.Fatalf("Unexpected error explaining unknown job: %v", err)
+	}
+	if resp.Status != scoot.Status_NOT_STARTED || len(resp.WaitingTasks) != 0 {
+		t.Errorf("Expected a job that isn't started, got %v", resp)
+	}
+
+	if _, err = ExplainJob("follower", scheduler, sagaCoord); err == nil {
+		t.Errorf("Expected an error when the scheduler fails")
+	} else if _, ok := err.(*scoot.ScootServerError); !ok {
+		t.Errorf("Expected ScootServerError when the scheduler fails, got %T", err)
+	}
+}
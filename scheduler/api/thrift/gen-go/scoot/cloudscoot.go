@@ -22,6 +22,15 @@ type CloudScoot interface {
 	//  - JobId
 	GetStatus(jobId string) (r *JobStatus, err error)
 	// Parameters:
+	//  - Req
+	WatchJob(req *WatchJobRequest) (r *WatchJobResponse, err error)
+	// Parameters:
+	//  - Req
+	ListJobs(req *ListJobsRequest) (r *ListJobsResponse, err error)
+	// Parameters:
+	//  - JobId
+	ExplainJob(jobId string) (r *JobExplanation, err error)
+	// Parameters:
 	//  - JobId
 	KillJob(jobId string) (r *JobStatus, err error)
 	// Parameters:
@@ -30,26 +39,36 @@ type CloudScoot interface {
 	// Parameters:
 	//  - Req
 	ReinstateWorker(req *ReinstateWorkerReq) (err error)
+	GetClusterState() (r *ClusterState, err error)
 	GetSchedulerStatus() (r *SchedulerStatus, err error)
 	// Parameters:
 	//  - MaxTasks
-	SetSchedulerStatus(maxTasks int32) (err error)
+	//  - Requestor
+	SetSchedulerStatus(maxTasks int32, requestor string) (err error)
 	GetClassLoadPercents() (r map[string]int32, err error)
 	// Parameters:
 	//  - LoadPercents
-	SetClassLoadPercents(loadPercents map[string]int32) (err error)
+	//  - Requestor
+	SetClassLoadPercents(loadPercents map[string]int32, requestor string) (err error)
 	GetRequestorToClassMap() (r map[string]string, err error)
 	// Parameters:
 	//  - RequestorToClassMap
-	SetRequestorToClassMap(requestorToClassMap map[string]string) (err error)
+	//  - Requestor
+	SetRequestorToClassMap(requestorToClassMap map[string]string, requestor string) (err error)
 	GetRebalanceMinimumDuration() (r int32, err error)
 	// Parameters:
 	//  - DurationMin
-	SetRebalanceMinimumDuration(durationMin int32) (err error)
+	//  - Requestor
+	SetRebalanceMinimumDuration(durationMin int32, requestor string) (err error)
 	GetRebalanceThreshold() (r int32, err error)
 	// Parameters:
 	//  - Threshold
-	SetRebalanceThreshold(threshold int32) (err error)
+	//  - Requestor
+	SetRebalanceThreshold(threshold int32, requestor string) (err error)
+	GetSettingsHistory() (r *SettingsHistory, err error)
+	// Parameters:
+	//  - Req
+	RollbackSettings(req *RollbackSettingsReq) (err error)
 }
 
 type CloudScootClient struct {
@@ -128,16 +147,16 @@ func (p *CloudScootClient) recvRunJob() (value *JobId, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error8 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error9 error
-		error9, err = error8.Read(iprot)
+		error27 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error28 error
+		error28, err = error27.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error9
+		err = error28
 		return
 	}
 	if mTypeId != thrift.REPLY {
@@ -212,16 +231,16 @@ func (p *CloudScootClient) recvGetStatus() (value *JobStatus, err error) {
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error10 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error11 error
-		error11, err = error10.Read(iprot)
+		error29 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error30 error
+		error30, err = error29.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error11
+		err = error30
 		return
 	}
 	if mTypeId != thrift.REPLY {
@@ -247,26 +266,26 @@ func (p *CloudScootClient) recvGetStatus() (value *JobStatus, err error) {
 }
 
 // Parameters:
-//  - JobId
-func (p *CloudScootClient) KillJob(jobId string) (r *JobStatus, err error) {
-	if err = p.sendKillJob(jobId); err != nil {
+//  - Req
+func (p *CloudScootClient) WatchJob(req *WatchJobRequest) (r *WatchJobResponse, err error) {
+	if err = p.sendWatchJob(req); err != nil {
 		return
 	}
-	return p.recvKillJob()
+	return p.recvWatchJob()
 }
 
-func (p *CloudScootClient) sendKillJob(jobId string) (err error) {
+func (p *CloudScootClient) sendWatchJob(req *WatchJobRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("KillJob", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("WatchJob", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := CloudScootKillJobArgs{
-		JobId: jobId,
+	args := CloudScootWatchJobArgs{
+		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
 		return
@@ -277,7 +296,7 @@ func (p *CloudScootClient) sendKillJob(jobId string) (err error) {
 	return oprot.Flush()
 }
 
-func (p *CloudScootClient) recvKillJob() (value *JobStatus, err error) {
+func (p *CloudScootClient) recvWatchJob() (value *WatchJobResponse, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -287,32 +306,32 @@ func (p *CloudScootClient) recvKillJob() (value *JobStatus, err error) {
 	if err != nil {
 		return
 	}
-	if method != "KillJob" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "KillJob failed: wrong method name")
+	if method != "WatchJob" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "WatchJob failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "KillJob failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "WatchJob failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error12 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error13 error
-		error13, err = error12.Read(iprot)
+		error31 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error32 error
+		error32, err = error31.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error13
+		err = error32
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "KillJob failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "WatchJob failed: invalid message type")
 		return
 	}
-	result := CloudScootKillJobResult{}
+	result := CloudScootWatchJobResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -332,24 +351,24 @@ func (p *CloudScootClient) recvKillJob() (value *JobStatus, err error) {
 
 // Parameters:
 //  - Req
-func (p *CloudScootClient) OfflineWorker(req *OfflineWorkerReq) (err error) {
-	if err = p.sendOfflineWorker(req); err != nil {
+func (p *CloudScootClient) ListJobs(req *ListJobsRequest) (r *ListJobsResponse, err error) {
+	if err = p.sendListJobs(req); err != nil {
 		return
 	}
-	return p.recvOfflineWorker()
+	return p.recvListJobs()
 }
 
-func (p *CloudScootClient) sendOfflineWorker(req *OfflineWorkerReq) (err error) {
+func (p *CloudScootClient) sendListJobs(req *ListJobsRequest) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("OfflineWorker", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("ListJobs", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := CloudScootOfflineWorkerArgs{
+	args := CloudScootListJobsArgs{
 		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
@@ -361,7 +380,7 @@ func (p *CloudScootClient) sendOfflineWorker(req *OfflineWorkerReq) (err error)
 	return oprot.Flush()
 }
 
-func (p *CloudScootClient) recvOfflineWorker() (err error) {
+func (p *CloudScootClient) recvListJobs() (value *ListJobsResponse, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -371,32 +390,32 @@ func (p *CloudScootClient) recvOfflineWorker() (err error) {
 	if err != nil {
 		return
 	}
-	if method != "OfflineWorker" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "OfflineWorker failed: wrong method name")
+	if method != "ListJobs" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ListJobs failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "OfflineWorker failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ListJobs failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error14 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error15 error
-		error15, err = error14.Read(iprot)
+		error33 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error34 error
+		error34, err = error33.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error15
+		err = error34
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "OfflineWorker failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ListJobs failed: invalid message type")
 		return
 	}
-	result := CloudScootOfflineWorkerResult{}
+	result := CloudScootListJobsResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -410,30 +429,31 @@ func (p *CloudScootClient) recvOfflineWorker() (err error) {
 		err = result.Err
 		return
 	}
+	value = result.GetSuccess()
 	return
 }
 
 // Parameters:
-//  - Req
-func (p *CloudScootClient) ReinstateWorker(req *ReinstateWorkerReq) (err error) {
-	if err = p.sendReinstateWorker(req); err != nil {
+//  - JobId
+func (p *CloudScootClient) ExplainJob(jobId string) (r *JobExplanation, err error) {
+	if err = p.sendExplainJob(jobId); err != nil {
 		return
 	}
-	return p.recvReinstateWorker()
+	return p.recvExplainJob()
 }
 
-func (p *CloudScootClient) sendReinstateWorker(req *ReinstateWorkerReq) (err error) {
+func (p *CloudScootClient) sendExplainJob(jobId string) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("ReinstateWorker", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("ExplainJob", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := CloudScootReinstateWorkerArgs{
-		Req: req,
+	args := CloudScootExplainJobArgs{
+		JobId: jobId,
 	}
 	if err = args.Write(oprot); err != nil {
 		return
@@ -444,7 +464,7 @@ func (p *CloudScootClient) sendReinstateWorker(req *ReinstateWorkerReq) (err err
 	return oprot.Flush()
 }
 
-func (p *CloudScootClient) recvReinstateWorker() (err error) {
+func (p *CloudScootClient) recvExplainJob() (value *JobExplanation, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -454,32 +474,32 @@ func (p *CloudScootClient) recvReinstateWorker() (err error) {
 	if err != nil {
 		return
 	}
-	if method != "ReinstateWorker" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ReinstateWorker failed: wrong method name")
+	if method != "ExplainJob" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ExplainJob failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ReinstateWorker failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ExplainJob failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error16 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error17 error
-		error17, err = error16.Read(iprot)
+		error35 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error36 error
+		error36, err = error35.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error17
+		err = error36
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ReinstateWorker failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ExplainJob failed: invalid message type")
 		return
 	}
-	result := CloudScootReinstateWorkerResult{}
+	result := CloudScootExplainJobResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -493,27 +513,32 @@ func (p *CloudScootClient) recvReinstateWorker() (err error) {
 		err = result.Err
 		return
 	}
+	value = result.GetSuccess()
 	return
 }
 
-func (p *CloudScootClient) GetSchedulerStatus() (r *SchedulerStatus, err error) {
-	if err = p.sendGetSchedulerStatus(); err != nil {
+// Parameters:
+//  - JobId
+func (p *CloudScootClient) KillJob(jobId string) (r *JobStatus, err error) {
+	if err = p.sendKillJob(jobId); err != nil {
 		return
 	}
-	return p.recvGetSchedulerStatus()
+	return p.recvKillJob()
 }
 
-func (p *CloudScootClient) sendGetSchedulerStatus() (err error) {
+func (p *CloudScootClient) sendKillJob(jobId string) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("GetSchedulerStatus", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("KillJob", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := CloudScootGetSchedulerStatusArgs{}
+	args := CloudScootKillJobArgs{
+		JobId: jobId,
+	}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -523,7 +548,7 @@ func (p *CloudScootClient) sendGetSchedulerStatus() (err error) {
 	return oprot.Flush()
 }
 
-func (p *CloudScootClient) recvGetSchedulerStatus() (value *SchedulerStatus, err error) {
+func (p *CloudScootClient) recvKillJob() (value *JobStatus, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -533,39 +558,42 @@ func (p *CloudScootClient) recvGetSchedulerStatus() (value *SchedulerStatus, err
 	if err != nil {
 		return
 	}
-	if method != "GetSchedulerStatus" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetSchedulerStatus failed: wrong method name")
+	if method != "KillJob" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "KillJob failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetSchedulerStatus failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "KillJob failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error18 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error19 error
-		error19, err = error18.Read(iprot)
+		error37 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error38 error
+		error38, err = error37.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error19
+		err = error38
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetSchedulerStatus failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "KillJob failed: invalid message type")
 		return
 	}
-	result := CloudScootGetSchedulerStatusResult{}
+	result := CloudScootKillJobResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
 	if err = iprot.ReadMessageEnd(); err != nil {
 		return
 	}
-	if result.Err != nil {
+	if result.Ir != nil {
+		err = result.Ir
+		return
+	} else if result.Err != nil {
 		err = result.Err
 		return
 	}
@@ -574,26 +602,26 @@ func (p *CloudScootClient) recvGetSchedulerStatus() (value *SchedulerStatus, err
 }
 
 // Parameters:
-//  - MaxTasks
-func (p *CloudScootClient) SetSchedulerStatus(maxTasks int32) (err error) {
-	if err = p.sendSetSchedulerStatus(maxTasks); err != nil {
+//  - Req
+func (p *CloudScootClient) OfflineWorker(req *OfflineWorkerReq) (err error) {
+	if err = p.sendOfflineWorker(req); err != nil {
 		return
 	}
-	return p.recvSetSchedulerStatus()
+	return p.recvOfflineWorker()
 }
 
-func (p *CloudScootClient) sendSetSchedulerStatus(maxTasks int32) (err error) {
+func (p *CloudScootClient) sendOfflineWorker(req *OfflineWorkerReq) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("SetSchedulerStatus", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("OfflineWorker", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := CloudScootSetSchedulerStatusArgs{
-		MaxTasks: maxTasks,
+	args := CloudScootOfflineWorkerArgs{
+		Req: req,
 	}
 	if err = args.Write(oprot); err != nil {
 		return
@@ -604,7 +632,7 @@ func (p *CloudScootClient) sendSetSchedulerStatus(maxTasks int32) (err error) {
 	return oprot.Flush()
 }
 
-func (p *CloudScootClient) recvSetSchedulerStatus() (err error) {
+func (p *CloudScootClient) recvOfflineWorker() (err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -614,32 +642,32 @@ func (p *CloudScootClient) recvSetSchedulerStatus() (err error) {
 	if err != nil {
 		return
 	}
-	if method != "SetSchedulerStatus" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SetSchedulerStatus failed: wrong method name")
+	if method != "OfflineWorker" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "OfflineWorker failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SetSchedulerStatus failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "OfflineWorker failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error20 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error21 error
-		error21, err = error20.Read(iprot)
+		error39 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error40 error
+		error40, err = error39.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error21
+		err = error40
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SetSchedulerStatus failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "OfflineWorker failed: invalid message type")
 		return
 	}
-	result := CloudScootSetSchedulerStatusResult{}
+	result := CloudScootOfflineWorkerResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -656,24 +684,28 @@ func (p *CloudScootClient) recvSetSchedulerStatus() (err error) {
 	return
 }
 
-func (p *CloudScootClient) GetClassLoadPercents() (r map[string]int32, err error) {
-	if err = p.sendGetClassLoadPercents(); err != nil {
+// Parameters:
+//  - Req
+func (p *CloudScootClient) ReinstateWorker(req *ReinstateWorkerReq) (err error) {
+	if err = p.sendReinstateWorker(req); err != nil {
 		return
 	}
-	return p.recvGetClassLoadPercents()
+	return p.recvReinstateWorker()
 }
 
-func (p *CloudScootClient) sendGetClassLoadPercents() (err error) {
+func (p *CloudScootClient) sendReinstateWorker(req *ReinstateWorkerReq) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("GetClassLoadPercents", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("ReinstateWorker", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := CloudScootGetClassLoadPercentsArgs{}
+	args := CloudScootReinstateWorkerArgs{
+		Req: req,
+	}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -683,7 +715,7 @@ func (p *CloudScootClient) sendGetClassLoadPercents() (err error) {
 	return oprot.Flush()
 }
 
-func (p *CloudScootClient) recvGetClassLoadPercents() (value map[string]int32, err error) {
+func (p *CloudScootClient) recvReinstateWorker() (err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -693,32 +725,32 @@ func (p *CloudScootClient) recvGetClassLoadPercents() (value map[string]int32, e
 	if err != nil {
 		return
 	}
-	if method != "GetClassLoadPercents" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetClassLoadPercents failed: wrong method name")
+	if method != "ReinstateWorker" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ReinstateWorker failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetClassLoadPercents failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ReinstateWorker failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error22 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error23 error
-		error23, err = error22.Read(iprot)
+		error41 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error42 error
+		error42, err = error41.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error23
+		err = error42
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetClassLoadPercents failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ReinstateWorker failed: invalid message type")
 		return
 	}
-	result := CloudScootGetClassLoadPercentsResult{}
+	result := CloudScootReinstateWorkerResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -728,33 +760,31 @@ func (p *CloudScootClient) recvGetClassLoadPercents() (value map[string]int32, e
 	if result.Ir != nil {
 		err = result.Ir
 		return
+	} else if result.Err != nil {
+		err = result.Err
+		return
 	}
-	value = result.GetSuccess()
 	return
 }
 
-// Parameters:
-//  - LoadPercents
-func (p *CloudScootClient) SetClassLoadPercents(loadPercents map[string]int32) (err error) {
-	if err = p.sendSetClassLoadPercents(loadPercents); err != nil {
+func (p *CloudScootClient) GetClusterState() (r *ClusterState, err error) {
+	if err = p.sendGetClusterState(); err != nil {
 		return
 	}
-	return p.recvSetClassLoadPercents()
+	return p.recvGetClusterState()
 }
 
-func (p *CloudScootClient) sendSetClassLoadPercents(loadPercents map[string]int32) (err error) {
+func (p *CloudScootClient) sendGetClusterState() (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("SetClassLoadPercents", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("GetClusterState", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := CloudScootSetClassLoadPercentsArgs{
-		LoadPercents: loadPercents,
-	}
+	args := CloudScootGetClusterStateArgs{}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -764,7 +794,7 @@ func (p *CloudScootClient) sendSetClassLoadPercents(loadPercents map[string]int3
 	return oprot.Flush()
 }
 
-func (p *CloudScootClient) recvSetClassLoadPercents() (err error) {
+func (p *CloudScootClient) recvGetClusterState() (value *ClusterState, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -774,66 +804,64 @@ func (p *CloudScootClient) recvSetClassLoadPercents() (err error) {
 	if err != nil {
 		return
 	}
-	if method != "SetClassLoadPercents" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SetClassLoadPercents failed: wrong method name")
+	if method != "GetClusterState" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetClusterState failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SetClassLoadPercents failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetClusterState failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error24 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error25 error
-		error25, err = error24.Read(iprot)
+		error43 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error44 error
+		error44, err = error43.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error25
+		err = error44
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SetClassLoadPercents failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetClusterState failed: invalid message type")
 		return
 	}
-	result := CloudScootSetClassLoadPercentsResult{}
+	result := CloudScootGetClusterStateResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
 	if err = iprot.ReadMessageEnd(); err != nil {
 		return
 	}
-	if result.Ir != nil {
-		err = result.Ir
-		return
-	} else if result.Err != nil {
+	if result.Err != nil {
 		err = result.Err
 		return
 	}
+	value = result.GetSuccess()
 	return
 }
 
-func (p *CloudScootClient) GetRequestorToClassMap() (r map[string]string, err error) {
-	if err = p.sendGetRequestorToClassMap(); err != nil {
+func (p *CloudScootClient) GetSchedulerStatus() (r *SchedulerStatus, err error) {
+	if err = p.sendGetSchedulerStatus(); err != nil {
 		return
 	}
-	return p.recvGetRequestorToClassMap()
+	return p.recvGetSchedulerStatus()
 }
 
-func (p *CloudScootClient) sendGetRequestorToClassMap() (err error) {
+func (p *CloudScootClient) sendGetSchedulerStatus() (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("GetRequestorToClassMap", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("GetSchedulerStatus", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := CloudScootGetRequestorToClassMapArgs{}
+	args := CloudScootGetSchedulerStatusArgs{}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -843,7 +871,7 @@ func (p *CloudScootClient) sendGetRequestorToClassMap() (err error) {
 	return oprot.Flush()
 }
 
-func (p *CloudScootClient) recvGetRequestorToClassMap() (value map[string]string, err error) {
+func (p *CloudScootClient) recvGetSchedulerStatus() (value *SchedulerStatus, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -853,40 +881,40 @@ func (p *CloudScootClient) recvGetRequestorToClassMap() (value map[string]string
 	if err != nil {
 		return
 	}
-	if method != "GetRequestorToClassMap" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetRequestorToClassMap failed: wrong method name")
+	if method != "GetSchedulerStatus" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetSchedulerStatus failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetRequestorToClassMap failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetSchedulerStatus failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error26 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error27 error
-		error27, err = error26.Read(iprot)
+		error45 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error46 error
+		error46, err = error45.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error27
+		err = error46
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetRequestorToClassMap failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetSchedulerStatus failed: invalid message type")
 		return
 	}
-	result := CloudScootGetRequestorToClassMapResult{}
+	result := CloudScootGetSchedulerStatusResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
 	if err = iprot.ReadMessageEnd(); err != nil {
 		return
 	}
-	if result.Ir != nil {
-		err = result.Ir
+	if result.Err != nil {
+		err = result.Err
 		return
 	}
 	value = result.GetSuccess()
@@ -894,26 +922,28 @@ func (p *CloudScootClient) recvGetRequestorToClassMap() (value map[string]string
 }
 
 // Parameters:
-//  - RequestorToClassMap
-func (p *CloudScootClient) SetRequestorToClassMap(requestorToClassMap map[string]string) (err error) {
-	if err = p.sendSetRequestorToClassMap(requestorToClassMap); err != nil {
+//  - MaxTasks
+//  - Requestor
+func (p *CloudScootClient) SetSchedulerStatus(maxTasks int32, requestor string) (err error) {
+	if err = p.sendSetSchedulerStatus(maxTasks, requestor); err != nil {
 		return
 	}
-	return p.recvSetRequestorToClassMap()
+	return p.recvSetSchedulerStatus()
 }
 
-func (p *CloudScootClient) sendSetRequestorToClassMap(requestorToClassMap map[string]string) (err error) {
+func (p *CloudScootClient) sendSetSchedulerStatus(maxTasks int32, requestor string) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("SetRequestorToClassMap", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("SetSchedulerStatus", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := CloudScootSetRequestorToClassMapArgs{
-		RequestorToClassMap: requestorToClassMap,
+	args := CloudScootSetSchedulerStatusArgs{
+		MaxTasks:  maxTasks,
+		Requestor: requestor,
 	}
 	if err = args.Write(oprot); err != nil {
 		return
@@ -924,7 +954,7 @@ func (p *CloudScootClient) sendSetRequestorToClassMap(requestorToClassMap map[st
 	return oprot.Flush()
 }
 
-func (p *CloudScootClient) recvSetRequestorToClassMap() (err error) {
+func (p *CloudScootClient) recvSetSchedulerStatus() (err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -934,32 +964,32 @@ func (p *CloudScootClient) recvSetRequestorToClassMap() (err error) {
 	if err != nil {
 		return
 	}
-	if method != "SetRequestorToClassMap" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SetRequestorToClassMap failed: wrong method name")
+	if method != "SetSchedulerStatus" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SetSchedulerStatus failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SetRequestorToClassMap failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SetSchedulerStatus failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error28 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error29 error
-		error29, err = error28.Read(iprot)
+		error47 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error48 error
+		error48, err = error47.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error29
+		err = error48
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SetRequestorToClassMap failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SetSchedulerStatus failed: invalid message type")
 		return
 	}
-	result := CloudScootSetRequestorToClassMapResult{}
+	result := CloudScootSetSchedulerStatusResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -976,24 +1006,24 @@ func (p *CloudScootClient) recvSetRequestorToClassMap() (err error) {
 	return
 }
 
-func (p *CloudScootClient) GetRebalanceMinimumDuration() (r int32, err error) {
-	if err = p.sendGetRebalanceMinimumDuration(); err != nil {
+func (p *CloudScootClient) GetClassLoadPercents() (r map[string]int32, err error) {
+	if err = p.sendGetClassLoadPercents(); err != nil {
 		return
 	}
-	return p.recvGetRebalanceMinimumDuration()
+	return p.recvGetClassLoadPercents()
 }
 
-func (p *CloudScootClient) sendGetRebalanceMinimumDuration() (err error) {
+func (p *CloudScootClient) sendGetClassLoadPercents() (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("GetRebalanceMinimumDuration", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("GetClassLoadPercents", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := CloudScootGetRebalanceMinimumDurationArgs{}
+	args := CloudScootGetClassLoadPercentsArgs{}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -1003,7 +1033,7 @@ func (p *CloudScootClient) sendGetRebalanceMinimumDuration() (err error) {
 	return oprot.Flush()
 }
 
-func (p *CloudScootClient) recvGetRebalanceMinimumDuration() (value int32, err error) {
+func (p *CloudScootClient) recvGetClassLoadPercents() (value map[string]int32, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1013,32 +1043,32 @@ func (p *CloudScootClient) recvGetRebalanceMinimumDuration() (value int32, err e
 	if err != nil {
 		return
 	}
-	if method != "GetRebalanceMinimumDuration" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetRebalanceMinimumDuration failed: wrong method name")
+	if method != "GetClassLoadPercents" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetClassLoadPercents failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetRebalanceMinimumDuration failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetClassLoadPercents failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error30 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error31 error
-		error31, err = error30.Read(iprot)
+		error49 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error50 error
+		error50, err = error49.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error31
+		err = error50
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetRebalanceMinimumDuration failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetClassLoadPercents failed: invalid message type")
 		return
 	}
-	result := CloudScootGetRebalanceMinimumDurationResult{}
+	result := CloudScootGetClassLoadPercentsResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -1054,26 +1084,28 @@ func (p *CloudScootClient) recvGetRebalanceMinimumDuration() (value int32, err e
 }
 
 // Parameters:
-//  - DurationMin
-func (p *CloudScootClient) SetRebalanceMinimumDuration(durationMin int32) (err error) {
-	if err = p.sendSetRebalanceMinimumDuration(durationMin); err != nil {
+//  - LoadPercents
+//  - Requestor
+func (p *CloudScootClient) SetClassLoadPercents(loadPercents map[string]int32, requestor string) (err error) {
+	if err = p.sendSetClassLoadPercents(loadPercents, requestor); err != nil {
 		return
 	}
-	return p.recvSetRebalanceMinimumDuration()
+	return p.recvSetClassLoadPercents()
 }
 
-func (p *CloudScootClient) sendSetRebalanceMinimumDuration(durationMin int32) (err error) {
+func (p *CloudScootClient) sendSetClassLoadPercents(loadPercents map[string]int32, requestor string) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("SetRebalanceMinimumDuration", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("SetClassLoadPercents", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := CloudScootSetRebalanceMinimumDurationArgs{
-		DurationMin: durationMin,
+	args := CloudScootSetClassLoadPercentsArgs{
+		LoadPercents: loadPercents,
+		Requestor:    requestor,
 	}
 	if err = args.Write(oprot); err != nil {
 		return
@@ -1084,7 +1116,7 @@ func (p *CloudScootClient) sendSetRebalanceMinimumDuration(durationMin int32) (e
 	return oprot.Flush()
 }
 
-func (p *CloudScootClient) recvSetRebalanceMinimumDuration() (err error) {
+func (p *CloudScootClient) recvSetClassLoadPercents() (err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1094,32 +1126,32 @@ func (p *CloudScootClient) recvSetRebalanceMinimumDuration() (err error) {
 	if err != nil {
 		return
 	}
-	if method != "SetRebalanceMinimumDuration" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SetRebalanceMinimumDuration failed: wrong method name")
+	if method != "SetClassLoadPercents" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SetClassLoadPercents failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SetRebalanceMinimumDuration failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SetClassLoadPercents failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error32 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error33 error
-		error33, err = error32.Read(iprot)
+		error51 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error52 error
+		error52, err = error51.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error33
+		err = error52
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SetRebalanceMinimumDuration failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SetClassLoadPercents failed: invalid message type")
 		return
 	}
-	result := CloudScootSetRebalanceMinimumDurationResult{}
+	result := CloudScootSetClassLoadPercentsResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -1136,24 +1168,24 @@ func (p *CloudScootClient) recvSetRebalanceMinimumDuration() (err error) {
 	return
 }
 
-func (p *CloudScootClient) GetRebalanceThreshold() (r int32, err error) {
-	if err = p.sendGetRebalanceThreshold(); err != nil {
+func (p *CloudScootClient) GetRequestorToClassMap() (r map[string]string, err error) {
+	if err = p.sendGetRequestorToClassMap(); err != nil {
 		return
 	}
-	return p.recvGetRebalanceThreshold()
+	return p.recvGetRequestorToClassMap()
 }
 
-func (p *CloudScootClient) sendGetRebalanceThreshold() (err error) {
+func (p *CloudScootClient) sendGetRequestorToClassMap() (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("GetRebalanceThreshold", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("GetRequestorToClassMap", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := CloudScootGetRebalanceThresholdArgs{}
+	args := CloudScootGetRequestorToClassMapArgs{}
 	if err = args.Write(oprot); err != nil {
 		return
 	}
@@ -1163,7 +1195,7 @@ func (p *CloudScootClient) sendGetRebalanceThreshold() (err error) {
 	return oprot.Flush()
 }
 
-func (p *CloudScootClient) recvGetRebalanceThreshold() (value int32, err error) {
+func (p *CloudScootClient) recvGetRequestorToClassMap() (value map[string]string, err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1173,32 +1205,32 @@ func (p *CloudScootClient) recvGetRebalanceThreshold() (value int32, err error)
 	if err != nil {
 		return
 	}
-	if method != "GetRebalanceThreshold" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetRebalanceThreshold failed: wrong method name")
+	if method != "GetRequestorToClassMap" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetRequestorToClassMap failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetRebalanceThreshold failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetRequestorToClassMap failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error34 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error35 error
-		error35, err = error34.Read(iprot)
+		error53 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error54 error
+		error54, err = error53.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error35
+		err = error54
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetRebalanceThreshold failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetRequestorToClassMap failed: invalid message type")
 		return
 	}
-	result := CloudScootGetRebalanceThresholdResult{}
+	result := CloudScootGetRequestorToClassMapResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -1214,26 +1246,28 @@ func (p *CloudScootClient) recvGetRebalanceThreshold() (value int32, err error)
 }
 
 // Parameters:
-//  - Threshold
-func (p *CloudScootClient) SetRebalanceThreshold(threshold int32) (err error) {
-	if err = p.sendSetRebalanceThreshold(threshold); err != nil {
+//  - RequestorToClassMap
+//  - Requestor
+func (p *CloudScootClient) SetRequestorToClassMap(requestorToClassMap map[string]string, requestor string) (err error) {
+	if err = p.sendSetRequestorToClassMap(requestorToClassMap, requestor); err != nil {
 		return
 	}
-	return p.recvSetRebalanceThreshold()
+	return p.recvSetRequestorToClassMap()
 }
 
-func (p *CloudScootClient) sendSetRebalanceThreshold(threshold int32) (err error) {
+func (p *CloudScootClient) sendSetRequestorToClassMap(requestorToClassMap map[string]string, requestor string) (err error) {
 	oprot := p.OutputProtocol
 	if oprot == nil {
 		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
 		p.OutputProtocol = oprot
 	}
 	p.SeqId++
-	if err = oprot.WriteMessageBegin("SetRebalanceThreshold", thrift.CALL, p.SeqId); err != nil {
+	if err = oprot.WriteMessageBegin("SetRequestorToClassMap", thrift.CALL, p.SeqId); err != nil {
 		return
 	}
-	args := CloudScootSetRebalanceThresholdArgs{
-		Threshold: threshold,
+	args := CloudScootSetRequestorToClassMapArgs{
+		RequestorToClassMap: requestorToClassMap,
+		Requestor:           requestor,
 	}
 	if err = args.Write(oprot); err != nil {
 		return
@@ -1244,7 +1278,7 @@ func (p *CloudScootClient) sendSetRebalanceThreshold(threshold int32) (err error
 	return oprot.Flush()
 }
 
-func (p *CloudScootClient) recvSetRebalanceThreshold() (err error) {
+func (p *CloudScootClient) recvSetRequestorToClassMap() (err error) {
 	iprot := p.InputProtocol
 	if iprot == nil {
 		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1254,32 +1288,32 @@ func (p *CloudScootClient) recvSetRebalanceThreshold() (err error) {
 	if err != nil {
 		return
 	}
-	if method != "SetRebalanceThreshold" {
-		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SetRebalanceThreshold failed: wrong method name")
+	if method != "SetRequestorToClassMap" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SetRequestorToClassMap failed: wrong method name")
 		return
 	}
 	if p.SeqId != seqId {
-		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SetRebalanceThreshold failed: out of sequence response")
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SetRequestorToClassMap failed: out of sequence response")
 		return
 	}
 	if mTypeId == thrift.EXCEPTION {
-		error36 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-		var error37 error
-		error37, err = error36.Read(iprot)
+		error55 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error56 error
+		error56, err = error55.Read(iprot)
 		if err != nil {
 			return
 		}
 		if err = iprot.ReadMessageEnd(); err != nil {
 			return
 		}
-		err = error37
+		err = error56
 		return
 	}
 	if mTypeId != thrift.REPLY {
-		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SetRebalanceThreshold failed: invalid message type")
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SetRequestorToClassMap failed: invalid message type")
 		return
 	}
-	result := CloudScootSetRebalanceThresholdResult{}
+	result := CloudScootSetRequestorToClassMapResult{}
 	if err = result.Read(iprot); err != nil {
 		return
 	}
@@ -1296,396 +1330,567 @@ func (p *CloudScootClient) recvSetRebalanceThreshold() (err error) {
 	return
 }
 
-type CloudScootProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      CloudScoot
-}
-
-func (p *CloudScootProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
-}
-
-func (p *CloudScootProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
-}
-
-func (p *CloudScootProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
+func (p *CloudScootClient) GetRebalanceMinimumDuration() (r int32, err error) {
+	if err = p.sendGetRebalanceMinimumDuration(); err != nil {
+		return
+	}
+	return p.recvGetRebalanceMinimumDuration()
 }
 
-func NewCloudScootProcessor(handler CloudScoot) *CloudScootProcessor {
-
-	self38 := &CloudScootProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self38.processorMap["RunJob"] = &cloudScootProcessorRunJob{handler: handler}
-	self38.processorMap["GetStatus"] = &cloudScootProcessorGetStatus{handler: handler}
-	self38.processorMap["KillJob"] = &cloudScootProcessorKillJob{handler: handler}
-	self38.processorMap["OfflineWorker"] = &cloudScootProcessorOfflineWorker{handler: handler}
-	self38.processorMap["ReinstateWorker"] = &cloudScootProcessorReinstateWorker{handler: handler}
-	self38.processorMap["GetSchedulerStatus"] = &cloudScootProcessorGetSchedulerStatus{handler: handler}
-	self38.processorMap["SetSchedulerStatus"] = &cloudScootProcessorSetSchedulerStatus{handler: handler}
-	self38.processorMap["GetClassLoadPercents"] = &cloudScootProcessorGetClassLoadPercents{handler: handler}
-	self38.processorMap["SetClassLoadPercents"] = &cloudScootProcessorSetClassLoadPercents{handler: handler}
-	self38.processorMap["GetRequestorToClassMap"] = &cloudScootProcessorGetRequestorToClassMap{handler: handler}
-	self38.processorMap["SetRequestorToClassMap"] = &cloudScootProcessorSetRequestorToClassMap{handler: handler}
-	self38.processorMap["GetRebalanceMinimumDuration"] = &cloudScootProcessorGetRebalanceMinimumDuration{handler: handler}
-	self38.processorMap["SetRebalanceMinimumDuration"] = &cloudScootProcessorSetRebalanceMinimumDuration{handler: handler}
-	self38.processorMap["GetRebalanceThreshold"] = &cloudScootProcessorGetRebalanceThreshold{handler: handler}
-	self38.processorMap["SetRebalanceThreshold"] = &cloudScootProcessorSetRebalanceThreshold{handler: handler}
-	return self38
+func (p *CloudScootClient) sendGetRebalanceMinimumDuration() (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("GetRebalanceMinimumDuration", thrift.CALL, p.SeqId); err != nil {
+		return
+	}
+	args := CloudScootGetRebalanceMinimumDurationArgs{}
+	if err = args.Write(oprot); err != nil {
+		return
+	}
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
+	}
+	return oprot.Flush()
 }
 
-func (p *CloudScootProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
+func (p *CloudScootClient) recvGetRebalanceMinimumDuration() (value int32, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
 	if err != nil {
-		return false, err
+		return
 	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(seqId, iprot, oprot)
+	if method != "GetRebalanceMinimumDuration" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetRebalanceMinimumDuration failed: wrong method name")
+		return
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x39 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x39.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush()
-	return false, x39
-
-}
-
-type cloudScootProcessorRunJob struct {
-	handler CloudScoot
-}
-
-func (p *cloudScootProcessorRunJob) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CloudScootRunJobArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("RunJob", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetRebalanceMinimumDuration failed: out of sequence response")
+		return
 	}
-
-	iprot.ReadMessageEnd()
-	result := CloudScootRunJobResult{}
-	var retval *JobId
-	var err2 error
-	if retval, err2 = p.handler.RunJob(args.Job); err2 != nil {
-		switch v := err2.(type) {
-		case *InvalidRequest:
-			result.Ir = v
-		case *CanNotScheduleNow:
-			result.Cnsn = v
-		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RunJob: "+err2.Error())
-			oprot.WriteMessageBegin("RunJob", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
+	if mTypeId == thrift.EXCEPTION {
+		error57 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error58 error
+		error58, err = error57.Read(iprot)
+		if err != nil {
+			return
 		}
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("RunJob", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error58
+		return
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetRebalanceMinimumDuration failed: invalid message type")
+		return
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	result := CloudScootGetRebalanceMinimumDurationResult{}
+	if err = result.Read(iprot); err != nil {
+		return
 	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
 	}
-	if err != nil {
+	if result.Ir != nil {
+		err = result.Ir
 		return
 	}
-	return true, err
+	value = result.GetSuccess()
+	return
 }
 
-type cloudScootProcessorGetStatus struct {
-	handler CloudScoot
+// Parameters:
+//  - DurationMin
+//  - Requestor
+func (p *CloudScootClient) SetRebalanceMinimumDuration(durationMin int32, requestor string) (err error) {
+	if err = p.sendSetRebalanceMinimumDuration(durationMin, requestor); err != nil {
+		return
+	}
+	return p.recvSetRebalanceMinimumDuration()
 }
 
-func (p *cloudScootProcessorGetStatus) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CloudScootGetStatusArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetStatus", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+func (p *CloudScootClient) sendSetRebalanceMinimumDuration(durationMin int32, requestor string) (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
 	}
-
-	iprot.ReadMessageEnd()
-	result := CloudScootGetStatusResult{}
-	var retval *JobStatus
-	var err2 error
-	if retval, err2 = p.handler.GetStatus(args.JobId); err2 != nil {
-		switch v := err2.(type) {
-		case *InvalidRequest:
-			result.Ir = v
-		case *ScootServerError:
-			result.Err = v
-		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetStatus: "+err2.Error())
-			oprot.WriteMessageBegin("GetStatus", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
-		}
-	} else {
-		result.Success = retval
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("SetRebalanceMinimumDuration", thrift.CALL, p.SeqId); err != nil {
+		return
 	}
-	if err2 = oprot.WriteMessageBegin("GetStatus", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	args := CloudScootSetRebalanceMinimumDurationArgs{
+		DurationMin: durationMin,
+		Requestor:   requestor,
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = args.Write(oprot); err != nil {
+		return
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
 	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
+	return oprot.Flush()
+}
+
+func (p *CloudScootClient) recvSetRebalanceMinimumDuration() (err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
 	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
 	if err != nil {
 		return
 	}
-	return true, err
-}
-
-type cloudScootProcessorKillJob struct {
-	handler CloudScoot
-}
-
-func (p *cloudScootProcessorKillJob) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CloudScootKillJobArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("KillJob", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+	if method != "SetRebalanceMinimumDuration" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SetRebalanceMinimumDuration failed: wrong method name")
+		return
 	}
-
-	iprot.ReadMessageEnd()
-	result := CloudScootKillJobResult{}
-	var retval *JobStatus
-	var err2 error
-	if retval, err2 = p.handler.KillJob(args.JobId); err2 != nil {
-		switch v := err2.(type) {
-		case *InvalidRequest:
-			result.Ir = v
-		case *ScootServerError:
-			result.Err = v
-		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing KillJob: "+err2.Error())
-			oprot.WriteMessageBegin("KillJob", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
-		}
-	} else {
-		result.Success = retval
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SetRebalanceMinimumDuration failed: out of sequence response")
+		return
 	}
-	if err2 = oprot.WriteMessageBegin("KillJob", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if mTypeId == thrift.EXCEPTION {
+		error59 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error60 error
+		error60, err = error59.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error60
+		return
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SetRebalanceMinimumDuration failed: invalid message type")
+		return
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	result := CloudScootSetRebalanceMinimumDurationResult{}
+	if err = result.Read(iprot); err != nil {
+		return
 	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
 	}
-	if err != nil {
+	if result.Ir != nil {
+		err = result.Ir
+		return
+	} else if result.Err != nil {
+		err = result.Err
 		return
 	}
-	return true, err
-}
-
-type cloudScootProcessorOfflineWorker struct {
-	handler CloudScoot
+	return
 }
 
-func (p *cloudScootProcessorOfflineWorker) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CloudScootOfflineWorkerArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("OfflineWorker", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+func (p *CloudScootClient) GetRebalanceThreshold() (r int32, err error) {
+	if err = p.sendGetRebalanceThreshold(); err != nil {
+		return
 	}
+	return p.recvGetRebalanceThreshold()
+}
 
-	iprot.ReadMessageEnd()
-	result := CloudScootOfflineWorkerResult{}
-	var err2 error
-	if err2 = p.handler.OfflineWorker(args.Req); err2 != nil {
-		switch v := err2.(type) {
-		case *InvalidRequest:
-			result.Ir = v
-		case *ScootServerError:
-			result.Err = v
-		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing OfflineWorker: "+err2.Error())
-			oprot.WriteMessageBegin("OfflineWorker", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
-		}
+func (p *CloudScootClient) sendGetRebalanceThreshold() (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
 	}
-	if err2 = oprot.WriteMessageBegin("OfflineWorker", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("GetRebalanceThreshold", thrift.CALL, p.SeqId); err != nil {
+		return
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	args := CloudScootGetRebalanceThresholdArgs{}
+	if err = args.Write(oprot); err != nil {
+		return
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
 	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
+	return oprot.Flush()
+}
+
+func (p *CloudScootClient) recvGetRebalanceThreshold() (value int32, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
 	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
 	if err != nil {
 		return
 	}
-	return true, err
-}
-
-type cloudScootProcessorReinstateWorker struct {
-	handler CloudScoot
-}
-
-func (p *cloudScootProcessorReinstateWorker) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CloudScootReinstateWorkerArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("ReinstateWorker", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+	if method != "GetRebalanceThreshold" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetRebalanceThreshold failed: wrong method name")
+		return
 	}
-
-	iprot.ReadMessageEnd()
-	result := CloudScootReinstateWorkerResult{}
-	var err2 error
-	if err2 = p.handler.ReinstateWorker(args.Req); err2 != nil {
-		switch v := err2.(type) {
-		case *InvalidRequest:
-			result.Ir = v
-		case *ScootServerError:
-			result.Err = v
-		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ReinstateWorker: "+err2.Error())
-			oprot.WriteMessageBegin("ReinstateWorker", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
-		}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetRebalanceThreshold failed: out of sequence response")
+		return
 	}
-	if err2 = oprot.WriteMessageBegin("ReinstateWorker", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if mTypeId == thrift.EXCEPTION {
+		error61 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error62 error
+		error62, err = error61.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error62
+		return
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetRebalanceThreshold failed: invalid message type")
+		return
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	result := CloudScootGetRebalanceThresholdResult{}
+	if err = result.Read(iprot); err != nil {
+		return
 	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
 	}
-	if err != nil {
+	if result.Ir != nil {
+		err = result.Ir
 		return
 	}
-	return true, err
+	value = result.GetSuccess()
+	return
 }
 
-type cloudScootProcessorGetSchedulerStatus struct {
-	handler CloudScoot
+// Parameters:
+//  - Threshold
+//  - Requestor
+func (p *CloudScootClient) SetRebalanceThreshold(threshold int32, requestor string) (err error) {
+	if err = p.sendSetRebalanceThreshold(threshold, requestor); err != nil {
+		return
+	}
+	return p.recvSetRebalanceThreshold()
 }
 
-func (p *cloudScootProcessorGetSchedulerStatus) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CloudScootGetSchedulerStatusArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetSchedulerStatus", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+func (p *CloudScootClient) sendSetRebalanceThreshold(threshold int32, requestor string) (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
 	}
-
-	iprot.ReadMessageEnd()
-	result := CloudScootGetSchedulerStatusResult{}
-	var retval *SchedulerStatus
-	var err2 error
-	if retval, err2 = p.handler.GetSchedulerStatus(); err2 != nil {
-		switch v := err2.(type) {
-		case *ScootServerError:
-			result.Err = v
-		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetSchedulerStatus: "+err2.Error())
-			oprot.WriteMessageBegin("GetSchedulerStatus", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
-		}
-	} else {
-		result.Success = retval
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("SetRebalanceThreshold", thrift.CALL, p.SeqId); err != nil {
+		return
 	}
-	if err2 = oprot.WriteMessageBegin("GetSchedulerStatus", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	args := CloudScootSetRebalanceThresholdArgs{
+		Threshold: threshold,
+		Requestor: requestor,
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = args.Write(oprot); err != nil {
+		return
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
 	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
+	return oprot.Flush()
+}
+
+func (p *CloudScootClient) recvSetRebalanceThreshold() (err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
 	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
 	if err != nil {
 		return
 	}
-	return true, err
+	if method != "SetRebalanceThreshold" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SetRebalanceThreshold failed: wrong method name")
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SetRebalanceThreshold failed: out of sequence response")
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error63 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error64 error
+		error64, err = error63.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error64
+		return
+	}
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SetRebalanceThreshold failed: invalid message type")
+		return
+	}
+	result := CloudScootSetRebalanceThresholdResult{}
+	if err = result.Read(iprot); err != nil {
+		return
+	}
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
+	}
+	if result.Ir != nil {
+		err = result.Ir
+		return
+	} else if result.Err != nil {
+		err = result.Err
+		return
+	}
+	return
 }
 
-type cloudScootProcessorSetSchedulerStatus struct {
+func (p *CloudScootClient) GetSettingsHistory() (r *SettingsHistory, err error) {
+	if err = p.sendGetSettingsHistory(); err != nil {
+		return
+	}
+	return p.recvGetSettingsHistory()
+}
+
+func (p *CloudScootClient) sendGetSettingsHistory() (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("GetSettingsHistory", thrift.CALL, p.SeqId); err != nil {
+		return
+	}
+	args := CloudScootGetSettingsHistoryArgs{}
+	if err = args.Write(oprot); err != nil {
+		return
+	}
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
+	}
+	return oprot.Flush()
+}
+
+func (p *CloudScootClient) recvGetSettingsHistory() (value *SettingsHistory, err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if method != "GetSettingsHistory" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "GetSettingsHistory failed: wrong method name")
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "GetSettingsHistory failed: out of sequence response")
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error65 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error66 error
+		error66, err = error65.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error66
+		return
+	}
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "GetSettingsHistory failed: invalid message type")
+		return
+	}
+	result := CloudScootGetSettingsHistoryResult{}
+	if err = result.Read(iprot); err != nil {
+		return
+	}
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
+	}
+	if result.Ir != nil {
+		err = result.Ir
+		return
+	} else if result.Err != nil {
+		err = result.Err
+		return
+	}
+	value = result.GetSuccess()
+	return
+}
+
+// Parameters:
+//  - Req
+func (p *CloudScootClient) RollbackSettings(req *RollbackSettingsReq) (err error) {
+	if err = p.sendRollbackSettings(req); err != nil {
+		return
+	}
+	return p.recvRollbackSettings()
+}
+
+func (p *CloudScootClient) sendRollbackSettings(req *RollbackSettingsReq) (err error) {
+	oprot := p.OutputProtocol
+	if oprot == nil {
+		oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.OutputProtocol = oprot
+	}
+	p.SeqId++
+	if err = oprot.WriteMessageBegin("RollbackSettings", thrift.CALL, p.SeqId); err != nil {
+		return
+	}
+	args := CloudScootRollbackSettingsArgs{
+		Req: req,
+	}
+	if err = args.Write(oprot); err != nil {
+		return
+	}
+	if err = oprot.WriteMessageEnd(); err != nil {
+		return
+	}
+	return oprot.Flush()
+}
+
+func (p *CloudScootClient) recvRollbackSettings() (err error) {
+	iprot := p.InputProtocol
+	if iprot == nil {
+		iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+		p.InputProtocol = iprot
+	}
+	method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return
+	}
+	if method != "RollbackSettings" {
+		err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RollbackSettings failed: wrong method name")
+		return
+	}
+	if p.SeqId != seqId {
+		err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RollbackSettings failed: out of sequence response")
+		return
+	}
+	if mTypeId == thrift.EXCEPTION {
+		error67 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+		var error68 error
+		error68, err = error67.Read(iprot)
+		if err != nil {
+			return
+		}
+		if err = iprot.ReadMessageEnd(); err != nil {
+			return
+		}
+		err = error68
+		return
+	}
+	if mTypeId != thrift.REPLY {
+		err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RollbackSettings failed: invalid message type")
+		return
+	}
+	result := CloudScootRollbackSettingsResult{}
+	if err = result.Read(iprot); err != nil {
+		return
+	}
+	if err = iprot.ReadMessageEnd(); err != nil {
+		return
+	}
+	if result.Ir != nil {
+		err = result.Ir
+		return
+	} else if result.Err != nil {
+		err = result.Err
+		return
+	}
+	return
+}
+
+type CloudScootProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      CloudScoot
+}
+
+func (p *CloudScootProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *CloudScootProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *CloudScootProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewCloudScootProcessor(handler CloudScoot) *CloudScootProcessor {
+
+	self69 := &CloudScootProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self69.processorMap["RunJob"] = &cloudScootProcessorRunJob{handler: handler}
+	self69.processorMap["GetStatus"] = &cloudScootProcessorGetStatus{handler: handler}
+	self69.processorMap["WatchJob"] = &cloudScootProcessorWatchJob{handler: handler}
+	self69.processorMap["ListJobs"] = &cloudScootProcessorListJobs{handler: handler}
+	self69.processorMap["ExplainJob"] = &cloudScootProcessorExplainJob{handler: handler}
+	self69.processorMap["KillJob"] = &cloudScootProcessorKillJob{handler: handler}
+	self69.processorMap["OfflineWorker"] = &cloudScootProcessorOfflineWorker{handler: handler}
+	self69.processorMap["ReinstateWorker"] = &cloudScootProcessorReinstateWorker{handler: handler}
+	self69.processorMap["GetClusterState"] = &cloudScootProcessorGetClusterState{handler: handler}
+	self69.processorMap["GetSchedulerStatus"] = &cloudScootProcessorGetSchedulerStatus{handler: handler}
+	self69.processorMap["SetSchedulerStatus"] = &cloudScootProcessorSetSchedulerStatus{handler: handler}
+	self69.processorMap["GetClassLoadPercents"] = &cloudScootProcessorGetClassLoadPercents{handler: handler}
+	self69.processorMap["SetClassLoadPercents"] = &cloudScootProcessorSetClassLoadPercents{handler: handler}
+	self69.processorMap["GetRequestorToClassMap"] = &cloudScootProcessorGetRequestorToClassMap{handler: handler}
+	self69.processorMap["SetRequestorToClassMap"] = &cloudScootProcessorSetRequestorToClassMap{handler: handler}
+	self69.processorMap["GetRebalanceMinimumDuration"] = &cloudScootProcessorGetRebalanceMinimumDuration{handler: handler}
+	self69.processorMap["SetRebalanceMinimumDuration"] = &cloudScootProcessorSetRebalanceMinimumDuration{handler: handler}
+	self69.processorMap["GetRebalanceThreshold"] = &cloudScootProcessorGetRebalanceThreshold{handler: handler}
+	self69.processorMap["SetRebalanceThreshold"] = &cloudScootProcessorSetRebalanceThreshold{handler: handler}
+	self69.processorMap["GetSettingsHistory"] = &cloudScootProcessorGetSettingsHistory{handler: handler}
+	self69.processorMap["RollbackSettings"] = &cloudScootProcessorRollbackSettings{handler: handler}
+	return self69
+}
+
+func (p *CloudScootProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x70 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x70.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush()
+	return false, x70
+
+}
+
+type cloudScootProcessorRunJob struct {
 	handler CloudScoot
 }
 
-func (p *cloudScootProcessorSetSchedulerStatus) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CloudScootSetSchedulerStatusArgs{}
+func (p *cloudScootProcessorRunJob) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootRunJobArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SetSchedulerStatus", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("RunJob", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -1693,24 +1898,27 @@ func (p *cloudScootProcessorSetSchedulerStatus) Process(seqId int32, iprot, opro
 	}
 
 	iprot.ReadMessageEnd()
-	result := CloudScootSetSchedulerStatusResult{}
+	result := CloudScootRunJobResult{}
+	var retval *JobId
 	var err2 error
-	if err2 = p.handler.SetSchedulerStatus(args.MaxTasks); err2 != nil {
+	if retval, err2 = p.handler.RunJob(args.Job); err2 != nil {
 		switch v := err2.(type) {
 		case *InvalidRequest:
 			result.Ir = v
-		case *ScootServerError:
-			result.Err = v
+		case *CanNotScheduleNow:
+			result.Cnsn = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SetSchedulerStatus: "+err2.Error())
-			oprot.WriteMessageBegin("SetSchedulerStatus", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RunJob: "+err2.Error())
+			oprot.WriteMessageBegin("RunJob", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
+	} else {
+		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("SetSchedulerStatus", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("RunJob", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1728,16 +1936,16 @@ func (p *cloudScootProcessorSetSchedulerStatus) Process(seqId int32, iprot, opro
 	return true, err
 }
 
-type cloudScootProcessorGetClassLoadPercents struct {
+type cloudScootProcessorGetStatus struct {
 	handler CloudScoot
 }
 
-func (p *cloudScootProcessorGetClassLoadPercents) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CloudScootGetClassLoadPercentsArgs{}
+func (p *cloudScootProcessorGetStatus) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootGetStatusArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetClassLoadPercents", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("GetStatus", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -1745,16 +1953,18 @@ func (p *cloudScootProcessorGetClassLoadPercents) Process(seqId int32, iprot, op
 	}
 
 	iprot.ReadMessageEnd()
-	result := CloudScootGetClassLoadPercentsResult{}
-	var retval map[string]int32
+	result := CloudScootGetStatusResult{}
+	var retval *JobStatus
 	var err2 error
-	if retval, err2 = p.handler.GetClassLoadPercents(); err2 != nil {
+	if retval, err2 = p.handler.GetStatus(args.JobId); err2 != nil {
 		switch v := err2.(type) {
 		case *InvalidRequest:
 			result.Ir = v
+		case *ScootServerError:
+			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetClassLoadPercents: "+err2.Error())
-			oprot.WriteMessageBegin("GetClassLoadPercents", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetStatus: "+err2.Error())
+			oprot.WriteMessageBegin("GetStatus", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
@@ -1763,7 +1973,7 @@ func (p *cloudScootProcessorGetClassLoadPercents) Process(seqId int32, iprot, op
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetClassLoadPercents", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("GetStatus", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1781,16 +1991,16 @@ func (p *cloudScootProcessorGetClassLoadPercents) Process(seqId int32, iprot, op
 	return true, err
 }
 
-type cloudScootProcessorSetClassLoadPercents struct {
+type cloudScootProcessorWatchJob struct {
 	handler CloudScoot
 }
 
-func (p *cloudScootProcessorSetClassLoadPercents) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CloudScootSetClassLoadPercentsArgs{}
+func (p *cloudScootProcessorWatchJob) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootWatchJobArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SetClassLoadPercents", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("WatchJob", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -1798,24 +2008,27 @@ func (p *cloudScootProcessorSetClassLoadPercents) Process(seqId int32, iprot, op
 	}
 
 	iprot.ReadMessageEnd()
-	result := CloudScootSetClassLoadPercentsResult{}
+	result := CloudScootWatchJobResult{}
+	var retval *WatchJobResponse
 	var err2 error
-	if err2 = p.handler.SetClassLoadPercents(args.LoadPercents); err2 != nil {
+	if retval, err2 = p.handler.WatchJob(args.Req); err2 != nil {
 		switch v := err2.(type) {
 		case *InvalidRequest:
 			result.Ir = v
 		case *ScootServerError:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SetClassLoadPercents: "+err2.Error())
-			oprot.WriteMessageBegin("SetClassLoadPercents", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing WatchJob: "+err2.Error())
+			oprot.WriteMessageBegin("WatchJob", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
+	} else {
+		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("SetClassLoadPercents", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("WatchJob", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1833,16 +2046,16 @@ func (p *cloudScootProcessorSetClassLoadPercents) Process(seqId int32, iprot, op
 	return true, err
 }
 
-type cloudScootProcessorGetRequestorToClassMap struct {
+type cloudScootProcessorListJobs struct {
 	handler CloudScoot
 }
 
-func (p *cloudScootProcessorGetRequestorToClassMap) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CloudScootGetRequestorToClassMapArgs{}
+func (p *cloudScootProcessorListJobs) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootListJobsArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetRequestorToClassMap", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("ListJobs", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -1850,16 +2063,18 @@ func (p *cloudScootProcessorGetRequestorToClassMap) Process(seqId int32, iprot,
 	}
 
 	iprot.ReadMessageEnd()
-	result := CloudScootGetRequestorToClassMapResult{}
-	var retval map[string]string
+	result := CloudScootListJobsResult{}
+	var retval *ListJobsResponse
 	var err2 error
-	if retval, err2 = p.handler.GetRequestorToClassMap(); err2 != nil {
+	if retval, err2 = p.handler.ListJobs(args.Req); err2 != nil {
 		switch v := err2.(type) {
 		case *InvalidRequest:
 			result.Ir = v
+		case *ScootServerError:
+			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetRequestorToClassMap: "+err2.Error())
-			oprot.WriteMessageBegin("GetRequestorToClassMap", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListJobs: "+err2.Error())
+			oprot.WriteMessageBegin("ListJobs", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
@@ -1868,7 +2083,7 @@ func (p *cloudScootProcessorGetRequestorToClassMap) Process(seqId int32, iprot,
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetRequestorToClassMap", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("ListJobs", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1886,16 +2101,16 @@ func (p *cloudScootProcessorGetRequestorToClassMap) Process(seqId int32, iprot,
 	return true, err
 }
 
-type cloudScootProcessorSetRequestorToClassMap struct {
+type cloudScootProcessorExplainJob struct {
 	handler CloudScoot
 }
 
-func (p *cloudScootProcessorSetRequestorToClassMap) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CloudScootSetRequestorToClassMapArgs{}
+func (p *cloudScootProcessorExplainJob) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootExplainJobArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SetRequestorToClassMap", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("ExplainJob", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -1903,24 +2118,27 @@ func (p *cloudScootProcessorSetRequestorToClassMap) Process(seqId int32, iprot,
 	}
 
 	iprot.ReadMessageEnd()
-	result := CloudScootSetRequestorToClassMapResult{}
+	result := CloudScootExplainJobResult{}
+	var retval *JobExplanation
 	var err2 error
-	if err2 = p.handler.SetRequestorToClassMap(args.RequestorToClassMap); err2 != nil {
+	if retval, err2 = p.handler.ExplainJob(args.JobId); err2 != nil {
 		switch v := err2.(type) {
 		case *InvalidRequest:
 			result.Ir = v
 		case *ScootServerError:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SetRequestorToClassMap: "+err2.Error())
-			oprot.WriteMessageBegin("SetRequestorToClassMap", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ExplainJob: "+err2.Error())
+			oprot.WriteMessageBegin("ExplainJob", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
+	} else {
+		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("SetRequestorToClassMap", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("ExplainJob", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1938,16 +2156,16 @@ func (p *cloudScootProcessorSetRequestorToClassMap) Process(seqId int32, iprot,
 	return true, err
 }
 
-type cloudScootProcessorGetRebalanceMinimumDuration struct {
+type cloudScootProcessorKillJob struct {
 	handler CloudScoot
 }
 
-func (p *cloudScootProcessorGetRebalanceMinimumDuration) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CloudScootGetRebalanceMinimumDurationArgs{}
+func (p *cloudScootProcessorKillJob) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootKillJobArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetRebalanceMinimumDuration", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("KillJob", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -1955,25 +2173,27 @@ func (p *cloudScootProcessorGetRebalanceMinimumDuration) Process(seqId int32, ip
 	}
 
 	iprot.ReadMessageEnd()
-	result := CloudScootGetRebalanceMinimumDurationResult{}
-	var retval int32
+	result := CloudScootKillJobResult{}
+	var retval *JobStatus
 	var err2 error
-	if retval, err2 = p.handler.GetRebalanceMinimumDuration(); err2 != nil {
+	if retval, err2 = p.handler.KillJob(args.JobId); err2 != nil {
 		switch v := err2.(type) {
 		case *InvalidRequest:
 			result.Ir = v
+		case *ScootServerError:
+			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetRebalanceMinimumDuration: "+err2.Error())
-			oprot.WriteMessageBegin("GetRebalanceMinimumDuration", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing KillJob: "+err2.Error())
+			oprot.WriteMessageBegin("KillJob", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
 	} else {
-		result.Success = &retval
+		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetRebalanceMinimumDuration", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("KillJob", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -1991,16 +2211,16 @@ func (p *cloudScootProcessorGetRebalanceMinimumDuration) Process(seqId int32, ip
 	return true, err
 }
 
-type cloudScootProcessorSetRebalanceMinimumDuration struct {
+type cloudScootProcessorOfflineWorker struct {
 	handler CloudScoot
 }
 
-func (p *cloudScootProcessorSetRebalanceMinimumDuration) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CloudScootSetRebalanceMinimumDurationArgs{}
+func (p *cloudScootProcessorOfflineWorker) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootOfflineWorkerArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SetRebalanceMinimumDuration", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("OfflineWorker", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -2008,24 +2228,24 @@ func (p *cloudScootProcessorSetRebalanceMinimumDuration) Process(seqId int32, ip
 	}
 
 	iprot.ReadMessageEnd()
-	result := CloudScootSetRebalanceMinimumDurationResult{}
+	result := CloudScootOfflineWorkerResult{}
 	var err2 error
-	if err2 = p.handler.SetRebalanceMinimumDuration(args.DurationMin); err2 != nil {
+	if err2 = p.handler.OfflineWorker(args.Req); err2 != nil {
 		switch v := err2.(type) {
 		case *InvalidRequest:
 			result.Ir = v
 		case *ScootServerError:
 			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SetRebalanceMinimumDuration: "+err2.Error())
-			oprot.WriteMessageBegin("SetRebalanceMinimumDuration", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing OfflineWorker: "+err2.Error())
+			oprot.WriteMessageBegin("OfflineWorker", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
 	}
-	if err2 = oprot.WriteMessageBegin("SetRebalanceMinimumDuration", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("OfflineWorker", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -2043,16 +2263,16 @@ func (p *cloudScootProcessorSetRebalanceMinimumDuration) Process(seqId int32, ip
 	return true, err
 }
 
-type cloudScootProcessorGetRebalanceThreshold struct {
+type cloudScootProcessorReinstateWorker struct {
 	handler CloudScoot
 }
 
-func (p *cloudScootProcessorGetRebalanceThreshold) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CloudScootGetRebalanceThresholdArgs{}
+func (p *cloudScootProcessorReinstateWorker) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootReinstateWorkerArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetRebalanceThreshold", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("ReinstateWorker", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush()
@@ -2060,119 +2280,2467 @@ func (p *cloudScootProcessorGetRebalanceThreshold) Process(seqId int32, iprot, o
 	}
 
 	iprot.ReadMessageEnd()
-	result := CloudScootGetRebalanceThresholdResult{}
-	var retval int32
+	result := CloudScootReinstateWorkerResult{}
 	var err2 error
-	if retval, err2 = p.handler.GetRebalanceThreshold(); err2 != nil {
+	if err2 = p.handler.ReinstateWorker(args.Req); err2 != nil {
 		switch v := err2.(type) {
 		case *InvalidRequest:
 			result.Ir = v
+		case *ScootServerError:
+			result.Err = v
 		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetRebalanceThreshold: "+err2.Error())
-			oprot.WriteMessageBegin("GetRebalanceThreshold", thrift.EXCEPTION, seqId)
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ReinstateWorker: "+err2.Error())
+			oprot.WriteMessageBegin("ReinstateWorker", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	}
+	if err2 = oprot.WriteMessageBegin("ReinstateWorker", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type cloudScootProcessorGetClusterState struct {
+	handler CloudScoot
+}
+
+func (p *cloudScootProcessorGetClusterState) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootGetClusterStateArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetClusterState", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := CloudScootGetClusterStateResult{}
+	var retval *ClusterState
+	var err2 error
+	if retval, err2 = p.handler.GetClusterState(); err2 != nil {
+		switch v := err2.(type) {
+		case *ScootServerError:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetClusterState: "+err2.Error())
+			oprot.WriteMessageBegin("GetClusterState", thrift.EXCEPTION, seqId)
 			x.Write(oprot)
 			oprot.WriteMessageEnd()
 			oprot.Flush()
 			return true, err2
 		}
 	} else {
-		result.Success = &retval
+		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetRebalanceThreshold", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("GetClusterState", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type cloudScootProcessorGetSchedulerStatus struct {
+	handler CloudScoot
+}
+
+func (p *cloudScootProcessorGetSchedulerStatus) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootGetSchedulerStatusArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetSchedulerStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := CloudScootGetSchedulerStatusResult{}
+	var retval *SchedulerStatus
+	var err2 error
+	if retval, err2 = p.handler.GetSchedulerStatus(); err2 != nil {
+		switch v := err2.(type) {
+		case *ScootServerError:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetSchedulerStatus: "+err2.Error())
+			oprot.WriteMessageBegin("GetSchedulerStatus", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetSchedulerStatus", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type cloudScootProcessorSetSchedulerStatus struct {
+	handler CloudScoot
+}
+
+func (p *cloudScootProcessorSetSchedulerStatus) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootSetSchedulerStatusArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SetSchedulerStatus", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := CloudScootSetSchedulerStatusResult{}
+	var err2 error
+	if err2 = p.handler.SetSchedulerStatus(args.MaxTasks, args.Requestor); err2 != nil {
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.Ir = v
+		case *ScootServerError:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SetSchedulerStatus: "+err2.Error())
+			oprot.WriteMessageBegin("SetSchedulerStatus", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	}
+	if err2 = oprot.WriteMessageBegin("SetSchedulerStatus", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
 		err = err2
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type cloudScootProcessorGetClassLoadPercents struct {
+	handler CloudScoot
+}
+
+func (p *cloudScootProcessorGetClassLoadPercents) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootGetClassLoadPercentsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetClassLoadPercents", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := CloudScootGetClassLoadPercentsResult{}
+	var retval map[string]int32
+	var err2 error
+	if retval, err2 = p.handler.GetClassLoadPercents(); err2 != nil {
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.Ir = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetClassLoadPercents: "+err2.Error())
+			oprot.WriteMessageBegin("GetClassLoadPercents", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetClassLoadPercents", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type cloudScootProcessorSetClassLoadPercents struct {
+	handler CloudScoot
+}
+
+func (p *cloudScootProcessorSetClassLoadPercents) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootSetClassLoadPercentsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SetClassLoadPercents", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := CloudScootSetClassLoadPercentsResult{}
+	var err2 error
+	if err2 = p.handler.SetClassLoadPercents(args.LoadPercents, args.Requestor); err2 != nil {
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.Ir = v
+		case *ScootServerError:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SetClassLoadPercents: "+err2.Error())
+			oprot.WriteMessageBegin("SetClassLoadPercents", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	}
+	if err2 = oprot.WriteMessageBegin("SetClassLoadPercents", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type cloudScootProcessorGetRequestorToClassMap struct {
+	handler CloudScoot
+}
+
+func (p *cloudScootProcessorGetRequestorToClassMap) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootGetRequestorToClassMapArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetRequestorToClassMap", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := CloudScootGetRequestorToClassMapResult{}
+	var retval map[string]string
+	var err2 error
+	if retval, err2 = p.handler.GetRequestorToClassMap(); err2 != nil {
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.Ir = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetRequestorToClassMap: "+err2.Error())
+			oprot.WriteMessageBegin("GetRequestorToClassMap", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetRequestorToClassMap", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type cloudScootProcessorSetRequestorToClassMap struct {
+	handler CloudScoot
+}
+
+func (p *cloudScootProcessorSetRequestorToClassMap) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootSetRequestorToClassMapArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SetRequestorToClassMap", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := CloudScootSetRequestorToClassMapResult{}
+	var err2 error
+	if err2 = p.handler.SetRequestorToClassMap(args.RequestorToClassMap, args.Requestor); err2 != nil {
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.Ir = v
+		case *ScootServerError:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SetRequestorToClassMap: "+err2.Error())
+			oprot.WriteMessageBegin("SetRequestorToClassMap", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	}
+	if err2 = oprot.WriteMessageBegin("SetRequestorToClassMap", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type cloudScootProcessorGetRebalanceMinimumDuration struct {
+	handler CloudScoot
+}
+
+func (p *cloudScootProcessorGetRebalanceMinimumDuration) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootGetRebalanceMinimumDurationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetRebalanceMinimumDuration", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := CloudScootGetRebalanceMinimumDurationResult{}
+	var retval int32
+	var err2 error
+	if retval, err2 = p.handler.GetRebalanceMinimumDuration(); err2 != nil {
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.Ir = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetRebalanceMinimumDuration: "+err2.Error())
+			oprot.WriteMessageBegin("GetRebalanceMinimumDuration", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	} else {
+		result.Success = &retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetRebalanceMinimumDuration", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type cloudScootProcessorSetRebalanceMinimumDuration struct {
+	handler CloudScoot
+}
+
+func (p *cloudScootProcessorSetRebalanceMinimumDuration) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootSetRebalanceMinimumDurationArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SetRebalanceMinimumDuration", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := CloudScootSetRebalanceMinimumDurationResult{}
+	var err2 error
+	if err2 = p.handler.SetRebalanceMinimumDuration(args.DurationMin, args.Requestor); err2 != nil {
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.Ir = v
+		case *ScootServerError:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SetRebalanceMinimumDuration: "+err2.Error())
+			oprot.WriteMessageBegin("SetRebalanceMinimumDuration", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	}
+	if err2 = oprot.WriteMessageBegin("SetRebalanceMinimumDuration", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type cloudScootProcessorGetRebalanceThreshold struct {
+	handler CloudScoot
+}
+
+func (p *cloudScootProcessorGetRebalanceThreshold) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootGetRebalanceThresholdArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetRebalanceThreshold", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := CloudScootGetRebalanceThresholdResult{}
+	var retval int32
+	var err2 error
+	if retval, err2 = p.handler.GetRebalanceThreshold(); err2 != nil {
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.Ir = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetRebalanceThreshold: "+err2.Error())
+			oprot.WriteMessageBegin("GetRebalanceThreshold", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	} else {
+		result.Success = &retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetRebalanceThreshold", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type cloudScootProcessorSetRebalanceThreshold struct {
+	handler CloudScoot
+}
+
+func (p *cloudScootProcessorSetRebalanceThreshold) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootSetRebalanceThresholdArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SetRebalanceThreshold", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := CloudScootSetRebalanceThresholdResult{}
+	var err2 error
+	if err2 = p.handler.SetRebalanceThreshold(args.Threshold, args.Requestor); err2 != nil {
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.Ir = v
+		case *ScootServerError:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SetRebalanceThreshold: "+err2.Error())
+			oprot.WriteMessageBegin("SetRebalanceThreshold", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	}
+	if err2 = oprot.WriteMessageBegin("SetRebalanceThreshold", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type cloudScootProcessorGetSettingsHistory struct {
+	handler CloudScoot
+}
+
+func (p *cloudScootProcessorGetSettingsHistory) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootGetSettingsHistoryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetSettingsHistory", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := CloudScootGetSettingsHistoryResult{}
+	var retval *SettingsHistory
+	var err2 error
+	if retval, err2 = p.handler.GetSettingsHistory(); err2 != nil {
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.Ir = v
+		case *ScootServerError:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetSettingsHistory: "+err2.Error())
+			oprot.WriteMessageBegin("GetSettingsHistory", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetSettingsHistory", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type cloudScootProcessorRollbackSettings struct {
+	handler CloudScoot
+}
+
+func (p *cloudScootProcessorRollbackSettings) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CloudScootRollbackSettingsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RollbackSettings", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush()
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	result := CloudScootRollbackSettingsResult{}
+	var err2 error
+	if err2 = p.handler.RollbackSettings(args.Req); err2 != nil {
+		switch v := err2.(type) {
+		case *InvalidRequest:
+			result.Ir = v
+		case *ScootServerError:
+			result.Err = v
+		default:
+			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RollbackSettings: "+err2.Error())
+			oprot.WriteMessageBegin("RollbackSettings", thrift.EXCEPTION, seqId)
+			x.Write(oprot)
+			oprot.WriteMessageEnd()
+			oprot.Flush()
+			return true, err2
+		}
+	}
+	if err2 = oprot.WriteMessageBegin("RollbackSettings", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+// Attributes:
+//  - Job
+type CloudScootRunJobArgs struct {
+	Job *JobDefinition `thrift:"job,1" json:"job"`
+}
+
+func NewCloudScootRunJobArgs() *CloudScootRunJobArgs {
+	return &CloudScootRunJobArgs{}
+}
+
+var CloudScootRunJobArgs_Job_DEFAULT *JobDefinition
+
+func (p *CloudScootRunJobArgs) GetJob() *JobDefinition {
+	if !p.IsSetJob() {
+		return CloudScootRunJobArgs_Job_DEFAULT
+	}
+	return p.Job
+}
+func (p *CloudScootRunJobArgs) IsSetJob() bool {
+	return p.Job != nil
+}
+
+func (p *CloudScootRunJobArgs) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CloudScootRunJobArgs) readField1(iprot thrift.TProtocol) error {
+	p.Job = &JobDefinition{}
+	if err := p.Job.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Job), err)
+	}
+	return nil
+}
+
+func (p *CloudScootRunJobArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("RunJob_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CloudScootRunJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("job", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:job: ", p), err)
+	}
+	if err := p.Job.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Job), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:job: ", p), err)
+	}
+	return err
+}
+
+func (p *CloudScootRunJobArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudScootRunJobArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Ir
+//  - Cnsn
+type CloudScootRunJobResult struct {
+	Success *JobId             `thrift:"success,0" json:"success,omitempty"`
+	Ir      *InvalidRequest    `thrift:"ir,1" json:"ir,omitempty"`
+	Cnsn    *CanNotScheduleNow `thrift:"cnsn,2" json:"cnsn,omitempty"`
+}
+
+func NewCloudScootRunJobResult() *CloudScootRunJobResult {
+	return &CloudScootRunJobResult{}
+}
+
+var CloudScootRunJobResult_Success_DEFAULT *JobId
+
+func (p *CloudScootRunJobResult) GetSuccess() *JobId {
+	if !p.IsSetSuccess() {
+		return CloudScootRunJobResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var CloudScootRunJobResult_Ir_DEFAULT *InvalidRequest
+
+func (p *CloudScootRunJobResult) GetIr() *InvalidRequest {
+	if !p.IsSetIr() {
+		return CloudScootRunJobResult_Ir_DEFAULT
+	}
+	return p.Ir
+}
+
+var CloudScootRunJobResult_Cnsn_DEFAULT *CanNotScheduleNow
+
+func (p *CloudScootRunJobResult) GetCnsn() *CanNotScheduleNow {
+	if !p.IsSetCnsn() {
+		return CloudScootRunJobResult_Cnsn_DEFAULT
+	}
+	return p.Cnsn
+}
+func (p *CloudScootRunJobResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CloudScootRunJobResult) IsSetIr() bool {
+	return p.Ir != nil
+}
+
+func (p *CloudScootRunJobResult) IsSetCnsn() bool {
+	return p.Cnsn != nil
+}
+
+func (p *CloudScootRunJobResult) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if err := p.readField0(iprot); err != nil {
+				return err
+			}
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CloudScootRunJobResult) readField0(iprot thrift.TProtocol) error {
+	p.Success = &JobId{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *CloudScootRunJobResult) readField1(iprot thrift.TProtocol) error {
+	p.Ir = &InvalidRequest{}
+	if err := p.Ir.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
+	}
+	return nil
+}
+
+func (p *CloudScootRunJobResult) readField2(iprot thrift.TProtocol) error {
+	p.Cnsn = &CanNotScheduleNow{}
+	if err := p.Cnsn.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Cnsn), err)
+	}
+	return nil
+}
+
+func (p *CloudScootRunJobResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("RunJob_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField0(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CloudScootRunJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootRunJobResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIr() {
+		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
+		}
+		if err := p.Ir.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ir), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ir: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootRunJobResult) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCnsn() {
+		if err := oprot.WriteFieldBegin("cnsn", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:cnsn: ", p), err)
+		}
+		if err := p.Cnsn.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Cnsn), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:cnsn: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootRunJobResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudScootRunJobResult(%+v)", *p)
+}
+
+// Attributes:
+//  - JobId
+type CloudScootGetStatusArgs struct {
+	JobId string `thrift:"jobId,1" json:"jobId"`
+}
+
+func NewCloudScootGetStatusArgs() *CloudScootGetStatusArgs {
+	return &CloudScootGetStatusArgs{}
+}
+
+func (p *CloudScootGetStatusArgs) GetJobId() string {
+	return p.JobId
+}
+func (p *CloudScootGetStatusArgs) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CloudScootGetStatusArgs) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.JobId = v
+	}
+	return nil
+}
+
+func (p *CloudScootGetStatusArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetStatus_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CloudScootGetStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("jobId", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jobId: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.JobId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.jobId (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jobId: ", p), err)
+	}
+	return err
+}
+
+func (p *CloudScootGetStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudScootGetStatusArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Ir
+//  - Err
+type CloudScootGetStatusResult struct {
+	Success *JobStatus        `thrift:"success,0" json:"success,omitempty"`
+	Ir      *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
+	Err     *ScootServerError `thrift:"err,2" json:"err,omitempty"`
+}
+
+func NewCloudScootGetStatusResult() *CloudScootGetStatusResult {
+	return &CloudScootGetStatusResult{}
+}
+
+var CloudScootGetStatusResult_Success_DEFAULT *JobStatus
+
+func (p *CloudScootGetStatusResult) GetSuccess() *JobStatus {
+	if !p.IsSetSuccess() {
+		return CloudScootGetStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var CloudScootGetStatusResult_Ir_DEFAULT *InvalidRequest
+
+func (p *CloudScootGetStatusResult) GetIr() *InvalidRequest {
+	if !p.IsSetIr() {
+		return CloudScootGetStatusResult_Ir_DEFAULT
+	}
+	return p.Ir
+}
+
+var CloudScootGetStatusResult_Err_DEFAULT *ScootServerError
+
+func (p *CloudScootGetStatusResult) GetErr() *ScootServerError {
+	if !p.IsSetErr() {
+		return CloudScootGetStatusResult_Err_DEFAULT
+	}
+	return p.Err
+}
+func (p *CloudScootGetStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CloudScootGetStatusResult) IsSetIr() bool {
+	return p.Ir != nil
+}
+
+func (p *CloudScootGetStatusResult) IsSetErr() bool {
+	return p.Err != nil
+}
+
+func (p *CloudScootGetStatusResult) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if err := p.readField0(iprot); err != nil {
+				return err
+			}
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CloudScootGetStatusResult) readField0(iprot thrift.TProtocol) error {
+	p.Success = &JobStatus{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *CloudScootGetStatusResult) readField1(iprot thrift.TProtocol) error {
+	p.Ir = &InvalidRequest{}
+	if err := p.Ir.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
+	}
+	return nil
+}
+
+func (p *CloudScootGetStatusResult) readField2(iprot thrift.TProtocol) error {
+	p.Err = &ScootServerError{}
+	if err := p.Err.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	}
+	return nil
+}
+
+func (p *CloudScootGetStatusResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetStatus_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField0(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CloudScootGetStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootGetStatusResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIr() {
+		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
+		}
+		if err := p.Ir.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ir), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ir: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootGetStatusResult) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
+		}
+		if err := p.Err.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:err: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootGetStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudScootGetStatusResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Req
+type CloudScootWatchJobArgs struct {
+	Req *WatchJobRequest `thrift:"req,1" json:"req"`
+}
+
+func NewCloudScootWatchJobArgs() *CloudScootWatchJobArgs {
+	return &CloudScootWatchJobArgs{}
+}
+
+var CloudScootWatchJobArgs_Req_DEFAULT *WatchJobRequest
+
+func (p *CloudScootWatchJobArgs) GetReq() *WatchJobRequest {
+	if !p.IsSetReq() {
+		return CloudScootWatchJobArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CloudScootWatchJobArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CloudScootWatchJobArgs) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CloudScootWatchJobArgs) readField1(iprot thrift.TProtocol) error {
+	p.Req = &WatchJobRequest{}
+	if err := p.Req.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
+	}
+	return nil
+}
+
+func (p *CloudScootWatchJobArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("WatchJob_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CloudScootWatchJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Req), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:req: ", p), err)
+	}
+	return err
+}
+
+func (p *CloudScootWatchJobArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudScootWatchJobArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Ir
+//  - Err
+type CloudScootWatchJobResult struct {
+	Success *WatchJobResponse `thrift:"success,0" json:"success,omitempty"`
+	Ir      *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
+	Err     *ScootServerError `thrift:"err,2" json:"err,omitempty"`
+}
+
+func NewCloudScootWatchJobResult() *CloudScootWatchJobResult {
+	return &CloudScootWatchJobResult{}
+}
+
+var CloudScootWatchJobResult_Success_DEFAULT *WatchJobResponse
+
+func (p *CloudScootWatchJobResult) GetSuccess() *WatchJobResponse {
+	if !p.IsSetSuccess() {
+		return CloudScootWatchJobResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var CloudScootWatchJobResult_Ir_DEFAULT *InvalidRequest
+
+func (p *CloudScootWatchJobResult) GetIr() *InvalidRequest {
+	if !p.IsSetIr() {
+		return CloudScootWatchJobResult_Ir_DEFAULT
+	}
+	return p.Ir
+}
+
+var CloudScootWatchJobResult_Err_DEFAULT *ScootServerError
+
+func (p *CloudScootWatchJobResult) GetErr() *ScootServerError {
+	if !p.IsSetErr() {
+		return CloudScootWatchJobResult_Err_DEFAULT
+	}
+	return p.Err
+}
+func (p *CloudScootWatchJobResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CloudScootWatchJobResult) IsSetIr() bool {
+	return p.Ir != nil
+}
+
+func (p *CloudScootWatchJobResult) IsSetErr() bool {
+	return p.Err != nil
+}
+
+func (p *CloudScootWatchJobResult) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if err := p.readField0(iprot); err != nil {
+				return err
+			}
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CloudScootWatchJobResult) readField0(iprot thrift.TProtocol) error {
+	p.Success = &WatchJobResponse{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *CloudScootWatchJobResult) readField1(iprot thrift.TProtocol) error {
+	p.Ir = &InvalidRequest{}
+	if err := p.Ir.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
+	}
+	return nil
+}
+
+func (p *CloudScootWatchJobResult) readField2(iprot thrift.TProtocol) error {
+	p.Err = &ScootServerError{}
+	if err := p.Err.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	}
+	return nil
+}
+
+func (p *CloudScootWatchJobResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("WatchJob_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField0(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CloudScootWatchJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootWatchJobResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIr() {
+		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
+		}
+		if err := p.Ir.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ir), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ir: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootWatchJobResult) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
+		}
+		if err := p.Err.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:err: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootWatchJobResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudScootWatchJobResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Req
+type CloudScootListJobsArgs struct {
+	Req *ListJobsRequest `thrift:"req,1" json:"req"`
+}
+
+func NewCloudScootListJobsArgs() *CloudScootListJobsArgs {
+	return &CloudScootListJobsArgs{}
+}
+
+var CloudScootListJobsArgs_Req_DEFAULT *ListJobsRequest
+
+func (p *CloudScootListJobsArgs) GetReq() *ListJobsRequest {
+	if !p.IsSetReq() {
+		return CloudScootListJobsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *CloudScootListJobsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CloudScootListJobsArgs) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CloudScootListJobsArgs) readField1(iprot thrift.TProtocol) error {
+	p.Req = &ListJobsRequest{}
+	if err := p.Req.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
+	}
+	return nil
+}
+
+func (p *CloudScootListJobsArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ListJobs_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CloudScootListJobsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
+	}
+	if err := p.Req.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Req), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:req: ", p), err)
+	}
+	return err
+}
+
+func (p *CloudScootListJobsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudScootListJobsArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Ir
+//  - Err
+type CloudScootListJobsResult struct {
+	Success *ListJobsResponse `thrift:"success,0" json:"success,omitempty"`
+	Ir      *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
+	Err     *ScootServerError `thrift:"err,2" json:"err,omitempty"`
+}
+
+func NewCloudScootListJobsResult() *CloudScootListJobsResult {
+	return &CloudScootListJobsResult{}
+}
+
+var CloudScootListJobsResult_Success_DEFAULT *ListJobsResponse
+
+func (p *CloudScootListJobsResult) GetSuccess() *ListJobsResponse {
+	if !p.IsSetSuccess() {
+		return CloudScootListJobsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var CloudScootListJobsResult_Ir_DEFAULT *InvalidRequest
+
+func (p *CloudScootListJobsResult) GetIr() *InvalidRequest {
+	if !p.IsSetIr() {
+		return CloudScootListJobsResult_Ir_DEFAULT
+	}
+	return p.Ir
+}
+
+var CloudScootListJobsResult_Err_DEFAULT *ScootServerError
+
+func (p *CloudScootListJobsResult) GetErr() *ScootServerError {
+	if !p.IsSetErr() {
+		return CloudScootListJobsResult_Err_DEFAULT
+	}
+	return p.Err
+}
+func (p *CloudScootListJobsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CloudScootListJobsResult) IsSetIr() bool {
+	return p.Ir != nil
+}
+
+func (p *CloudScootListJobsResult) IsSetErr() bool {
+	return p.Err != nil
+}
+
+func (p *CloudScootListJobsResult) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if err := p.readField0(iprot); err != nil {
+				return err
+			}
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CloudScootListJobsResult) readField0(iprot thrift.TProtocol) error {
+	p.Success = &ListJobsResponse{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *CloudScootListJobsResult) readField1(iprot thrift.TProtocol) error {
+	p.Ir = &InvalidRequest{}
+	if err := p.Ir.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
+	}
+	return nil
+}
+
+func (p *CloudScootListJobsResult) readField2(iprot thrift.TProtocol) error {
+	p.Err = &ScootServerError{}
+	if err := p.Err.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	}
+	return nil
+}
+
+func (p *CloudScootListJobsResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ListJobs_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField0(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CloudScootListJobsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootListJobsResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIr() {
+		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
+		}
+		if err := p.Ir.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ir), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ir: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootListJobsResult) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
+		}
+		if err := p.Err.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:err: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootListJobsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudScootListJobsResult(%+v)", *p)
+}
+
+// Attributes:
+//  - JobId
+type CloudScootExplainJobArgs struct {
+	JobId string `thrift:"jobId,1" json:"jobId"`
+}
+
+func NewCloudScootExplainJobArgs() *CloudScootExplainJobArgs {
+	return &CloudScootExplainJobArgs{}
+}
+
+func (p *CloudScootExplainJobArgs) GetJobId() string {
+	return p.JobId
+}
+func (p *CloudScootExplainJobArgs) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CloudScootExplainJobArgs) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.JobId = v
+	}
+	return nil
+}
+
+func (p *CloudScootExplainJobArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ExplainJob_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CloudScootExplainJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("jobId", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jobId: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.JobId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.jobId (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jobId: ", p), err)
+	}
+	return err
+}
+
+func (p *CloudScootExplainJobArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudScootExplainJobArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Ir
+//  - Err
+type CloudScootExplainJobResult struct {
+	Success *JobExplanation   `thrift:"success,0" json:"success,omitempty"`
+	Ir      *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
+	Err     *ScootServerError `thrift:"err,2" json:"err,omitempty"`
+}
+
+func NewCloudScootExplainJobResult() *CloudScootExplainJobResult {
+	return &CloudScootExplainJobResult{}
+}
+
+var CloudScootExplainJobResult_Success_DEFAULT *JobExplanation
+
+func (p *CloudScootExplainJobResult) GetSuccess() *JobExplanation {
+	if !p.IsSetSuccess() {
+		return CloudScootExplainJobResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var CloudScootExplainJobResult_Ir_DEFAULT *InvalidRequest
+
+func (p *CloudScootExplainJobResult) GetIr() *InvalidRequest {
+	if !p.IsSetIr() {
+		return CloudScootExplainJobResult_Ir_DEFAULT
+	}
+	return p.Ir
+}
+
+var CloudScootExplainJobResult_Err_DEFAULT *ScootServerError
+
+func (p *CloudScootExplainJobResult) GetErr() *ScootServerError {
+	if !p.IsSetErr() {
+		return CloudScootExplainJobResult_Err_DEFAULT
+	}
+	return p.Err
+}
+func (p *CloudScootExplainJobResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CloudScootExplainJobResult) IsSetIr() bool {
+	return p.Ir != nil
+}
+
+func (p *CloudScootExplainJobResult) IsSetErr() bool {
+	return p.Err != nil
+}
+
+func (p *CloudScootExplainJobResult) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if err := p.readField0(iprot); err != nil {
+				return err
+			}
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CloudScootExplainJobResult) readField0(iprot thrift.TProtocol) error {
+	p.Success = &JobExplanation{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *CloudScootExplainJobResult) readField1(iprot thrift.TProtocol) error {
+	p.Ir = &InvalidRequest{}
+	if err := p.Ir.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
+	}
+	return nil
+}
+
+func (p *CloudScootExplainJobResult) readField2(iprot thrift.TProtocol) error {
+	p.Err = &ScootServerError{}
+	if err := p.Err.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	}
+	return nil
+}
+
+func (p *CloudScootExplainJobResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ExplainJob_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField0(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CloudScootExplainJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootExplainJobResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIr() {
+		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
+		}
+		if err := p.Ir.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ir), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ir: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootExplainJobResult) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
+		}
+		if err := p.Err.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:err: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootExplainJobResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudScootExplainJobResult(%+v)", *p)
+}
+
+// Attributes:
+//  - JobId
+type CloudScootKillJobArgs struct {
+	JobId string `thrift:"jobId,1" json:"jobId"`
+}
+
+func NewCloudScootKillJobArgs() *CloudScootKillJobArgs {
+	return &CloudScootKillJobArgs{}
+}
+
+func (p *CloudScootKillJobArgs) GetJobId() string {
+	return p.JobId
+}
+func (p *CloudScootKillJobArgs) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CloudScootKillJobArgs) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.JobId = v
+	}
+	return nil
+}
+
+func (p *CloudScootKillJobArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("KillJob_args"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CloudScootKillJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("jobId", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jobId: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.JobId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.jobId (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jobId: ", p), err)
+	}
+	return err
+}
+
+func (p *CloudScootKillJobArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudScootKillJobArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Ir
+//  - Err
+type CloudScootKillJobResult struct {
+	Success *JobStatus        `thrift:"success,0" json:"success,omitempty"`
+	Ir      *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
+	Err     *ScootServerError `thrift:"err,2" json:"err,omitempty"`
+}
+
+func NewCloudScootKillJobResult() *CloudScootKillJobResult {
+	return &CloudScootKillJobResult{}
+}
+
+var CloudScootKillJobResult_Success_DEFAULT *JobStatus
+
+func (p *CloudScootKillJobResult) GetSuccess() *JobStatus {
+	if !p.IsSetSuccess() {
+		return CloudScootKillJobResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var CloudScootKillJobResult_Ir_DEFAULT *InvalidRequest
+
+func (p *CloudScootKillJobResult) GetIr() *InvalidRequest {
+	if !p.IsSetIr() {
+		return CloudScootKillJobResult_Ir_DEFAULT
+	}
+	return p.Ir
+}
+
+var CloudScootKillJobResult_Err_DEFAULT *ScootServerError
+
+func (p *CloudScootKillJobResult) GetErr() *ScootServerError {
+	if !p.IsSetErr() {
+		return CloudScootKillJobResult_Err_DEFAULT
+	}
+	return p.Err
+}
+func (p *CloudScootKillJobResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CloudScootKillJobResult) IsSetIr() bool {
+	return p.Ir != nil
+}
+
+func (p *CloudScootKillJobResult) IsSetErr() bool {
+	return p.Err != nil
+}
+
+func (p *CloudScootKillJobResult) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 0:
+			if err := p.readField0(iprot); err != nil {
+				return err
+			}
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *CloudScootKillJobResult) readField0(iprot thrift.TProtocol) error {
+	p.Success = &JobStatus{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	}
+	return nil
+}
+
+func (p *CloudScootKillJobResult) readField1(iprot thrift.TProtocol) error {
+	p.Ir = &InvalidRequest{}
+	if err := p.Ir.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
+	}
+	return nil
+}
+
+func (p *CloudScootKillJobResult) readField2(iprot thrift.TProtocol) error {
+	p.Err = &ScootServerError{}
+	if err := p.Err.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	}
+	return nil
+}
+
+func (p *CloudScootKillJobResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("KillJob_result"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField0(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err := p.writeField2(oprot); err != nil {
+		return err
 	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	if err != nil {
-		return
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	return true, err
+	return nil
 }
 
-type cloudScootProcessorSetRebalanceThreshold struct {
-	handler CloudScoot
+func (p *CloudScootKillJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
+		}
+	}
+	return err
 }
 
-func (p *cloudScootProcessorSetRebalanceThreshold) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CloudScootSetRebalanceThresholdArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SetRebalanceThreshold", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush()
-		return false, err
+func (p *CloudScootKillJobResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIr() {
+		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
+		}
+		if err := p.Ir.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ir), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ir: ", p), err)
+		}
 	}
+	return err
+}
 
-	iprot.ReadMessageEnd()
-	result := CloudScootSetRebalanceThresholdResult{}
-	var err2 error
-	if err2 = p.handler.SetRebalanceThreshold(args.Threshold); err2 != nil {
-		switch v := err2.(type) {
-		case *InvalidRequest:
-			result.Ir = v
-		case *ScootServerError:
-			result.Err = v
-		default:
-			x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SetRebalanceThreshold: "+err2.Error())
-			oprot.WriteMessageBegin("SetRebalanceThreshold", thrift.EXCEPTION, seqId)
-			x.Write(oprot)
-			oprot.WriteMessageEnd()
-			oprot.Flush()
-			return true, err2
+func (p *CloudScootKillJobResult) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
+		}
+		if err := p.Err.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:err: ", p), err)
 		}
 	}
-	if err2 = oprot.WriteMessageBegin("SetRebalanceThreshold", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+	return err
 }
 
-// HELPER FUNCTIONS AND STRUCTURES
+func (p *CloudScootKillJobResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CloudScootKillJobResult(%+v)", *p)
+}
 
 // Attributes:
-//  - Job
-type CloudScootRunJobArgs struct {
-	Job *JobDefinition `thrift:"job,1" json:"job"`
+//  - Req
+type CloudScootOfflineWorkerArgs struct {
+	Req *OfflineWorkerReq `thrift:"req,1" json:"req"`
 }
 
-func NewCloudScootRunJobArgs() *CloudScootRunJobArgs {
-	return &CloudScootRunJobArgs{}
+func NewCloudScootOfflineWorkerArgs() *CloudScootOfflineWorkerArgs {
+	return &CloudScootOfflineWorkerArgs{}
 }
 
-var CloudScootRunJobArgs_Job_DEFAULT *JobDefinition
+var CloudScootOfflineWorkerArgs_Req_DEFAULT *OfflineWorkerReq
 
-func (p *CloudScootRunJobArgs) GetJob() *JobDefinition {
-	if !p.IsSetJob() {
-		return CloudScootRunJobArgs_Job_DEFAULT
+func (p *CloudScootOfflineWorkerArgs) GetReq() *OfflineWorkerReq {
+	if !p.IsSetReq() {
+		return CloudScootOfflineWorkerArgs_Req_DEFAULT
 	}
-	return p.Job
+	return p.Req
 }
-func (p *CloudScootRunJobArgs) IsSetJob() bool {
-	return p.Job != nil
+func (p *CloudScootOfflineWorkerArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *CloudScootRunJobArgs) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootOfflineWorkerArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -2205,16 +4773,16 @@ func (p *CloudScootRunJobArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootRunJobArgs) readField1(iprot thrift.TProtocol) error {
-	p.Job = &JobDefinition{}
-	if err := p.Job.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Job), err)
+func (p *CloudScootOfflineWorkerArgs) readField1(iprot thrift.TProtocol) error {
+	p.Req = &OfflineWorkerReq{}
+	if err := p.Req.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
 	}
 	return nil
 }
 
-func (p *CloudScootRunJobArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("RunJob_args"); err != nil {
+func (p *CloudScootOfflineWorkerArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("OfflineWorker_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -2229,79 +4797,64 @@ func (p *CloudScootRunJobArgs) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootRunJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("job", thrift.STRUCT, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:job: ", p), err)
+func (p *CloudScootOfflineWorkerArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
-	if err := p.Job.Write(oprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Job), err)
+	if err := p.Req.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Req), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:job: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:req: ", p), err)
 	}
 	return err
 }
 
-func (p *CloudScootRunJobArgs) String() string {
+func (p *CloudScootOfflineWorkerArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootRunJobArgs(%+v)", *p)
+	return fmt.Sprintf("CloudScootOfflineWorkerArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - Ir
-//  - Cnsn
-type CloudScootRunJobResult struct {
-	Success *JobId             `thrift:"success,0" json:"success,omitempty"`
-	Ir      *InvalidRequest    `thrift:"ir,1" json:"ir,omitempty"`
-	Cnsn    *CanNotScheduleNow `thrift:"cnsn,2" json:"cnsn,omitempty"`
-}
-
-func NewCloudScootRunJobResult() *CloudScootRunJobResult {
-	return &CloudScootRunJobResult{}
+//  - Err
+type CloudScootOfflineWorkerResult struct {
+	Ir  *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
+	Err *ScootServerError `thrift:"err,2" json:"err,omitempty"`
 }
 
-var CloudScootRunJobResult_Success_DEFAULT *JobId
-
-func (p *CloudScootRunJobResult) GetSuccess() *JobId {
-	if !p.IsSetSuccess() {
-		return CloudScootRunJobResult_Success_DEFAULT
-	}
-	return p.Success
+func NewCloudScootOfflineWorkerResult() *CloudScootOfflineWorkerResult {
+	return &CloudScootOfflineWorkerResult{}
 }
 
-var CloudScootRunJobResult_Ir_DEFAULT *InvalidRequest
+var CloudScootOfflineWorkerResult_Ir_DEFAULT *InvalidRequest
 
-func (p *CloudScootRunJobResult) GetIr() *InvalidRequest {
+func (p *CloudScootOfflineWorkerResult) GetIr() *InvalidRequest {
 	if !p.IsSetIr() {
-		return CloudScootRunJobResult_Ir_DEFAULT
+		return CloudScootOfflineWorkerResult_Ir_DEFAULT
 	}
 	return p.Ir
 }
 
-var CloudScootRunJobResult_Cnsn_DEFAULT *CanNotScheduleNow
+var CloudScootOfflineWorkerResult_Err_DEFAULT *ScootServerError
 
-func (p *CloudScootRunJobResult) GetCnsn() *CanNotScheduleNow {
-	if !p.IsSetCnsn() {
-		return CloudScootRunJobResult_Cnsn_DEFAULT
+func (p *CloudScootOfflineWorkerResult) GetErr() *ScootServerError {
+	if !p.IsSetErr() {
+		return CloudScootOfflineWorkerResult_Err_DEFAULT
 	}
-	return p.Cnsn
-}
-func (p *CloudScootRunJobResult) IsSetSuccess() bool {
-	return p.Success != nil
+	return p.Err
 }
-
-func (p *CloudScootRunJobResult) IsSetIr() bool {
+func (p *CloudScootOfflineWorkerResult) IsSetIr() bool {
 	return p.Ir != nil
 }
 
-func (p *CloudScootRunJobResult) IsSetCnsn() bool {
-	return p.Cnsn != nil
+func (p *CloudScootOfflineWorkerResult) IsSetErr() bool {
+	return p.Err != nil
 }
 
-func (p *CloudScootRunJobResult) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootOfflineWorkerResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -2315,10 +4868,6 @@ func (p *CloudScootRunJobResult) Read(iprot thrift.TProtocol) error {
 			break
 		}
 		switch fieldId {
-		case 0:
-			if err := p.readField0(iprot); err != nil {
-				return err
-			}
 		case 1:
 			if err := p.readField1(iprot); err != nil {
 				return err
@@ -2342,15 +4891,7 @@ func (p *CloudScootRunJobResult) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootRunJobResult) readField0(iprot thrift.TProtocol) error {
-	p.Success = &JobId{}
-	if err := p.Success.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-	}
-	return nil
-}
-
-func (p *CloudScootRunJobResult) readField1(iprot thrift.TProtocol) error {
+func (p *CloudScootOfflineWorkerResult) readField1(iprot thrift.TProtocol) error {
 	p.Ir = &InvalidRequest{}
 	if err := p.Ir.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
@@ -2358,21 +4899,18 @@ func (p *CloudScootRunJobResult) readField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootRunJobResult) readField2(iprot thrift.TProtocol) error {
-	p.Cnsn = &CanNotScheduleNow{}
-	if err := p.Cnsn.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Cnsn), err)
+func (p *CloudScootOfflineWorkerResult) readField2(iprot thrift.TProtocol) error {
+	p.Err = &ScootServerError{}
+	if err := p.Err.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
 	}
 	return nil
 }
 
-func (p *CloudScootRunJobResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("RunJob_result"); err != nil {
+func (p *CloudScootOfflineWorkerResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("OfflineWorker_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	if err := p.writeField0(oprot); err != nil {
-		return err
-	}
 	if err := p.writeField1(oprot); err != nil {
 		return err
 	}
@@ -2388,22 +4926,7 @@ func (p *CloudScootRunJobResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootRunJobResult) writeField0(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
-		}
-		if err := p.Success.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *CloudScootRunJobResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootOfflineWorkerResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetIr() {
 		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
@@ -2418,42 +4941,51 @@ func (p *CloudScootRunJobResult) writeField1(oprot thrift.TProtocol) (err error)
 	return err
 }
 
-func (p *CloudScootRunJobResult) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCnsn() {
-		if err := oprot.WriteFieldBegin("cnsn", thrift.STRUCT, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:cnsn: ", p), err)
+func (p *CloudScootOfflineWorkerResult) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
 		}
-		if err := p.Cnsn.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Cnsn), err)
+		if err := p.Err.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:cnsn: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:err: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *CloudScootRunJobResult) String() string {
+func (p *CloudScootOfflineWorkerResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootRunJobResult(%+v)", *p)
+	return fmt.Sprintf("CloudScootOfflineWorkerResult(%+v)", *p)
 }
 
 // Attributes:
-//  - JobId
-type CloudScootGetStatusArgs struct {
-	JobId string `thrift:"jobId,1" json:"jobId"`
+//  - Req
+type CloudScootReinstateWorkerArgs struct {
+	Req *ReinstateWorkerReq `thrift:"req,1" json:"req"`
 }
 
-func NewCloudScootGetStatusArgs() *CloudScootGetStatusArgs {
-	return &CloudScootGetStatusArgs{}
+func NewCloudScootReinstateWorkerArgs() *CloudScootReinstateWorkerArgs {
+	return &CloudScootReinstateWorkerArgs{}
 }
 
-func (p *CloudScootGetStatusArgs) GetJobId() string {
-	return p.JobId
+var CloudScootReinstateWorkerArgs_Req_DEFAULT *ReinstateWorkerReq
+
+func (p *CloudScootReinstateWorkerArgs) GetReq() *ReinstateWorkerReq {
+	if !p.IsSetReq() {
+		return CloudScootReinstateWorkerArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *CloudScootGetStatusArgs) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootReinstateWorkerArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CloudScootReinstateWorkerArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -2486,17 +5018,16 @@ func (p *CloudScootGetStatusArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootGetStatusArgs) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.JobId = v
+func (p *CloudScootReinstateWorkerArgs) readField1(iprot thrift.TProtocol) error {
+	p.Req = &ReinstateWorkerReq{}
+	if err := p.Req.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
 	}
 	return nil
 }
 
-func (p *CloudScootGetStatusArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("GetStatus_args"); err != nil {
+func (p *CloudScootReinstateWorkerArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ReinstateWorker_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -2511,79 +5042,64 @@ func (p *CloudScootGetStatusArgs) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootGetStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("jobId", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jobId: ", p), err)
+func (p *CloudScootReinstateWorkerArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
-	if err := oprot.WriteString(string(p.JobId)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.jobId (1) field write error: ", p), err)
+	if err := p.Req.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Req), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jobId: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:req: ", p), err)
 	}
 	return err
 }
 
-func (p *CloudScootGetStatusArgs) String() string {
+func (p *CloudScootReinstateWorkerArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootGetStatusArgs(%+v)", *p)
+	return fmt.Sprintf("CloudScootReinstateWorkerArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - Ir
 //  - Err
-type CloudScootGetStatusResult struct {
-	Success *JobStatus        `thrift:"success,0" json:"success,omitempty"`
-	Ir      *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
-	Err     *ScootServerError `thrift:"err,2" json:"err,omitempty"`
-}
-
-func NewCloudScootGetStatusResult() *CloudScootGetStatusResult {
-	return &CloudScootGetStatusResult{}
+type CloudScootReinstateWorkerResult struct {
+	Ir  *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
+	Err *ScootServerError `thrift:"err,2" json:"err,omitempty"`
 }
 
-var CloudScootGetStatusResult_Success_DEFAULT *JobStatus
-
-func (p *CloudScootGetStatusResult) GetSuccess() *JobStatus {
-	if !p.IsSetSuccess() {
-		return CloudScootGetStatusResult_Success_DEFAULT
-	}
-	return p.Success
+func NewCloudScootReinstateWorkerResult() *CloudScootReinstateWorkerResult {
+	return &CloudScootReinstateWorkerResult{}
 }
 
-var CloudScootGetStatusResult_Ir_DEFAULT *InvalidRequest
+var CloudScootReinstateWorkerResult_Ir_DEFAULT *InvalidRequest
 
-func (p *CloudScootGetStatusResult) GetIr() *InvalidRequest {
+func (p *CloudScootReinstateWorkerResult) GetIr() *InvalidRequest {
 	if !p.IsSetIr() {
-		return CloudScootGetStatusResult_Ir_DEFAULT
+		return CloudScootReinstateWorkerResult_Ir_DEFAULT
 	}
 	return p.Ir
 }
 
-var CloudScootGetStatusResult_Err_DEFAULT *ScootServerError
+var CloudScootReinstateWorkerResult_Err_DEFAULT *ScootServerError
 
-func (p *CloudScootGetStatusResult) GetErr() *ScootServerError {
+func (p *CloudScootReinstateWorkerResult) GetErr() *ScootServerError {
 	if !p.IsSetErr() {
-		return CloudScootGetStatusResult_Err_DEFAULT
+		return CloudScootReinstateWorkerResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *CloudScootGetStatusResult) IsSetSuccess() bool {
-	return p.Success != nil
-}
-
-func (p *CloudScootGetStatusResult) IsSetIr() bool {
+func (p *CloudScootReinstateWorkerResult) IsSetIr() bool {
 	return p.Ir != nil
 }
 
-func (p *CloudScootGetStatusResult) IsSetErr() bool {
+func (p *CloudScootReinstateWorkerResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *CloudScootGetStatusResult) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootReinstateWorkerResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -2597,10 +5113,6 @@ func (p *CloudScootGetStatusResult) Read(iprot thrift.TProtocol) error {
 			break
 		}
 		switch fieldId {
-		case 0:
-			if err := p.readField0(iprot); err != nil {
-				return err
-			}
 		case 1:
 			if err := p.readField1(iprot); err != nil {
 				return err
@@ -2624,15 +5136,7 @@ func (p *CloudScootGetStatusResult) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootGetStatusResult) readField0(iprot thrift.TProtocol) error {
-	p.Success = &JobStatus{}
-	if err := p.Success.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-	}
-	return nil
-}
-
-func (p *CloudScootGetStatusResult) readField1(iprot thrift.TProtocol) error {
+func (p *CloudScootReinstateWorkerResult) readField1(iprot thrift.TProtocol) error {
 	p.Ir = &InvalidRequest{}
 	if err := p.Ir.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
@@ -2640,7 +5144,7 @@ func (p *CloudScootGetStatusResult) readField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootGetStatusResult) readField2(iprot thrift.TProtocol) error {
+func (p *CloudScootReinstateWorkerResult) readField2(iprot thrift.TProtocol) error {
 	p.Err = &ScootServerError{}
 	if err := p.Err.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
@@ -2648,13 +5152,10 @@ func (p *CloudScootGetStatusResult) readField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootGetStatusResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("GetStatus_result"); err != nil {
+func (p *CloudScootReinstateWorkerResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ReinstateWorker_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	if err := p.writeField0(oprot); err != nil {
-		return err
-	}
 	if err := p.writeField1(oprot); err != nil {
 		return err
 	}
@@ -2670,22 +5171,7 @@ func (p *CloudScootGetStatusResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootGetStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
-		}
-		if err := p.Success.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *CloudScootGetStatusResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootReinstateWorkerResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetIr() {
 		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
@@ -2700,7 +5186,7 @@ func (p *CloudScootGetStatusResult) writeField1(oprot thrift.TProtocol) (err err
 	return err
 }
 
-func (p *CloudScootGetStatusResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootReinstateWorkerResult) writeField2(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
@@ -2715,27 +5201,21 @@ func (p *CloudScootGetStatusResult) writeField2(oprot thrift.TProtocol) (err err
 	return err
 }
 
-func (p *CloudScootGetStatusResult) String() string {
+func (p *CloudScootReinstateWorkerResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootGetStatusResult(%+v)", *p)
+	return fmt.Sprintf("CloudScootReinstateWorkerResult(%+v)", *p)
 }
 
-// Attributes:
-//  - JobId
-type CloudScootKillJobArgs struct {
-	JobId string `thrift:"jobId,1" json:"jobId"`
+type CloudScootGetClusterStateArgs struct {
 }
 
-func NewCloudScootKillJobArgs() *CloudScootKillJobArgs {
-	return &CloudScootKillJobArgs{}
+func NewCloudScootGetClusterStateArgs() *CloudScootGetClusterStateArgs {
+	return &CloudScootGetClusterStateArgs{}
 }
 
-func (p *CloudScootKillJobArgs) GetJobId() string {
-	return p.JobId
-}
-func (p *CloudScootKillJobArgs) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootGetClusterStateArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -2748,15 +5228,8 @@ func (p *CloudScootKillJobArgs) Read(iprot thrift.TProtocol) error {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		switch fieldId {
-		case 1:
-			if err := p.readField1(iprot); err != nil {
-				return err
-			}
-		default:
-			if err := iprot.Skip(fieldTypeId); err != nil {
-				return err
-			}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
 		}
 		if err := iprot.ReadFieldEnd(); err != nil {
 			return err
@@ -2768,22 +5241,10 @@ func (p *CloudScootKillJobArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootKillJobArgs) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.JobId = v
-	}
-	return nil
-}
-
-func (p *CloudScootKillJobArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("KillJob_args"); err != nil {
+func (p *CloudScootGetClusterStateArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetClusterState_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	if err := p.writeField1(oprot); err != nil {
-		return err
-	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -2793,79 +5254,51 @@ func (p *CloudScootKillJobArgs) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootKillJobArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("jobId", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jobId: ", p), err)
-	}
-	if err := oprot.WriteString(string(p.JobId)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.jobId (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jobId: ", p), err)
-	}
-	return err
-}
-
-func (p *CloudScootKillJobArgs) String() string {
+func (p *CloudScootGetClusterStateArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootKillJobArgs(%+v)", *p)
+	return fmt.Sprintf("CloudScootGetClusterStateArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
-//  - Ir
 //  - Err
-type CloudScootKillJobResult struct {
-	Success *JobStatus        `thrift:"success,0" json:"success,omitempty"`
-	Ir      *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
-	Err     *ScootServerError `thrift:"err,2" json:"err,omitempty"`
+type CloudScootGetClusterStateResult struct {
+	Success *ClusterState     `thrift:"success,0" json:"success,omitempty"`
+	Err     *ScootServerError `thrift:"err,1" json:"err,omitempty"`
 }
 
-func NewCloudScootKillJobResult() *CloudScootKillJobResult {
-	return &CloudScootKillJobResult{}
+func NewCloudScootGetClusterStateResult() *CloudScootGetClusterStateResult {
+	return &CloudScootGetClusterStateResult{}
 }
 
-var CloudScootKillJobResult_Success_DEFAULT *JobStatus
+var CloudScootGetClusterStateResult_Success_DEFAULT *ClusterState
 
-func (p *CloudScootKillJobResult) GetSuccess() *JobStatus {
+func (p *CloudScootGetClusterStateResult) GetSuccess() *ClusterState {
 	if !p.IsSetSuccess() {
-		return CloudScootKillJobResult_Success_DEFAULT
+		return CloudScootGetClusterStateResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var CloudScootKillJobResult_Ir_DEFAULT *InvalidRequest
-
-func (p *CloudScootKillJobResult) GetIr() *InvalidRequest {
-	if !p.IsSetIr() {
-		return CloudScootKillJobResult_Ir_DEFAULT
-	}
-	return p.Ir
-}
-
-var CloudScootKillJobResult_Err_DEFAULT *ScootServerError
+var CloudScootGetClusterStateResult_Err_DEFAULT *ScootServerError
 
-func (p *CloudScootKillJobResult) GetErr() *ScootServerError {
+func (p *CloudScootGetClusterStateResult) GetErr() *ScootServerError {
 	if !p.IsSetErr() {
-		return CloudScootKillJobResult_Err_DEFAULT
+		return CloudScootGetClusterStateResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *CloudScootKillJobResult) IsSetSuccess() bool {
+func (p *CloudScootGetClusterStateResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CloudScootKillJobResult) IsSetIr() bool {
-	return p.Ir != nil
-}
-
-func (p *CloudScootKillJobResult) IsSetErr() bool {
+func (p *CloudScootGetClusterStateResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *CloudScootKillJobResult) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootGetClusterStateResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -2887,10 +5320,6 @@ func (p *CloudScootKillJobResult) Read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
-		case 2:
-			if err := p.readField2(iprot); err != nil {
-				return err
-			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -2900,29 +5329,21 @@ func (p *CloudScootKillJobResult) Read(iprot thrift.TProtocol) error {
 			return err
 		}
 	}
-	if err := iprot.ReadStructEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	return nil
-}
-
-func (p *CloudScootKillJobResult) readField0(iprot thrift.TProtocol) error {
-	p.Success = &JobStatus{}
-	if err := p.Success.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
 	return nil
 }
 
-func (p *CloudScootKillJobResult) readField1(iprot thrift.TProtocol) error {
-	p.Ir = &InvalidRequest{}
-	if err := p.Ir.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
+func (p *CloudScootGetClusterStateResult) readField0(iprot thrift.TProtocol) error {
+	p.Success = &ClusterState{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
 	}
 	return nil
 }
 
-func (p *CloudScootKillJobResult) readField2(iprot thrift.TProtocol) error {
+func (p *CloudScootGetClusterStateResult) readField1(iprot thrift.TProtocol) error {
 	p.Err = &ScootServerError{}
 	if err := p.Err.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
@@ -2930,8 +5351,8 @@ func (p *CloudScootKillJobResult) readField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootKillJobResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("KillJob_result"); err != nil {
+func (p *CloudScootGetClusterStateResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetClusterState_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField0(oprot); err != nil {
@@ -2940,9 +5361,6 @@ func (p *CloudScootKillJobResult) Write(oprot thrift.TProtocol) error {
 	if err := p.writeField1(oprot); err != nil {
 		return err
 	}
-	if err := p.writeField2(oprot); err != nil {
-		return err
-	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -2952,7 +5370,7 @@ func (p *CloudScootKillJobResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootKillJobResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootGetClusterStateResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
@@ -2967,66 +5385,36 @@ func (p *CloudScootKillJobResult) writeField0(oprot thrift.TProtocol) (err error
 	return err
 }
 
-func (p *CloudScootKillJobResult) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIr() {
-		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
-		}
-		if err := p.Ir.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ir), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ir: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *CloudScootKillJobResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootGetClusterStateResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
-		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
+		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
 		}
 		if err := p.Err.Write(oprot); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:err: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *CloudScootKillJobResult) String() string {
+func (p *CloudScootGetClusterStateResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootKillJobResult(%+v)", *p)
-}
-
-// Attributes:
-//  - Req
-type CloudScootOfflineWorkerArgs struct {
-	Req *OfflineWorkerReq `thrift:"req,1" json:"req"`
+	return fmt.Sprintf("CloudScootGetClusterStateResult(%+v)", *p)
 }
 
-func NewCloudScootOfflineWorkerArgs() *CloudScootOfflineWorkerArgs {
-	return &CloudScootOfflineWorkerArgs{}
+type CloudScootGetSchedulerStatusArgs struct {
 }
 
-var CloudScootOfflineWorkerArgs_Req_DEFAULT *OfflineWorkerReq
-
-func (p *CloudScootOfflineWorkerArgs) GetReq() *OfflineWorkerReq {
-	if !p.IsSetReq() {
-		return CloudScootOfflineWorkerArgs_Req_DEFAULT
-	}
-	return p.Req
-}
-func (p *CloudScootOfflineWorkerArgs) IsSetReq() bool {
-	return p.Req != nil
+func NewCloudScootGetSchedulerStatusArgs() *CloudScootGetSchedulerStatusArgs {
+	return &CloudScootGetSchedulerStatusArgs{}
 }
 
-func (p *CloudScootOfflineWorkerArgs) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootGetSchedulerStatusArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3039,15 +5427,8 @@ func (p *CloudScootOfflineWorkerArgs) Read(iprot thrift.TProtocol) error {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		switch fieldId {
-		case 1:
-			if err := p.readField1(iprot); err != nil {
-				return err
-			}
-		default:
-			if err := iprot.Skip(fieldTypeId); err != nil {
-				return err
-			}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
 		}
 		if err := iprot.ReadFieldEnd(); err != nil {
 			return err
@@ -3059,21 +5440,10 @@ func (p *CloudScootOfflineWorkerArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootOfflineWorkerArgs) readField1(iprot thrift.TProtocol) error {
-	p.Req = &OfflineWorkerReq{}
-	if err := p.Req.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
-	}
-	return nil
-}
-
-func (p *CloudScootOfflineWorkerArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("OfflineWorker_args"); err != nil {
+func (p *CloudScootGetSchedulerStatusArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetSchedulerStatus_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	if err := p.writeField1(oprot); err != nil {
-		return err
-	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -3083,64 +5453,51 @@ func (p *CloudScootOfflineWorkerArgs) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootOfflineWorkerArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
-	}
-	if err := p.Req.Write(oprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Req), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:req: ", p), err)
-	}
-	return err
-}
-
-func (p *CloudScootOfflineWorkerArgs) String() string {
+func (p *CloudScootGetSchedulerStatusArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootOfflineWorkerArgs(%+v)", *p)
+	return fmt.Sprintf("CloudScootGetSchedulerStatusArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Ir
+//  - Success
 //  - Err
-type CloudScootOfflineWorkerResult struct {
-	Ir  *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
-	Err *ScootServerError `thrift:"err,2" json:"err,omitempty"`
+type CloudScootGetSchedulerStatusResult struct {
+	Success *SchedulerStatus  `thrift:"success,0" json:"success,omitempty"`
+	Err     *ScootServerError `thrift:"err,1" json:"err,omitempty"`
 }
 
-func NewCloudScootOfflineWorkerResult() *CloudScootOfflineWorkerResult {
-	return &CloudScootOfflineWorkerResult{}
+func NewCloudScootGetSchedulerStatusResult() *CloudScootGetSchedulerStatusResult {
+	return &CloudScootGetSchedulerStatusResult{}
 }
 
-var CloudScootOfflineWorkerResult_Ir_DEFAULT *InvalidRequest
+var CloudScootGetSchedulerStatusResult_Success_DEFAULT *SchedulerStatus
 
-func (p *CloudScootOfflineWorkerResult) GetIr() *InvalidRequest {
-	if !p.IsSetIr() {
-		return CloudScootOfflineWorkerResult_Ir_DEFAULT
+func (p *CloudScootGetSchedulerStatusResult) GetSuccess() *SchedulerStatus {
+	if !p.IsSetSuccess() {
+		return CloudScootGetSchedulerStatusResult_Success_DEFAULT
 	}
-	return p.Ir
+	return p.Success
 }
 
-var CloudScootOfflineWorkerResult_Err_DEFAULT *ScootServerError
+var CloudScootGetSchedulerStatusResult_Err_DEFAULT *ScootServerError
 
-func (p *CloudScootOfflineWorkerResult) GetErr() *ScootServerError {
+func (p *CloudScootGetSchedulerStatusResult) GetErr() *ScootServerError {
 	if !p.IsSetErr() {
-		return CloudScootOfflineWorkerResult_Err_DEFAULT
+		return CloudScootGetSchedulerStatusResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *CloudScootOfflineWorkerResult) IsSetIr() bool {
-	return p.Ir != nil
+func (p *CloudScootGetSchedulerStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
 }
 
-func (p *CloudScootOfflineWorkerResult) IsSetErr() bool {
+func (p *CloudScootGetSchedulerStatusResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *CloudScootOfflineWorkerResult) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootGetSchedulerStatusResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3154,12 +5511,12 @@ func (p *CloudScootOfflineWorkerResult) Read(iprot thrift.TProtocol) error {
 			break
 		}
 		switch fieldId {
-		case 1:
-			if err := p.readField1(iprot); err != nil {
+		case 0:
+			if err := p.readField0(iprot); err != nil {
 				return err
 			}
-		case 2:
-			if err := p.readField2(iprot); err != nil {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
 				return err
 			}
 		default:
@@ -3177,15 +5534,15 @@ func (p *CloudScootOfflineWorkerResult) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootOfflineWorkerResult) readField1(iprot thrift.TProtocol) error {
-	p.Ir = &InvalidRequest{}
-	if err := p.Ir.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
+func (p *CloudScootGetSchedulerStatusResult) readField0(iprot thrift.TProtocol) error {
+	p.Success = &SchedulerStatus{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
 	}
 	return nil
 }
 
-func (p *CloudScootOfflineWorkerResult) readField2(iprot thrift.TProtocol) error {
+func (p *CloudScootGetSchedulerStatusResult) readField1(iprot thrift.TProtocol) error {
 	p.Err = &ScootServerError{}
 	if err := p.Err.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
@@ -3193,14 +5550,14 @@ func (p *CloudScootOfflineWorkerResult) readField2(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *CloudScootOfflineWorkerResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("OfflineWorker_result"); err != nil {
+func (p *CloudScootGetSchedulerStatusResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetSchedulerStatus_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	if err := p.writeField1(oprot); err != nil {
+	if err := p.writeField0(oprot); err != nil {
 		return err
 	}
-	if err := p.writeField2(oprot); err != nil {
+	if err := p.writeField1(oprot); err != nil {
 		return err
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
@@ -3212,66 +5569,63 @@ func (p *CloudScootOfflineWorkerResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootOfflineWorkerResult) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIr() {
-		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
+func (p *CloudScootGetSchedulerStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
 		}
-		if err := p.Ir.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ir), err)
+		if err := p.Success.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ir: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *CloudScootOfflineWorkerResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootGetSchedulerStatusResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
-		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
+		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
 		}
 		if err := p.Err.Write(oprot); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:err: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *CloudScootOfflineWorkerResult) String() string {
+func (p *CloudScootGetSchedulerStatusResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootOfflineWorkerResult(%+v)", *p)
+	return fmt.Sprintf("CloudScootGetSchedulerStatusResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Req
-type CloudScootReinstateWorkerArgs struct {
-	Req *ReinstateWorkerReq `thrift:"req,1" json:"req"`
+//  - MaxTasks
+//  - Requestor
+type CloudScootSetSchedulerStatusArgs struct {
+	MaxTasks  int32  `thrift:"maxTasks,1" json:"maxTasks"`
+	Requestor string `thrift:"requestor,2" json:"requestor"`
 }
 
-func NewCloudScootReinstateWorkerArgs() *CloudScootReinstateWorkerArgs {
-	return &CloudScootReinstateWorkerArgs{}
+func NewCloudScootSetSchedulerStatusArgs() *CloudScootSetSchedulerStatusArgs {
+	return &CloudScootSetSchedulerStatusArgs{}
 }
 
-var CloudScootReinstateWorkerArgs_Req_DEFAULT *ReinstateWorkerReq
-
-func (p *CloudScootReinstateWorkerArgs) GetReq() *ReinstateWorkerReq {
-	if !p.IsSetReq() {
-		return CloudScootReinstateWorkerArgs_Req_DEFAULT
-	}
-	return p.Req
-}
-func (p *CloudScootReinstateWorkerArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *CloudScootSetSchedulerStatusArgs) GetMaxTasks() int32 {
+	return p.MaxTasks
 }
 
-func (p *CloudScootReinstateWorkerArgs) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootSetSchedulerStatusArgs) GetRequestor() string {
+	return p.Requestor
+}
+func (p *CloudScootSetSchedulerStatusArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3289,6 +5643,10 @@ func (p *CloudScootReinstateWorkerArgs) Read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -3304,88 +5662,114 @@ func (p *CloudScootReinstateWorkerArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootReinstateWorkerArgs) readField1(iprot thrift.TProtocol) error {
-	p.Req = &ReinstateWorkerReq{}
-	if err := p.Req.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
+func (p *CloudScootSetSchedulerStatusArgs) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.MaxTasks = v
 	}
 	return nil
 }
 
-func (p *CloudScootReinstateWorkerArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("ReinstateWorker_args"); err != nil {
+func (p *CloudScootSetSchedulerStatusArgs) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Requestor = v
+	}
+	return nil
+}
+
+func (p *CloudScootSetSchedulerStatusArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SetSchedulerStatus_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
-	if err := oprot.WriteStructEnd(); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CloudScootSetSchedulerStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("maxTasks", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:maxTasks: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.MaxTasks)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.maxTasks (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:maxTasks: ", p), err)
 	}
-	return nil
+	return err
 }
 
-func (p *CloudScootReinstateWorkerArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
+func (p *CloudScootSetSchedulerStatusArgs) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("requestor", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:requestor: ", p), err)
 	}
-	if err := p.Req.Write(oprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Req), err)
+	if err := oprot.WriteString(string(p.Requestor)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.requestor (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:req: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:requestor: ", p), err)
 	}
 	return err
 }
 
-func (p *CloudScootReinstateWorkerArgs) String() string {
+func (p *CloudScootSetSchedulerStatusArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootReinstateWorkerArgs(%+v)", *p)
+	return fmt.Sprintf("CloudScootSetSchedulerStatusArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ir
 //  - Err
-type CloudScootReinstateWorkerResult struct {
+type CloudScootSetSchedulerStatusResult struct {
 	Ir  *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
 	Err *ScootServerError `thrift:"err,2" json:"err,omitempty"`
 }
 
-func NewCloudScootReinstateWorkerResult() *CloudScootReinstateWorkerResult {
-	return &CloudScootReinstateWorkerResult{}
+func NewCloudScootSetSchedulerStatusResult() *CloudScootSetSchedulerStatusResult {
+	return &CloudScootSetSchedulerStatusResult{}
 }
 
-var CloudScootReinstateWorkerResult_Ir_DEFAULT *InvalidRequest
+var CloudScootSetSchedulerStatusResult_Ir_DEFAULT *InvalidRequest
 
-func (p *CloudScootReinstateWorkerResult) GetIr() *InvalidRequest {
+func (p *CloudScootSetSchedulerStatusResult) GetIr() *InvalidRequest {
 	if !p.IsSetIr() {
-		return CloudScootReinstateWorkerResult_Ir_DEFAULT
+		return CloudScootSetSchedulerStatusResult_Ir_DEFAULT
 	}
 	return p.Ir
 }
 
-var CloudScootReinstateWorkerResult_Err_DEFAULT *ScootServerError
+var CloudScootSetSchedulerStatusResult_Err_DEFAULT *ScootServerError
 
-func (p *CloudScootReinstateWorkerResult) GetErr() *ScootServerError {
+func (p *CloudScootSetSchedulerStatusResult) GetErr() *ScootServerError {
 	if !p.IsSetErr() {
-		return CloudScootReinstateWorkerResult_Err_DEFAULT
+		return CloudScootSetSchedulerStatusResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *CloudScootReinstateWorkerResult) IsSetIr() bool {
+func (p *CloudScootSetSchedulerStatusResult) IsSetIr() bool {
 	return p.Ir != nil
 }
 
-func (p *CloudScootReinstateWorkerResult) IsSetErr() bool {
+func (p *CloudScootSetSchedulerStatusResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *CloudScootReinstateWorkerResult) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootSetSchedulerStatusResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3422,7 +5806,7 @@ func (p *CloudScootReinstateWorkerResult) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootReinstateWorkerResult) readField1(iprot thrift.TProtocol) error {
+func (p *CloudScootSetSchedulerStatusResult) readField1(iprot thrift.TProtocol) error {
 	p.Ir = &InvalidRequest{}
 	if err := p.Ir.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
@@ -3430,7 +5814,7 @@ func (p *CloudScootReinstateWorkerResult) readField1(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *CloudScootReinstateWorkerResult) readField2(iprot thrift.TProtocol) error {
+func (p *CloudScootSetSchedulerStatusResult) readField2(iprot thrift.TProtocol) error {
 	p.Err = &ScootServerError{}
 	if err := p.Err.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
@@ -3438,8 +5822,8 @@ func (p *CloudScootReinstateWorkerResult) readField2(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *CloudScootReinstateWorkerResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("ReinstateWorker_result"); err != nil {
+func (p *CloudScootSetSchedulerStatusResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SetSchedulerStatus_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -3457,7 +5841,7 @@ func (p *CloudScootReinstateWorkerResult) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootReinstateWorkerResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootSetSchedulerStatusResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetIr() {
 		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
@@ -3472,7 +5856,7 @@ func (p *CloudScootReinstateWorkerResult) writeField1(oprot thrift.TProtocol) (e
 	return err
 }
 
-func (p *CloudScootReinstateWorkerResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootSetSchedulerStatusResult) writeField2(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
@@ -3487,21 +5871,21 @@ func (p *CloudScootReinstateWorkerResult) writeField2(oprot thrift.TProtocol) (e
 	return err
 }
 
-func (p *CloudScootReinstateWorkerResult) String() string {
+func (p *CloudScootSetSchedulerStatusResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootReinstateWorkerResult(%+v)", *p)
+	return fmt.Sprintf("CloudScootSetSchedulerStatusResult(%+v)", *p)
 }
 
-type CloudScootGetSchedulerStatusArgs struct {
+type CloudScootGetClassLoadPercentsArgs struct {
 }
 
-func NewCloudScootGetSchedulerStatusArgs() *CloudScootGetSchedulerStatusArgs {
-	return &CloudScootGetSchedulerStatusArgs{}
+func NewCloudScootGetClassLoadPercentsArgs() *CloudScootGetClassLoadPercentsArgs {
+	return &CloudScootGetClassLoadPercentsArgs{}
 }
 
-func (p *CloudScootGetSchedulerStatusArgs) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootGetClassLoadPercentsArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3527,8 +5911,8 @@ func (p *CloudScootGetSchedulerStatusArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootGetSchedulerStatusArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("GetSchedulerStatus_args"); err != nil {
+func (p *CloudScootGetClassLoadPercentsArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetClassLoadPercents_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
@@ -3540,51 +5924,48 @@ func (p *CloudScootGetSchedulerStatusArgs) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootGetSchedulerStatusArgs) String() string {
+func (p *CloudScootGetClassLoadPercentsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootGetSchedulerStatusArgs(%+v)", *p)
+	return fmt.Sprintf("CloudScootGetClassLoadPercentsArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
-//  - Err
-type CloudScootGetSchedulerStatusResult struct {
-	Success *SchedulerStatus  `thrift:"success,0" json:"success,omitempty"`
-	Err     *ScootServerError `thrift:"err,1" json:"err,omitempty"`
+//  - Ir
+type CloudScootGetClassLoadPercentsResult struct {
+	Success map[string]int32 `thrift:"success,0" json:"success,omitempty"`
+	Ir      *InvalidRequest  `thrift:"ir,1" json:"ir,omitempty"`
 }
 
-func NewCloudScootGetSchedulerStatusResult() *CloudScootGetSchedulerStatusResult {
-	return &CloudScootGetSchedulerStatusResult{}
+func NewCloudScootGetClassLoadPercentsResult() *CloudScootGetClassLoadPercentsResult {
+	return &CloudScootGetClassLoadPercentsResult{}
 }
 
-var CloudScootGetSchedulerStatusResult_Success_DEFAULT *SchedulerStatus
+var CloudScootGetClassLoadPercentsResult_Success_DEFAULT map[string]int32
 
-func (p *CloudScootGetSchedulerStatusResult) GetSuccess() *SchedulerStatus {
-	if !p.IsSetSuccess() {
-		return CloudScootGetSchedulerStatusResult_Success_DEFAULT
-	}
+func (p *CloudScootGetClassLoadPercentsResult) GetSuccess() map[string]int32 {
 	return p.Success
 }
 
-var CloudScootGetSchedulerStatusResult_Err_DEFAULT *ScootServerError
+var CloudScootGetClassLoadPercentsResult_Ir_DEFAULT *InvalidRequest
 
-func (p *CloudScootGetSchedulerStatusResult) GetErr() *ScootServerError {
-	if !p.IsSetErr() {
-		return CloudScootGetSchedulerStatusResult_Err_DEFAULT
+func (p *CloudScootGetClassLoadPercentsResult) GetIr() *InvalidRequest {
+	if !p.IsSetIr() {
+		return CloudScootGetClassLoadPercentsResult_Ir_DEFAULT
 	}
-	return p.Err
+	return p.Ir
 }
-func (p *CloudScootGetSchedulerStatusResult) IsSetSuccess() bool {
+func (p *CloudScootGetClassLoadPercentsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CloudScootGetSchedulerStatusResult) IsSetErr() bool {
-	return p.Err != nil
+func (p *CloudScootGetClassLoadPercentsResult) IsSetIr() bool {
+	return p.Ir != nil
 }
 
-func (p *CloudScootGetSchedulerStatusResult) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootGetClassLoadPercentsResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3621,24 +6002,44 @@ func (p *CloudScootGetSchedulerStatusResult) Read(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *CloudScootGetSchedulerStatusResult) readField0(iprot thrift.TProtocol) error {
-	p.Success = &SchedulerStatus{}
-	if err := p.Success.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+func (p *CloudScootGetClassLoadPercentsResult) readField0(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.PrependError("error reading map begin: ", err)
+	}
+	tMap := make(map[string]int32, size)
+	p.Success = tMap
+	for i := 0; i < size; i++ {
+		var _key71 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_key71 = v
+		}
+		var _val72 int32
+		if v, err := iprot.ReadI32(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_val72 = v
+		}
+		p.Success[_key71] = _val72
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return thrift.PrependError("error reading map end: ", err)
 	}
 	return nil
 }
 
-func (p *CloudScootGetSchedulerStatusResult) readField1(iprot thrift.TProtocol) error {
-	p.Err = &ScootServerError{}
-	if err := p.Err.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+func (p *CloudScootGetClassLoadPercentsResult) readField1(iprot thrift.TProtocol) error {
+	p.Ir = &InvalidRequest{}
+	if err := p.Ir.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
 	}
 	return nil
 }
 
-func (p *CloudScootGetSchedulerStatusResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("GetSchedulerStatus_result"); err != nil {
+func (p *CloudScootGetClassLoadPercentsResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetClassLoadPercents_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField0(oprot); err != nil {
@@ -3656,13 +6057,24 @@ func (p *CloudScootGetSchedulerStatusResult) Write(oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *CloudScootGetSchedulerStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootGetClassLoadPercentsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+		if err := oprot.WriteFieldBegin("success", thrift.MAP, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
 		}
-		if err := p.Success.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.I32, len(p.Success)); err != nil {
+			return thrift.PrependError("error writing map begin: ", err)
+		}
+		for k, v := range p.Success {
+			if err := oprot.WriteString(string(k)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+			if err := oprot.WriteI32(int32(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return thrift.PrependError("error writing map end: ", err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
@@ -3671,42 +6083,48 @@ func (p *CloudScootGetSchedulerStatusResult) writeField0(oprot thrift.TProtocol)
 	return err
 }
 
-func (p *CloudScootGetSchedulerStatusResult) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetErr() {
-		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err)
+func (p *CloudScootGetClassLoadPercentsResult) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIr() {
+		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
 		}
-		if err := p.Err.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		if err := p.Ir.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ir), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ir: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *CloudScootGetSchedulerStatusResult) String() string {
+func (p *CloudScootGetClassLoadPercentsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootGetSchedulerStatusResult(%+v)", *p)
+	return fmt.Sprintf("CloudScootGetClassLoadPercentsResult(%+v)", *p)
 }
 
 // Attributes:
-//  - MaxTasks
-type CloudScootSetSchedulerStatusArgs struct {
-	MaxTasks int32 `thrift:"maxTasks,1" json:"maxTasks"`
+//  - LoadPercents
+//  - Requestor
+type CloudScootSetClassLoadPercentsArgs struct {
+	LoadPercents map[string]int32 `thrift:"loadPercents,1" json:"loadPercents"`
+	Requestor    string           `thrift:"requestor,2" json:"requestor"`
 }
 
-func NewCloudScootSetSchedulerStatusArgs() *CloudScootSetSchedulerStatusArgs {
-	return &CloudScootSetSchedulerStatusArgs{}
+func NewCloudScootSetClassLoadPercentsArgs() *CloudScootSetClassLoadPercentsArgs {
+	return &CloudScootSetClassLoadPercentsArgs{}
 }
 
-func (p *CloudScootSetSchedulerStatusArgs) GetMaxTasks() int32 {
-	return p.MaxTasks
+func (p *CloudScootSetClassLoadPercentsArgs) GetLoadPercents() map[string]int32 {
+	return p.LoadPercents
 }
-func (p *CloudScootSetSchedulerStatusArgs) Read(iprot thrift.TProtocol) error {
+
+func (p *CloudScootSetClassLoadPercentsArgs) GetRequestor() string {
+	return p.Requestor
+}
+func (p *CloudScootSetClassLoadPercentsArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3724,6 +6142,10 @@ func (p *CloudScootSetSchedulerStatusArgs) Read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -3739,89 +6161,144 @@ func (p *CloudScootSetSchedulerStatusArgs) Read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *CloudScootSetSchedulerStatusArgs) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
+func (p *CloudScootSetClassLoadPercentsArgs) readField1(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.PrependError("error reading map begin: ", err)
+	}
+	tMap := make(map[string]int32, size)
+	p.LoadPercents = tMap
+	for i := 0; i < size; i++ {
+		var _key73 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_key73 = v
+		}
+		var _val74 int32
+		if v, err := iprot.ReadI32(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_val74 = v
+		}
+		p.LoadPercents[_key73] = _val74
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return thrift.PrependError("error reading map end: ", err)
+	}
+	return nil
+}
+
+func (p *CloudScootSetClassLoadPercentsArgs) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.MaxTasks = v
+		p.Requestor = v
 	}
 	return nil
 }
 
-func (p *CloudScootSetSchedulerStatusArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("SetSchedulerStatus_args"); err != nil {
+func (p *CloudScootSetClassLoadPercentsArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SetClassLoadPercents_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	if err := p.writeField1(oprot); err != nil {
-		return err
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *CloudScootSetClassLoadPercentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("loadPercents", thrift.MAP, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:loadPercents: ", p), err)
+	}
+	if err := oprot.WriteMapBegin(thrift.STRING, thrift.I32, len(p.LoadPercents)); err != nil {
+		return thrift.PrependError("error writing map begin: ", err)
+	}
+	for k, v := range p.LoadPercents {
+		if err := oprot.WriteString(string(k)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
 	}
-	if err := oprot.WriteFieldStop(); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
+	if err := oprot.WriteMapEnd(); err != nil {
+		return thrift.PrependError("error writing map end: ", err)
 	}
-	if err := oprot.WriteStructEnd(); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:loadPercents: ", p), err)
 	}
-	return nil
+	return err
 }
 
-func (p *CloudScootSetSchedulerStatusArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("maxTasks", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:maxTasks: ", p), err)
+func (p *CloudScootSetClassLoadPercentsArgs) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("requestor", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:requestor: ", p), err)
 	}
-	if err := oprot.WriteI32(int32(p.MaxTasks)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.maxTasks (1) field write error: ", p), err)
+	if err := oprot.WriteString(string(p.Requestor)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.requestor (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:maxTasks: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:requestor: ", p), err)
 	}
 	return err
 }
 
-func (p *CloudScootSetSchedulerStatusArgs) String() string {
+func (p *CloudScootSetClassLoadPercentsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootSetSchedulerStatusArgs(%+v)", *p)
+	return fmt.Sprintf("CloudScootSetClassLoadPercentsArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ir
 //  - Err
-type CloudScootSetSchedulerStatusResult struct {
+type CloudScootSetClassLoadPercentsResult struct {
 	Ir  *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
 	Err *ScootServerError `thrift:"err,2" json:"err,omitempty"`
 }
 
-func NewCloudScootSetSchedulerStatusResult() *CloudScootSetSchedulerStatusResult {
-	return &CloudScootSetSchedulerStatusResult{}
+func NewCloudScootSetClassLoadPercentsResult() *CloudScootSetClassLoadPercentsResult {
+	return &CloudScootSetClassLoadPercentsResult{}
 }
 
-var CloudScootSetSchedulerStatusResult_Ir_DEFAULT *InvalidRequest
+var CloudScootSetClassLoadPercentsResult_Ir_DEFAULT *InvalidRequest
 
-func (p *CloudScootSetSchedulerStatusResult) GetIr() *InvalidRequest {
+func (p *CloudScootSetClassLoadPercentsResult) GetIr() *InvalidRequest {
 	if !p.IsSetIr() {
-		return CloudScootSetSchedulerStatusResult_Ir_DEFAULT
+		return CloudScootSetClassLoadPercentsResult_Ir_DEFAULT
 	}
 	return p.Ir
 }
 
-var CloudScootSetSchedulerStatusResult_Err_DEFAULT *ScootServerError
+var CloudScootSetClassLoadPercentsResult_Err_DEFAULT *ScootServerError
 
-func (p *CloudScootSetSchedulerStatusResult) GetErr() *ScootServerError {
+func (p *CloudScootSetClassLoadPercentsResult) GetErr() *ScootServerError {
 	if !p.IsSetErr() {
-		return CloudScootSetSchedulerStatusResult_Err_DEFAULT
+		return CloudScootSetClassLoadPercentsResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *CloudScootSetSchedulerStatusResult) IsSetIr() bool {
+func (p *CloudScootSetClassLoadPercentsResult) IsSetIr() bool {
 	return p.Ir != nil
 }
 
-func (p *CloudScootSetSchedulerStatusResult) IsSetErr() bool {
+func (p *CloudScootSetClassLoadPercentsResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *CloudScootSetSchedulerStatusResult) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootSetClassLoadPercentsResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3858,7 +6335,7 @@ func (p *CloudScootSetSchedulerStatusResult) Read(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *CloudScootSetSchedulerStatusResult) readField1(iprot thrift.TProtocol) error {
+func (p *CloudScootSetClassLoadPercentsResult) readField1(iprot thrift.TProtocol) error {
 	p.Ir = &InvalidRequest{}
 	if err := p.Ir.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
@@ -3866,7 +6343,7 @@ func (p *CloudScootSetSchedulerStatusResult) readField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *CloudScootSetSchedulerStatusResult) readField2(iprot thrift.TProtocol) error {
+func (p *CloudScootSetClassLoadPercentsResult) readField2(iprot thrift.TProtocol) error {
 	p.Err = &ScootServerError{}
 	if err := p.Err.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
@@ -3874,8 +6351,8 @@ func (p *CloudScootSetSchedulerStatusResult) readField2(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *CloudScootSetSchedulerStatusResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("SetSchedulerStatus_result"); err != nil {
+func (p *CloudScootSetClassLoadPercentsResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SetClassLoadPercents_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -3893,7 +6370,7 @@ func (p *CloudScootSetSchedulerStatusResult) Write(oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *CloudScootSetSchedulerStatusResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootSetClassLoadPercentsResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetIr() {
 		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
@@ -3908,7 +6385,7 @@ func (p *CloudScootSetSchedulerStatusResult) writeField1(oprot thrift.TProtocol)
 	return err
 }
 
-func (p *CloudScootSetSchedulerStatusResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootSetClassLoadPercentsResult) writeField2(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
@@ -3923,21 +6400,21 @@ func (p *CloudScootSetSchedulerStatusResult) writeField2(oprot thrift.TProtocol)
 	return err
 }
 
-func (p *CloudScootSetSchedulerStatusResult) String() string {
+func (p *CloudScootSetClassLoadPercentsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootSetSchedulerStatusResult(%+v)", *p)
+	return fmt.Sprintf("CloudScootSetClassLoadPercentsResult(%+v)", *p)
 }
 
-type CloudScootGetClassLoadPercentsArgs struct {
+type CloudScootGetRequestorToClassMapArgs struct {
 }
 
-func NewCloudScootGetClassLoadPercentsArgs() *CloudScootGetClassLoadPercentsArgs {
-	return &CloudScootGetClassLoadPercentsArgs{}
+func NewCloudScootGetRequestorToClassMapArgs() *CloudScootGetRequestorToClassMapArgs {
+	return &CloudScootGetRequestorToClassMapArgs{}
 }
 
-func (p *CloudScootGetClassLoadPercentsArgs) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootGetRequestorToClassMapArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -3963,8 +6440,8 @@ func (p *CloudScootGetClassLoadPercentsArgs) Read(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *CloudScootGetClassLoadPercentsArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("GetClassLoadPercents_args"); err != nil {
+func (p *CloudScootGetRequestorToClassMapArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetRequestorToClassMap_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
@@ -3976,48 +6453,48 @@ func (p *CloudScootGetClassLoadPercentsArgs) Write(oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *CloudScootGetClassLoadPercentsArgs) String() string {
+func (p *CloudScootGetRequestorToClassMapArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootGetClassLoadPercentsArgs(%+v)", *p)
+	return fmt.Sprintf("CloudScootGetRequestorToClassMapArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - Ir
-type CloudScootGetClassLoadPercentsResult struct {
-	Success map[string]int32 `thrift:"success,0" json:"success,omitempty"`
-	Ir      *InvalidRequest  `thrift:"ir,1" json:"ir,omitempty"`
+type CloudScootGetRequestorToClassMapResult struct {
+	Success map[string]string `thrift:"success,0" json:"success,omitempty"`
+	Ir      *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
 }
 
-func NewCloudScootGetClassLoadPercentsResult() *CloudScootGetClassLoadPercentsResult {
-	return &CloudScootGetClassLoadPercentsResult{}
+func NewCloudScootGetRequestorToClassMapResult() *CloudScootGetRequestorToClassMapResult {
+	return &CloudScootGetRequestorToClassMapResult{}
 }
 
-var CloudScootGetClassLoadPercentsResult_Success_DEFAULT map[string]int32
+var CloudScootGetRequestorToClassMapResult_Success_DEFAULT map[string]string
 
-func (p *CloudScootGetClassLoadPercentsResult) GetSuccess() map[string]int32 {
+func (p *CloudScootGetRequestorToClassMapResult) GetSuccess() map[string]string {
 	return p.Success
 }
 
-var CloudScootGetClassLoadPercentsResult_Ir_DEFAULT *InvalidRequest
+var CloudScootGetRequestorToClassMapResult_Ir_DEFAULT *InvalidRequest
 
-func (p *CloudScootGetClassLoadPercentsResult) GetIr() *InvalidRequest {
+func (p *CloudScootGetRequestorToClassMapResult) GetIr() *InvalidRequest {
 	if !p.IsSetIr() {
-		return CloudScootGetClassLoadPercentsResult_Ir_DEFAULT
+		return CloudScootGetRequestorToClassMapResult_Ir_DEFAULT
 	}
 	return p.Ir
 }
-func (p *CloudScootGetClassLoadPercentsResult) IsSetSuccess() bool {
+func (p *CloudScootGetRequestorToClassMapResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CloudScootGetClassLoadPercentsResult) IsSetIr() bool {
+func (p *CloudScootGetRequestorToClassMapResult) IsSetIr() bool {
 	return p.Ir != nil
 }
 
-func (p *CloudScootGetClassLoadPercentsResult) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootGetRequestorToClassMapResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4054,27 +6531,27 @@ func (p *CloudScootGetClassLoadPercentsResult) Read(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *CloudScootGetClassLoadPercentsResult) readField0(iprot thrift.TProtocol) error {
+func (p *CloudScootGetRequestorToClassMapResult) readField0(iprot thrift.TProtocol) error {
 	_, _, size, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.PrependError("error reading map begin: ", err)
 	}
-	tMap := make(map[string]int32, size)
+	tMap := make(map[string]string, size)
 	p.Success = tMap
 	for i := 0; i < size; i++ {
-		var _key40 string
+		var _key75 string
 		if v, err := iprot.ReadString(); err != nil {
 			return thrift.PrependError("error reading field 0: ", err)
 		} else {
-			_key40 = v
+			_key75 = v
 		}
-		var _val41 int32
-		if v, err := iprot.ReadI32(); err != nil {
+		var _val76 string
+		if v, err := iprot.ReadString(); err != nil {
 			return thrift.PrependError("error reading field 0: ", err)
 		} else {
-			_val41 = v
+			_val76 = v
 		}
-		p.Success[_key40] = _val41
+		p.Success[_key75] = _val76
 	}
 	if err := iprot.ReadMapEnd(); err != nil {
 		return thrift.PrependError("error reading map end: ", err)
@@ -4082,7 +6559,7 @@ func (p *CloudScootGetClassLoadPercentsResult) readField0(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *CloudScootGetClassLoadPercentsResult) readField1(iprot thrift.TProtocol) error {
+func (p *CloudScootGetRequestorToClassMapResult) readField1(iprot thrift.TProtocol) error {
 	p.Ir = &InvalidRequest{}
 	if err := p.Ir.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
@@ -4090,8 +6567,8 @@ func (p *CloudScootGetClassLoadPercentsResult) readField1(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *CloudScootGetClassLoadPercentsResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("GetClassLoadPercents_result"); err != nil {
+func (p *CloudScootGetRequestorToClassMapResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetRequestorToClassMap_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField0(oprot); err != nil {
@@ -4109,19 +6586,19 @@ func (p *CloudScootGetClassLoadPercentsResult) Write(oprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *CloudScootGetClassLoadPercentsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootGetRequestorToClassMapResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err := oprot.WriteFieldBegin("success", thrift.MAP, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.I32, len(p.Success)); err != nil {
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Success)); err != nil {
 			return thrift.PrependError("error writing map begin: ", err)
 		}
 		for k, v := range p.Success {
 			if err := oprot.WriteString(string(k)); err != nil {
 				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 			}
-			if err := oprot.WriteI32(int32(v)); err != nil {
+			if err := oprot.WriteString(string(v)); err != nil {
 				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 			}
 		}
@@ -4135,7 +6612,7 @@ func (p *CloudScootGetClassLoadPercentsResult) writeField0(oprot thrift.TProtoco
 	return err
 }
 
-func (p *CloudScootGetClassLoadPercentsResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootGetRequestorToClassMapResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetIr() {
 		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
@@ -4150,27 +6627,33 @@ func (p *CloudScootGetClassLoadPercentsResult) writeField1(oprot thrift.TProtoco
 	return err
 }
 
-func (p *CloudScootGetClassLoadPercentsResult) String() string {
+func (p *CloudScootGetRequestorToClassMapResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootGetClassLoadPercentsResult(%+v)", *p)
+	return fmt.Sprintf("CloudScootGetRequestorToClassMapResult(%+v)", *p)
 }
 
 // Attributes:
-//  - LoadPercents
-type CloudScootSetClassLoadPercentsArgs struct {
-	LoadPercents map[string]int32 `thrift:"loadPercents,1" json:"loadPercents"`
+//  - RequestorToClassMap
+//  - Requestor
+type CloudScootSetRequestorToClassMapArgs struct {
+	RequestorToClassMap map[string]string `thrift:"requestorToClassMap,1" json:"requestorToClassMap"`
+	Requestor           string            `thrift:"requestor,2" json:"requestor"`
 }
 
-func NewCloudScootSetClassLoadPercentsArgs() *CloudScootSetClassLoadPercentsArgs {
-	return &CloudScootSetClassLoadPercentsArgs{}
+func NewCloudScootSetRequestorToClassMapArgs() *CloudScootSetRequestorToClassMapArgs {
+	return &CloudScootSetRequestorToClassMapArgs{}
 }
 
-func (p *CloudScootSetClassLoadPercentsArgs) GetLoadPercents() map[string]int32 {
-	return p.LoadPercents
+func (p *CloudScootSetRequestorToClassMapArgs) GetRequestorToClassMap() map[string]string {
+	return p.RequestorToClassMap
 }
-func (p *CloudScootSetClassLoadPercentsArgs) Read(iprot thrift.TProtocol) error {
+
+func (p *CloudScootSetRequestorToClassMapArgs) GetRequestor() string {
+	return p.Requestor
+}
+func (p *CloudScootSetRequestorToClassMapArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4188,6 +6671,10 @@ func (p *CloudScootSetClassLoadPercentsArgs) Read(iprot thrift.TProtocol) error
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -4203,27 +6690,27 @@ func (p *CloudScootSetClassLoadPercentsArgs) Read(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *CloudScootSetClassLoadPercentsArgs) readField1(iprot thrift.TProtocol) error {
+func (p *CloudScootSetRequestorToClassMapArgs) readField1(iprot thrift.TProtocol) error {
 	_, _, size, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.PrependError("error reading map begin: ", err)
 	}
-	tMap := make(map[string]int32, size)
-	p.LoadPercents = tMap
+	tMap := make(map[string]string, size)
+	p.RequestorToClassMap = tMap
 	for i := 0; i < size; i++ {
-		var _key42 string
+		var _key77 string
 		if v, err := iprot.ReadString(); err != nil {
 			return thrift.PrependError("error reading field 0: ", err)
 		} else {
-			_key42 = v
+			_key77 = v
 		}
-		var _val43 int32
-		if v, err := iprot.ReadI32(); err != nil {
+		var _val78 string
+		if v, err := iprot.ReadString(); err != nil {
 			return thrift.PrependError("error reading field 0: ", err)
 		} else {
-			_val43 = v
+			_val78 = v
 		}
-		p.LoadPercents[_key42] = _val43
+		p.RequestorToClassMap[_key77] = _val78
 	}
 	if err := iprot.ReadMapEnd(); err != nil {
 		return thrift.PrependError("error reading map end: ", err)
@@ -4231,13 +6718,25 @@ func (p *CloudScootSetClassLoadPercentsArgs) readField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *CloudScootSetClassLoadPercentsArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("SetClassLoadPercents_args"); err != nil {
+func (p *CloudScootSetRequestorToClassMapArgs) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Requestor = v
+	}
+	return nil
+}
+
+func (p *CloudScootSetRequestorToClassMapArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SetRequestorToClassMap_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -4247,18 +6746,18 @@ func (p *CloudScootSetClassLoadPercentsArgs) Write(oprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *CloudScootSetClassLoadPercentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("loadPercents", thrift.MAP, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:loadPercents: ", p), err)
+func (p *CloudScootSetRequestorToClassMapArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("requestorToClassMap", thrift.MAP, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:requestorToClassMap: ", p), err)
 	}
-	if err := oprot.WriteMapBegin(thrift.STRING, thrift.I32, len(p.LoadPercents)); err != nil {
+	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.RequestorToClassMap)); err != nil {
 		return thrift.PrependError("error writing map begin: ", err)
 	}
-	for k, v := range p.LoadPercents {
+	for k, v := range p.RequestorToClassMap {
 		if err := oprot.WriteString(string(k)); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 		}
-		if err := oprot.WriteI32(int32(v)); err != nil {
+		if err := oprot.WriteString(string(v)); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 		}
 	}
@@ -4266,56 +6765,69 @@ func (p *CloudScootSetClassLoadPercentsArgs) writeField1(oprot thrift.TProtocol)
 		return thrift.PrependError("error writing map end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:loadPercents: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:requestorToClassMap: ", p), err)
 	}
 	return err
 }
 
-func (p *CloudScootSetClassLoadPercentsArgs) String() string {
+func (p *CloudScootSetRequestorToClassMapArgs) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("requestor", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:requestor: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.Requestor)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.requestor (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:requestor: ", p), err)
+	}
+	return err
+}
+
+func (p *CloudScootSetRequestorToClassMapArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootSetClassLoadPercentsArgs(%+v)", *p)
+	return fmt.Sprintf("CloudScootSetRequestorToClassMapArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ir
 //  - Err
-type CloudScootSetClassLoadPercentsResult struct {
+type CloudScootSetRequestorToClassMapResult struct {
 	Ir  *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
 	Err *ScootServerError `thrift:"err,2" json:"err,omitempty"`
 }
 
-func NewCloudScootSetClassLoadPercentsResult() *CloudScootSetClassLoadPercentsResult {
-	return &CloudScootSetClassLoadPercentsResult{}
+func NewCloudScootSetRequestorToClassMapResult() *CloudScootSetRequestorToClassMapResult {
+	return &CloudScootSetRequestorToClassMapResult{}
 }
 
-var CloudScootSetClassLoadPercentsResult_Ir_DEFAULT *InvalidRequest
+var CloudScootSetRequestorToClassMapResult_Ir_DEFAULT *InvalidRequest
 
-func (p *CloudScootSetClassLoadPercentsResult) GetIr() *InvalidRequest {
+func (p *CloudScootSetRequestorToClassMapResult) GetIr() *InvalidRequest {
 	if !p.IsSetIr() {
-		return CloudScootSetClassLoadPercentsResult_Ir_DEFAULT
+		return CloudScootSetRequestorToClassMapResult_Ir_DEFAULT
 	}
 	return p.Ir
 }
 
-var CloudScootSetClassLoadPercentsResult_Err_DEFAULT *ScootServerError
+var CloudScootSetRequestorToClassMapResult_Err_DEFAULT *ScootServerError
 
-func (p *CloudScootSetClassLoadPercentsResult) GetErr() *ScootServerError {
+func (p *CloudScootSetRequestorToClassMapResult) GetErr() *ScootServerError {
 	if !p.IsSetErr() {
-		return CloudScootSetClassLoadPercentsResult_Err_DEFAULT
+		return CloudScootSetRequestorToClassMapResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *CloudScootSetClassLoadPercentsResult) IsSetIr() bool {
+func (p *CloudScootSetRequestorToClassMapResult) IsSetIr() bool {
 	return p.Ir != nil
 }
 
-func (p *CloudScootSetClassLoadPercentsResult) IsSetErr() bool {
+func (p *CloudScootSetRequestorToClassMapResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *CloudScootSetClassLoadPercentsResult) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootSetRequestorToClassMapResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4352,7 +6864,7 @@ func (p *CloudScootSetClassLoadPercentsResult) Read(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *CloudScootSetClassLoadPercentsResult) readField1(iprot thrift.TProtocol) error {
+func (p *CloudScootSetRequestorToClassMapResult) readField1(iprot thrift.TProtocol) error {
 	p.Ir = &InvalidRequest{}
 	if err := p.Ir.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
@@ -4360,7 +6872,7 @@ func (p *CloudScootSetClassLoadPercentsResult) readField1(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *CloudScootSetClassLoadPercentsResult) readField2(iprot thrift.TProtocol) error {
+func (p *CloudScootSetRequestorToClassMapResult) readField2(iprot thrift.TProtocol) error {
 	p.Err = &ScootServerError{}
 	if err := p.Err.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
@@ -4368,8 +6880,8 @@ func (p *CloudScootSetClassLoadPercentsResult) readField2(iprot thrift.TProtocol
 	return nil
 }
 
-func (p *CloudScootSetClassLoadPercentsResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("SetClassLoadPercents_result"); err != nil {
+func (p *CloudScootSetRequestorToClassMapResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SetRequestorToClassMap_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -4387,7 +6899,7 @@ func (p *CloudScootSetClassLoadPercentsResult) Write(oprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *CloudScootSetClassLoadPercentsResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootSetRequestorToClassMapResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetIr() {
 		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
@@ -4402,7 +6914,7 @@ func (p *CloudScootSetClassLoadPercentsResult) writeField1(oprot thrift.TProtoco
 	return err
 }
 
-func (p *CloudScootSetClassLoadPercentsResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootSetRequestorToClassMapResult) writeField2(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
@@ -4417,21 +6929,21 @@ func (p *CloudScootSetClassLoadPercentsResult) writeField2(oprot thrift.TProtoco
 	return err
 }
 
-func (p *CloudScootSetClassLoadPercentsResult) String() string {
+func (p *CloudScootSetRequestorToClassMapResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootSetClassLoadPercentsResult(%+v)", *p)
+	return fmt.Sprintf("CloudScootSetRequestorToClassMapResult(%+v)", *p)
 }
 
-type CloudScootGetRequestorToClassMapArgs struct {
+type CloudScootGetRebalanceMinimumDurationArgs struct {
 }
 
-func NewCloudScootGetRequestorToClassMapArgs() *CloudScootGetRequestorToClassMapArgs {
-	return &CloudScootGetRequestorToClassMapArgs{}
+func NewCloudScootGetRebalanceMinimumDurationArgs() *CloudScootGetRebalanceMinimumDurationArgs {
+	return &CloudScootGetRebalanceMinimumDurationArgs{}
 }
 
-func (p *CloudScootGetRequestorToClassMapArgs) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootGetRebalanceMinimumDurationArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4457,8 +6969,8 @@ func (p *CloudScootGetRequestorToClassMapArgs) Read(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *CloudScootGetRequestorToClassMapArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("GetRequestorToClassMap_args"); err != nil {
+func (p *CloudScootGetRebalanceMinimumDurationArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetRebalanceMinimumDuration_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
@@ -4470,48 +6982,51 @@ func (p *CloudScootGetRequestorToClassMapArgs) Write(oprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *CloudScootGetRequestorToClassMapArgs) String() string {
+func (p *CloudScootGetRebalanceMinimumDurationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootGetRequestorToClassMapArgs(%+v)", *p)
+	return fmt.Sprintf("CloudScootGetRebalanceMinimumDurationArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - Ir
-type CloudScootGetRequestorToClassMapResult struct {
-	Success map[string]string `thrift:"success,0" json:"success,omitempty"`
-	Ir      *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
+type CloudScootGetRebalanceMinimumDurationResult struct {
+	Success *int32          `thrift:"success,0" json:"success,omitempty"`
+	Ir      *InvalidRequest `thrift:"ir,1" json:"ir,omitempty"`
 }
 
-func NewCloudScootGetRequestorToClassMapResult() *CloudScootGetRequestorToClassMapResult {
-	return &CloudScootGetRequestorToClassMapResult{}
+func NewCloudScootGetRebalanceMinimumDurationResult() *CloudScootGetRebalanceMinimumDurationResult {
+	return &CloudScootGetRebalanceMinimumDurationResult{}
 }
 
-var CloudScootGetRequestorToClassMapResult_Success_DEFAULT map[string]string
+var CloudScootGetRebalanceMinimumDurationResult_Success_DEFAULT int32
 
-func (p *CloudScootGetRequestorToClassMapResult) GetSuccess() map[string]string {
-	return p.Success
+func (p *CloudScootGetRebalanceMinimumDurationResult) GetSuccess() int32 {
+	if !p.IsSetSuccess() {
+		return CloudScootGetRebalanceMinimumDurationResult_Success_DEFAULT
+	}
+	return *p.Success
 }
 
-var CloudScootGetRequestorToClassMapResult_Ir_DEFAULT *InvalidRequest
+var CloudScootGetRebalanceMinimumDurationResult_Ir_DEFAULT *InvalidRequest
 
-func (p *CloudScootGetRequestorToClassMapResult) GetIr() *InvalidRequest {
+func (p *CloudScootGetRebalanceMinimumDurationResult) GetIr() *InvalidRequest {
 	if !p.IsSetIr() {
-		return CloudScootGetRequestorToClassMapResult_Ir_DEFAULT
+		return CloudScootGetRebalanceMinimumDurationResult_Ir_DEFAULT
 	}
 	return p.Ir
 }
-func (p *CloudScootGetRequestorToClassMapResult) IsSetSuccess() bool {
+func (p *CloudScootGetRebalanceMinimumDurationResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CloudScootGetRequestorToClassMapResult) IsSetIr() bool {
+func (p *CloudScootGetRebalanceMinimumDurationResult) IsSetIr() bool {
 	return p.Ir != nil
 }
 
-func (p *CloudScootGetRequestorToClassMapResult) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootGetRebalanceMinimumDurationResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4548,35 +7063,16 @@ func (p *CloudScootGetRequestorToClassMapResult) Read(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *CloudScootGetRequestorToClassMapResult) readField0(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return thrift.PrependError("error reading map begin: ", err)
-	}
-	tMap := make(map[string]string, size)
-	p.Success = tMap
-	for i := 0; i < size; i++ {
-		var _key44 string
-		if v, err := iprot.ReadString(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_key44 = v
-		}
-		var _val45 string
-		if v, err := iprot.ReadString(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_val45 = v
-		}
-		p.Success[_key44] = _val45
-	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return thrift.PrependError("error reading map end: ", err)
+func (p *CloudScootGetRebalanceMinimumDurationResult) readField0(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 0: ", err)
+	} else {
+		p.Success = &v
 	}
 	return nil
 }
 
-func (p *CloudScootGetRequestorToClassMapResult) readField1(iprot thrift.TProtocol) error {
+func (p *CloudScootGetRebalanceMinimumDurationResult) readField1(iprot thrift.TProtocol) error {
 	p.Ir = &InvalidRequest{}
 	if err := p.Ir.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
@@ -4584,8 +7080,8 @@ func (p *CloudScootGetRequestorToClassMapResult) readField1(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *CloudScootGetRequestorToClassMapResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("GetRequestorToClassMap_result"); err != nil {
+func (p *CloudScootGetRebalanceMinimumDurationResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetRebalanceMinimumDuration_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField0(oprot); err != nil {
@@ -4603,24 +7099,13 @@ func (p *CloudScootGetRequestorToClassMapResult) Write(oprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *CloudScootGetRequestorToClassMapResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootGetRebalanceMinimumDurationResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err := oprot.WriteFieldBegin("success", thrift.MAP, 0); err != nil {
+		if err := oprot.WriteFieldBegin("success", thrift.I32, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Success)); err != nil {
-			return thrift.PrependError("error writing map begin: ", err)
-		}
-		for k, v := range p.Success {
-			if err := oprot.WriteString(string(k)); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-			}
-			if err := oprot.WriteString(string(v)); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-			}
-		}
-		if err := oprot.WriteMapEnd(); err != nil {
-			return thrift.PrependError("error writing map end: ", err)
+		if err := oprot.WriteI32(int32(*p.Success)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
@@ -4629,7 +7114,7 @@ func (p *CloudScootGetRequestorToClassMapResult) writeField0(oprot thrift.TProto
 	return err
 }
 
-func (p *CloudScootGetRequestorToClassMapResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootGetRebalanceMinimumDurationResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetIr() {
 		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
@@ -4644,27 +7129,33 @@ func (p *CloudScootGetRequestorToClassMapResult) writeField1(oprot thrift.TProto
 	return err
 }
 
-func (p *CloudScootGetRequestorToClassMapResult) String() string {
+func (p *CloudScootGetRebalanceMinimumDurationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootGetRequestorToClassMapResult(%+v)", *p)
+	return fmt.Sprintf("CloudScootGetRebalanceMinimumDurationResult(%+v)", *p)
 }
 
 // Attributes:
-//  - RequestorToClassMap
-type CloudScootSetRequestorToClassMapArgs struct {
-	RequestorToClassMap map[string]string `thrift:"requestorToClassMap,1" json:"requestorToClassMap"`
+//  - DurationMin
+//  - Requestor
+type CloudScootSetRebalanceMinimumDurationArgs struct {
+	DurationMin int32  `thrift:"durationMin,1" json:"durationMin"`
+	Requestor   string `thrift:"requestor,2" json:"requestor"`
 }
 
-func NewCloudScootSetRequestorToClassMapArgs() *CloudScootSetRequestorToClassMapArgs {
-	return &CloudScootSetRequestorToClassMapArgs{}
+func NewCloudScootSetRebalanceMinimumDurationArgs() *CloudScootSetRebalanceMinimumDurationArgs {
+	return &CloudScootSetRebalanceMinimumDurationArgs{}
 }
 
-func (p *CloudScootSetRequestorToClassMapArgs) GetRequestorToClassMap() map[string]string {
-	return p.RequestorToClassMap
+func (p *CloudScootSetRebalanceMinimumDurationArgs) GetDurationMin() int32 {
+	return p.DurationMin
 }
-func (p *CloudScootSetRequestorToClassMapArgs) Read(iprot thrift.TProtocol) error {
+
+func (p *CloudScootSetRebalanceMinimumDurationArgs) GetRequestor() string {
+	return p.Requestor
+}
+func (p *CloudScootSetRebalanceMinimumDurationArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4682,6 +7173,10 @@ func (p *CloudScootSetRequestorToClassMapArgs) Read(iprot thrift.TProtocol) erro
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -4697,41 +7192,34 @@ func (p *CloudScootSetRequestorToClassMapArgs) Read(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *CloudScootSetRequestorToClassMapArgs) readField1(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return thrift.PrependError("error reading map begin: ", err)
-	}
-	tMap := make(map[string]string, size)
-	p.RequestorToClassMap = tMap
-	for i := 0; i < size; i++ {
-		var _key46 string
-		if v, err := iprot.ReadString(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_key46 = v
-		}
-		var _val47 string
-		if v, err := iprot.ReadString(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_val47 = v
-		}
-		p.RequestorToClassMap[_key46] = _val47
+func (p *CloudScootSetRebalanceMinimumDurationArgs) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.DurationMin = v
 	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return thrift.PrependError("error reading map end: ", err)
+	return nil
+}
+
+func (p *CloudScootSetRebalanceMinimumDurationArgs) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Requestor = v
 	}
 	return nil
 }
 
-func (p *CloudScootSetRequestorToClassMapArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("SetRequestorToClassMap_args"); err != nil {
+func (p *CloudScootSetRebalanceMinimumDurationArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SetRebalanceMinimumDuration_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -4741,75 +7229,77 @@ func (p *CloudScootSetRequestorToClassMapArgs) Write(oprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *CloudScootSetRequestorToClassMapArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("requestorToClassMap", thrift.MAP, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:requestorToClassMap: ", p), err)
+func (p *CloudScootSetRebalanceMinimumDurationArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("durationMin", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:durationMin: ", p), err)
 	}
-	if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.RequestorToClassMap)); err != nil {
-		return thrift.PrependError("error writing map begin: ", err)
+	if err := oprot.WriteI32(int32(p.DurationMin)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.durationMin (1) field write error: ", p), err)
 	}
-	for k, v := range p.RequestorToClassMap {
-		if err := oprot.WriteString(string(k)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-		}
-		if err := oprot.WriteString(string(v)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-		}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:durationMin: ", p), err)
 	}
-	if err := oprot.WriteMapEnd(); err != nil {
-		return thrift.PrependError("error writing map end: ", err)
+	return err
+}
+
+func (p *CloudScootSetRebalanceMinimumDurationArgs) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("requestor", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:requestor: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.Requestor)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.requestor (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:requestorToClassMap: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:requestor: ", p), err)
 	}
 	return err
 }
 
-func (p *CloudScootSetRequestorToClassMapArgs) String() string {
+func (p *CloudScootSetRebalanceMinimumDurationArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootSetRequestorToClassMapArgs(%+v)", *p)
+	return fmt.Sprintf("CloudScootSetRebalanceMinimumDurationArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ir
 //  - Err
-type CloudScootSetRequestorToClassMapResult struct {
+type CloudScootSetRebalanceMinimumDurationResult struct {
 	Ir  *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
 	Err *ScootServerError `thrift:"err,2" json:"err,omitempty"`
 }
 
-func NewCloudScootSetRequestorToClassMapResult() *CloudScootSetRequestorToClassMapResult {
-	return &CloudScootSetRequestorToClassMapResult{}
+func NewCloudScootSetRebalanceMinimumDurationResult() *CloudScootSetRebalanceMinimumDurationResult {
+	return &CloudScootSetRebalanceMinimumDurationResult{}
 }
 
-var CloudScootSetRequestorToClassMapResult_Ir_DEFAULT *InvalidRequest
+var CloudScootSetRebalanceMinimumDurationResult_Ir_DEFAULT *InvalidRequest
 
-func (p *CloudScootSetRequestorToClassMapResult) GetIr() *InvalidRequest {
+func (p *CloudScootSetRebalanceMinimumDurationResult) GetIr() *InvalidRequest {
 	if !p.IsSetIr() {
-		return CloudScootSetRequestorToClassMapResult_Ir_DEFAULT
+		return CloudScootSetRebalanceMinimumDurationResult_Ir_DEFAULT
 	}
 	return p.Ir
 }
 
-var CloudScootSetRequestorToClassMapResult_Err_DEFAULT *ScootServerError
+var CloudScootSetRebalanceMinimumDurationResult_Err_DEFAULT *ScootServerError
 
-func (p *CloudScootSetRequestorToClassMapResult) GetErr() *ScootServerError {
+func (p *CloudScootSetRebalanceMinimumDurationResult) GetErr() *ScootServerError {
 	if !p.IsSetErr() {
-		return CloudScootSetRequestorToClassMapResult_Err_DEFAULT
+		return CloudScootSetRebalanceMinimumDurationResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *CloudScootSetRequestorToClassMapResult) IsSetIr() bool {
+func (p *CloudScootSetRebalanceMinimumDurationResult) IsSetIr() bool {
 	return p.Ir != nil
 }
 
-func (p *CloudScootSetRequestorToClassMapResult) IsSetErr() bool {
+func (p *CloudScootSetRebalanceMinimumDurationResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *CloudScootSetRequestorToClassMapResult) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootSetRebalanceMinimumDurationResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4846,7 +7336,7 @@ func (p *CloudScootSetRequestorToClassMapResult) Read(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *CloudScootSetRequestorToClassMapResult) readField1(iprot thrift.TProtocol) error {
+func (p *CloudScootSetRebalanceMinimumDurationResult) readField1(iprot thrift.TProtocol) error {
 	p.Ir = &InvalidRequest{}
 	if err := p.Ir.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
@@ -4854,7 +7344,7 @@ func (p *CloudScootSetRequestorToClassMapResult) readField1(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *CloudScootSetRequestorToClassMapResult) readField2(iprot thrift.TProtocol) error {
+func (p *CloudScootSetRebalanceMinimumDurationResult) readField2(iprot thrift.TProtocol) error {
 	p.Err = &ScootServerError{}
 	if err := p.Err.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
@@ -4862,8 +7352,8 @@ func (p *CloudScootSetRequestorToClassMapResult) readField2(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *CloudScootSetRequestorToClassMapResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("SetRequestorToClassMap_result"); err != nil {
+func (p *CloudScootSetRebalanceMinimumDurationResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SetRebalanceMinimumDuration_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -4881,7 +7371,7 @@ func (p *CloudScootSetRequestorToClassMapResult) Write(oprot thrift.TProtocol) e
 	return nil
 }
 
-func (p *CloudScootSetRequestorToClassMapResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootSetRebalanceMinimumDurationResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetIr() {
 		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
@@ -4896,7 +7386,7 @@ func (p *CloudScootSetRequestorToClassMapResult) writeField1(oprot thrift.TProto
 	return err
 }
 
-func (p *CloudScootSetRequestorToClassMapResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootSetRebalanceMinimumDurationResult) writeField2(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
@@ -4911,21 +7401,21 @@ func (p *CloudScootSetRequestorToClassMapResult) writeField2(oprot thrift.TProto
 	return err
 }
 
-func (p *CloudScootSetRequestorToClassMapResult) String() string {
+func (p *CloudScootSetRebalanceMinimumDurationResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootSetRequestorToClassMapResult(%+v)", *p)
+	return fmt.Sprintf("CloudScootSetRebalanceMinimumDurationResult(%+v)", *p)
 }
 
-type CloudScootGetRebalanceMinimumDurationArgs struct {
+type CloudScootGetRebalanceThresholdArgs struct {
 }
 
-func NewCloudScootGetRebalanceMinimumDurationArgs() *CloudScootGetRebalanceMinimumDurationArgs {
-	return &CloudScootGetRebalanceMinimumDurationArgs{}
+func NewCloudScootGetRebalanceThresholdArgs() *CloudScootGetRebalanceThresholdArgs {
+	return &CloudScootGetRebalanceThresholdArgs{}
 }
 
-func (p *CloudScootGetRebalanceMinimumDurationArgs) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootGetRebalanceThresholdArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -4951,8 +7441,8 @@ func (p *CloudScootGetRebalanceMinimumDurationArgs) Read(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *CloudScootGetRebalanceMinimumDurationArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("GetRebalanceMinimumDuration_args"); err != nil {
+func (p *CloudScootGetRebalanceThresholdArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetRebalanceThreshold_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
@@ -4964,51 +7454,51 @@ func (p *CloudScootGetRebalanceMinimumDurationArgs) Write(oprot thrift.TProtocol
 	return nil
 }
 
-func (p *CloudScootGetRebalanceMinimumDurationArgs) String() string {
+func (p *CloudScootGetRebalanceThresholdArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootGetRebalanceMinimumDurationArgs(%+v)", *p)
+	return fmt.Sprintf("CloudScootGetRebalanceThresholdArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - Ir
-type CloudScootGetRebalanceMinimumDurationResult struct {
+type CloudScootGetRebalanceThresholdResult struct {
 	Success *int32          `thrift:"success,0" json:"success,omitempty"`
 	Ir      *InvalidRequest `thrift:"ir,1" json:"ir,omitempty"`
 }
 
-func NewCloudScootGetRebalanceMinimumDurationResult() *CloudScootGetRebalanceMinimumDurationResult {
-	return &CloudScootGetRebalanceMinimumDurationResult{}
+func NewCloudScootGetRebalanceThresholdResult() *CloudScootGetRebalanceThresholdResult {
+	return &CloudScootGetRebalanceThresholdResult{}
 }
 
-var CloudScootGetRebalanceMinimumDurationResult_Success_DEFAULT int32
+var CloudScootGetRebalanceThresholdResult_Success_DEFAULT int32
 
-func (p *CloudScootGetRebalanceMinimumDurationResult) GetSuccess() int32 {
+func (p *CloudScootGetRebalanceThresholdResult) GetSuccess() int32 {
 	if !p.IsSetSuccess() {
-		return CloudScootGetRebalanceMinimumDurationResult_Success_DEFAULT
+		return CloudScootGetRebalanceThresholdResult_Success_DEFAULT
 	}
 	return *p.Success
 }
 
-var CloudScootGetRebalanceMinimumDurationResult_Ir_DEFAULT *InvalidRequest
+var CloudScootGetRebalanceThresholdResult_Ir_DEFAULT *InvalidRequest
 
-func (p *CloudScootGetRebalanceMinimumDurationResult) GetIr() *InvalidRequest {
+func (p *CloudScootGetRebalanceThresholdResult) GetIr() *InvalidRequest {
 	if !p.IsSetIr() {
-		return CloudScootGetRebalanceMinimumDurationResult_Ir_DEFAULT
+		return CloudScootGetRebalanceThresholdResult_Ir_DEFAULT
 	}
 	return p.Ir
 }
-func (p *CloudScootGetRebalanceMinimumDurationResult) IsSetSuccess() bool {
+func (p *CloudScootGetRebalanceThresholdResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CloudScootGetRebalanceMinimumDurationResult) IsSetIr() bool {
+func (p *CloudScootGetRebalanceThresholdResult) IsSetIr() bool {
 	return p.Ir != nil
 }
 
-func (p *CloudScootGetRebalanceMinimumDurationResult) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootGetRebalanceThresholdResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5045,7 +7535,7 @@ func (p *CloudScootGetRebalanceMinimumDurationResult) Read(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *CloudScootGetRebalanceMinimumDurationResult) readField0(iprot thrift.TProtocol) error {
+func (p *CloudScootGetRebalanceThresholdResult) readField0(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return thrift.PrependError("error reading field 0: ", err)
 	} else {
@@ -5054,7 +7544,7 @@ func (p *CloudScootGetRebalanceMinimumDurationResult) readField0(iprot thrift.TP
 	return nil
 }
 
-func (p *CloudScootGetRebalanceMinimumDurationResult) readField1(iprot thrift.TProtocol) error {
+func (p *CloudScootGetRebalanceThresholdResult) readField1(iprot thrift.TProtocol) error {
 	p.Ir = &InvalidRequest{}
 	if err := p.Ir.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
@@ -5062,8 +7552,8 @@ func (p *CloudScootGetRebalanceMinimumDurationResult) readField1(iprot thrift.TP
 	return nil
 }
 
-func (p *CloudScootGetRebalanceMinimumDurationResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("GetRebalanceMinimumDuration_result"); err != nil {
+func (p *CloudScootGetRebalanceThresholdResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetRebalanceThreshold_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField0(oprot); err != nil {
@@ -5081,7 +7571,7 @@ func (p *CloudScootGetRebalanceMinimumDurationResult) Write(oprot thrift.TProtoc
 	return nil
 }
 
-func (p *CloudScootGetRebalanceMinimumDurationResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootGetRebalanceThresholdResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err := oprot.WriteFieldBegin("success", thrift.I32, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
@@ -5096,7 +7586,7 @@ func (p *CloudScootGetRebalanceMinimumDurationResult) writeField0(oprot thrift.T
 	return err
 }
 
-func (p *CloudScootGetRebalanceMinimumDurationResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootGetRebalanceThresholdResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetIr() {
 		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
@@ -5111,27 +7601,33 @@ func (p *CloudScootGetRebalanceMinimumDurationResult) writeField1(oprot thrift.T
 	return err
 }
 
-func (p *CloudScootGetRebalanceMinimumDurationResult) String() string {
+func (p *CloudScootGetRebalanceThresholdResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootGetRebalanceMinimumDurationResult(%+v)", *p)
+	return fmt.Sprintf("CloudScootGetRebalanceThresholdResult(%+v)", *p)
 }
 
 // Attributes:
-//  - DurationMin
-type CloudScootSetRebalanceMinimumDurationArgs struct {
-	DurationMin int32 `thrift:"durationMin,1" json:"durationMin"`
+//  - Threshold
+//  - Requestor
+type CloudScootSetRebalanceThresholdArgs struct {
+	Threshold int32  `thrift:"threshold,1" json:"threshold"`
+	Requestor string `thrift:"requestor,2" json:"requestor"`
 }
 
-func NewCloudScootSetRebalanceMinimumDurationArgs() *CloudScootSetRebalanceMinimumDurationArgs {
-	return &CloudScootSetRebalanceMinimumDurationArgs{}
+func NewCloudScootSetRebalanceThresholdArgs() *CloudScootSetRebalanceThresholdArgs {
+	return &CloudScootSetRebalanceThresholdArgs{}
 }
 
-func (p *CloudScootSetRebalanceMinimumDurationArgs) GetDurationMin() int32 {
-	return p.DurationMin
+func (p *CloudScootSetRebalanceThresholdArgs) GetThreshold() int32 {
+	return p.Threshold
 }
-func (p *CloudScootSetRebalanceMinimumDurationArgs) Read(iprot thrift.TProtocol) error {
+
+func (p *CloudScootSetRebalanceThresholdArgs) GetRequestor() string {
+	return p.Requestor
+}
+func (p *CloudScootSetRebalanceThresholdArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5149,6 +7645,10 @@ func (p *CloudScootSetRebalanceMinimumDurationArgs) Read(iprot thrift.TProtocol)
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -5164,22 +7664,34 @@ func (p *CloudScootSetRebalanceMinimumDurationArgs) Read(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *CloudScootSetRebalanceMinimumDurationArgs) readField1(iprot thrift.TProtocol) error {
+func (p *CloudScootSetRebalanceThresholdArgs) readField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.DurationMin = v
+		p.Threshold = v
 	}
 	return nil
 }
 
-func (p *CloudScootSetRebalanceMinimumDurationArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("SetRebalanceMinimumDuration_args"); err != nil {
+func (p *CloudScootSetRebalanceThresholdArgs) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Requestor = v
+	}
+	return nil
+}
+
+func (p *CloudScootSetRebalanceThresholdArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SetRebalanceThreshold_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -5189,64 +7701,77 @@ func (p *CloudScootSetRebalanceMinimumDurationArgs) Write(oprot thrift.TProtocol
 	return nil
 }
 
-func (p *CloudScootSetRebalanceMinimumDurationArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("durationMin", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:durationMin: ", p), err)
+func (p *CloudScootSetRebalanceThresholdArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("threshold", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:threshold: ", p), err)
 	}
-	if err := oprot.WriteI32(int32(p.DurationMin)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.durationMin (1) field write error: ", p), err)
+	if err := oprot.WriteI32(int32(p.Threshold)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.threshold (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:durationMin: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:threshold: ", p), err)
 	}
 	return err
 }
 
-func (p *CloudScootSetRebalanceMinimumDurationArgs) String() string {
+func (p *CloudScootSetRebalanceThresholdArgs) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("requestor", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:requestor: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.Requestor)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.requestor (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:requestor: ", p), err)
+	}
+	return err
+}
+
+func (p *CloudScootSetRebalanceThresholdArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootSetRebalanceMinimumDurationArgs(%+v)", *p)
+	return fmt.Sprintf("CloudScootSetRebalanceThresholdArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ir
 //  - Err
-type CloudScootSetRebalanceMinimumDurationResult struct {
+type CloudScootSetRebalanceThresholdResult struct {
 	Ir  *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
 	Err *ScootServerError `thrift:"err,2" json:"err,omitempty"`
 }
 
-func NewCloudScootSetRebalanceMinimumDurationResult() *CloudScootSetRebalanceMinimumDurationResult {
-	return &CloudScootSetRebalanceMinimumDurationResult{}
+func NewCloudScootSetRebalanceThresholdResult() *CloudScootSetRebalanceThresholdResult {
+	return &CloudScootSetRebalanceThresholdResult{}
 }
 
-var CloudScootSetRebalanceMinimumDurationResult_Ir_DEFAULT *InvalidRequest
+var CloudScootSetRebalanceThresholdResult_Ir_DEFAULT *InvalidRequest
 
-func (p *CloudScootSetRebalanceMinimumDurationResult) GetIr() *InvalidRequest {
+func (p *CloudScootSetRebalanceThresholdResult) GetIr() *InvalidRequest {
 	if !p.IsSetIr() {
-		return CloudScootSetRebalanceMinimumDurationResult_Ir_DEFAULT
+		return CloudScootSetRebalanceThresholdResult_Ir_DEFAULT
 	}
 	return p.Ir
 }
 
-var CloudScootSetRebalanceMinimumDurationResult_Err_DEFAULT *ScootServerError
+var CloudScootSetRebalanceThresholdResult_Err_DEFAULT *ScootServerError
 
-func (p *CloudScootSetRebalanceMinimumDurationResult) GetErr() *ScootServerError {
+func (p *CloudScootSetRebalanceThresholdResult) GetErr() *ScootServerError {
 	if !p.IsSetErr() {
-		return CloudScootSetRebalanceMinimumDurationResult_Err_DEFAULT
+		return CloudScootSetRebalanceThresholdResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *CloudScootSetRebalanceMinimumDurationResult) IsSetIr() bool {
+func (p *CloudScootSetRebalanceThresholdResult) IsSetIr() bool {
 	return p.Ir != nil
 }
 
-func (p *CloudScootSetRebalanceMinimumDurationResult) IsSetErr() bool {
+func (p *CloudScootSetRebalanceThresholdResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *CloudScootSetRebalanceMinimumDurationResult) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootSetRebalanceThresholdResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5283,7 +7808,7 @@ func (p *CloudScootSetRebalanceMinimumDurationResult) Read(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *CloudScootSetRebalanceMinimumDurationResult) readField1(iprot thrift.TProtocol) error {
+func (p *CloudScootSetRebalanceThresholdResult) readField1(iprot thrift.TProtocol) error {
 	p.Ir = &InvalidRequest{}
 	if err := p.Ir.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
@@ -5291,7 +7816,7 @@ func (p *CloudScootSetRebalanceMinimumDurationResult) readField1(iprot thrift.TP
 	return nil
 }
 
-func (p *CloudScootSetRebalanceMinimumDurationResult) readField2(iprot thrift.TProtocol) error {
+func (p *CloudScootSetRebalanceThresholdResult) readField2(iprot thrift.TProtocol) error {
 	p.Err = &ScootServerError{}
 	if err := p.Err.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
@@ -5299,8 +7824,8 @@ func (p *CloudScootSetRebalanceMinimumDurationResult) readField2(iprot thrift.TP
 	return nil
 }
 
-func (p *CloudScootSetRebalanceMinimumDurationResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("SetRebalanceMinimumDuration_result"); err != nil {
+func (p *CloudScootSetRebalanceThresholdResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SetRebalanceThreshold_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -5318,7 +7843,7 @@ func (p *CloudScootSetRebalanceMinimumDurationResult) Write(oprot thrift.TProtoc
 	return nil
 }
 
-func (p *CloudScootSetRebalanceMinimumDurationResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootSetRebalanceThresholdResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetIr() {
 		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
@@ -5333,7 +7858,7 @@ func (p *CloudScootSetRebalanceMinimumDurationResult) writeField1(oprot thrift.T
 	return err
 }
 
-func (p *CloudScootSetRebalanceMinimumDurationResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootSetRebalanceThresholdResult) writeField2(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
@@ -5348,21 +7873,21 @@ func (p *CloudScootSetRebalanceMinimumDurationResult) writeField2(oprot thrift.T
 	return err
 }
 
-func (p *CloudScootSetRebalanceMinimumDurationResult) String() string {
+func (p *CloudScootSetRebalanceThresholdResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootSetRebalanceMinimumDurationResult(%+v)", *p)
+	return fmt.Sprintf("CloudScootSetRebalanceThresholdResult(%+v)", *p)
 }
 
-type CloudScootGetRebalanceThresholdArgs struct {
+type CloudScootGetSettingsHistoryArgs struct {
 }
 
-func NewCloudScootGetRebalanceThresholdArgs() *CloudScootGetRebalanceThresholdArgs {
-	return &CloudScootGetRebalanceThresholdArgs{}
+func NewCloudScootGetSettingsHistoryArgs() *CloudScootGetSettingsHistoryArgs {
+	return &CloudScootGetSettingsHistoryArgs{}
 }
 
-func (p *CloudScootGetRebalanceThresholdArgs) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootGetSettingsHistoryArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5388,8 +7913,8 @@ func (p *CloudScootGetRebalanceThresholdArgs) Read(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *CloudScootGetRebalanceThresholdArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("GetRebalanceThreshold_args"); err != nil {
+func (p *CloudScootGetSettingsHistoryArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetSettingsHistory_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
@@ -5401,51 +7926,66 @@ func (p *CloudScootGetRebalanceThresholdArgs) Write(oprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *CloudScootGetRebalanceThresholdArgs) String() string {
+func (p *CloudScootGetSettingsHistoryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootGetRebalanceThresholdArgs(%+v)", *p)
+	return fmt.Sprintf("CloudScootGetSettingsHistoryArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - Ir
-type CloudScootGetRebalanceThresholdResult struct {
-	Success *int32          `thrift:"success,0" json:"success,omitempty"`
-	Ir      *InvalidRequest `thrift:"ir,1" json:"ir,omitempty"`
+//  - Err
+type CloudScootGetSettingsHistoryResult struct {
+	Success *SettingsHistory  `thrift:"success,0" json:"success,omitempty"`
+	Ir      *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
+	Err     *ScootServerError `thrift:"err,2" json:"err,omitempty"`
 }
 
-func NewCloudScootGetRebalanceThresholdResult() *CloudScootGetRebalanceThresholdResult {
-	return &CloudScootGetRebalanceThresholdResult{}
+func NewCloudScootGetSettingsHistoryResult() *CloudScootGetSettingsHistoryResult {
+	return &CloudScootGetSettingsHistoryResult{}
 }
 
-var CloudScootGetRebalanceThresholdResult_Success_DEFAULT int32
+var CloudScootGetSettingsHistoryResult_Success_DEFAULT *SettingsHistory
 
-func (p *CloudScootGetRebalanceThresholdResult) GetSuccess() int32 {
+func (p *CloudScootGetSettingsHistoryResult) GetSuccess() *SettingsHistory {
 	if !p.IsSetSuccess() {
-		return CloudScootGetRebalanceThresholdResult_Success_DEFAULT
+		return CloudScootGetSettingsHistoryResult_Success_DEFAULT
 	}
-	return *p.Success
+	return p.Success
 }
 
-var CloudScootGetRebalanceThresholdResult_Ir_DEFAULT *InvalidRequest
+var CloudScootGetSettingsHistoryResult_Ir_DEFAULT *InvalidRequest
 
-func (p *CloudScootGetRebalanceThresholdResult) GetIr() *InvalidRequest {
+func (p *CloudScootGetSettingsHistoryResult) GetIr() *InvalidRequest {
 	if !p.IsSetIr() {
-		return CloudScootGetRebalanceThresholdResult_Ir_DEFAULT
+		return CloudScootGetSettingsHistoryResult_Ir_DEFAULT
 	}
 	return p.Ir
 }
-func (p *CloudScootGetRebalanceThresholdResult) IsSetSuccess() bool {
+
+var CloudScootGetSettingsHistoryResult_Err_DEFAULT *ScootServerError
+
+func (p *CloudScootGetSettingsHistoryResult) GetErr() *ScootServerError {
+	if !p.IsSetErr() {
+		return CloudScootGetSettingsHistoryResult_Err_DEFAULT
+	}
+	return p.Err
+}
+func (p *CloudScootGetSettingsHistoryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CloudScootGetRebalanceThresholdResult) IsSetIr() bool {
+func (p *CloudScootGetSettingsHistoryResult) IsSetIr() bool {
 	return p.Ir != nil
 }
 
-func (p *CloudScootGetRebalanceThresholdResult) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootGetSettingsHistoryResult) IsSetErr() bool {
+	return p.Err != nil
+}
+
+func (p *CloudScootGetSettingsHistoryResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5467,6 +8007,10 @@ func (p *CloudScootGetRebalanceThresholdResult) Read(iprot thrift.TProtocol) err
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -5482,16 +8026,15 @@ func (p *CloudScootGetRebalanceThresholdResult) Read(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *CloudScootGetRebalanceThresholdResult) readField0(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 0: ", err)
-	} else {
-		p.Success = &v
+func (p *CloudScootGetSettingsHistoryResult) readField0(iprot thrift.TProtocol) error {
+	p.Success = &SettingsHistory{}
+	if err := p.Success.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
 	}
 	return nil
 }
 
-func (p *CloudScootGetRebalanceThresholdResult) readField1(iprot thrift.TProtocol) error {
+func (p *CloudScootGetSettingsHistoryResult) readField1(iprot thrift.TProtocol) error {
 	p.Ir = &InvalidRequest{}
 	if err := p.Ir.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
@@ -5499,8 +8042,16 @@ func (p *CloudScootGetRebalanceThresholdResult) readField1(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *CloudScootGetRebalanceThresholdResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("GetRebalanceThreshold_result"); err != nil {
+func (p *CloudScootGetSettingsHistoryResult) readField2(iprot thrift.TProtocol) error {
+	p.Err = &ScootServerError{}
+	if err := p.Err.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+	}
+	return nil
+}
+
+func (p *CloudScootGetSettingsHistoryResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GetSettingsHistory_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField0(oprot); err != nil {
@@ -5509,6 +8060,9 @@ func (p *CloudScootGetRebalanceThresholdResult) Write(oprot thrift.TProtocol) er
 	if err := p.writeField1(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -5518,13 +8072,13 @@ func (p *CloudScootGetRebalanceThresholdResult) Write(oprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *CloudScootGetRebalanceThresholdResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootGetSettingsHistoryResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
-		if err := oprot.WriteFieldBegin("success", thrift.I32, 0); err != nil {
+		if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err)
 		}
-		if err := oprot.WriteI32(int32(*p.Success)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err)
+		if err := p.Success.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err)
@@ -5533,7 +8087,7 @@ func (p *CloudScootGetRebalanceThresholdResult) writeField0(oprot thrift.TProtoc
 	return err
 }
 
-func (p *CloudScootGetRebalanceThresholdResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootGetSettingsHistoryResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetIr() {
 		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
@@ -5548,27 +8102,51 @@ func (p *CloudScootGetRebalanceThresholdResult) writeField1(oprot thrift.TProtoc
 	return err
 }
 
-func (p *CloudScootGetRebalanceThresholdResult) String() string {
+func (p *CloudScootGetSettingsHistoryResult) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetErr() {
+		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
+		}
+		if err := p.Err.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:err: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *CloudScootGetSettingsHistoryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootGetRebalanceThresholdResult(%+v)", *p)
+	return fmt.Sprintf("CloudScootGetSettingsHistoryResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Threshold
-type CloudScootSetRebalanceThresholdArgs struct {
-	Threshold int32 `thrift:"threshold,1" json:"threshold"`
+//  - Req
+type CloudScootRollbackSettingsArgs struct {
+	Req *RollbackSettingsReq `thrift:"req,1" json:"req"`
 }
 
-func NewCloudScootSetRebalanceThresholdArgs() *CloudScootSetRebalanceThresholdArgs {
-	return &CloudScootSetRebalanceThresholdArgs{}
+func NewCloudScootRollbackSettingsArgs() *CloudScootRollbackSettingsArgs {
+	return &CloudScootRollbackSettingsArgs{}
 }
 
-func (p *CloudScootSetRebalanceThresholdArgs) GetThreshold() int32 {
-	return p.Threshold
+var CloudScootRollbackSettingsArgs_Req_DEFAULT *RollbackSettingsReq
+
+func (p *CloudScootRollbackSettingsArgs) GetReq() *RollbackSettingsReq {
+	if !p.IsSetReq() {
+		return CloudScootRollbackSettingsArgs_Req_DEFAULT
+	}
+	return p.Req
 }
-func (p *CloudScootSetRebalanceThresholdArgs) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootRollbackSettingsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CloudScootRollbackSettingsArgs) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5601,17 +8179,16 @@ func (p *CloudScootSetRebalanceThresholdArgs) Read(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *CloudScootSetRebalanceThresholdArgs) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.Threshold = v
+func (p *CloudScootRollbackSettingsArgs) readField1(iprot thrift.TProtocol) error {
+	p.Req = &RollbackSettingsReq{}
+	if err := p.Req.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Req), err)
 	}
 	return nil
 }
 
-func (p *CloudScootSetRebalanceThresholdArgs) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("SetRebalanceThreshold_args"); err != nil {
+func (p *CloudScootRollbackSettingsArgs) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("RollbackSettings_args"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -5626,64 +8203,64 @@ func (p *CloudScootSetRebalanceThresholdArgs) Write(oprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *CloudScootSetRebalanceThresholdArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("threshold", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:threshold: ", p), err)
+func (p *CloudScootRollbackSettingsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("req", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:req: ", p), err)
 	}
-	if err := oprot.WriteI32(int32(p.Threshold)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.threshold (1) field write error: ", p), err)
+	if err := p.Req.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Req), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:threshold: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:req: ", p), err)
 	}
 	return err
 }
 
-func (p *CloudScootSetRebalanceThresholdArgs) String() string {
+func (p *CloudScootRollbackSettingsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootSetRebalanceThresholdArgs(%+v)", *p)
+	return fmt.Sprintf("CloudScootRollbackSettingsArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ir
 //  - Err
-type CloudScootSetRebalanceThresholdResult struct {
+type CloudScootRollbackSettingsResult struct {
 	Ir  *InvalidRequest   `thrift:"ir,1" json:"ir,omitempty"`
 	Err *ScootServerError `thrift:"err,2" json:"err,omitempty"`
 }
 
-func NewCloudScootSetRebalanceThresholdResult() *CloudScootSetRebalanceThresholdResult {
-	return &CloudScootSetRebalanceThresholdResult{}
+func NewCloudScootRollbackSettingsResult() *CloudScootRollbackSettingsResult {
+	return &CloudScootRollbackSettingsResult{}
 }
 
-var CloudScootSetRebalanceThresholdResult_Ir_DEFAULT *InvalidRequest
+var CloudScootRollbackSettingsResult_Ir_DEFAULT *InvalidRequest
 
-func (p *CloudScootSetRebalanceThresholdResult) GetIr() *InvalidRequest {
+func (p *CloudScootRollbackSettingsResult) GetIr() *InvalidRequest {
 	if !p.IsSetIr() {
-		return CloudScootSetRebalanceThresholdResult_Ir_DEFAULT
+		return CloudScootRollbackSettingsResult_Ir_DEFAULT
 	}
 	return p.Ir
 }
 
-var CloudScootSetRebalanceThresholdResult_Err_DEFAULT *ScootServerError
+var CloudScootRollbackSettingsResult_Err_DEFAULT *ScootServerError
 
-func (p *CloudScootSetRebalanceThresholdResult) GetErr() *ScootServerError {
+func (p *CloudScootRollbackSettingsResult) GetErr() *ScootServerError {
 	if !p.IsSetErr() {
-		return CloudScootSetRebalanceThresholdResult_Err_DEFAULT
+		return CloudScootRollbackSettingsResult_Err_DEFAULT
 	}
 	return p.Err
 }
-func (p *CloudScootSetRebalanceThresholdResult) IsSetIr() bool {
+func (p *CloudScootRollbackSettingsResult) IsSetIr() bool {
 	return p.Ir != nil
 }
 
-func (p *CloudScootSetRebalanceThresholdResult) IsSetErr() bool {
+func (p *CloudScootRollbackSettingsResult) IsSetErr() bool {
 	return p.Err != nil
 }
 
-func (p *CloudScootSetRebalanceThresholdResult) Read(iprot thrift.TProtocol) error {
+func (p *CloudScootRollbackSettingsResult) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -5720,7 +8297,7 @@ func (p *CloudScootSetRebalanceThresholdResult) Read(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *CloudScootSetRebalanceThresholdResult) readField1(iprot thrift.TProtocol) error {
+func (p *CloudScootRollbackSettingsResult) readField1(iprot thrift.TProtocol) error {
 	p.Ir = &InvalidRequest{}
 	if err := p.Ir.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ir), err)
@@ -5728,7 +8305,7 @@ func (p *CloudScootSetRebalanceThresholdResult) readField1(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *CloudScootSetRebalanceThresholdResult) readField2(iprot thrift.TProtocol) error {
+func (p *CloudScootRollbackSettingsResult) readField2(iprot thrift.TProtocol) error {
 	p.Err = &ScootServerError{}
 	if err := p.Err.Read(iprot); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
@@ -5736,8 +8313,8 @@ func (p *CloudScootSetRebalanceThresholdResult) readField2(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *CloudScootSetRebalanceThresholdResult) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("SetRebalanceThreshold_result"); err != nil {
+func (p *CloudScootRollbackSettingsResult) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("RollbackSettings_result"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -5755,7 +8332,7 @@ func (p *CloudScootSetRebalanceThresholdResult) Write(oprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *CloudScootSetRebalanceThresholdResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootRollbackSettingsResult) writeField1(oprot thrift.TProtocol) (err error) {
 	if p.IsSetIr() {
 		if err := oprot.WriteFieldBegin("ir", thrift.STRUCT, 1); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ir: ", p), err)
@@ -5770,7 +8347,7 @@ func (p *CloudScootSetRebalanceThresholdResult) writeField1(oprot thrift.TProtoc
 	return err
 }
 
-func (p *CloudScootSetRebalanceThresholdResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *CloudScootRollbackSettingsResult) writeField2(oprot thrift.TProtocol) (err error) {
 	if p.IsSetErr() {
 		if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 2); err != nil {
 			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:err: ", p), err)
@@ -5785,9 +8362,9 @@ func (p *CloudScootSetRebalanceThresholdResult) writeField2(oprot thrift.TProtoc
 	return err
 }
 
-func (p *CloudScootSetRebalanceThresholdResult) String() string {
+func (p *CloudScootRollbackSettingsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CloudScootSetRebalanceThresholdResult(%+v)", *p)
+	return fmt.Sprintf("CloudScootRollbackSettingsResult(%+v)", *p)
 }
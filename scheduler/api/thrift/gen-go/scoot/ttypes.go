@@ -125,6 +125,53 @@ func (p *RunStatusState) UnmarshalText(text []byte) error {
 	return nil
 }
 
+type EnvMode int64
+
+const (
+	EnvMode_INHERIT   EnvMode = 0
+	EnvMode_ALLOWLIST EnvMode = 1
+	EnvMode_CLEAN     EnvMode = 2
+)
+
+func (p EnvMode) String() string {
+	switch p {
+	case EnvMode_INHERIT:
+		return "INHERIT"
+	case EnvMode_ALLOWLIST:
+		return "ALLOWLIST"
+	case EnvMode_CLEAN:
+		return "CLEAN"
+	}
+	return "<UNSET>"
+}
+
+func EnvModeFromString(s string) (EnvMode, error) {
+	switch s {
+	case "INHERIT":
+		return EnvMode_INHERIT, nil
+	case "ALLOWLIST":
+		return EnvMode_ALLOWLIST, nil
+	case "CLEAN":
+		return EnvMode_CLEAN, nil
+	}
+	return EnvMode(0), fmt.Errorf("not a valid EnvMode string")
+}
+
+func EnvModePtr(v EnvMode) *EnvMode { return &v }
+
+func (p EnvMode) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *EnvMode) UnmarshalText(text []byte) error {
+	q, err := EnvModeFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
 type Status int64
 
 const (
@@ -182,6 +229,145 @@ func (p *Status) UnmarshalText(text []byte) error {
 	return nil
 }
 
+type WaitReason int64
+
+const (
+	WaitReason_NOT_EVALUATED      WaitReason = 0
+	WaitReason_DEPENDENCIES       WaitReason = 1
+	WaitReason_RETRY_BACKOFF      WaitReason = 2
+	WaitReason_UNSCHEDULABLE      WaitReason = 3
+	WaitReason_NOT_SELECTED       WaitReason = 4
+	WaitReason_NO_IDLE_NODES      WaitReason = 5
+	WaitReason_NO_AVAILABLE_NODES WaitReason = 6
+	WaitReason_NO_FITTING_NODE    WaitReason = 7
+)
+
+func (p WaitReason) String() string {
+	switch p {
+	case WaitReason_NOT_EVALUATED:
+		return "NOT_EVALUATED"
+	case WaitReason_DEPENDENCIES:
+		return "DEPENDENCIES"
+	case WaitReason_RETRY_BACKOFF:
+		return "RETRY_BACKOFF"
+	case WaitReason_UNSCHEDULABLE:
+		return "UNSCHEDULABLE"
+	case WaitReason_NOT_SELECTED:
+		return "NOT_SELECTED"
+	case WaitReason_NO_IDLE_NODES:
+		return "NO_IDLE_NODES"
+	case WaitReason_NO_AVAILABLE_NODES:
+		return "NO_AVAILABLE_NODES"
+	case WaitReason_NO_FITTING_NODE:
+		return "NO_FITTING_NODE"
+	}
+	return "<UNSET>"
+}
+
+func WaitReasonFromString(s string) (WaitReason, error) {
+	switch s {
+	case "NOT_EVALUATED":
+		return WaitReason_NOT_EVALUATED, nil
+	case "DEPENDENCIES":
+		return WaitReason_DEPENDENCIES, nil
+	case "RETRY_BACKOFF":
+		return WaitReason_RETRY_BACKOFF, nil
+	case "UNSCHEDULABLE":
+		return WaitReason_UNSCHEDULABLE, nil
+	case "NOT_SELECTED":
+		return WaitReason_NOT_SELECTED, nil
+	case "NO_IDLE_NODES":
+		return WaitReason_NO_IDLE_NODES, nil
+	case "NO_AVAILABLE_NODES":
+		return WaitReason_NO_AVAILABLE_NODES, nil
+	case "NO_FITTING_NODE":
+		return WaitReason_NO_FITTING_NODE, nil
+	}
+	return WaitReason(0), fmt.Errorf("not a valid WaitReason string")
+}
+
+func WaitReasonPtr(v WaitReason) *WaitReason { return &v }
+
+func (p WaitReason) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *WaitReason) UnmarshalText(text []byte) error {
+	q, err := WaitReasonFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+type WorkerState int64
+
+const (
+	WorkerState_IDLE      WorkerState = 0
+	WorkerState_BUSY      WorkerState = 1
+	WorkerState_NOT_READY WorkerState = 2
+	WorkerState_LOST      WorkerState = 3
+	WorkerState_FLAKY     WorkerState = 4
+	WorkerState_OFFLINED  WorkerState = 5
+	WorkerState_DRAINING  WorkerState = 6
+)
+
+func (p WorkerState) String() string {
+	switch p {
+	case WorkerState_IDLE:
+		return "IDLE"
+	case WorkerState_BUSY:
+		return "BUSY"
+	case WorkerState_NOT_READY:
+		return "NOT_READY"
+	case WorkerState_LOST:
+		return "LOST"
+	case WorkerState_FLAKY:
+		return "FLAKY"
+	case WorkerState_OFFLINED:
+		return "OFFLINED"
+	case WorkerState_DRAINING:
+		return "DRAINING"
+	}
+	return "<UNSET>"
+}
+
+func WorkerStateFromString(s string) (WorkerState, error) {
+	switch s {
+	case "IDLE":
+		return WorkerState_IDLE, nil
+	case "BUSY":
+		return WorkerState_BUSY, nil
+	case "NOT_READY":
+		return WorkerState_NOT_READY, nil
+	case "LOST":
+		return WorkerState_LOST, nil
+	case "FLAKY":
+		return WorkerState_FLAKY, nil
+	case "OFFLINED":
+		return WorkerState_OFFLINED, nil
+	case "DRAINING":
+		return WorkerState_DRAINING, nil
+	}
+	return WorkerState(0), fmt.Errorf("not a valid WorkerState string")
+}
+
+func WorkerStatePtr(v WorkerState) *WorkerState { return &v }
+
+func (p WorkerState) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *WorkerState) UnmarshalText(text []byte) error {
+	q, err := WorkerStateFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
 // Attributes:
 //  - Message
 type InvalidRequest struct {
@@ -511,17 +697,19 @@ func (p *ScootServerError) Error() string {
 //  - JobId
 //  - TaskId
 //  - Tag
+//  - PreviousAttempts
 type RunStatus struct {
-	Status     RunStatusState `thrift:"status,1,required" json:"status"`
-	RunId      string         `thrift:"runId,2,required" json:"runId"`
-	OutUri     *string        `thrift:"outUri,3" json:"outUri,omitempty"`
-	ErrUri     *string        `thrift:"errUri,4" json:"errUri,omitempty"`
-	Error      *string        `thrift:"error,5" json:"error,omitempty"`
-	ExitCode   *int32         `thrift:"exitCode,6" json:"exitCode,omitempty"`
-	SnapshotId *string        `thrift:"snapshotId,7" json:"snapshotId,omitempty"`
-	JobId      *string        `thrift:"jobId,8" json:"jobId,omitempty"`
-	TaskId     *string        `thrift:"taskId,9" json:"taskId,omitempty"`
-	Tag        *string        `thrift:"tag,10" json:"tag,omitempty"`
+	Status           RunStatusState `thrift:"status,1,required" json:"status"`
+	RunId            string         `thrift:"runId,2,required" json:"runId"`
+	OutUri           *string        `thrift:"outUri,3" json:"outUri,omitempty"`
+	ErrUri           *string        `thrift:"errUri,4" json:"errUri,omitempty"`
+	Error            *string        `thrift:"error,5" json:"error,omitempty"`
+	ExitCode         *int32         `thrift:"exitCode,6" json:"exitCode,omitempty"`
+	SnapshotId       *string        `thrift:"snapshotId,7" json:"snapshotId,omitempty"`
+	JobId            *string        `thrift:"jobId,8" json:"jobId,omitempty"`
+	TaskId           *string        `thrift:"taskId,9" json:"taskId,omitempty"`
+	Tag              *string        `thrift:"tag,10" json:"tag,omitempty"`
+	PreviousAttempts []*RunStatus   `thrift:"previousAttempts,11" json:"previousAttempts,omitempty"`
 }
 
 func NewRunStatus() *RunStatus {
@@ -607,6 +795,12 @@ func (p *RunStatus) GetTag() string {
 	}
 	return *p.Tag
 }
+
+var RunStatus_PreviousAttempts_DEFAULT []*RunStatus
+
+func (p *RunStatus) GetPreviousAttempts() []*RunStatus {
+	return p.PreviousAttempts
+}
 func (p *RunStatus) IsSetOutUri() bool {
 	return p.OutUri != nil
 }
@@ -639,6 +833,10 @@ func (p *RunStatus) IsSetTag() bool {
 	return p.Tag != nil
 }
 
+func (p *RunStatus) IsSetPreviousAttempts() bool {
+	return p.PreviousAttempts != nil
+}
+
 func (p *RunStatus) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -698,6 +896,10 @@ func (p *RunStatus) Read(iprot thrift.TProtocol) error {
 			if err := p.readField10(iprot); err != nil {
 				return err
 			}
+		case 11:
+			if err := p.readField11(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -810,6 +1012,26 @@ func (p *RunStatus) readField10(iprot thrift.TProtocol) error {
 	return nil
 }
 
+func (p *RunStatus) readField11(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*RunStatus, 0, size)
+	p.PreviousAttempts = tSlice
+	for i := 0; i < size; i++ {
+		_elem0 := &RunStatus{}
+		if err := _elem0.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem0), err)
+		}
+		p.PreviousAttempts = append(p.PreviousAttempts, _elem0)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
 func (p *RunStatus) Write(oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin("RunStatus"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -844,6 +1066,9 @@ func (p *RunStatus) Write(oprot thrift.TProtocol) error {
 	if err := p.writeField10(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField11(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -999,6 +1224,29 @@ func (p *RunStatus) writeField10(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
+func (p *RunStatus) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPreviousAttempts() {
+		if err := oprot.WriteFieldBegin("previousAttempts", thrift.LIST, 11); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:previousAttempts: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.PreviousAttempts)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.PreviousAttempts {
+			if err := v.Write(oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 11:previousAttempts: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *RunStatus) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1009,9 +1257,15 @@ func (p *RunStatus) String() string {
 // Attributes:
 //  - Argv
 //  - EnvVars
+//  - EnvMode
+//  - EnvAllowlist
+//  - SecretEnv
 type Command struct {
-	Argv    []string          `thrift:"argv,1" json:"argv"`
-	EnvVars map[string]string `thrift:"envVars,2" json:"envVars,omitempty"`
+	Argv         []string          `thrift:"argv,1" json:"argv"`
+	EnvVars      map[string]string `thrift:"envVars,2" json:"envVars,omitempty"`
+	EnvMode      *EnvMode          `thrift:"envMode,3" json:"envMode,omitempty"`
+	EnvAllowlist []string          `thrift:"envAllowlist,4" json:"envAllowlist,omitempty"`
+	SecretEnv    map[string]string `thrift:"secretEnv,5" json:"secretEnv,omitempty"`
 }
 
 func NewCommand() *Command {
@@ -1027,10 +1281,43 @@ var Command_EnvVars_DEFAULT map[string]string
 func (p *Command) GetEnvVars() map[string]string {
 	return p.EnvVars
 }
+
+var Command_EnvMode_DEFAULT EnvMode
+
+func (p *Command) GetEnvMode() EnvMode {
+	if !p.IsSetEnvMode() {
+		return Command_EnvMode_DEFAULT
+	}
+	return *p.EnvMode
+}
+
+var Command_EnvAllowlist_DEFAULT []string
+
+func (p *Command) GetEnvAllowlist() []string {
+	return p.EnvAllowlist
+}
+
+var Command_SecretEnv_DEFAULT map[string]string
+
+func (p *Command) GetSecretEnv() map[string]string {
+	return p.SecretEnv
+}
 func (p *Command) IsSetEnvVars() bool {
 	return p.EnvVars != nil
 }
 
+func (p *Command) IsSetEnvMode() bool {
+	return p.EnvMode != nil
+}
+
+func (p *Command) IsSetEnvAllowlist() bool {
+	return p.EnvAllowlist != nil
+}
+
+func (p *Command) IsSetSecretEnv() bool {
+	return p.SecretEnv != nil
+}
+
 func (p *Command) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -1053,6 +1340,18 @@ func (p *Command) Read(iprot thrift.TProtocol) error {
 			if err := p.readField2(iprot); err != nil {
 				return err
 			}
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -1076,13 +1375,13 @@ func (p *Command) readField1(iprot thrift.TProtocol) error {
 	tSlice := make([]string, 0, size)
 	p.Argv = tSlice
 	for i := 0; i < size; i++ {
-		var _elem0 string
+		var _elem1 string
 		if v, err := iprot.ReadString(); err != nil {
 			return thrift.PrependError("error reading field 0: ", err)
 		} else {
-			_elem0 = v
+			_elem1 = v
 		}
-		p.Argv = append(p.Argv, _elem0)
+		p.Argv = append(p.Argv, _elem1)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -1098,19 +1397,79 @@ func (p *Command) readField2(iprot thrift.TProtocol) error {
 	tMap := make(map[string]string, size)
 	p.EnvVars = tMap
 	for i := 0; i < size; i++ {
-		var _key1 string
+		var _key2 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_key2 = v
+		}
+		var _val3 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_val3 = v
+		}
+		p.EnvVars[_key2] = _val3
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return thrift.PrependError("error reading map end: ", err)
+	}
+	return nil
+}
+
+func (p *Command) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		temp := EnvMode(v)
+		p.EnvMode = &temp
+	}
+	return nil
+}
+
+func (p *Command) readField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.EnvAllowlist = tSlice
+	for i := 0; i < size; i++ {
+		var _elem4 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem4 = v
+		}
+		p.EnvAllowlist = append(p.EnvAllowlist, _elem4)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *Command) readField5(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.PrependError("error reading map begin: ", err)
+	}
+	tMap := make(map[string]string, size)
+	p.SecretEnv = tMap
+	for i := 0; i < size; i++ {
+		var _key5 string
 		if v, err := iprot.ReadString(); err != nil {
 			return thrift.PrependError("error reading field 0: ", err)
 		} else {
-			_key1 = v
+			_key5 = v
 		}
-		var _val2 string
+		var _val6 string
 		if v, err := iprot.ReadString(); err != nil {
 			return thrift.PrependError("error reading field 0: ", err)
 		} else {
-			_val2 = v
+			_val6 = v
 		}
-		p.EnvVars[_key1] = _val2
+		p.SecretEnv[_key5] = _val6
 	}
 	if err := iprot.ReadMapEnd(); err != nil {
 		return thrift.PrependError("error reading map end: ", err)
@@ -1128,6 +1487,15 @@ func (p *Command) Write(oprot thrift.TProtocol) error {
 	if err := p.writeField2(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -1184,6 +1552,70 @@ func (p *Command) writeField2(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
+func (p *Command) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnvMode() {
+		if err := oprot.WriteFieldBegin("envMode", thrift.I32, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:envMode: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.EnvMode)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.envMode (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:envMode: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Command) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnvAllowlist() {
+		if err := oprot.WriteFieldBegin("envAllowlist", thrift.LIST, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:envAllowlist: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.EnvAllowlist)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.EnvAllowlist {
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:envAllowlist: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Command) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSecretEnv() {
+		if err := oprot.WriteFieldBegin("secretEnv", thrift.MAP, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:secretEnv: ", p), err)
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.SecretEnv)); err != nil {
+			return thrift.PrependError("error writing map begin: ", err)
+		}
+		for k, v := range p.SecretEnv {
+			if err := oprot.WriteString(string(k)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return thrift.PrependError("error writing map end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:secretEnv: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *Command) String() string {
 	if p == nil {
 		return "<nil>"
@@ -1192,79 +1624,62 @@ func (p *Command) String() string {
 }
 
 // Attributes:
-//  - Command
-//  - SnapshotId
-//  - TaskId
-//  - TimeoutMs
-type TaskDefinition struct {
-	Command    *Command `thrift:"command,1,required" json:"command"`
-	SnapshotId *string  `thrift:"snapshotId,2" json:"snapshotId,omitempty"`
-	TaskId     *string  `thrift:"taskId,3" json:"taskId,omitempty"`
-	TimeoutMs  *int32   `thrift:"timeoutMs,4" json:"timeoutMs,omitempty"`
+//  - CpuMillis
+//  - MemoryBytes
+//  - DiskBytes
+type Resources struct {
+	CpuMillis   *int64 `thrift:"cpuMillis,1" json:"cpuMillis,omitempty"`
+	MemoryBytes *int64 `thrift:"memoryBytes,2" json:"memoryBytes,omitempty"`
+	DiskBytes   *int64 `thrift:"diskBytes,3" json:"diskBytes,omitempty"`
 }
 
-func NewTaskDefinition() *TaskDefinition {
-	return &TaskDefinition{}
+func NewResources() *Resources {
+	return &Resources{}
 }
 
-var TaskDefinition_Command_DEFAULT *Command
+var Resources_CpuMillis_DEFAULT int64
 
-func (p *TaskDefinition) GetCommand() *Command {
-	if !p.IsSetCommand() {
-		return TaskDefinition_Command_DEFAULT
+func (p *Resources) GetCpuMillis() int64 {
+	if !p.IsSetCpuMillis() {
+		return Resources_CpuMillis_DEFAULT
 	}
-	return p.Command
+	return *p.CpuMillis
 }
 
-var TaskDefinition_SnapshotId_DEFAULT string
+var Resources_MemoryBytes_DEFAULT int64
 
-func (p *TaskDefinition) GetSnapshotId() string {
-	if !p.IsSetSnapshotId() {
-		return TaskDefinition_SnapshotId_DEFAULT
+func (p *Resources) GetMemoryBytes() int64 {
+	if !p.IsSetMemoryBytes() {
+		return Resources_MemoryBytes_DEFAULT
 	}
-	return *p.SnapshotId
+	return *p.MemoryBytes
 }
 
-var TaskDefinition_TaskId_DEFAULT string
+var Resources_DiskBytes_DEFAULT int64
 
-func (p *TaskDefinition) GetTaskId() string {
-	if !p.IsSetTaskId() {
-		return TaskDefinition_TaskId_DEFAULT
+func (p *Resources) GetDiskBytes() int64 {
+	if !p.IsSetDiskBytes() {
+		return Resources_DiskBytes_DEFAULT
 	}
-	return *p.TaskId
+	return *p.DiskBytes
+}
+func (p *Resources) IsSetCpuMillis() bool {
+	return p.CpuMillis != nil
 }
 
-var TaskDefinition_TimeoutMs_DEFAULT int32
-
-func (p *TaskDefinition) GetTimeoutMs() int32 {
-	if !p.IsSetTimeoutMs() {
-		return TaskDefinition_TimeoutMs_DEFAULT
-	}
-	return *p.TimeoutMs
+func (p *Resources) IsSetMemoryBytes() bool {
+	return p.MemoryBytes != nil
 }
-func (p *TaskDefinition) IsSetCommand() bool {
-	return p.Command != nil
+
+func (p *Resources) IsSetDiskBytes() bool {
+	return p.DiskBytes != nil
 }
 
-func (p *TaskDefinition) IsSetSnapshotId() bool {
-	return p.SnapshotId != nil
-}
-
-func (p *TaskDefinition) IsSetTaskId() bool {
-	return p.TaskId != nil
-}
-
-func (p *TaskDefinition) IsSetTimeoutMs() bool {
-	return p.TimeoutMs != nil
-}
-
-func (p *TaskDefinition) Read(iprot thrift.TProtocol) error {
+func (p *Resources) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetCommand bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -1278,7 +1693,6 @@ func (p *TaskDefinition) Read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
-			issetCommand = true
 		case 2:
 			if err := p.readField2(iprot); err != nil {
 				return err
@@ -1287,10 +1701,6 @@ func (p *TaskDefinition) Read(iprot thrift.TProtocol) error {
 			if err := p.readField3(iprot); err != nil {
 				return err
 			}
-		case 4:
-			if err := p.readField4(iprot); err != nil {
-				return err
-			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -1303,49 +1713,38 @@ func (p *TaskDefinition) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetCommand {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Command is not set"))
-	}
 	return nil
 }
 
-func (p *TaskDefinition) readField1(iprot thrift.TProtocol) error {
-	p.Command = &Command{}
-	if err := p.Command.Read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Command), err)
+func (p *Resources) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.CpuMillis = &v
 	}
 	return nil
 }
 
-func (p *TaskDefinition) readField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *Resources) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.SnapshotId = &v
+		p.MemoryBytes = &v
 	}
 	return nil
 }
 
-func (p *TaskDefinition) readField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *Resources) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		p.TaskId = &v
-	}
-	return nil
-}
-
-func (p *TaskDefinition) readField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		p.TimeoutMs = &v
+		p.DiskBytes = &v
 	}
 	return nil
 }
 
-func (p *TaskDefinition) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("TaskDefinition"); err != nil {
+func (p *Resources) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("Resources"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -1357,9 +1756,6 @@ func (p *TaskDefinition) Write(oprot thrift.TProtocol) error {
 	if err := p.writeField3(oprot); err != nil {
 		return err
 	}
-	if err := p.writeField4(oprot); err != nil {
-		return err
-	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -1369,195 +1765,116 @@ func (p *TaskDefinition) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *TaskDefinition) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("command", thrift.STRUCT, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:command: ", p), err)
-	}
-	if err := p.Command.Write(oprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Command), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:command: ", p), err)
-	}
-	return err
-}
-
-func (p *TaskDefinition) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSnapshotId() {
-		if err := oprot.WriteFieldBegin("snapshotId", thrift.STRING, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:snapshotId: ", p), err)
+func (p *Resources) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCpuMillis() {
+		if err := oprot.WriteFieldBegin("cpuMillis", thrift.I64, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cpuMillis: ", p), err)
 		}
-		if err := oprot.WriteString(string(*p.SnapshotId)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.snapshotId (2) field write error: ", p), err)
+		if err := oprot.WriteI64(int64(*p.CpuMillis)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.cpuMillis (1) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:snapshotId: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cpuMillis: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *TaskDefinition) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTaskId() {
-		if err := oprot.WriteFieldBegin("taskId", thrift.STRING, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:taskId: ", p), err)
+func (p *Resources) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMemoryBytes() {
+		if err := oprot.WriteFieldBegin("memoryBytes", thrift.I64, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:memoryBytes: ", p), err)
 		}
-		if err := oprot.WriteString(string(*p.TaskId)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.taskId (3) field write error: ", p), err)
+		if err := oprot.WriteI64(int64(*p.MemoryBytes)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.memoryBytes (2) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:taskId: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:memoryBytes: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *TaskDefinition) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTimeoutMs() {
-		if err := oprot.WriteFieldBegin("timeoutMs", thrift.I32, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:timeoutMs: ", p), err)
+func (p *Resources) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiskBytes() {
+		if err := oprot.WriteFieldBegin("diskBytes", thrift.I64, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:diskBytes: ", p), err)
 		}
-		if err := oprot.WriteI32(int32(*p.TimeoutMs)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.timeoutMs (4) field write error: ", p), err)
+		if err := oprot.WriteI64(int64(*p.DiskBytes)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.diskBytes (3) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:timeoutMs: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:diskBytes: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *TaskDefinition) String() string {
+func (p *Resources) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("TaskDefinition(%+v)", *p)
+	return fmt.Sprintf("Resources(%+v)", *p)
 }
 
 // Attributes:
-//  - Tasks
-//  - DEPRECATEDJobType
-//  - DefaultTaskTimeoutMs
-//  - Priority
-//  - Tag
-//  - Basis
-//  - Requestor
-//  - JobType
-type JobDefinition struct {
-	Tasks                []*TaskDefinition `thrift:"tasks,1,required" json:"tasks"`
-	DEPRECATEDJobType    *JobType          `thrift:"DEPRECATED_jobType,2" json:"DEPRECATED_jobType,omitempty"`
-	DefaultTaskTimeoutMs *int32            `thrift:"defaultTaskTimeoutMs,3" json:"defaultTaskTimeoutMs,omitempty"`
-	Priority             *int32            `thrift:"priority,4" json:"priority,omitempty"`
-	Tag                  *string           `thrift:"tag,5" json:"tag,omitempty"`
-	Basis                *string           `thrift:"basis,6" json:"basis,omitempty"`
-	Requestor            *string           `thrift:"requestor,7" json:"requestor,omitempty"`
-	JobType              *string           `thrift:"jobType,8" json:"jobType,omitempty"`
-}
-
-func NewJobDefinition() *JobDefinition {
-	return &JobDefinition{}
-}
-
-func (p *JobDefinition) GetTasks() []*TaskDefinition {
-	return p.Tasks
-}
-
-var JobDefinition_DEPRECATEDJobType_DEFAULT JobType
-
-func (p *JobDefinition) GetDEPRECATEDJobType() JobType {
-	if !p.IsSetDEPRECATEDJobType() {
-		return JobDefinition_DEPRECATEDJobType_DEFAULT
-	}
-	return *p.DEPRECATEDJobType
-}
-
-var JobDefinition_DefaultTaskTimeoutMs_DEFAULT int32
-
-func (p *JobDefinition) GetDefaultTaskTimeoutMs() int32 {
-	if !p.IsSetDefaultTaskTimeoutMs() {
-		return JobDefinition_DefaultTaskTimeoutMs_DEFAULT
-	}
-	return *p.DefaultTaskTimeoutMs
-}
-
-var JobDefinition_Priority_DEFAULT int32
-
-func (p *JobDefinition) GetPriority() int32 {
-	if !p.IsSetPriority() {
-		return JobDefinition_Priority_DEFAULT
-	}
-	return *p.Priority
+//  - Command
+//  - SnapshotId
+//  - TimeoutMs
+type Cleanup struct {
+	Command    *Command `thrift:"command,1,required" json:"command"`
+	SnapshotId *string  `thrift:"snapshotId,2" json:"snapshotId,omitempty"`
+	TimeoutMs  *int32   `thrift:"timeoutMs,3" json:"timeoutMs,omitempty"`
 }
 
-var JobDefinition_Tag_DEFAULT string
-
-func (p *JobDefinition) GetTag() string {
-	if !p.IsSetTag() {
-		return JobDefinition_Tag_DEFAULT
-	}
-	return *p.Tag
+func NewCleanup() *Cleanup {
+	return &Cleanup{}
 }
 
-var JobDefinition_Basis_DEFAULT string
+var Cleanup_Command_DEFAULT *Command
 
-func (p *JobDefinition) GetBasis() string {
-	if !p.IsSetBasis() {
-		return JobDefinition_Basis_DEFAULT
+func (p *Cleanup) GetCommand() *Command {
+	if !p.IsSetCommand() {
+		return Cleanup_Command_DEFAULT
 	}
-	return *p.Basis
+	return p.Command
 }
 
-var JobDefinition_Requestor_DEFAULT string
+var Cleanup_SnapshotId_DEFAULT string
 
-func (p *JobDefinition) GetRequestor() string {
-	if !p.IsSetRequestor() {
-		return JobDefinition_Requestor_DEFAULT
+func (p *Cleanup) GetSnapshotId() string {
+	if !p.IsSetSnapshotId() {
+		return Cleanup_SnapshotId_DEFAULT
 	}
-	return *p.Requestor
+	return *p.SnapshotId
 }
 
-var JobDefinition_JobType_DEFAULT string
+var Cleanup_TimeoutMs_DEFAULT int32
 
-func (p *JobDefinition) GetJobType() string {
-	if !p.IsSetJobType() {
-		return JobDefinition_JobType_DEFAULT
+func (p *Cleanup) GetTimeoutMs() int32 {
+	if !p.IsSetTimeoutMs() {
+		return Cleanup_TimeoutMs_DEFAULT
 	}
-	return *p.JobType
-}
-func (p *JobDefinition) IsSetDEPRECATEDJobType() bool {
-	return p.DEPRECATEDJobType != nil
-}
-
-func (p *JobDefinition) IsSetDefaultTaskTimeoutMs() bool {
-	return p.DefaultTaskTimeoutMs != nil
-}
-
-func (p *JobDefinition) IsSetPriority() bool {
-	return p.Priority != nil
-}
-
-func (p *JobDefinition) IsSetTag() bool {
-	return p.Tag != nil
+	return *p.TimeoutMs
 }
-
-func (p *JobDefinition) IsSetBasis() bool {
-	return p.Basis != nil
+func (p *Cleanup) IsSetCommand() bool {
+	return p.Command != nil
 }
 
-func (p *JobDefinition) IsSetRequestor() bool {
-	return p.Requestor != nil
+func (p *Cleanup) IsSetSnapshotId() bool {
+	return p.SnapshotId != nil
 }
 
-func (p *JobDefinition) IsSetJobType() bool {
-	return p.JobType != nil
+func (p *Cleanup) IsSetTimeoutMs() bool {
+	return p.TimeoutMs != nil
 }
 
-func (p *JobDefinition) Read(iprot thrift.TProtocol) error {
+func (p *Cleanup) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetTasks bool = false
+	var issetCommand bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -1572,7 +1889,7 @@ func (p *JobDefinition) Read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
-			issetTasks = true
+			issetCommand = true
 		case 2:
 			if err := p.readField2(iprot); err != nil {
 				return err
@@ -1581,26 +1898,6 @@ func (p *JobDefinition) Read(iprot thrift.TProtocol) error {
 			if err := p.readField3(iprot); err != nil {
 				return err
 			}
-		case 4:
-			if err := p.readField4(iprot); err != nil {
-				return err
-			}
-		case 5:
-			if err := p.readField5(iprot); err != nil {
-				return err
-			}
-		case 6:
-			if err := p.readField6(iprot); err != nil {
-				return err
-			}
-		case 7:
-			if err := p.readField7(iprot); err != nil {
-				return err
-			}
-		case 8:
-			if err := p.readField8(iprot); err != nil {
-				return err
-			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -1613,98 +1910,40 @@ func (p *JobDefinition) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetTasks {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Tasks is not set"))
+	if !issetCommand {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Command is not set"))
 	}
 	return nil
 }
 
-func (p *JobDefinition) readField1(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*TaskDefinition, 0, size)
-	p.Tasks = tSlice
-	for i := 0; i < size; i++ {
-		_elem3 := &TaskDefinition{}
-		if err := _elem3.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem3), err)
-		}
-		p.Tasks = append(p.Tasks, _elem3)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *Cleanup) readField1(iprot thrift.TProtocol) error {
+	p.Command = &Command{}
+	if err := p.Command.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Command), err)
 	}
 	return nil
 }
 
-func (p *JobDefinition) readField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *Cleanup) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		temp := JobType(v)
-		p.DEPRECATEDJobType = &temp
+		p.SnapshotId = &v
 	}
 	return nil
 }
 
-func (p *JobDefinition) readField3(iprot thrift.TProtocol) error {
+func (p *Cleanup) readField3(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return thrift.PrependError("error reading field 3: ", err)
 	} else {
-		p.DefaultTaskTimeoutMs = &v
+		p.TimeoutMs = &v
 	}
 	return nil
 }
 
-func (p *JobDefinition) readField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		p.Priority = &v
-	}
-	return nil
-}
-
-func (p *JobDefinition) readField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 5: ", err)
-	} else {
-		p.Tag = &v
-	}
-	return nil
-}
-
-func (p *JobDefinition) readField6(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 6: ", err)
-	} else {
-		p.Basis = &v
-	}
-	return nil
-}
-
-func (p *JobDefinition) readField7(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 7: ", err)
-	} else {
-		p.Requestor = &v
-	}
-	return nil
-}
-
-func (p *JobDefinition) readField8(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 8: ", err)
-	} else {
-		p.JobType = &v
-	}
-	return nil
-}
-
-func (p *JobDefinition) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("JobDefinition"); err != nil {
+func (p *Cleanup) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("Cleanup"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -1716,21 +1955,6 @@ func (p *JobDefinition) Write(oprot thrift.TProtocol) error {
 	if err := p.writeField3(oprot); err != nil {
 		return err
 	}
-	if err := p.writeField4(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField5(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField6(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField7(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField8(oprot); err != nil {
-		return err
-	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -1740,159 +1964,137 @@ func (p *JobDefinition) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JobDefinition) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("tasks", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:tasks: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Tasks)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Tasks {
-		if err := v.Write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-		}
+func (p *Cleanup) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("command", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:command: ", p), err)
 	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := p.Command.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Command), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:tasks: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:command: ", p), err)
 	}
 	return err
 }
 
-func (p *JobDefinition) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDEPRECATEDJobType() {
-		if err := oprot.WriteFieldBegin("DEPRECATED_jobType", thrift.I32, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:DEPRECATED_jobType: ", p), err)
+func (p *Cleanup) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSnapshotId() {
+		if err := oprot.WriteFieldBegin("snapshotId", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:snapshotId: ", p), err)
 		}
-		if err := oprot.WriteI32(int32(*p.DEPRECATEDJobType)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.DEPRECATED_jobType (2) field write error: ", p), err)
+		if err := oprot.WriteString(string(*p.SnapshotId)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.snapshotId (2) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:DEPRECATED_jobType: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:snapshotId: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *JobDefinition) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDefaultTaskTimeoutMs() {
-		if err := oprot.WriteFieldBegin("defaultTaskTimeoutMs", thrift.I32, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:defaultTaskTimeoutMs: ", p), err)
+func (p *Cleanup) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTimeoutMs() {
+		if err := oprot.WriteFieldBegin("timeoutMs", thrift.I32, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:timeoutMs: ", p), err)
 		}
-		if err := oprot.WriteI32(int32(*p.DefaultTaskTimeoutMs)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.defaultTaskTimeoutMs (3) field write error: ", p), err)
+		if err := oprot.WriteI32(int32(*p.TimeoutMs)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.timeoutMs (3) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:defaultTaskTimeoutMs: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:timeoutMs: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *JobDefinition) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPriority() {
-		if err := oprot.WriteFieldBegin("priority", thrift.I32, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:priority: ", p), err)
-		}
-		if err := oprot.WriteI32(int32(*p.Priority)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.priority (4) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:priority: ", p), err)
-		}
+func (p *Cleanup) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return err
+	return fmt.Sprintf("Cleanup(%+v)", *p)
 }
 
-func (p *JobDefinition) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTag() {
-		if err := oprot.WriteFieldBegin("tag", thrift.STRING, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:tag: ", p), err)
-		}
-		if err := oprot.WriteString(string(*p.Tag)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.tag (5) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:tag: ", p), err)
-		}
-	}
-	return err
+// Attributes:
+//  - MaxAttempts
+//  - RetryableExitCodes
+//  - RetryableStates
+//  - BackoffMs
+//  - MaxBackoffMs
+type RetryPolicy struct {
+	MaxAttempts        *int32           `thrift:"maxAttempts,1" json:"maxAttempts,omitempty"`
+	RetryableExitCodes []int32          `thrift:"retryableExitCodes,2" json:"retryableExitCodes,omitempty"`
+	RetryableStates    []RunStatusState `thrift:"retryableStates,3" json:"retryableStates,omitempty"`
+	BackoffMs          *int32           `thrift:"backoffMs,4" json:"backoffMs,omitempty"`
+	MaxBackoffMs       *int32           `thrift:"maxBackoffMs,5" json:"maxBackoffMs,omitempty"`
 }
 
-func (p *JobDefinition) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetBasis() {
-		if err := oprot.WriteFieldBegin("basis", thrift.STRING, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:basis: ", p), err)
-		}
-		if err := oprot.WriteString(string(*p.Basis)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.basis (6) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:basis: ", p), err)
-		}
-	}
-	return err
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{}
 }
 
-func (p *JobDefinition) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRequestor() {
-		if err := oprot.WriteFieldBegin("requestor", thrift.STRING, 7); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:requestor: ", p), err)
-		}
-		if err := oprot.WriteString(string(*p.Requestor)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.requestor (7) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:requestor: ", p), err)
-		}
+var RetryPolicy_MaxAttempts_DEFAULT int32
+
+func (p *RetryPolicy) GetMaxAttempts() int32 {
+	if !p.IsSetMaxAttempts() {
+		return RetryPolicy_MaxAttempts_DEFAULT
 	}
-	return err
+	return *p.MaxAttempts
 }
 
-func (p *JobDefinition) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetJobType() {
-		if err := oprot.WriteFieldBegin("jobType", thrift.STRING, 8); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:jobType: ", p), err)
-		}
-		if err := oprot.WriteString(string(*p.JobType)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.jobType (8) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:jobType: ", p), err)
-		}
+var RetryPolicy_RetryableExitCodes_DEFAULT []int32
+
+func (p *RetryPolicy) GetRetryableExitCodes() []int32 {
+	return p.RetryableExitCodes
+}
+
+var RetryPolicy_RetryableStates_DEFAULT []RunStatusState
+
+func (p *RetryPolicy) GetRetryableStates() []RunStatusState {
+	return p.RetryableStates
+}
+
+var RetryPolicy_BackoffMs_DEFAULT int32
+
+func (p *RetryPolicy) GetBackoffMs() int32 {
+	if !p.IsSetBackoffMs() {
+		return RetryPolicy_BackoffMs_DEFAULT
 	}
-	return err
+	return *p.BackoffMs
 }
 
-func (p *JobDefinition) String() string {
-	if p == nil {
-		return "<nil>"
+var RetryPolicy_MaxBackoffMs_DEFAULT int32
+
+func (p *RetryPolicy) GetMaxBackoffMs() int32 {
+	if !p.IsSetMaxBackoffMs() {
+		return RetryPolicy_MaxBackoffMs_DEFAULT
 	}
-	return fmt.Sprintf("JobDefinition(%+v)", *p)
+	return *p.MaxBackoffMs
+}
+func (p *RetryPolicy) IsSetMaxAttempts() bool {
+	return p.MaxAttempts != nil
 }
 
-// Attributes:
-//  - ID
-type JobId struct {
-	ID string `thrift:"id,1,required" json:"id"`
+func (p *RetryPolicy) IsSetRetryableExitCodes() bool {
+	return p.RetryableExitCodes != nil
 }
 
-func NewJobId() *JobId {
-	return &JobId{}
+func (p *RetryPolicy) IsSetRetryableStates() bool {
+	return p.RetryableStates != nil
 }
 
-func (p *JobId) GetID() string {
-	return p.ID
+func (p *RetryPolicy) IsSetBackoffMs() bool {
+	return p.BackoffMs != nil
 }
-func (p *JobId) Read(iprot thrift.TProtocol) error {
+
+func (p *RetryPolicy) IsSetMaxBackoffMs() bool {
+	return p.MaxBackoffMs != nil
+}
+
+func (p *RetryPolicy) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetID bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -1906,7 +2108,22 @@ func (p *JobId) Read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
-			issetID = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -1919,107 +2136,4395 @@ func (p *JobId) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetID {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
-	}
 	return nil
 }
 
-func (p *JobId) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *RetryPolicy) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.ID = v
+		p.MaxAttempts = &v
 	}
 	return nil
 }
 
-func (p *JobId) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("JobId"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if err := p.writeField1(oprot); err != nil {
-		return err
+func (p *RetryPolicy) readField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	if err := oprot.WriteFieldStop(); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
+	tSlice := make([]int32, 0, size)
+	p.RetryableExitCodes = tSlice
+	for i := 0; i < size; i++ {
+		var _elem7 int32
+		if v, err := iprot.ReadI32(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem7 = v
+		}
+		p.RetryableExitCodes = append(p.RetryableExitCodes, _elem7)
 	}
-	if err := oprot.WriteStructEnd(); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *JobId) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
+func (p *RetryPolicy) readField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	if err := oprot.WriteString(string(p.ID)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
+	tSlice := make([]RunStatusState, 0, size)
+	p.RetryableStates = tSlice
+	for i := 0; i < size; i++ {
+		var _elem8 RunStatusState
+		if v, err := iprot.ReadI32(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			temp := RunStatusState(v)
+			_elem8 = temp
+		}
+		p.RetryableStates = append(p.RetryableStates, _elem8)
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
-	return err
+	return nil
 }
 
-func (p *JobId) String() string {
-	if p == nil {
+func (p *RetryPolicy) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.BackoffMs = &v
+	}
+	return nil
+}
+
+func (p *RetryPolicy) readField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.MaxBackoffMs = &v
+	}
+	return nil
+}
+
+func (p *RetryPolicy) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("RetryPolicy"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *RetryPolicy) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxAttempts() {
+		if err := oprot.WriteFieldBegin("maxAttempts", thrift.I32, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:maxAttempts: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.MaxAttempts)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.maxAttempts (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:maxAttempts: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *RetryPolicy) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRetryableExitCodes() {
+		if err := oprot.WriteFieldBegin("retryableExitCodes", thrift.LIST, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:retryableExitCodes: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.I32, len(p.RetryableExitCodes)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.RetryableExitCodes {
+			if err := oprot.WriteI32(int32(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:retryableExitCodes: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *RetryPolicy) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRetryableStates() {
+		if err := oprot.WriteFieldBegin("retryableStates", thrift.LIST, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:retryableStates: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.I32, len(p.RetryableStates)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.RetryableStates {
+			if err := oprot.WriteI32(int32(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:retryableStates: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *RetryPolicy) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBackoffMs() {
+		if err := oprot.WriteFieldBegin("backoffMs", thrift.I32, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:backoffMs: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.BackoffMs)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.backoffMs (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:backoffMs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *RetryPolicy) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxBackoffMs() {
+		if err := oprot.WriteFieldBegin("maxBackoffMs", thrift.I32, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:maxBackoffMs: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.MaxBackoffMs)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.maxBackoffMs (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:maxBackoffMs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *RetryPolicy) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RetryPolicy(%+v)", *p)
+}
+
+// Attributes:
+//  - Command
+//  - SnapshotId
+//  - TaskId
+//  - TimeoutMs
+//  - OutputPaths
+//  - Dependencies
+//  - Resources
+//  - RequiredLabels
+//  - PreferredLabels
+//  - Cleanup
+//  - RetryPolicy
+type TaskDefinition struct {
+	Command         *Command     `thrift:"command,1,required" json:"command"`
+	SnapshotId      *string      `thrift:"snapshotId,2" json:"snapshotId,omitempty"`
+	TaskId          *string      `thrift:"taskId,3" json:"taskId,omitempty"`
+	TimeoutMs       *int32       `thrift:"timeoutMs,4" json:"timeoutMs,omitempty"`
+	OutputPaths     []string     `thrift:"outputPaths,5" json:"outputPaths,omitempty"`
+	Dependencies    []string     `thrift:"dependencies,6" json:"dependencies,omitempty"`
+	Resources       *Resources   `thrift:"resources,7" json:"resources,omitempty"`
+	RequiredLabels  []string     `thrift:"requiredLabels,8" json:"requiredLabels,omitempty"`
+	PreferredLabels []string     `thrift:"preferredLabels,9" json:"preferredLabels,omitempty"`
+	Cleanup         *Cleanup     `thrift:"cleanup,10" json:"cleanup,omitempty"`
+	RetryPolicy     *RetryPolicy `thrift:"retryPolicy,11" json:"retryPolicy,omitempty"`
+}
+
+func NewTaskDefinition() *TaskDefinition {
+	return &TaskDefinition{}
+}
+
+var TaskDefinition_Command_DEFAULT *Command
+
+func (p *TaskDefinition) GetCommand() *Command {
+	if !p.IsSetCommand() {
+		return TaskDefinition_Command_DEFAULT
+	}
+	return p.Command
+}
+
+var TaskDefinition_SnapshotId_DEFAULT string
+
+func (p *TaskDefinition) GetSnapshotId() string {
+	if !p.IsSetSnapshotId() {
+		return TaskDefinition_SnapshotId_DEFAULT
+	}
+	return *p.SnapshotId
+}
+
+var TaskDefinition_TaskId_DEFAULT string
+
+func (p *TaskDefinition) GetTaskId() string {
+	if !p.IsSetTaskId() {
+		return TaskDefinition_TaskId_DEFAULT
+	}
+	return *p.TaskId
+}
+
+var TaskDefinition_TimeoutMs_DEFAULT int32
+
+func (p *TaskDefinition) GetTimeoutMs() int32 {
+	if !p.IsSetTimeoutMs() {
+		return TaskDefinition_TimeoutMs_DEFAULT
+	}
+	return *p.TimeoutMs
+}
+
+var TaskDefinition_OutputPaths_DEFAULT []string
+
+func (p *TaskDefinition) GetOutputPaths() []string {
+	return p.OutputPaths
+}
+
+var TaskDefinition_Dependencies_DEFAULT []string
+
+func (p *TaskDefinition) GetDependencies() []string {
+	return p.Dependencies
+}
+
+var TaskDefinition_Resources_DEFAULT *Resources
+
+func (p *TaskDefinition) GetResources() *Resources {
+	if !p.IsSetResources() {
+		return TaskDefinition_Resources_DEFAULT
+	}
+	return p.Resources
+}
+
+var TaskDefinition_RequiredLabels_DEFAULT []string
+
+func (p *TaskDefinition) GetRequiredLabels() []string {
+	return p.RequiredLabels
+}
+
+var TaskDefinition_PreferredLabels_DEFAULT []string
+
+func (p *TaskDefinition) GetPreferredLabels() []string {
+	return p.PreferredLabels
+}
+
+var TaskDefinition_Cleanup_DEFAULT *Cleanup
+
+func (p *TaskDefinition) GetCleanup() *Cleanup {
+	if !p.IsSetCleanup() {
+		return TaskDefinition_Cleanup_DEFAULT
+	}
+	return p.Cleanup
+}
+
+var TaskDefinition_RetryPolicy_DEFAULT *RetryPolicy
+
+func (p *TaskDefinition) GetRetryPolicy() *RetryPolicy {
+	if !p.IsSetRetryPolicy() {
+		return TaskDefinition_RetryPolicy_DEFAULT
+	}
+	return p.RetryPolicy
+}
+func (p *TaskDefinition) IsSetCommand() bool {
+	return p.Command != nil
+}
+
+func (p *TaskDefinition) IsSetSnapshotId() bool {
+	return p.SnapshotId != nil
+}
+
+func (p *TaskDefinition) IsSetTaskId() bool {
+	return p.TaskId != nil
+}
+
+func (p *TaskDefinition) IsSetTimeoutMs() bool {
+	return p.TimeoutMs != nil
+}
+
+func (p *TaskDefinition) IsSetOutputPaths() bool {
+	return p.OutputPaths != nil
+}
+
+func (p *TaskDefinition) IsSetDependencies() bool {
+	return p.Dependencies != nil
+}
+
+func (p *TaskDefinition) IsSetResources() bool {
+	return p.Resources != nil
+}
+
+func (p *TaskDefinition) IsSetRequiredLabels() bool {
+	return p.RequiredLabels != nil
+}
+
+func (p *TaskDefinition) IsSetPreferredLabels() bool {
+	return p.PreferredLabels != nil
+}
+
+func (p *TaskDefinition) IsSetCleanup() bool {
+	return p.Cleanup != nil
+}
+
+func (p *TaskDefinition) IsSetRetryPolicy() bool {
+	return p.RetryPolicy != nil
+}
+
+func (p *TaskDefinition) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetCommand bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetCommand = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
+		case 7:
+			if err := p.readField7(iprot); err != nil {
+				return err
+			}
+		case 8:
+			if err := p.readField8(iprot); err != nil {
+				return err
+			}
+		case 9:
+			if err := p.readField9(iprot); err != nil {
+				return err
+			}
+		case 10:
+			if err := p.readField10(iprot); err != nil {
+				return err
+			}
+		case 11:
+			if err := p.readField11(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetCommand {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Command is not set"))
+	}
+	return nil
+}
+
+func (p *TaskDefinition) readField1(iprot thrift.TProtocol) error {
+	p.Command = &Command{}
+	if err := p.Command.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Command), err)
+	}
+	return nil
+}
+
+func (p *TaskDefinition) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.SnapshotId = &v
+	}
+	return nil
+}
+
+func (p *TaskDefinition) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.TaskId = &v
+	}
+	return nil
+}
+
+func (p *TaskDefinition) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.TimeoutMs = &v
+	}
+	return nil
+}
+
+func (p *TaskDefinition) readField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.OutputPaths = tSlice
+	for i := 0; i < size; i++ {
+		var _elem9 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem9 = v
+		}
+		p.OutputPaths = append(p.OutputPaths, _elem9)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *TaskDefinition) readField6(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.Dependencies = tSlice
+	for i := 0; i < size; i++ {
+		var _elem10 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem10 = v
+		}
+		p.Dependencies = append(p.Dependencies, _elem10)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *TaskDefinition) readField7(iprot thrift.TProtocol) error {
+	p.Resources = &Resources{}
+	if err := p.Resources.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Resources), err)
+	}
+	return nil
+}
+
+func (p *TaskDefinition) readField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.RequiredLabels = tSlice
+	for i := 0; i < size; i++ {
+		var _elem11 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem11 = v
+		}
+		p.RequiredLabels = append(p.RequiredLabels, _elem11)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *TaskDefinition) readField9(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.PreferredLabels = tSlice
+	for i := 0; i < size; i++ {
+		var _elem12 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem12 = v
+		}
+		p.PreferredLabels = append(p.PreferredLabels, _elem12)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *TaskDefinition) readField10(iprot thrift.TProtocol) error {
+	p.Cleanup = &Cleanup{}
+	if err := p.Cleanup.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Cleanup), err)
+	}
+	return nil
+}
+
+func (p *TaskDefinition) readField11(iprot thrift.TProtocol) error {
+	p.RetryPolicy = &RetryPolicy{}
+	if err := p.RetryPolicy.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.RetryPolicy), err)
+	}
+	return nil
+}
+
+func (p *TaskDefinition) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("TaskDefinition"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField8(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField9(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField10(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField11(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *TaskDefinition) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("command", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:command: ", p), err)
+	}
+	if err := p.Command.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Command), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:command: ", p), err)
+	}
+	return err
+}
+
+func (p *TaskDefinition) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSnapshotId() {
+		if err := oprot.WriteFieldBegin("snapshotId", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:snapshotId: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.SnapshotId)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.snapshotId (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:snapshotId: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TaskDefinition) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTaskId() {
+		if err := oprot.WriteFieldBegin("taskId", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:taskId: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.TaskId)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.taskId (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:taskId: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TaskDefinition) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTimeoutMs() {
+		if err := oprot.WriteFieldBegin("timeoutMs", thrift.I32, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:timeoutMs: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.TimeoutMs)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.timeoutMs (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:timeoutMs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TaskDefinition) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputPaths() {
+		if err := oprot.WriteFieldBegin("outputPaths", thrift.LIST, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:outputPaths: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.OutputPaths)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.OutputPaths {
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:outputPaths: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TaskDefinition) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDependencies() {
+		if err := oprot.WriteFieldBegin("dependencies", thrift.LIST, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:dependencies: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Dependencies)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.Dependencies {
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:dependencies: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TaskDefinition) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResources() {
+		if err := oprot.WriteFieldBegin("resources", thrift.STRUCT, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:resources: ", p), err)
+		}
+		if err := p.Resources.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Resources), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:resources: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TaskDefinition) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRequiredLabels() {
+		if err := oprot.WriteFieldBegin("requiredLabels", thrift.LIST, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:requiredLabels: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.RequiredLabels)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.RequiredLabels {
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:requiredLabels: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TaskDefinition) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPreferredLabels() {
+		if err := oprot.WriteFieldBegin("preferredLabels", thrift.LIST, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:preferredLabels: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.PreferredLabels)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.PreferredLabels {
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:preferredLabels: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TaskDefinition) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCleanup() {
+		if err := oprot.WriteFieldBegin("cleanup", thrift.STRUCT, 10); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:cleanup: ", p), err)
+		}
+		if err := p.Cleanup.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Cleanup), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:cleanup: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TaskDefinition) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRetryPolicy() {
+		if err := oprot.WriteFieldBegin("retryPolicy", thrift.STRUCT, 11); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:retryPolicy: ", p), err)
+		}
+		if err := p.RetryPolicy.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.RetryPolicy), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 11:retryPolicy: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TaskDefinition) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TaskDefinition(%+v)", *p)
+}
+
+// Attributes:
+//  - Tasks
+//  - DEPRECATEDJobType
+//  - DefaultTaskTimeoutMs
+//  - Priority
+//  - Tag
+//  - Basis
+//  - Requestor
+//  - JobType
+//  - Cleanup
+type JobDefinition struct {
+	Tasks                []*TaskDefinition `thrift:"tasks,1,required" json:"tasks"`
+	DEPRECATEDJobType    *JobType          `thrift:"DEPRECATED_jobType,2" json:"DEPRECATED_jobType,omitempty"`
+	DefaultTaskTimeoutMs *int32            `thrift:"defaultTaskTimeoutMs,3" json:"defaultTaskTimeoutMs,omitempty"`
+	Priority             *int32            `thrift:"priority,4" json:"priority,omitempty"`
+	Tag                  *string           `thrift:"tag,5" json:"tag,omitempty"`
+	Basis                *string           `thrift:"basis,6" json:"basis,omitempty"`
+	Requestor            *string           `thrift:"requestor,7" json:"requestor,omitempty"`
+	JobType              *string           `thrift:"jobType,8" json:"jobType,omitempty"`
+	Cleanup              *Cleanup          `thrift:"cleanup,9" json:"cleanup,omitempty"`
+}
+
+func NewJobDefinition() *JobDefinition {
+	return &JobDefinition{}
+}
+
+func (p *JobDefinition) GetTasks() []*TaskDefinition {
+	return p.Tasks
+}
+
+var JobDefinition_DEPRECATEDJobType_DEFAULT JobType
+
+func (p *JobDefinition) GetDEPRECATEDJobType() JobType {
+	if !p.IsSetDEPRECATEDJobType() {
+		return JobDefinition_DEPRECATEDJobType_DEFAULT
+	}
+	return *p.DEPRECATEDJobType
+}
+
+var JobDefinition_DefaultTaskTimeoutMs_DEFAULT int32
+
+func (p *JobDefinition) GetDefaultTaskTimeoutMs() int32 {
+	if !p.IsSetDefaultTaskTimeoutMs() {
+		return JobDefinition_DefaultTaskTimeoutMs_DEFAULT
+	}
+	return *p.DefaultTaskTimeoutMs
+}
+
+var JobDefinition_Priority_DEFAULT int32
+
+func (p *JobDefinition) GetPriority() int32 {
+	if !p.IsSetPriority() {
+		return JobDefinition_Priority_DEFAULT
+	}
+	return *p.Priority
+}
+
+var JobDefinition_Tag_DEFAULT string
+
+func (p *JobDefinition) GetTag() string {
+	if !p.IsSetTag() {
+		return JobDefinition_Tag_DEFAULT
+	}
+	return *p.Tag
+}
+
+var JobDefinition_Basis_DEFAULT string
+
+func (p *JobDefinition) GetBasis() string {
+	if !p.IsSetBasis() {
+		return JobDefinition_Basis_DEFAULT
+	}
+	return *p.Basis
+}
+
+var JobDefinition_Requestor_DEFAULT string
+
+func (p *JobDefinition) GetRequestor() string {
+	if !p.IsSetRequestor() {
+		return JobDefinition_Requestor_DEFAULT
+	}
+	return *p.Requestor
+}
+
+var JobDefinition_JobType_DEFAULT string
+
+func (p *JobDefinition) GetJobType() string {
+	if !p.IsSetJobType() {
+		return JobDefinition_JobType_DEFAULT
+	}
+	return *p.JobType
+}
+
+var JobDefinition_Cleanup_DEFAULT *Cleanup
+
+func (p *JobDefinition) GetCleanup() *Cleanup {
+	if !p.IsSetCleanup() {
+		return JobDefinition_Cleanup_DEFAULT
+	}
+	return p.Cleanup
+}
+func (p *JobDefinition) IsSetDEPRECATEDJobType() bool {
+	return p.DEPRECATEDJobType != nil
+}
+
+func (p *JobDefinition) IsSetDefaultTaskTimeoutMs() bool {
+	return p.DefaultTaskTimeoutMs != nil
+}
+
+func (p *JobDefinition) IsSetPriority() bool {
+	return p.Priority != nil
+}
+
+func (p *JobDefinition) IsSetTag() bool {
+	return p.Tag != nil
+}
+
+func (p *JobDefinition) IsSetBasis() bool {
+	return p.Basis != nil
+}
+
+func (p *JobDefinition) IsSetRequestor() bool {
+	return p.Requestor != nil
+}
+
+func (p *JobDefinition) IsSetJobType() bool {
+	return p.JobType != nil
+}
+
+func (p *JobDefinition) IsSetCleanup() bool {
+	return p.Cleanup != nil
+}
+
+func (p *JobDefinition) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetTasks bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetTasks = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
+		case 7:
+			if err := p.readField7(iprot); err != nil {
+				return err
+			}
+		case 8:
+			if err := p.readField8(iprot); err != nil {
+				return err
+			}
+		case 9:
+			if err := p.readField9(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetTasks {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Tasks is not set"))
+	}
+	return nil
+}
+
+func (p *JobDefinition) readField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*TaskDefinition, 0, size)
+	p.Tasks = tSlice
+	for i := 0; i < size; i++ {
+		_elem13 := &TaskDefinition{}
+		if err := _elem13.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem13), err)
+		}
+		p.Tasks = append(p.Tasks, _elem13)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JobDefinition) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := JobType(v)
+		p.DEPRECATEDJobType = &temp
+	}
+	return nil
+}
+
+func (p *JobDefinition) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.DefaultTaskTimeoutMs = &v
+	}
+	return nil
+}
+
+func (p *JobDefinition) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Priority = &v
+	}
+	return nil
+}
+
+func (p *JobDefinition) readField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.Tag = &v
+	}
+	return nil
+}
+
+func (p *JobDefinition) readField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.Basis = &v
+	}
+	return nil
+}
+
+func (p *JobDefinition) readField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.Requestor = &v
+	}
+	return nil
+}
+
+func (p *JobDefinition) readField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.JobType = &v
+	}
+	return nil
+}
+
+func (p *JobDefinition) readField9(iprot thrift.TProtocol) error {
+	p.Cleanup = &Cleanup{}
+	if err := p.Cleanup.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Cleanup), err)
+	}
+	return nil
+}
+
+func (p *JobDefinition) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("JobDefinition"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField8(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField9(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JobDefinition) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("tasks", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:tasks: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Tasks)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Tasks {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:tasks: ", p), err)
+	}
+	return err
+}
+
+func (p *JobDefinition) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDEPRECATEDJobType() {
+		if err := oprot.WriteFieldBegin("DEPRECATED_jobType", thrift.I32, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:DEPRECATED_jobType: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.DEPRECATEDJobType)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.DEPRECATED_jobType (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:DEPRECATED_jobType: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobDefinition) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDefaultTaskTimeoutMs() {
+		if err := oprot.WriteFieldBegin("defaultTaskTimeoutMs", thrift.I32, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:defaultTaskTimeoutMs: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.DefaultTaskTimeoutMs)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.defaultTaskTimeoutMs (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:defaultTaskTimeoutMs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobDefinition) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPriority() {
+		if err := oprot.WriteFieldBegin("priority", thrift.I32, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:priority: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.Priority)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.priority (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:priority: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobDefinition) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTag() {
+		if err := oprot.WriteFieldBegin("tag", thrift.STRING, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:tag: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Tag)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.tag (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:tag: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobDefinition) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBasis() {
+		if err := oprot.WriteFieldBegin("basis", thrift.STRING, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:basis: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Basis)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.basis (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:basis: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobDefinition) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRequestor() {
+		if err := oprot.WriteFieldBegin("requestor", thrift.STRING, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:requestor: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Requestor)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.requestor (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:requestor: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobDefinition) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetJobType() {
+		if err := oprot.WriteFieldBegin("jobType", thrift.STRING, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:jobType: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.JobType)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.jobType (8) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:jobType: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobDefinition) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCleanup() {
+		if err := oprot.WriteFieldBegin("cleanup", thrift.STRUCT, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:cleanup: ", p), err)
+		}
+		if err := p.Cleanup.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Cleanup), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:cleanup: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobDefinition) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobDefinition(%+v)", *p)
+}
+
+// Attributes:
+//  - ID
+type JobId struct {
+	ID string `thrift:"id,1,required" json:"id"`
+}
+
+func NewJobId() *JobId {
+	return &JobId{}
+}
+
+func (p *JobId) GetID() string {
+	return p.ID
+}
+func (p *JobId) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetID bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetID = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetID {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
+	}
+	return nil
+}
+
+func (p *JobId) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
+	}
+	return nil
+}
+
+func (p *JobId) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("JobId"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JobId) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.ID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
+	}
+	return err
+}
+
+func (p *JobId) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobId(%+v)", *p)
+}
+
+// Attributes:
+//  - ID
+//  - Status
+//  - TaskStatus
+//  - TaskData
+//  - UnschedulableTasks
+type JobStatus struct {
+	ID                 string                `thrift:"id,1,required" json:"id"`
+	Status             Status                `thrift:"status,2,required" json:"status"`
+	TaskStatus         map[string]Status     `thrift:"taskStatus,3" json:"taskStatus,omitempty"`
+	TaskData           map[string]*RunStatus `thrift:"taskData,4" json:"taskData,omitempty"`
+	UnschedulableTasks map[string]string     `thrift:"unschedulableTasks,5" json:"unschedulableTasks,omitempty"`
+}
+
+func NewJobStatus() *JobStatus {
+	return &JobStatus{}
+}
+
+func (p *JobStatus) GetID() string {
+	return p.ID
+}
+
+func (p *JobStatus) GetStatus() Status {
+	return p.Status
+}
+
+var JobStatus_TaskStatus_DEFAULT map[string]Status
+
+func (p *JobStatus) GetTaskStatus() map[string]Status {
+	return p.TaskStatus
+}
+
+var JobStatus_TaskData_DEFAULT map[string]*RunStatus
+
+func (p *JobStatus) GetTaskData() map[string]*RunStatus {
+	return p.TaskData
+}
+
+var JobStatus_UnschedulableTasks_DEFAULT map[string]string
+
+func (p *JobStatus) GetUnschedulableTasks() map[string]string {
+	return p.UnschedulableTasks
+}
+func (p *JobStatus) IsSetTaskStatus() bool {
+	return p.TaskStatus != nil
+}
+
+func (p *JobStatus) IsSetTaskData() bool {
+	return p.TaskData != nil
+}
+
+func (p *JobStatus) IsSetUnschedulableTasks() bool {
+	return p.UnschedulableTasks != nil
+}
+
+func (p *JobStatus) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetID bool = false
+	var issetStatus bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetID = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetStatus = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetID {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
+	}
+	if !issetStatus {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Status is not set"))
+	}
+	return nil
+}
+
+func (p *JobStatus) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
+	}
+	return nil
+}
+
+func (p *JobStatus) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := Status(v)
+		p.Status = temp
+	}
+	return nil
+}
+
+func (p *JobStatus) readField3(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.PrependError("error reading map begin: ", err)
+	}
+	tMap := make(map[string]Status, size)
+	p.TaskStatus = tMap
+	for i := 0; i < size; i++ {
+		var _key14 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_key14 = v
+		}
+		var _val15 Status
+		if v, err := iprot.ReadI32(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			temp := Status(v)
+			_val15 = temp
+		}
+		p.TaskStatus[_key14] = _val15
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return thrift.PrependError("error reading map end: ", err)
+	}
+	return nil
+}
+
+func (p *JobStatus) readField4(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.PrependError("error reading map begin: ", err)
+	}
+	tMap := make(map[string]*RunStatus, size)
+	p.TaskData = tMap
+	for i := 0; i < size; i++ {
+		var _key16 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_key16 = v
+		}
+		_val17 := &RunStatus{}
+		if err := _val17.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _val17), err)
+		}
+		p.TaskData[_key16] = _val17
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return thrift.PrependError("error reading map end: ", err)
+	}
+	return nil
+}
+
+func (p *JobStatus) readField5(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.PrependError("error reading map begin: ", err)
+	}
+	tMap := make(map[string]string, size)
+	p.UnschedulableTasks = tMap
+	for i := 0; i < size; i++ {
+		var _key18 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_key18 = v
+		}
+		var _val19 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_val19 = v
+		}
+		p.UnschedulableTasks[_key18] = _val19
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return thrift.PrependError("error reading map end: ", err)
+	}
+	return nil
+}
+
+func (p *JobStatus) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("JobStatus"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JobStatus) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.ID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
+	}
+	return err
+}
+
+func (p *JobStatus) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("status", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:status: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.Status)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.status (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:status: ", p), err)
+	}
+	return err
+}
+
+func (p *JobStatus) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTaskStatus() {
+		if err := oprot.WriteFieldBegin("taskStatus", thrift.MAP, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:taskStatus: ", p), err)
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.I32, len(p.TaskStatus)); err != nil {
+			return thrift.PrependError("error writing map begin: ", err)
+		}
+		for k, v := range p.TaskStatus {
+			if err := oprot.WriteString(string(k)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+			if err := oprot.WriteI32(int32(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return thrift.PrependError("error writing map end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:taskStatus: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobStatus) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTaskData() {
+		if err := oprot.WriteFieldBegin("taskData", thrift.MAP, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:taskData: ", p), err)
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.TaskData)); err != nil {
+			return thrift.PrependError("error writing map begin: ", err)
+		}
+		for k, v := range p.TaskData {
+			if err := oprot.WriteString(string(k)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+			if err := v.Write(oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return thrift.PrependError("error writing map end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:taskData: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobStatus) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUnschedulableTasks() {
+		if err := oprot.WriteFieldBegin("unschedulableTasks", thrift.MAP, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:unschedulableTasks: ", p), err)
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.UnschedulableTasks)); err != nil {
+			return thrift.PrependError("error writing map begin: ", err)
+		}
+		for k, v := range p.UnschedulableTasks {
+			if err := oprot.WriteString(string(k)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return thrift.PrependError("error writing map end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:unschedulableTasks: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobStatus) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobStatus(%+v)", *p)
+}
+
+// Attributes:
+//  - JobId
+//  - SinceVersion
+//  - TimeoutMs
+type WatchJobRequest struct {
+	JobId        string `thrift:"jobId,1,required" json:"jobId"`
+	SinceVersion *int64 `thrift:"sinceVersion,2" json:"sinceVersion,omitempty"`
+	TimeoutMs    *int32 `thrift:"timeoutMs,3" json:"timeoutMs,omitempty"`
+}
+
+func NewWatchJobRequest() *WatchJobRequest {
+	return &WatchJobRequest{}
+}
+
+func (p *WatchJobRequest) GetJobId() string {
+	return p.JobId
+}
+
+var WatchJobRequest_SinceVersion_DEFAULT int64
+
+func (p *WatchJobRequest) GetSinceVersion() int64 {
+	if !p.IsSetSinceVersion() {
+		return WatchJobRequest_SinceVersion_DEFAULT
+	}
+	return *p.SinceVersion
+}
+
+var WatchJobRequest_TimeoutMs_DEFAULT int32
+
+func (p *WatchJobRequest) GetTimeoutMs() int32 {
+	if !p.IsSetTimeoutMs() {
+		return WatchJobRequest_TimeoutMs_DEFAULT
+	}
+	return *p.TimeoutMs
+}
+func (p *WatchJobRequest) IsSetSinceVersion() bool {
+	return p.SinceVersion != nil
+}
+
+func (p *WatchJobRequest) IsSetTimeoutMs() bool {
+	return p.TimeoutMs != nil
+}
+
+func (p *WatchJobRequest) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetJobId bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetJobId = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetJobId {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field JobId is not set"))
+	}
+	return nil
+}
+
+func (p *WatchJobRequest) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.JobId = v
+	}
+	return nil
+}
+
+func (p *WatchJobRequest) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.SinceVersion = &v
+	}
+	return nil
+}
+
+func (p *WatchJobRequest) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.TimeoutMs = &v
+	}
+	return nil
+}
+
+func (p *WatchJobRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("WatchJobRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *WatchJobRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("jobId", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jobId: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.JobId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.jobId (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jobId: ", p), err)
+	}
+	return err
+}
+
+func (p *WatchJobRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSinceVersion() {
+		if err := oprot.WriteFieldBegin("sinceVersion", thrift.I64, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:sinceVersion: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.SinceVersion)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.sinceVersion (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:sinceVersion: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *WatchJobRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTimeoutMs() {
+		if err := oprot.WriteFieldBegin("timeoutMs", thrift.I32, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:timeoutMs: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.TimeoutMs)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.timeoutMs (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:timeoutMs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *WatchJobRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WatchJobRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - JobStatus
+//  - Version
+type WatchJobResponse struct {
+	JobStatus *JobStatus `thrift:"jobStatus,1,required" json:"jobStatus"`
+	Version   int64      `thrift:"version,2,required" json:"version"`
+}
+
+func NewWatchJobResponse() *WatchJobResponse {
+	return &WatchJobResponse{}
+}
+
+var WatchJobResponse_JobStatus_DEFAULT *JobStatus
+
+func (p *WatchJobResponse) GetJobStatus() *JobStatus {
+	if !p.IsSetJobStatus() {
+		return WatchJobResponse_JobStatus_DEFAULT
+	}
+	return p.JobStatus
+}
+
+func (p *WatchJobResponse) GetVersion() int64 {
+	return p.Version
+}
+func (p *WatchJobResponse) IsSetJobStatus() bool {
+	return p.JobStatus != nil
+}
+
+func (p *WatchJobResponse) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetJobStatus bool = false
+	var issetVersion bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetJobStatus = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetVersion = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetJobStatus {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field JobStatus is not set"))
+	}
+	if !issetVersion {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Version is not set"))
+	}
+	return nil
+}
+
+func (p *WatchJobResponse) readField1(iprot thrift.TProtocol) error {
+	p.JobStatus = &JobStatus{}
+	if err := p.JobStatus.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JobStatus), err)
+	}
+	return nil
+}
+
+func (p *WatchJobResponse) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Version = v
+	}
+	return nil
+}
+
+func (p *WatchJobResponse) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("WatchJobResponse"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *WatchJobResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("jobStatus", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jobStatus: ", p), err)
+	}
+	if err := p.JobStatus.Write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JobStatus), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jobStatus: ", p), err)
+	}
+	return err
+}
+
+func (p *WatchJobResponse) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("version", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:version: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.Version)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.version (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:version: ", p), err)
+	}
+	return err
+}
+
+func (p *WatchJobResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WatchJobResponse(%+v)", *p)
+}
+
+// Attributes:
+//  - Requestor
+//  - Tag
+//  - Basis
+//  - JobType
+//  - Priority
+//  - Status
+//  - IncludeHistory
+//  - MaxJobs
+type ListJobsRequest struct {
+	Requestor      *string `thrift:"requestor,1" json:"requestor,omitempty"`
+	Tag            *string `thrift:"tag,2" json:"tag,omitempty"`
+	Basis          *string `thrift:"basis,3" json:"basis,omitempty"`
+	JobType        *string `thrift:"jobType,4" json:"jobType,omitempty"`
+	Priority       *int32  `thrift:"priority,5" json:"priority,omitempty"`
+	Status         *Status `thrift:"status,6" json:"status,omitempty"`
+	IncludeHistory *bool   `thrift:"includeHistory,7" json:"includeHistory,omitempty"`
+	MaxJobs        *int32  `thrift:"maxJobs,8" json:"maxJobs,omitempty"`
+}
+
+func NewListJobsRequest() *ListJobsRequest {
+	return &ListJobsRequest{}
+}
+
+var ListJobsRequest_Requestor_DEFAULT string
+
+func (p *ListJobsRequest) GetRequestor() string {
+	if !p.IsSetRequestor() {
+		return ListJobsRequest_Requestor_DEFAULT
+	}
+	return *p.Requestor
+}
+
+var ListJobsRequest_Tag_DEFAULT string
+
+func (p *ListJobsRequest) GetTag() string {
+	if !p.IsSetTag() {
+		return ListJobsRequest_Tag_DEFAULT
+	}
+	return *p.Tag
+}
+
+var ListJobsRequest_Basis_DEFAULT string
+
+func (p *ListJobsRequest) GetBasis() string {
+	if !p.IsSetBasis() {
+		return ListJobsRequest_Basis_DEFAULT
+	}
+	return *p.Basis
+}
+
+var ListJobsRequest_JobType_DEFAULT string
+
+func (p *ListJobsRequest) GetJobType() string {
+	if !p.IsSetJobType() {
+		return ListJobsRequest_JobType_DEFAULT
+	}
+	return *p.JobType
+}
+
+var ListJobsRequest_Priority_DEFAULT int32
+
+func (p *ListJobsRequest) GetPriority() int32 {
+	if !p.IsSetPriority() {
+		return ListJobsRequest_Priority_DEFAULT
+	}
+	return *p.Priority
+}
+
+var ListJobsRequest_Status_DEFAULT Status
+
+func (p *ListJobsRequest) GetStatus() Status {
+	if !p.IsSetStatus() {
+		return ListJobsRequest_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var ListJobsRequest_IncludeHistory_DEFAULT bool
+
+func (p *ListJobsRequest) GetIncludeHistory() bool {
+	if !p.IsSetIncludeHistory() {
+		return ListJobsRequest_IncludeHistory_DEFAULT
+	}
+	return *p.IncludeHistory
+}
+
+var ListJobsRequest_MaxJobs_DEFAULT int32
+
+func (p *ListJobsRequest) GetMaxJobs() int32 {
+	if !p.IsSetMaxJobs() {
+		return ListJobsRequest_MaxJobs_DEFAULT
+	}
+	return *p.MaxJobs
+}
+func (p *ListJobsRequest) IsSetRequestor() bool {
+	return p.Requestor != nil
+}
+
+func (p *ListJobsRequest) IsSetTag() bool {
+	return p.Tag != nil
+}
+
+func (p *ListJobsRequest) IsSetBasis() bool {
+	return p.Basis != nil
+}
+
+func (p *ListJobsRequest) IsSetJobType() bool {
+	return p.JobType != nil
+}
+
+func (p *ListJobsRequest) IsSetPriority() bool {
+	return p.Priority != nil
+}
+
+func (p *ListJobsRequest) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *ListJobsRequest) IsSetIncludeHistory() bool {
+	return p.IncludeHistory != nil
+}
+
+func (p *ListJobsRequest) IsSetMaxJobs() bool {
+	return p.MaxJobs != nil
+}
+
+func (p *ListJobsRequest) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
+		case 7:
+			if err := p.readField7(iprot); err != nil {
+				return err
+			}
+		case 8:
+			if err := p.readField8(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *ListJobsRequest) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Requestor = &v
+	}
+	return nil
+}
+
+func (p *ListJobsRequest) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Tag = &v
+	}
+	return nil
+}
+
+func (p *ListJobsRequest) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Basis = &v
+	}
+	return nil
+}
+
+func (p *ListJobsRequest) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.JobType = &v
+	}
+	return nil
+}
+
+func (p *ListJobsRequest) readField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.Priority = &v
+	}
+	return nil
+}
+
+func (p *ListJobsRequest) readField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		temp := Status(v)
+		p.Status = &temp
+	}
+	return nil
+}
+
+func (p *ListJobsRequest) readField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.IncludeHistory = &v
+	}
+	return nil
+}
+
+func (p *ListJobsRequest) readField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.MaxJobs = &v
+	}
+	return nil
+}
+
+func (p *ListJobsRequest) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ListJobsRequest"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField8(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ListJobsRequest) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRequestor() {
+		if err := oprot.WriteFieldBegin("requestor", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:requestor: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Requestor)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.requestor (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:requestor: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ListJobsRequest) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTag() {
+		if err := oprot.WriteFieldBegin("tag", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:tag: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Tag)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.tag (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:tag: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ListJobsRequest) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBasis() {
+		if err := oprot.WriteFieldBegin("basis", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:basis: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Basis)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.basis (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:basis: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ListJobsRequest) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetJobType() {
+		if err := oprot.WriteFieldBegin("jobType", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:jobType: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.JobType)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.jobType (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:jobType: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ListJobsRequest) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPriority() {
+		if err := oprot.WriteFieldBegin("priority", thrift.I32, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:priority: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.Priority)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.priority (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:priority: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ListJobsRequest) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatus() {
+		if err := oprot.WriteFieldBegin("status", thrift.I32, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:status: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.Status)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.status (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:status: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ListJobsRequest) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIncludeHistory() {
+		if err := oprot.WriteFieldBegin("includeHistory", thrift.BOOL, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:includeHistory: ", p), err)
+		}
+		if err := oprot.WriteBool(bool(*p.IncludeHistory)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.includeHistory (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:includeHistory: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ListJobsRequest) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxJobs() {
+		if err := oprot.WriteFieldBegin("maxJobs", thrift.I32, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:maxJobs: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.MaxJobs)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.maxJobs (8) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:maxJobs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ListJobsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListJobsRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - ID
+//  - Status
+//  - Requestor
+//  - Tag
+//  - Basis
+//  - JobType
+//  - Priority
+//  - NumTasks
+//  - NumRunningTasks
+//  - NumCompletedTasks
+type JobSummary struct {
+	ID                string  `thrift:"id,1,required" json:"id"`
+	Status            Status  `thrift:"status,2,required" json:"status"`
+	Requestor         *string `thrift:"requestor,3" json:"requestor,omitempty"`
+	Tag               *string `thrift:"tag,4" json:"tag,omitempty"`
+	Basis             *string `thrift:"basis,5" json:"basis,omitempty"`
+	JobType           *string `thrift:"jobType,6" json:"jobType,omitempty"`
+	Priority          *int32  `thrift:"priority,7" json:"priority,omitempty"`
+	NumTasks          *int32  `thrift:"numTasks,8" json:"numTasks,omitempty"`
+	NumRunningTasks   *int32  `thrift:"numRunningTasks,9" json:"numRunningTasks,omitempty"`
+	NumCompletedTasks *int32  `thrift:"numCompletedTasks,10" json:"numCompletedTasks,omitempty"`
+}
+
+func NewJobSummary() *JobSummary {
+	return &JobSummary{}
+}
+
+func (p *JobSummary) GetID() string {
+	return p.ID
+}
+
+func (p *JobSummary) GetStatus() Status {
+	return p.Status
+}
+
+var JobSummary_Requestor_DEFAULT string
+
+func (p *JobSummary) GetRequestor() string {
+	if !p.IsSetRequestor() {
+		return JobSummary_Requestor_DEFAULT
+	}
+	return *p.Requestor
+}
+
+var JobSummary_Tag_DEFAULT string
+
+func (p *JobSummary) GetTag() string {
+	if !p.IsSetTag() {
+		return JobSummary_Tag_DEFAULT
+	}
+	return *p.Tag
+}
+
+var JobSummary_Basis_DEFAULT string
+
+func (p *JobSummary) GetBasis() string {
+	if !p.IsSetBasis() {
+		return JobSummary_Basis_DEFAULT
+	}
+	return *p.Basis
+}
+
+var JobSummary_JobType_DEFAULT string
+
+func (p *JobSummary) GetJobType() string {
+	if !p.IsSetJobType() {
+		return JobSummary_JobType_DEFAULT
+	}
+	return *p.JobType
+}
+
+var JobSummary_Priority_DEFAULT int32
+
+func (p *JobSummary) GetPriority() int32 {
+	if !p.IsSetPriority() {
+		return JobSummary_Priority_DEFAULT
+	}
+	return *p.Priority
+}
+
+var JobSummary_NumTasks_DEFAULT int32
+
+func (p *JobSummary) GetNumTasks() int32 {
+	if !p.IsSetNumTasks() {
+		return JobSummary_NumTasks_DEFAULT
+	}
+	return *p.NumTasks
+}
+
+var JobSummary_NumRunningTasks_DEFAULT int32
+
+func (p *JobSummary) GetNumRunningTasks() int32 {
+	if !p.IsSetNumRunningTasks() {
+		return JobSummary_NumRunningTasks_DEFAULT
+	}
+	return *p.NumRunningTasks
+}
+
+var JobSummary_NumCompletedTasks_DEFAULT int32
+
+func (p *JobSummary) GetNumCompletedTasks() int32 {
+	if !p.IsSetNumCompletedTasks() {
+		return JobSummary_NumCompletedTasks_DEFAULT
+	}
+	return *p.NumCompletedTasks
+}
+func (p *JobSummary) IsSetRequestor() bool {
+	return p.Requestor != nil
+}
+
+func (p *JobSummary) IsSetTag() bool {
+	return p.Tag != nil
+}
+
+func (p *JobSummary) IsSetBasis() bool {
+	return p.Basis != nil
+}
+
+func (p *JobSummary) IsSetJobType() bool {
+	return p.JobType != nil
+}
+
+func (p *JobSummary) IsSetPriority() bool {
+	return p.Priority != nil
+}
+
+func (p *JobSummary) IsSetNumTasks() bool {
+	return p.NumTasks != nil
+}
+
+func (p *JobSummary) IsSetNumRunningTasks() bool {
+	return p.NumRunningTasks != nil
+}
+
+func (p *JobSummary) IsSetNumCompletedTasks() bool {
+	return p.NumCompletedTasks != nil
+}
+
+func (p *JobSummary) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetID bool = false
+	var issetStatus bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetID = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetStatus = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
+		case 7:
+			if err := p.readField7(iprot); err != nil {
+				return err
+			}
+		case 8:
+			if err := p.readField8(iprot); err != nil {
+				return err
+			}
+		case 9:
+			if err := p.readField9(iprot); err != nil {
+				return err
+			}
+		case 10:
+			if err := p.readField10(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetID {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
+	}
+	if !issetStatus {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Status is not set"))
+	}
+	return nil
+}
+
+func (p *JobSummary) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
+	}
+	return nil
+}
+
+func (p *JobSummary) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := Status(v)
+		p.Status = temp
+	}
+	return nil
+}
+
+func (p *JobSummary) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Requestor = &v
+	}
+	return nil
+}
+
+func (p *JobSummary) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Tag = &v
+	}
+	return nil
+}
+
+func (p *JobSummary) readField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.Basis = &v
+	}
+	return nil
+}
+
+func (p *JobSummary) readField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.JobType = &v
+	}
+	return nil
+}
+
+func (p *JobSummary) readField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.Priority = &v
+	}
+	return nil
+}
+
+func (p *JobSummary) readField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.NumTasks = &v
+	}
+	return nil
+}
+
+func (p *JobSummary) readField9(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.NumRunningTasks = &v
+	}
+	return nil
+}
+
+func (p *JobSummary) readField10(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 10: ", err)
+	} else {
+		p.NumCompletedTasks = &v
+	}
+	return nil
+}
+
+func (p *JobSummary) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("JobSummary"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField8(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField9(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField10(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JobSummary) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.ID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
+	}
+	return err
+}
+
+func (p *JobSummary) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("status", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:status: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.Status)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.status (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:status: ", p), err)
+	}
+	return err
+}
+
+func (p *JobSummary) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRequestor() {
+		if err := oprot.WriteFieldBegin("requestor", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:requestor: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Requestor)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.requestor (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:requestor: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobSummary) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTag() {
+		if err := oprot.WriteFieldBegin("tag", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:tag: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Tag)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.tag (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:tag: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobSummary) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBasis() {
+		if err := oprot.WriteFieldBegin("basis", thrift.STRING, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:basis: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Basis)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.basis (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:basis: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobSummary) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetJobType() {
+		if err := oprot.WriteFieldBegin("jobType", thrift.STRING, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:jobType: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.JobType)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.jobType (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:jobType: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobSummary) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPriority() {
+		if err := oprot.WriteFieldBegin("priority", thrift.I32, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:priority: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.Priority)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.priority (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:priority: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobSummary) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNumTasks() {
+		if err := oprot.WriteFieldBegin("numTasks", thrift.I32, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:numTasks: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.NumTasks)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.numTasks (8) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:numTasks: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobSummary) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNumRunningTasks() {
+		if err := oprot.WriteFieldBegin("numRunningTasks", thrift.I32, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:numRunningTasks: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.NumRunningTasks)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.numRunningTasks (9) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:numRunningTasks: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobSummary) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNumCompletedTasks() {
+		if err := oprot.WriteFieldBegin("numCompletedTasks", thrift.I32, 10); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:numCompletedTasks: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.NumCompletedTasks)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.numCompletedTasks (10) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:numCompletedTasks: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobSummary) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobSummary(%+v)", *p)
+}
+
+// Attributes:
+//  - Jobs
+type ListJobsResponse struct {
+	Jobs []*JobSummary `thrift:"jobs,1,required" json:"jobs"`
+}
+
+func NewListJobsResponse() *ListJobsResponse {
+	return &ListJobsResponse{}
+}
+
+func (p *ListJobsResponse) GetJobs() []*JobSummary {
+	return p.Jobs
+}
+func (p *ListJobsResponse) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetJobs bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetJobs = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetJobs {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Jobs is not set"))
+	}
+	return nil
+}
+
+func (p *ListJobsResponse) readField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*JobSummary, 0, size)
+	p.Jobs = tSlice
+	for i := 0; i < size; i++ {
+		_elem20 := &JobSummary{}
+		if err := _elem20.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem20), err)
+		}
+		p.Jobs = append(p.Jobs, _elem20)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ListJobsResponse) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ListJobsResponse"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ListJobsResponse) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("jobs", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jobs: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Jobs)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Jobs {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jobs: ", p), err)
+	}
+	return err
+}
+
+func (p *ListJobsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListJobsResponse(%+v)", *p)
+}
+
+// Attributes:
+//  - TaskId
+//  - Reason
+//  - Detail
+type TaskExplanation struct {
+	TaskId string     `thrift:"taskId,1,required" json:"taskId"`
+	Reason WaitReason `thrift:"reason,2,required" json:"reason"`
+	Detail *string    `thrift:"detail,3" json:"detail,omitempty"`
+}
+
+func NewTaskExplanation() *TaskExplanation {
+	return &TaskExplanation{}
+}
+
+func (p *TaskExplanation) GetTaskId() string {
+	return p.TaskId
+}
+
+func (p *TaskExplanation) GetReason() WaitReason {
+	return p.Reason
+}
+
+var TaskExplanation_Detail_DEFAULT string
+
+func (p *TaskExplanation) GetDetail() string {
+	if !p.IsSetDetail() {
+		return TaskExplanation_Detail_DEFAULT
+	}
+	return *p.Detail
+}
+func (p *TaskExplanation) IsSetDetail() bool {
+	return p.Detail != nil
+}
+
+func (p *TaskExplanation) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetTaskId bool = false
+	var issetReason bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetTaskId = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetReason = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetTaskId {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TaskId is not set"))
+	}
+	if !issetReason {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Reason is not set"))
+	}
+	return nil
+}
+
+func (p *TaskExplanation) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.TaskId = v
+	}
+	return nil
+}
+
+func (p *TaskExplanation) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := WaitReason(v)
+		p.Reason = temp
+	}
+	return nil
+}
+
+func (p *TaskExplanation) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Detail = &v
+	}
+	return nil
+}
+
+func (p *TaskExplanation) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("TaskExplanation"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *TaskExplanation) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("taskId", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:taskId: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.TaskId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.taskId (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:taskId: ", p), err)
+	}
+	return err
+}
+
+func (p *TaskExplanation) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("reason", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:reason: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.Reason)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.reason (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:reason: ", p), err)
+	}
+	return err
+}
+
+func (p *TaskExplanation) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDetail() {
+		if err := oprot.WriteFieldBegin("detail", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:detail: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Detail)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.detail (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:detail: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TaskExplanation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TaskExplanation(%+v)", *p)
+}
+
+// Attributes:
+//  - JobId
+//  - Status
+//  - WaitingTasks
+//  - Notes
+//  - ExplainedAtMs
+type JobExplanation struct {
+	JobId         string             `thrift:"jobId,1,required" json:"jobId"`
+	Status        Status             `thrift:"status,2,required" json:"status"`
+	WaitingTasks  []*TaskExplanation `thrift:"waitingTasks,3,required" json:"waitingTasks"`
+	Notes         []string           `thrift:"notes,4" json:"notes,omitempty"`
+	ExplainedAtMs *int64             `thrift:"explainedAtMs,5" json:"explainedAtMs,omitempty"`
+}
+
+func NewJobExplanation() *JobExplanation {
+	return &JobExplanation{}
+}
+
+func (p *JobExplanation) GetJobId() string {
+	return p.JobId
+}
+
+func (p *JobExplanation) GetStatus() Status {
+	return p.Status
+}
+
+func (p *JobExplanation) GetWaitingTasks() []*TaskExplanation {
+	return p.WaitingTasks
+}
+
+var JobExplanation_Notes_DEFAULT []string
+
+func (p *JobExplanation) GetNotes() []string {
+	return p.Notes
+}
+
+var JobExplanation_ExplainedAtMs_DEFAULT int64
+
+func (p *JobExplanation) GetExplainedAtMs() int64 {
+	if !p.IsSetExplainedAtMs() {
+		return JobExplanation_ExplainedAtMs_DEFAULT
+	}
+	return *p.ExplainedAtMs
+}
+func (p *JobExplanation) IsSetNotes() bool {
+	return p.Notes != nil
+}
+
+func (p *JobExplanation) IsSetExplainedAtMs() bool {
+	return p.ExplainedAtMs != nil
+}
+
+func (p *JobExplanation) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetJobId bool = false
+	var issetStatus bool = false
+	var issetWaitingTasks bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetJobId = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetStatus = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+			issetWaitingTasks = true
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetJobId {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field JobId is not set"))
+	}
+	if !issetStatus {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Status is not set"))
+	}
+	if !issetWaitingTasks {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field WaitingTasks is not set"))
+	}
+	return nil
+}
+
+func (p *JobExplanation) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.JobId = v
+	}
+	return nil
+}
+
+func (p *JobExplanation) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := Status(v)
+		p.Status = temp
+	}
+	return nil
+}
+
+func (p *JobExplanation) readField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*TaskExplanation, 0, size)
+	p.WaitingTasks = tSlice
+	for i := 0; i < size; i++ {
+		_elem21 := &TaskExplanation{}
+		if err := _elem21.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem21), err)
+		}
+		p.WaitingTasks = append(p.WaitingTasks, _elem21)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JobExplanation) readField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.Notes = tSlice
+	for i := 0; i < size; i++ {
+		var _elem22 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem22 = v
+		}
+		p.Notes = append(p.Notes, _elem22)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *JobExplanation) readField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.ExplainedAtMs = &v
+	}
+	return nil
+}
+
+func (p *JobExplanation) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("JobExplanation"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *JobExplanation) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("jobId", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jobId: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.JobId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.jobId (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jobId: ", p), err)
+	}
+	return err
+}
+
+func (p *JobExplanation) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("status", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:status: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.Status)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.status (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:status: ", p), err)
+	}
+	return err
+}
+
+func (p *JobExplanation) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("waitingTasks", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:waitingTasks: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.WaitingTasks)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.WaitingTasks {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:waitingTasks: ", p), err)
+	}
+	return err
+}
+
+func (p *JobExplanation) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNotes() {
+		if err := oprot.WriteFieldBegin("notes", thrift.LIST, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:notes: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Notes)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.Notes {
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:notes: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobExplanation) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetExplainedAtMs() {
+		if err := oprot.WriteFieldBegin("explainedAtMs", thrift.I64, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:explainedAtMs: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.ExplainedAtMs)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.explainedAtMs (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:explainedAtMs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *JobExplanation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("JobExplanation(%+v)", *p)
+}
+
+// Attributes:
+//  - ID
+//  - Requestor
+//  - Reason
+//  - DurationMs
+//  - IdIsPattern
+type OfflineWorkerReq struct {
+	ID          string  `thrift:"id,1,required" json:"id"`
+	Requestor   string  `thrift:"requestor,2,required" json:"requestor"`
+	Reason      *string `thrift:"reason,3" json:"reason,omitempty"`
+	DurationMs  *int64  `thrift:"durationMs,4" json:"durationMs,omitempty"`
+	IdIsPattern *bool   `thrift:"idIsPattern,5" json:"idIsPattern,omitempty"`
+}
+
+func NewOfflineWorkerReq() *OfflineWorkerReq {
+	return &OfflineWorkerReq{}
+}
+
+func (p *OfflineWorkerReq) GetID() string {
+	return p.ID
+}
+
+func (p *OfflineWorkerReq) GetRequestor() string {
+	return p.Requestor
+}
+
+var OfflineWorkerReq_Reason_DEFAULT string
+
+func (p *OfflineWorkerReq) GetReason() string {
+	if !p.IsSetReason() {
+		return OfflineWorkerReq_Reason_DEFAULT
+	}
+	return *p.Reason
+}
+
+var OfflineWorkerReq_DurationMs_DEFAULT int64
+
+func (p *OfflineWorkerReq) GetDurationMs() int64 {
+	if !p.IsSetDurationMs() {
+		return OfflineWorkerReq_DurationMs_DEFAULT
+	}
+	return *p.DurationMs
+}
+
+var OfflineWorkerReq_IdIsPattern_DEFAULT bool
+
+func (p *OfflineWorkerReq) GetIdIsPattern() bool {
+	if !p.IsSetIdIsPattern() {
+		return OfflineWorkerReq_IdIsPattern_DEFAULT
+	}
+	return *p.IdIsPattern
+}
+func (p *OfflineWorkerReq) IsSetReason() bool {
+	return p.Reason != nil
+}
+
+func (p *OfflineWorkerReq) IsSetDurationMs() bool {
+	return p.DurationMs != nil
+}
+
+func (p *OfflineWorkerReq) IsSetIdIsPattern() bool {
+	return p.IdIsPattern != nil
+}
+
+func (p *OfflineWorkerReq) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetID bool = false
+	var issetRequestor bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetID = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetRequestor = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetID {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
+	}
+	if !issetRequestor {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Requestor is not set"))
+	}
+	return nil
+}
+
+func (p *OfflineWorkerReq) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
+	}
+	return nil
+}
+
+func (p *OfflineWorkerReq) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Requestor = v
+	}
+	return nil
+}
+
+func (p *OfflineWorkerReq) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Reason = &v
+	}
+	return nil
+}
+
+func (p *OfflineWorkerReq) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.DurationMs = &v
+	}
+	return nil
+}
+
+func (p *OfflineWorkerReq) readField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.IdIsPattern = &v
+	}
+	return nil
+}
+
+func (p *OfflineWorkerReq) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("OfflineWorkerReq"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *OfflineWorkerReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.ID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
+	}
+	return err
+}
+
+func (p *OfflineWorkerReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("requestor", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:requestor: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.Requestor)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.requestor (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:requestor: ", p), err)
+	}
+	return err
+}
+
+func (p *OfflineWorkerReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetReason() {
+		if err := oprot.WriteFieldBegin("reason", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:reason: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Reason)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.reason (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:reason: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *OfflineWorkerReq) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDurationMs() {
+		if err := oprot.WriteFieldBegin("durationMs", thrift.I64, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:durationMs: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.DurationMs)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.durationMs (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:durationMs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *OfflineWorkerReq) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIdIsPattern() {
+		if err := oprot.WriteFieldBegin("idIsPattern", thrift.BOOL, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:idIsPattern: ", p), err)
+		}
+		if err := oprot.WriteBool(bool(*p.IdIsPattern)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.idIsPattern (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:idIsPattern: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *OfflineWorkerReq) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OfflineWorkerReq(%+v)", *p)
+}
+
+// Attributes:
+//  - ID
+//  - Requestor
+//  - IdIsPattern
+type ReinstateWorkerReq struct {
+	ID          string `thrift:"id,1,required" json:"id"`
+	Requestor   string `thrift:"requestor,2,required" json:"requestor"`
+	IdIsPattern *bool  `thrift:"idIsPattern,3" json:"idIsPattern,omitempty"`
+}
+
+func NewReinstateWorkerReq() *ReinstateWorkerReq {
+	return &ReinstateWorkerReq{}
+}
+
+func (p *ReinstateWorkerReq) GetID() string {
+	return p.ID
+}
+
+func (p *ReinstateWorkerReq) GetRequestor() string {
+	return p.Requestor
+}
+
+var ReinstateWorkerReq_IdIsPattern_DEFAULT bool
+
+func (p *ReinstateWorkerReq) GetIdIsPattern() bool {
+	if !p.IsSetIdIsPattern() {
+		return ReinstateWorkerReq_IdIsPattern_DEFAULT
+	}
+	return *p.IdIsPattern
+}
+func (p *ReinstateWorkerReq) IsSetIdIsPattern() bool {
+	return p.IdIsPattern != nil
+}
+
+func (p *ReinstateWorkerReq) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetID bool = false
+	var issetRequestor bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetID = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetRequestor = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetID {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
+	}
+	if !issetRequestor {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Requestor is not set"))
+	}
+	return nil
+}
+
+func (p *ReinstateWorkerReq) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
+	}
+	return nil
+}
+
+func (p *ReinstateWorkerReq) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Requestor = v
+	}
+	return nil
+}
+
+func (p *ReinstateWorkerReq) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.IdIsPattern = &v
+	}
+	return nil
+}
+
+func (p *ReinstateWorkerReq) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ReinstateWorkerReq"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ReinstateWorkerReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.ID)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
+	}
+	return err
+}
+
+func (p *ReinstateWorkerReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("requestor", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:requestor: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.Requestor)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.requestor (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:requestor: ", p), err)
+	}
+	return err
+}
+
+func (p *ReinstateWorkerReq) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIdIsPattern() {
+		if err := oprot.WriteFieldBegin("idIsPattern", thrift.BOOL, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:idIsPattern: ", p), err)
+		}
+		if err := oprot.WriteBool(bool(*p.IdIsPattern)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.idIsPattern (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:idIsPattern: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ReinstateWorkerReq) String() string {
+	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobId(%+v)", *p)
+	return fmt.Sprintf("ReinstateWorkerReq(%+v)", *p)
 }
 
 // Attributes:
-//  - ID
-//  - Status
-//  - TaskStatus
-//  - TaskData
-type JobStatus struct {
-	ID         string                `thrift:"id,1,required" json:"id"`
-	Status     Status                `thrift:"status,2,required" json:"status"`
-	TaskStatus map[string]Status     `thrift:"taskStatus,3" json:"taskStatus,omitempty"`
-	TaskData   map[string]*RunStatus `thrift:"taskData,4" json:"taskData,omitempty"`
+//  - CurrentTasks
+//  - MaxTasks
+type SchedulerStatus struct {
+	CurrentTasks int32 `thrift:"currentTasks,1,required" json:"currentTasks"`
+	MaxTasks     int32 `thrift:"maxTasks,2,required" json:"maxTasks"`
 }
 
-func NewJobStatus() *JobStatus {
-	return &JobStatus{}
+func NewSchedulerStatus() *SchedulerStatus {
+	return &SchedulerStatus{}
 }
 
-func (p *JobStatus) GetID() string {
+func (p *SchedulerStatus) GetCurrentTasks() int32 {
+	return p.CurrentTasks
+}
+
+func (p *SchedulerStatus) GetMaxTasks() int32 {
+	return p.MaxTasks
+}
+func (p *SchedulerStatus) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetCurrentTasks bool = false
+	var issetMaxTasks bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetCurrentTasks = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetMaxTasks = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetCurrentTasks {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field CurrentTasks is not set"))
+	}
+	if !issetMaxTasks {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field MaxTasks is not set"))
+	}
+	return nil
+}
+
+func (p *SchedulerStatus) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.CurrentTasks = v
+	}
+	return nil
+}
+
+func (p *SchedulerStatus) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.MaxTasks = v
+	}
+	return nil
+}
+
+func (p *SchedulerStatus) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SchedulerStatus"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *SchedulerStatus) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("currentTasks", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:currentTasks: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.CurrentTasks)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.currentTasks (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:currentTasks: ", p), err)
+	}
+	return err
+}
+
+func (p *SchedulerStatus) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("maxTasks", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:maxTasks: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.MaxTasks)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.maxTasks (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:maxTasks: ", p), err)
+	}
+	return err
+}
+
+func (p *SchedulerStatus) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SchedulerStatus(%+v)", *p)
+}
+
+// Attributes:
+//  - ID
+//  - State
+//  - RunningJobId
+//  - RunningTaskId
+//  - NumRunningTasks
+//  - SnapshotId
+//  - InStateForMs
+//  - OfflinedBy
+//  - Labels
+//  - OfflineReason
+//  - OfflineExpiresInMs
+type WorkerSummary struct {
+	ID                 string            `thrift:"id,1,required" json:"id"`
+	State              WorkerState       `thrift:"state,2,required" json:"state"`
+	RunningJobId       *string           `thrift:"runningJobId,3" json:"runningJobId,omitempty"`
+	RunningTaskId      *string           `thrift:"runningTaskId,4" json:"runningTaskId,omitempty"`
+	NumRunningTasks    int32             `thrift:"numRunningTasks,5,required" json:"numRunningTasks"`
+	SnapshotId         *string           `thrift:"snapshotId,6" json:"snapshotId,omitempty"`
+	InStateForMs       *int64            `thrift:"inStateForMs,7" json:"inStateForMs,omitempty"`
+	OfflinedBy         *string           `thrift:"offlinedBy,8" json:"offlinedBy,omitempty"`
+	Labels             map[string]string `thrift:"labels,9" json:"labels,omitempty"`
+	OfflineReason      *string           `thrift:"offlineReason,10" json:"offlineReason,omitempty"`
+	OfflineExpiresInMs *int64            `thrift:"offlineExpiresInMs,11" json:"offlineExpiresInMs,omitempty"`
+}
+
+func NewWorkerSummary() *WorkerSummary {
+	return &WorkerSummary{}
+}
+
+func (p *WorkerSummary) GetID() string {
 	return p.ID
 }
 
-func (p *JobStatus) GetStatus() Status {
-	return p.Status
+func (p *WorkerSummary) GetState() WorkerState {
+	return p.State
 }
 
-var JobStatus_TaskStatus_DEFAULT map[string]Status
+var WorkerSummary_RunningJobId_DEFAULT string
 
-func (p *JobStatus) GetTaskStatus() map[string]Status {
-	return p.TaskStatus
+func (p *WorkerSummary) GetRunningJobId() string {
+	if !p.IsSetRunningJobId() {
+		return WorkerSummary_RunningJobId_DEFAULT
+	}
+	return *p.RunningJobId
 }
 
-var JobStatus_TaskData_DEFAULT map[string]*RunStatus
+var WorkerSummary_RunningTaskId_DEFAULT string
 
-func (p *JobStatus) GetTaskData() map[string]*RunStatus {
-	return p.TaskData
+func (p *WorkerSummary) GetRunningTaskId() string {
+	if !p.IsSetRunningTaskId() {
+		return WorkerSummary_RunningTaskId_DEFAULT
+	}
+	return *p.RunningTaskId
 }
-func (p *JobStatus) IsSetTaskStatus() bool {
-	return p.TaskStatus != nil
+
+func (p *WorkerSummary) GetNumRunningTasks() int32 {
+	return p.NumRunningTasks
 }
 
-func (p *JobStatus) IsSetTaskData() bool {
-	return p.TaskData != nil
+var WorkerSummary_SnapshotId_DEFAULT string
+
+func (p *WorkerSummary) GetSnapshotId() string {
+	if !p.IsSetSnapshotId() {
+		return WorkerSummary_SnapshotId_DEFAULT
+	}
+	return *p.SnapshotId
 }
 
-func (p *JobStatus) Read(iprot thrift.TProtocol) error {
+var WorkerSummary_InStateForMs_DEFAULT int64
+
+func (p *WorkerSummary) GetInStateForMs() int64 {
+	if !p.IsSetInStateForMs() {
+		return WorkerSummary_InStateForMs_DEFAULT
+	}
+	return *p.InStateForMs
+}
+
+var WorkerSummary_OfflinedBy_DEFAULT string
+
+func (p *WorkerSummary) GetOfflinedBy() string {
+	if !p.IsSetOfflinedBy() {
+		return WorkerSummary_OfflinedBy_DEFAULT
+	}
+	return *p.OfflinedBy
+}
+
+var WorkerSummary_Labels_DEFAULT map[string]string
+
+func (p *WorkerSummary) GetLabels() map[string]string {
+	return p.Labels
+}
+
+var WorkerSummary_OfflineReason_DEFAULT string
+
+func (p *WorkerSummary) GetOfflineReason() string {
+	if !p.IsSetOfflineReason() {
+		return WorkerSummary_OfflineReason_DEFAULT
+	}
+	return *p.OfflineReason
+}
+
+var WorkerSummary_OfflineExpiresInMs_DEFAULT int64
+
+func (p *WorkerSummary) GetOfflineExpiresInMs() int64 {
+	if !p.IsSetOfflineExpiresInMs() {
+		return WorkerSummary_OfflineExpiresInMs_DEFAULT
+	}
+	return *p.OfflineExpiresInMs
+}
+func (p *WorkerSummary) IsSetRunningJobId() bool {
+	return p.RunningJobId != nil
+}
+
+func (p *WorkerSummary) IsSetRunningTaskId() bool {
+	return p.RunningTaskId != nil
+}
+
+func (p *WorkerSummary) IsSetSnapshotId() bool {
+	return p.SnapshotId != nil
+}
+
+func (p *WorkerSummary) IsSetInStateForMs() bool {
+	return p.InStateForMs != nil
+}
+
+func (p *WorkerSummary) IsSetOfflinedBy() bool {
+	return p.OfflinedBy != nil
+}
+
+func (p *WorkerSummary) IsSetLabels() bool {
+	return p.Labels != nil
+}
+
+func (p *WorkerSummary) IsSetOfflineReason() bool {
+	return p.OfflineReason != nil
+}
+
+func (p *WorkerSummary) IsSetOfflineExpiresInMs() bool {
+	return p.OfflineExpiresInMs != nil
+}
+
+func (p *WorkerSummary) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
 	var issetID bool = false
-	var issetStatus bool = false
+	var issetState bool = false
+	var issetNumRunningTasks bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -2039,7 +6544,7 @@ func (p *JobStatus) Read(iprot thrift.TProtocol) error {
 			if err := p.readField2(iprot); err != nil {
 				return err
 			}
-			issetStatus = true
+			issetState = true
 		case 3:
 			if err := p.readField3(iprot); err != nil {
 				return err
@@ -2048,6 +6553,35 @@ func (p *JobStatus) Read(iprot thrift.TProtocol) error {
 			if err := p.readField4(iprot); err != nil {
 				return err
 			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+			issetNumRunningTasks = true
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
+		case 7:
+			if err := p.readField7(iprot); err != nil {
+				return err
+			}
+		case 8:
+			if err := p.readField8(iprot); err != nil {
+				return err
+			}
+		case 9:
+			if err := p.readField9(iprot); err != nil {
+				return err
+			}
+		case 10:
+			if err := p.readField10(iprot); err != nil {
+				return err
+			}
+		case 11:
+			if err := p.readField11(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -2063,53 +6597,109 @@ func (p *JobStatus) Read(iprot thrift.TProtocol) error {
 	if !issetID {
 		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
 	}
-	if !issetStatus {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Status is not set"))
+	if !issetState {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field State is not set"))
+	}
+	if !issetNumRunningTasks {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumRunningTasks is not set"))
+	}
+	return nil
+}
+
+func (p *WorkerSummary) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ID = v
+	}
+	return nil
+}
+
+func (p *WorkerSummary) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := WorkerState(v)
+		p.State = temp
+	}
+	return nil
+}
+
+func (p *WorkerSummary) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.RunningJobId = &v
 	}
 	return nil
 }
 
-func (p *JobStatus) readField1(iprot thrift.TProtocol) error {
+func (p *WorkerSummary) readField4(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
+		return thrift.PrependError("error reading field 4: ", err)
 	} else {
-		p.ID = v
+		p.RunningTaskId = &v
 	}
 	return nil
 }
 
-func (p *JobStatus) readField2(iprot thrift.TProtocol) error {
+func (p *WorkerSummary) readField5(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
+		return thrift.PrependError("error reading field 5: ", err)
 	} else {
-		temp := Status(v)
-		p.Status = temp
+		p.NumRunningTasks = v
 	}
 	return nil
 }
 
-func (p *JobStatus) readField3(iprot thrift.TProtocol) error {
+func (p *WorkerSummary) readField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.SnapshotId = &v
+	}
+	return nil
+}
+
+func (p *WorkerSummary) readField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.InStateForMs = &v
+	}
+	return nil
+}
+
+func (p *WorkerSummary) readField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.OfflinedBy = &v
+	}
+	return nil
+}
+
+func (p *WorkerSummary) readField9(iprot thrift.TProtocol) error {
 	_, _, size, err := iprot.ReadMapBegin()
 	if err != nil {
 		return thrift.PrependError("error reading map begin: ", err)
 	}
-	tMap := make(map[string]Status, size)
-	p.TaskStatus = tMap
+	tMap := make(map[string]string, size)
+	p.Labels = tMap
 	for i := 0; i < size; i++ {
-		var _key4 string
+		var _key23 string
 		if v, err := iprot.ReadString(); err != nil {
 			return thrift.PrependError("error reading field 0: ", err)
 		} else {
-			_key4 = v
+			_key23 = v
 		}
-		var _val5 Status
-		if v, err := iprot.ReadI32(); err != nil {
+		var _val24 string
+		if v, err := iprot.ReadString(); err != nil {
 			return thrift.PrependError("error reading field 0: ", err)
 		} else {
-			temp := Status(v)
-			_val5 = temp
+			_val24 = v
 		}
-		p.TaskStatus[_key4] = _val5
+		p.Labels[_key23] = _val24
 	}
 	if err := iprot.ReadMapEnd(); err != nil {
 		return thrift.PrependError("error reading map end: ", err)
@@ -2117,34 +6707,26 @@ func (p *JobStatus) readField3(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JobStatus) readField4(iprot thrift.TProtocol) error {
-	_, _, size, err := iprot.ReadMapBegin()
-	if err != nil {
-		return thrift.PrependError("error reading map begin: ", err)
-	}
-	tMap := make(map[string]*RunStatus, size)
-	p.TaskData = tMap
-	for i := 0; i < size; i++ {
-		var _key6 string
-		if v, err := iprot.ReadString(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_key6 = v
-		}
-		_val7 := &RunStatus{}
-		if err := _val7.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _val7), err)
-		}
-		p.TaskData[_key6] = _val7
+func (p *WorkerSummary) readField10(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 10: ", err)
+	} else {
+		p.OfflineReason = &v
 	}
-	if err := iprot.ReadMapEnd(); err != nil {
-		return thrift.PrependError("error reading map end: ", err)
+	return nil
+}
+
+func (p *WorkerSummary) readField11(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 11: ", err)
+	} else {
+		p.OfflineExpiresInMs = &v
 	}
 	return nil
 }
 
-func (p *JobStatus) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("JobStatus"); err != nil {
+func (p *WorkerSummary) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("WorkerSummary"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -2159,6 +6741,27 @@ func (p *JobStatus) Write(oprot thrift.TProtocol) error {
 	if err := p.writeField4(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField8(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField9(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField10(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField11(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -2168,7 +6771,7 @@ func (p *JobStatus) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *JobStatus) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkerSummary) writeField1(oprot thrift.TProtocol) (err error) {
 	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
 	}
@@ -2181,32 +6784,120 @@ func (p *JobStatus) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *JobStatus) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("status", thrift.I32, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:status: ", p), err)
+func (p *WorkerSummary) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("state", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:state: ", p), err)
 	}
-	if err := oprot.WriteI32(int32(p.Status)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.status (2) field write error: ", p), err)
+	if err := oprot.WriteI32(int32(p.State)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.state (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:status: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:state: ", p), err)
 	}
 	return err
 }
 
-func (p *JobStatus) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTaskStatus() {
-		if err := oprot.WriteFieldBegin("taskStatus", thrift.MAP, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:taskStatus: ", p), err)
+func (p *WorkerSummary) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRunningJobId() {
+		if err := oprot.WriteFieldBegin("runningJobId", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:runningJobId: ", p), err)
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.I32, len(p.TaskStatus)); err != nil {
+		if err := oprot.WriteString(string(*p.RunningJobId)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.runningJobId (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:runningJobId: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *WorkerSummary) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRunningTaskId() {
+		if err := oprot.WriteFieldBegin("runningTaskId", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:runningTaskId: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.RunningTaskId)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.runningTaskId (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:runningTaskId: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *WorkerSummary) writeField5(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("numRunningTasks", thrift.I32, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:numRunningTasks: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.NumRunningTasks)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.numRunningTasks (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:numRunningTasks: ", p), err)
+	}
+	return err
+}
+
+func (p *WorkerSummary) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSnapshotId() {
+		if err := oprot.WriteFieldBegin("snapshotId", thrift.STRING, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:snapshotId: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.SnapshotId)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.snapshotId (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:snapshotId: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *WorkerSummary) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetInStateForMs() {
+		if err := oprot.WriteFieldBegin("inStateForMs", thrift.I64, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:inStateForMs: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.InStateForMs)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.inStateForMs (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:inStateForMs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *WorkerSummary) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOfflinedBy() {
+		if err := oprot.WriteFieldBegin("offlinedBy", thrift.STRING, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:offlinedBy: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.OfflinedBy)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.offlinedBy (8) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:offlinedBy: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *WorkerSummary) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabels() {
+		if err := oprot.WriteFieldBegin("labels", thrift.MAP, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:labels: ", p), err)
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Labels)); err != nil {
 			return thrift.PrependError("error writing map begin: ", err)
 		}
-		for k, v := range p.TaskStatus {
+		for k, v := range p.Labels {
 			if err := oprot.WriteString(string(k)); err != nil {
 				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 			}
-			if err := oprot.WriteI32(int32(v)); err != nil {
+			if err := oprot.WriteString(string(v)); err != nil {
 				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 			}
 		}
@@ -2214,71 +6905,228 @@ func (p *JobStatus) writeField3(oprot thrift.TProtocol) (err error) {
 			return thrift.PrependError("error writing map end: ", err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:taskStatus: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:labels: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *JobStatus) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTaskData() {
-		if err := oprot.WriteFieldBegin("taskData", thrift.MAP, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:taskData: ", p), err)
+func (p *WorkerSummary) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOfflineReason() {
+		if err := oprot.WriteFieldBegin("offlineReason", thrift.STRING, 10); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:offlineReason: ", p), err)
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRUCT, len(p.TaskData)); err != nil {
-			return thrift.PrependError("error writing map begin: ", err)
+		if err := oprot.WriteString(string(*p.OfflineReason)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.offlineReason (10) field write error: ", p), err)
 		}
-		for k, v := range p.TaskData {
-			if err := oprot.WriteString(string(k)); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:offlineReason: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *WorkerSummary) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOfflineExpiresInMs() {
+		if err := oprot.WriteFieldBegin("offlineExpiresInMs", thrift.I64, 11); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:offlineExpiresInMs: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.OfflineExpiresInMs)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.offlineExpiresInMs (11) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 11:offlineExpiresInMs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *WorkerSummary) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("WorkerSummary(%+v)", *p)
+}
+
+// Attributes:
+//  - Workers
+type ClusterState struct {
+	Workers []*WorkerSummary `thrift:"workers,1,required" json:"workers"`
+}
+
+func NewClusterState() *ClusterState {
+	return &ClusterState{}
+}
+
+func (p *ClusterState) GetWorkers() []*WorkerSummary {
+	return p.Workers
+}
+func (p *ClusterState) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetWorkers bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
 			}
-			if err := v.Write(oprot); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			issetWorkers = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
 			}
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
-			return thrift.PrependError("error writing map end: ", err)
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
 		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:taskData: ", p), err)
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetWorkers {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Workers is not set"))
+	}
+	return nil
+}
+
+func (p *ClusterState) readField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*WorkerSummary, 0, size)
+	p.Workers = tSlice
+	for i := 0; i < size; i++ {
+		_elem25 := &WorkerSummary{}
+		if err := _elem25.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem25), err)
+		}
+		p.Workers = append(p.Workers, _elem25)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ClusterState) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ClusterState"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ClusterState) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("workers", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:workers: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Workers)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Workers {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
 		}
 	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:workers: ", p), err)
+	}
 	return err
 }
 
-func (p *JobStatus) String() string {
+func (p *ClusterState) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("JobStatus(%+v)", *p)
+	return fmt.Sprintf("ClusterState(%+v)", *p)
 }
 
 // Attributes:
-//  - ID
-//  - Requestor
-type OfflineWorkerReq struct {
-	ID        string `thrift:"id,1,required" json:"id"`
-	Requestor string `thrift:"requestor,2,required" json:"requestor"`
+//  - Version
+//  - PersistedAtMs
+//  - ChangedBy
+//  - Change
+//  - SettingsJson
+type SettingsVersion struct {
+	Version       int32   `thrift:"version,1,required" json:"version"`
+	PersistedAtMs int64   `thrift:"persistedAtMs,2,required" json:"persistedAtMs"`
+	ChangedBy     *string `thrift:"changedBy,3" json:"changedBy,omitempty"`
+	Change        *string `thrift:"change,4" json:"change,omitempty"`
+	SettingsJson  string  `thrift:"settingsJson,5,required" json:"settingsJson"`
 }
 
-func NewOfflineWorkerReq() *OfflineWorkerReq {
-	return &OfflineWorkerReq{}
+func NewSettingsVersion() *SettingsVersion {
+	return &SettingsVersion{}
 }
 
-func (p *OfflineWorkerReq) GetID() string {
-	return p.ID
+func (p *SettingsVersion) GetVersion() int32 {
+	return p.Version
 }
 
-func (p *OfflineWorkerReq) GetRequestor() string {
-	return p.Requestor
+func (p *SettingsVersion) GetPersistedAtMs() int64 {
+	return p.PersistedAtMs
 }
-func (p *OfflineWorkerReq) Read(iprot thrift.TProtocol) error {
+
+var SettingsVersion_ChangedBy_DEFAULT string
+
+func (p *SettingsVersion) GetChangedBy() string {
+	if !p.IsSetChangedBy() {
+		return SettingsVersion_ChangedBy_DEFAULT
+	}
+	return *p.ChangedBy
+}
+
+var SettingsVersion_Change_DEFAULT string
+
+func (p *SettingsVersion) GetChange() string {
+	if !p.IsSetChange() {
+		return SettingsVersion_Change_DEFAULT
+	}
+	return *p.Change
+}
+
+func (p *SettingsVersion) GetSettingsJson() string {
+	return p.SettingsJson
+}
+func (p *SettingsVersion) IsSetChangedBy() bool {
+	return p.ChangedBy != nil
+}
+
+func (p *SettingsVersion) IsSetChange() bool {
+	return p.Change != nil
+}
+
+func (p *SettingsVersion) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetID bool = false
-	var issetRequestor bool = false
+	var issetVersion bool = false
+	var issetPersistedAtMs bool = false
+	var issetSettingsJson bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -2293,12 +7141,25 @@ func (p *OfflineWorkerReq) Read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
-			issetID = true
+			issetVersion = true
 		case 2:
 			if err := p.readField2(iprot); err != nil {
 				return err
 			}
-			issetRequestor = true
+			issetPersistedAtMs = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+			issetSettingsJson = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -2311,35 +7172,65 @@ func (p *OfflineWorkerReq) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetID {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
+	if !issetVersion {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Version is not set"))
 	}
-	if !issetRequestor {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Requestor is not set"))
+	if !issetPersistedAtMs {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PersistedAtMs is not set"))
+	}
+	if !issetSettingsJson {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field SettingsJson is not set"))
 	}
 	return nil
 }
 
-func (p *OfflineWorkerReq) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *SettingsVersion) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.ID = v
+		p.Version = v
 	}
 	return nil
 }
 
-func (p *OfflineWorkerReq) readField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *SettingsVersion) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.Requestor = v
+		p.PersistedAtMs = v
 	}
 	return nil
 }
 
-func (p *OfflineWorkerReq) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("OfflineWorkerReq"); err != nil {
+func (p *SettingsVersion) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.ChangedBy = &v
+	}
+	return nil
+}
+
+func (p *SettingsVersion) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Change = &v
+	}
+	return nil
+}
+
+func (p *SettingsVersion) readField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.SettingsJson = v
+	}
+	return nil
+}
+
+func (p *SettingsVersion) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SettingsVersion"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -2348,6 +7239,15 @@ func (p *OfflineWorkerReq) Write(oprot thrift.TProtocol) error {
 	if err := p.writeField2(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -2357,65 +7257,101 @@ func (p *OfflineWorkerReq) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *OfflineWorkerReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
+func (p *SettingsVersion) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("version", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:version: ", p), err)
 	}
-	if err := oprot.WriteString(string(p.ID)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
+	if err := oprot.WriteI32(int32(p.Version)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.version (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:version: ", p), err)
 	}
 	return err
 }
 
-func (p *OfflineWorkerReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("requestor", thrift.STRING, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:requestor: ", p), err)
+func (p *SettingsVersion) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("persistedAtMs", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:persistedAtMs: ", p), err)
 	}
-	if err := oprot.WriteString(string(p.Requestor)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.requestor (2) field write error: ", p), err)
+	if err := oprot.WriteI64(int64(p.PersistedAtMs)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.persistedAtMs (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:requestor: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:persistedAtMs: ", p), err)
 	}
 	return err
 }
 
-func (p *OfflineWorkerReq) String() string {
+func (p *SettingsVersion) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChangedBy() {
+		if err := oprot.WriteFieldBegin("changedBy", thrift.STRING, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:changedBy: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.ChangedBy)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.changedBy (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:changedBy: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SettingsVersion) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetChange() {
+		if err := oprot.WriteFieldBegin("change", thrift.STRING, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:change: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Change)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.change (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:change: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SettingsVersion) writeField5(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("settingsJson", thrift.STRING, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:settingsJson: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.SettingsJson)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.settingsJson (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:settingsJson: ", p), err)
+	}
+	return err
+}
+
+func (p *SettingsVersion) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("OfflineWorkerReq(%+v)", *p)
+	return fmt.Sprintf("SettingsVersion(%+v)", *p)
 }
 
 // Attributes:
-//  - ID
-//  - Requestor
-type ReinstateWorkerReq struct {
-	ID        string `thrift:"id,1,required" json:"id"`
-	Requestor string `thrift:"requestor,2,required" json:"requestor"`
+//  - Versions
+type SettingsHistory struct {
+	Versions []*SettingsVersion `thrift:"versions,1,required" json:"versions"`
 }
 
-func NewReinstateWorkerReq() *ReinstateWorkerReq {
-	return &ReinstateWorkerReq{}
-}
-
-func (p *ReinstateWorkerReq) GetID() string {
-	return p.ID
+func NewSettingsHistory() *SettingsHistory {
+	return &SettingsHistory{}
 }
 
-func (p *ReinstateWorkerReq) GetRequestor() string {
-	return p.Requestor
+func (p *SettingsHistory) GetVersions() []*SettingsVersion {
+	return p.Versions
 }
-func (p *ReinstateWorkerReq) Read(iprot thrift.TProtocol) error {
+func (p *SettingsHistory) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetID bool = false
-	var issetRequestor bool = false
+	var issetVersions bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -2430,12 +7366,7 @@ func (p *ReinstateWorkerReq) Read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
-			issetID = true
-		case 2:
-			if err := p.readField2(iprot); err != nil {
-				return err
-			}
-			issetRequestor = true
+			issetVersions = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -2448,43 +7379,39 @@ func (p *ReinstateWorkerReq) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetID {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ID is not set"))
-	}
-	if !issetRequestor {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Requestor is not set"))
+	if !issetVersions {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Versions is not set"))
 	}
 	return nil
 }
 
-func (p *ReinstateWorkerReq) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.ID = v
+func (p *SettingsHistory) readField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
 	}
-	return nil
-}
-
-func (p *ReinstateWorkerReq) readField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.Requestor = v
+	tSlice := make([]*SettingsVersion, 0, size)
+	p.Versions = tSlice
+	for i := 0; i < size; i++ {
+		_elem26 := &SettingsVersion{}
+		if err := _elem26.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem26), err)
+		}
+		p.Versions = append(p.Versions, _elem26)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
 	}
 	return nil
 }
 
-func (p *ReinstateWorkerReq) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("ReinstateWorkerReq"); err != nil {
+func (p *SettingsHistory) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SettingsHistory"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
 		return err
 	}
-	if err := p.writeField2(oprot); err != nil {
-		return err
-	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -2494,65 +7421,60 @@ func (p *ReinstateWorkerReq) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ReinstateWorkerReq) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("id", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err)
-	}
-	if err := oprot.WriteString(string(p.ID)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err)
+func (p *SettingsHistory) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("versions", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:versions: ", p), err)
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err)
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Versions)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
 	}
-	return err
-}
-
-func (p *ReinstateWorkerReq) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("requestor", thrift.STRING, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:requestor: ", p), err)
+	for _, v := range p.Versions {
+		if err := v.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
 	}
-	if err := oprot.WriteString(string(p.Requestor)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.requestor (2) field write error: ", p), err)
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:requestor: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:versions: ", p), err)
 	}
 	return err
 }
 
-func (p *ReinstateWorkerReq) String() string {
+func (p *SettingsHistory) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ReinstateWorkerReq(%+v)", *p)
+	return fmt.Sprintf("SettingsHistory(%+v)", *p)
 }
 
 // Attributes:
-//  - CurrentTasks
-//  - MaxTasks
-type SchedulerStatus struct {
-	CurrentTasks int32 `thrift:"currentTasks,1,required" json:"currentTasks"`
-	MaxTasks     int32 `thrift:"maxTasks,2,required" json:"maxTasks"`
+//  - Version
+//  - Requestor
+type RollbackSettingsReq struct {
+	Version   int32  `thrift:"version,1,required" json:"version"`
+	Requestor string `thrift:"requestor,2,required" json:"requestor"`
 }
 
-func NewSchedulerStatus() *SchedulerStatus {
-	return &SchedulerStatus{}
+func NewRollbackSettingsReq() *RollbackSettingsReq {
+	return &RollbackSettingsReq{}
 }
 
-func (p *SchedulerStatus) GetCurrentTasks() int32 {
-	return p.CurrentTasks
+func (p *RollbackSettingsReq) GetVersion() int32 {
+	return p.Version
 }
 
-func (p *SchedulerStatus) GetMaxTasks() int32 {
-	return p.MaxTasks
+func (p *RollbackSettingsReq) GetRequestor() string {
+	return p.Requestor
 }
-func (p *SchedulerStatus) Read(iprot thrift.TProtocol) error {
+func (p *RollbackSettingsReq) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetCurrentTasks bool = false
-	var issetMaxTasks bool = false
+	var issetVersion bool = false
+	var issetRequestor bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -2567,12 +7489,12 @@ func (p *SchedulerStatus) Read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
-			issetCurrentTasks = true
+			issetVersion = true
 		case 2:
 			if err := p.readField2(iprot); err != nil {
 				return err
 			}
-			issetMaxTasks = true
+			issetRequestor = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -2585,35 +7507,35 @@ func (p *SchedulerStatus) Read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetCurrentTasks {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field CurrentTasks is not set"))
+	if !issetVersion {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Version is not set"))
 	}
-	if !issetMaxTasks {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field MaxTasks is not set"))
+	if !issetRequestor {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Requestor is not set"))
 	}
 	return nil
 }
 
-func (p *SchedulerStatus) readField1(iprot thrift.TProtocol) error {
+func (p *RollbackSettingsReq) readField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.CurrentTasks = v
+		p.Version = v
 	}
 	return nil
 }
 
-func (p *SchedulerStatus) readField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *RollbackSettingsReq) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.MaxTasks = v
+		p.Requestor = v
 	}
 	return nil
 }
 
-func (p *SchedulerStatus) Write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("SchedulerStatus"); err != nil {
+func (p *RollbackSettingsReq) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("RollbackSettingsReq"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -2631,35 +7553,35 @@ func (p *SchedulerStatus) Write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *SchedulerStatus) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("currentTasks", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:currentTasks: ", p), err)
+func (p *RollbackSettingsReq) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("version", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:version: ", p), err)
 	}
-	if err := oprot.WriteI32(int32(p.CurrentTasks)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.currentTasks (1) field write error: ", p), err)
+	if err := oprot.WriteI32(int32(p.Version)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.version (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:currentTasks: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:version: ", p), err)
 	}
 	return err
 }
 
-func (p *SchedulerStatus) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("maxTasks", thrift.I32, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:maxTasks: ", p), err)
+func (p *RollbackSettingsReq) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("requestor", thrift.STRING, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:requestor: ", p), err)
 	}
-	if err := oprot.WriteI32(int32(p.MaxTasks)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.maxTasks (2) field write error: ", p), err)
+	if err := oprot.WriteString(string(p.Requestor)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.requestor (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:maxTasks: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:requestor: ", p), err)
 	}
 	return err
 }
 
-func (p *SchedulerStatus) String() string {
+func (p *RollbackSettingsReq) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SchedulerStatus(%+v)", *p)
+	return fmt.Sprintf("RollbackSettingsReq(%+v)", *p)
 }
@@ -0,0 +1,69 @@
+package thrift
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
+	"github.com/twitter/scoot/scheduler/domain"
+	"github.com/twitter/scoot/scheduler/server"
+)
+
+var domainWorkerStateToThrift = map[domain.WorkerState]scoot.WorkerState{
+	domain.WorkerIdle:     scoot.WorkerState_IDLE,
+	domain.WorkerBusy:     scoot.WorkerState_BUSY,
+	domain.WorkerNotReady: scoot.WorkerState_NOT_READY,
+	domain.WorkerLost:     scoot.WorkerState_LOST,
+	domain.WorkerFlaky:    scoot.WorkerState_FLAKY,
+	domain.WorkerOfflined: scoot.WorkerState_OFFLINED,
+	domain.WorkerDraining: scoot.WorkerState_DRAINING,
+}
+
+// Implementation of the GetClusterState API, returns the state of each of the scheduler's workers.
+func GetClusterState(scheduler server.Scheduler) (*scoot.ClusterState, error) {
+	workers, err := scheduler.GetClusterState()
+	if err != nil {
+		log.Errorf("GetClusterState failed: %v", err)
+		return nil, scoot.NewScootServerError()
+	}
+	now := time.Now()
+	resp := scoot.NewClusterState()
+	resp.Workers = make([]*scoot.WorkerSummary, 0, len(workers))
+	for _, worker := range workers {
+		resp.Workers = append(resp.Workers, domainWorkerSummaryToThrift(worker, now))
+	}
+	return resp, nil
+}
+
+func domainWorkerSummaryToThrift(worker domain.WorkerSummary, now time.Time) *scoot.WorkerSummary {
+	ws := scoot.NewWorkerSummary()
+	ws.ID = worker.ID
+	ws.State = domainWorkerStateToThrift[worker.State]
+	ws.NumRunningTasks = int32(worker.NumRunningTasks)
+	ws.RunningJobId = optionalString(worker.RunningJobID)
+	ws.RunningTaskId = optionalString(worker.RunningTaskID)
+	ws.SnapshotId = optionalString(worker.SnapshotID)
+	ws.OfflinedBy = optionalString(worker.OfflinedBy)
+	ws.OfflineReason = optionalString(worker.OfflineReason)
+	if !worker.OfflinedUntil.IsZero() {
+		expiresInMs := int64(worker.OfflinedUntil.Sub(now) / time.Millisecond)
+		ws.OfflineExpiresInMs = &expiresInMs
+	}
+	if !worker.InStateSince.IsZero() {
+		inStateForMs := int64(now.Sub(worker.InStateSince) / time.Millisecond)
+		ws.InStateForMs = &inStateForMs
+	}
+	if len(worker.Labels) > 0 {
+		ws.Labels = worker.Labels
+	}
+	return ws
+}
+
+// Returns a pointer to s for an optional thrift field, nil if s is empty.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
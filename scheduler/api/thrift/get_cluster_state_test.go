@@ -0,0 +1,49 @@
+package thrift
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
+	"github.com/twitter/scoot/scheduler/domain"
+	"github.com/twitter/scoot/scheduler/server"
+)
+
+func Test_GetClusterState(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	scheduler := server.NewMockScheduler(mockCtrl)
+	scheduler.EXPECT().GetClusterState().Return([]domain.WorkerSummary{
+		{ID: "node1", State: domain.WorkerBusy, RunningJobID: "job1", RunningTaskID: "task1", NumRunningTasks: 1, SnapshotID: "snap"},
+		{ID: "node2", State: domain.WorkerOfflined, InStateSince: time.Now().Add(-time.Minute), OfflinedBy: "admin",
+			OfflineReason: "rack maintenance", OfflinedUntil: time.Now().Add(time.Hour)},
+	}, nil)
+
+	resp, err := GetClusterState(scheduler)
+	if err != nil {
+		t.Fatalf("Unexpected error getting cluster state: %v", err)
+	}
+	if len(resp.Workers) != 2 {
+		t.Fatalf("Expected 2 workers, got %v", resp.Workers)
+	}
+	busy, offlined := resp.Workers[0], resp.Workers[1]
+	if busy.State != scoot.WorkerState_BUSY || busy.GetRunningJobId() != "job1" || busy.GetRunningTaskId() != "task1" ||
+		busy.GetSnapshotId() != "snap" || busy.NumRunningTasks != 1 || busy.InStateForMs != nil || busy.OfflinedBy != nil || busy.OfflineExpiresInMs != nil {
+		t.Errorf("Unexpected busy worker %v", busy)
+	}
+	if offlined.State != scoot.WorkerState_OFFLINED || offlined.GetOfflinedBy() != "admin" ||
+		offlined.GetInStateForMs() < int64(time.Minute/time.Millisecond) || offlined.RunningJobId != nil ||
+		offlined.GetOfflineReason() != "rack maintenance" || offlined.GetOfflineExpiresInMs() <= 0 {
+		t.Errorf("Unexpected offlined worker %v", offlined)
+	}
+
+	scheduler.EXPECT().GetClusterState().Return(nil, errors.New("not the leader"))
+	if _, err := GetClusterState(scheduler); err == nil {
+		t.Errorf("Expected an error when the scheduler fails")
+	} else if _, ok := err.(*scoot.ScootServerError); !ok {
+		t.Errorf("Expected ScootServerError when the scheduler fails, got %T", err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	s "github.com/twitter/scoot/saga"
 	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
 	"github.com/twitter/scoot/scheduler/domain"
+	"github.com/twitter/scoot/scheduler/server"
 	"github.com/twitter/scoot/worker/domain/gen-go/worker"
 )
 
@@ -40,6 +41,17 @@ func GetJobStatus(jobId string, sc s.SagaCoordinator) (*scoot.JobStatus, error)
 	return convertSagaStateToJobStatus(state), nil
 }
 
+// Adds the waiting tasks that no worker can run, which only the scheduler knows about, to the job status.
+// Nothing is added for jobs that are done, or if the scheduler is standing by.
+func AddUnschedulableTasks(js *scoot.JobStatus, scheduler server.Scheduler) {
+	if js == nil || js.ID == "" || js.Status == scoot.Status_COMPLETED || js.Status == scoot.Status_ROLLED_BACK {
+		return
+	}
+	if unschedulable := scheduler.GetUnschedulableTasks(js.ID); len(unschedulable) > 0 {
+		js.UnschedulableTasks = unschedulable
+	}
+}
+
 // Converts a SagaState to a corresponding JobStatus
 func convertSagaStateToJobStatus(sagaState *s.SagaState) *scoot.JobStatus {
 	js := scoot.NewJobStatus()
@@ -64,6 +76,14 @@ func convertSagaStateToJobStatus(sagaState *s.SagaState) *scoot.JobStatus {
 			} else if sagaState.IsTaskStarted(id) {
 				taskStatus = scoot.Status_ROLLING_BACK
 			}
+			// Tasks without a cleanup end their compensating task without data, report how they ended instead.
+			data := sagaState.GetEndCompTaskData(id)
+			if data == nil {
+				data = sagaState.GetEndTaskData(id)
+			}
+			if thriftJobStatus, err := workerRunStatusToScootRunStatus(data); err == nil && thriftJobStatus != nil {
+				js.TaskData[id] = thriftJobStatus
+			}
 		} else {
 			if sagaState.IsTaskCompleted(id) {
 				taskStatus = scoot.Status_COMPLETED
@@ -115,6 +135,11 @@ func workerRunStatusToScootRunStatus(resultsFromSaga []byte) (*scoot.RunStatus,
 	workerRunStatus := worker.RunStatus{}
 	thrifthelpers.JsonDeserialize(&workerRunStatus, resultsFromSaga)
 
+	return workerThriftRunStatusToScoot(&workerRunStatus)
+}
+
+// Translates a worker RunStatus, along with the earlier attempts it carries.
+func workerThriftRunStatusToScoot(workerRunStatus *worker.RunStatus) (*scoot.RunStatus, error) {
 	status, err := scoot.RunStatusStateFromString(workerRunStatus.Status.String())
 	if err != nil {
 		return nil, err
@@ -129,6 +154,13 @@ func workerRunStatusToScootRunStatus(resultsFromSaga []byte) (*scoot.RunStatus,
 		Error:      workerRunStatus.Error,
 		SnapshotId: workerRunStatus.SnapshotId,
 	}
+	for _, attempt := range workerRunStatus.PreviousAttempts {
+		previous, err := workerThriftRunStatusToScoot(attempt)
+		if err != nil {
+			return nil, err
+		}
+		scootRunStatus.PreviousAttempts = append(scootRunStatus.PreviousAttempts, previous)
+	}
 
 	return &scootRunStatus, nil
 }
@@ -1,6 +1,7 @@
 package thrift
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 	s "github.com/twitter/scoot/saga"
 	"github.com/twitter/scoot/saga/sagalogs"
 	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
+	"github.com/twitter/scoot/scheduler/server"
 	"github.com/twitter/scoot/worker/domain"
 	"github.com/twitter/scoot/worker/domain/gen-go/worker"
 )
@@ -228,3 +230,67 @@ func TestRunStatusRoundTrip(t *testing.T) {
 		t.Fatalf("runStatus.OutUri: %v (expected %v)", *runStatus.OutUri, stdoutRef)
 	}
 }
+
+func TestRunStatusRoundTrip_PreviousAttempts(t *testing.T) {
+	sagaCoord := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	jobID, taskID := "foo", "t"
+	saga, err := sagaCoord.MakeSaga(jobID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	previous := []runner.RunStatus{
+		{RunID: runner.RunID("1"), State: runner.FAILED, ExitCode: 210},
+		{RunID: runner.RunID("2"), State: runner.COMPLETE, ExitCode: 75},
+	}
+	st := runner.RunStatus{RunID: runner.RunID("3"), State: runner.COMPLETE}
+	statusAsBytes, err := domain.SerializeProcessStatusWithAttempts(st, previous)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = saga.BulkMessage([]s.SagaMessage{
+		s.MakeStartTaskMessage(jobID, taskID, nil),
+		s.MakeEndTaskMessage(jobID, taskID, statusAsBytes),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	jobStatus, err := GetJobStatus(jobID, sagaCoord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runStatus := jobStatus.TaskData[taskID]
+	if runStatus == nil || runStatus.RunId != "3" || len(runStatus.PreviousAttempts) != 2 {
+		t.Fatalf("Expected the run status to carry 2 previous attempts, got %v", runStatus)
+	}
+	for i, attempt := range runStatus.PreviousAttempts {
+		if attempt.RunId != string(previous[i].RunID) || attempt.GetExitCode() != int32(previous[i].ExitCode) {
+			t.Errorf("Expected attempt %d to be %v, got %v", i, previous[i], attempt)
+		}
+	}
+}
+
+func Test_AddUnschedulableTasks(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	unschedulable := map[string]string{"task1": "no node matches required labels [rack=r3]"}
+	scheduler := server.NewMockScheduler(mockCtrl)
+	scheduler.EXPECT().GetUnschedulableTasks("job1").Return(unschedulable)
+	scheduler.EXPECT().GetUnschedulableTasks("job2").Return(nil)
+
+	js := &scoot.JobStatus{ID: "job1", Status: scoot.Status_IN_PROGRESS}
+	AddUnschedulableTasks(js, scheduler)
+	if !reflect.DeepEqual(js.UnschedulableTasks, unschedulable) {
+		t.Errorf("Expected unschedulable tasks %v, got %v", unschedulable, js.UnschedulableTasks)
+	}
+
+	js = &scoot.JobStatus{ID: "job2", Status: scoot.Status_IN_PROGRESS}
+	AddUnschedulableTasks(js, scheduler)
+	if js.UnschedulableTasks != nil {
+		t.Errorf("Expected no unschedulable tasks when the scheduler reports none, got %v", js.UnschedulableTasks)
+	}
+
+	// completed jobs aren't looked up
+	AddUnschedulableTasks(&scoot.JobStatus{ID: "job3", Status: scoot.Status_COMPLETED}, scheduler)
+}
@@ -0,0 +1,254 @@
+package thrift
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	s "github.com/twitter/scoot/saga"
+	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
+	"github.com/twitter/scoot/scheduler/domain"
+	"github.com/twitter/scoot/scheduler/server"
+)
+
+// Implementation of the ListJobs API.  In progress jobs matching the request's filter are
+// taken from the scheduler, and if history is requested the jobs that are no longer being
+// scheduled are read from the saga log, or from the cache of ended jobs if it's not nil.
+// In progress jobs are listed first, followed by the historical jobs ordered by id.
+func ListJobs(req *scoot.ListJobsRequest, scheduler server.Scheduler, sc s.SagaCoordinator,
+	cache *JobSummaryCache) (*scoot.ListJobsResponse, error) {
+	if req == nil {
+		req = scoot.NewListJobsRequest()
+	}
+	filter, err := thriftListJobsRequestToDomainFilter(req)
+	if err != nil {
+		ir := scoot.NewInvalidRequest()
+		msg := err.Error()
+		ir.Message = &msg
+		return nil, ir
+	}
+	maxJobs := int(req.GetMaxJobs())
+
+	summaries, err := scheduler.ListJobs(filter)
+	if err != nil {
+		return nil, scoot.NewScootServerError()
+	}
+	if maxJobs > 0 && len(summaries) > maxJobs {
+		summaries = summaries[:maxJobs]
+	}
+
+	if req.GetIncludeHistory() && (maxJobs == 0 || len(summaries) < maxJobs) {
+		history, err := listHistoricalJobs(filter, summaries, maxJobs-len(summaries), sc, cache)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, history...)
+	}
+
+	resp := scoot.NewListJobsResponse()
+	resp.Jobs = make([]*scoot.JobSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		resp.Jobs = append(resp.Jobs, domainJobSummaryToThrift(summary))
+	}
+	return resp, nil
+}
+
+// Caches the summaries of ended jobs, which don't change, so ListJobs only reads the saga
+// of a historical job from the log until it has ended.
+type JobSummaryCache struct {
+	summaries map[string]domain.JobSummary
+	mutex     sync.Mutex
+}
+
+func NewJobSummaryCache() *JobSummaryCache {
+	return &JobSummaryCache{summaries: map[string]domain.JobSummary{}}
+}
+
+func (c *JobSummaryCache) get(id string) (domain.JobSummary, bool) {
+	if c == nil {
+		return domain.JobSummary{}, false
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	summary, ok := c.summaries[id]
+	return summary, ok
+}
+
+func (c *JobSummaryCache) put(summary domain.JobSummary) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.summaries[summary.ID] = summary
+}
+
+// Drops the summaries of the jobs that are no longer in the saga log.
+func (c *JobSummaryCache) retain(ids []string) {
+	if c == nil {
+		return
+	}
+	keep := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		keep[id] = true
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for id := range c.summaries {
+		if !keep[id] {
+			delete(c.summaries, id)
+		}
+	}
+}
+
+// Reads the jobs in the saga log that aren't in the live list and match the filter.
+// At most maxJobs summaries are returned when maxJobs is positive.
+func listHistoricalJobs(filter domain.JobFilter, live []domain.JobSummary, maxJobs int,
+	sc s.SagaCoordinator, cache *JobSummaryCache) ([]domain.JobSummary, error) {
+	ids, err := sc.ListSagas()
+	if err != nil {
+		return nil, scoot.NewScootServerError()
+	}
+	sort.Strings(ids)
+	cache.retain(ids)
+
+	liveIds := make(map[string]bool, len(live))
+	for _, summary := range live {
+		liveIds[summary.ID] = true
+	}
+
+	history := []domain.JobSummary{}
+	for _, id := range ids {
+		if liveIds[id] {
+			continue
+		}
+		summary, ok := cache.get(id)
+		if !ok {
+			state, err := sc.GetSagaState(id)
+			if err != nil || state == nil {
+				// the saga may have been removed from the log since it was listed
+				log.Infof("Skipping job %s in ListJobs, cannot read its saga state: %v", id, err)
+				continue
+			}
+			if summary, err = sagaStateToJobSummary(state); err != nil {
+				log.Infof("Skipping job %s in ListJobs, cannot deserialize its definition: %v", id, err)
+				continue
+			}
+			if state.IsSagaCompleted() {
+				cache.put(summary)
+			}
+		}
+		if !filter.Matches(summary) {
+			continue
+		}
+		history = append(history, summary)
+		if maxJobs > 0 && len(history) == maxJobs {
+			break
+		}
+	}
+	return history, nil
+}
+
+// Summarizes a job from its saga state, using the same status rules as the scheduler's
+// summaries of live jobs.
+func sagaStateToJobSummary(state *s.SagaState) (domain.JobSummary, error) {
+	job, err := domain.DeserializeJob(state.Job())
+	if err != nil {
+		return domain.JobSummary{}, err
+	}
+
+	summary := domain.JobSummary{
+		ID:        state.SagaId(),
+		Requestor: job.Def.Requestor,
+		Tag:       job.Def.Tag,
+		Basis:     job.Def.Basis,
+		JobType:   job.Def.JobType,
+		Priority:  job.Def.Priority,
+		NumTasks:  len(job.Def.Tasks),
+	}
+	for _, id := range state.GetTaskIds() {
+		if state.IsTaskCompleted(id) {
+			summary.NumCompletedTasks++
+		} else if state.IsTaskStarted(id) {
+			summary.NumRunningTasks++
+		}
+	}
+
+	switch {
+	case state.IsSagaCompleted() && state.IsSagaAborted():
+		summary.Status = domain.RolledBack
+	case state.IsSagaCompleted():
+		summary.Status = domain.Completed
+	case summary.NumRunningTasks == 0 && summary.NumCompletedTasks == 0:
+		summary.Status = domain.NotStarted
+	case state.IsSagaAborted():
+		summary.Status = domain.RollingBack
+	default:
+		summary.Status = domain.InProgress
+	}
+	return summary, nil
+}
+
+func thriftListJobsRequestToDomainFilter(req *scoot.ListJobsRequest) (domain.JobFilter, error) {
+	filter := domain.JobFilter{
+		Requestor: req.GetRequestor(),
+		Tag:       req.GetTag(),
+		Basis:     req.GetBasis(),
+		JobType:   req.GetJobType(),
+	}
+	if req.IsSetPriority() {
+		priority := domain.Priority(req.GetPriority())
+		if priority < domain.P0 || priority > domain.P2 {
+			return filter, fmt.Errorf("invalid priority %d, must be between %d and %d", priority, domain.P0, domain.P2)
+		}
+		filter.Priority = &priority
+	}
+	if req.IsSetStatus() {
+		status, ok := thriftStatusToDomain[req.GetStatus()]
+		if !ok {
+			return filter, fmt.Errorf("invalid status %s", req.GetStatus())
+		}
+		filter.Status = &status
+	}
+	if req.GetMaxJobs() < 0 {
+		return filter, fmt.Errorf("invalid maxJobs %d, must be >= 0", req.GetMaxJobs())
+	}
+	return filter, nil
+}
+
+func domainJobSummaryToThrift(summary domain.JobSummary) *scoot.JobSummary {
+	js := scoot.NewJobSummary()
+	js.ID = summary.ID
+	js.Status = domainStatusToThrift[summary.Status]
+	js.Requestor = &summary.Requestor
+	js.Tag = &summary.Tag
+	js.Basis = &summary.Basis
+	js.JobType = &summary.JobType
+	priority := int32(summary.Priority)
+	js.Priority = &priority
+	numTasks := int32(summary.NumTasks)
+	js.NumTasks = &numTasks
+	numRunning := int32(summary.NumRunningTasks)
+	js.NumRunningTasks = &numRunning
+	numCompleted := int32(summary.NumCompletedTasks)
+	js.NumCompletedTasks = &numCompleted
+	return js
+}
+
+var thriftStatusToDomain = map[scoot.Status]domain.Status{
+	scoot.Status_NOT_STARTED:  domain.NotStarted,
+	scoot.Status_IN_PROGRESS:  domain.InProgress,
+	scoot.Status_COMPLETED:    domain.Completed,
+	scoot.Status_ROLLING_BACK: domain.RollingBack,
+	scoot.Status_ROLLED_BACK:  domain.RolledBack,
+}
+
+var domainStatusToThrift = map[domain.Status]scoot.Status{
+	domain.NotStarted:  scoot.Status_NOT_STARTED,
+	domain.InProgress:  scoot.Status_IN_PROGRESS,
+	domain.Completed:   scoot.Status_COMPLETED,
+	domain.RollingBack: scoot.Status_ROLLING_BACK,
+	domain.RolledBack:  scoot.Status_ROLLED_BACK,
+}
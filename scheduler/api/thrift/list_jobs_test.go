@@ -0,0 +1,170 @@
+package thrift
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/twitter/scoot/saga/sagalogs"
+	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
+	"github.com/twitter/scoot/scheduler/domain"
+	"github.com/twitter/scoot/scheduler/server"
+	"github.com/twitter/scoot/tests/testhelpers"
+)
+
+func Test_ListJobs_LiveAndHistory(t *testing.T) {
+	rng := testhelpers.NewRand()
+	sagaCoord := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+
+	// a finished job from the land requestor that is only in the saga log
+	done := domain.GenJob(testhelpers.GenJobId(rng), 2)
+	done.Def.Requestor = "land"
+	doneBytes, _ := done.Serialize()
+	saga, _ := sagaCoord.MakeSaga(done.Id, doneBytes)
+	for _, task := range done.Def.Tasks {
+		saga.StartTask(task.TaskID, nil)
+		saga.EndTask(task.TaskID, nil)
+	}
+	saga.EndSaga()
+
+	// a finished job from another requestor
+	other := domain.GenJob(testhelpers.GenJobId(rng), 1)
+	other.Def.Requestor = "other"
+	otherBytes, _ := other.Serialize()
+	sagaCoord.MakeSaga(other.Id, otherBytes)
+
+	// a live job that is also in the saga log, it must only be listed once
+	live := domain.GenJob(testhelpers.GenJobId(rng), 3)
+	live.Def.Requestor = "land"
+	liveBytes, _ := live.Serialize()
+	sagaCoord.MakeSaga(live.Id, liveBytes)
+	liveSummary := domain.JobSummary{ID: live.Id, Status: domain.InProgress, Requestor: "land", NumTasks: 3, NumRunningTasks: 1}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	scheduler := server.NewMockScheduler(mockCtrl)
+	scheduler.EXPECT().ListJobs(domain.JobFilter{Requestor: "land"}).Return([]domain.JobSummary{liveSummary}, nil).Times(2)
+
+	requestor := "land"
+	req := scoot.NewListJobsRequest()
+	req.Requestor = &requestor
+	resp, err := ListJobs(req, scheduler, sagaCoord, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error listing jobs: %v", err)
+	}
+	if len(resp.Jobs) != 1 || resp.Jobs[0].ID != live.Id || resp.Jobs[0].GetNumRunningTasks() != 1 {
+		t.Fatalf("Expected only the live job %s, got %v", live.Id, resp.Jobs)
+	}
+
+	includeHistory := true
+	req.IncludeHistory = &includeHistory
+	resp, err = ListJobs(req, scheduler, sagaCoord, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error listing jobs: %v", err)
+	}
+	if len(resp.Jobs) != 2 || resp.Jobs[0].ID != live.Id || resp.Jobs[1].ID != done.Id {
+		t.Fatalf("Expected the live job %s and the finished job %s, got %v", live.Id, done.Id, resp.Jobs)
+	}
+	if resp.Jobs[1].Status != scoot.Status_COMPLETED || resp.Jobs[1].GetNumCompletedTasks() != 2 {
+		t.Errorf("Expected the finished job to be completed with 2 tasks, got %v", resp.Jobs[1])
+	}
+}
+
+func Test_ListJobs_HistoryStatusAndCache(t *testing.T) {
+	rng := testhelpers.NewRand()
+	sagaCoord := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+
+	done := domain.GenJob(testhelpers.GenJobId(rng), 1)
+	doneBytes, _ := done.Serialize()
+	saga, _ := sagaCoord.MakeSaga(done.Id, doneBytes)
+	saga.StartTask(done.Def.Tasks[0].TaskID, nil)
+	saga.EndTask(done.Def.Tasks[0].TaskID, nil)
+	saga.EndSaga()
+
+	// a job that isn't being scheduled anymore, none of its tasks were started
+	waiting := domain.GenJob(testhelpers.GenJobId(rng), 1)
+	waitingBytes, _ := waiting.Serialize()
+	sagaCoord.MakeSaga(waiting.Id, waitingBytes)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	scheduler := server.NewMockScheduler(mockCtrl)
+	scheduler.EXPECT().ListJobs(gomock.Any()).Return(nil, nil).Times(2)
+
+	cache := NewJobSummaryCache()
+	cache.put(domain.JobSummary{ID: "gced"})
+	includeHistory := true
+	req := scoot.NewListJobsRequest()
+	req.IncludeHistory = &includeHistory
+	resp, err := ListJobs(req, scheduler, sagaCoord, cache)
+	if err != nil {
+		t.Fatalf("Unexpected error listing jobs: %v", err)
+	}
+	statuses := map[string]scoot.Status{}
+	for _, job := range resp.Jobs {
+		statuses[job.ID] = job.Status
+	}
+	if len(resp.Jobs) != 2 || statuses[done.Id] != scoot.Status_COMPLETED || statuses[waiting.Id] != scoot.Status_NOT_STARTED {
+		t.Fatalf("Expected the completed job %s and the not started job %s, got %v", done.Id, waiting.Id, resp.Jobs)
+	}
+
+	// only the ended job is cached, and the job that's no longer in the saga log is dropped
+	if _, ok := cache.get(done.Id); !ok {
+		t.Errorf("Expected the ended job to be cached")
+	}
+	if _, ok := cache.get(waiting.Id); ok {
+		t.Errorf("Expected the job that hasn't ended not to be cached")
+	}
+	if _, ok := cache.get("gced"); ok {
+		t.Errorf("Expected the job that's no longer in the saga log to be dropped")
+	}
+
+	// the cached summary is listed without reading the saga
+	cache.put(domain.JobSummary{ID: done.Id, Status: domain.Completed, Tag: "cached"})
+	resp, err = ListJobs(req, scheduler, sagaCoord, cache)
+	if err != nil {
+		t.Fatalf("Unexpected error listing jobs: %v", err)
+	}
+	for _, job := range resp.Jobs {
+		if job.ID == done.Id && job.GetTag() != "cached" {
+			t.Errorf("Expected the cached summary of job %s, got %v", done.Id, job)
+		}
+	}
+}
+
+func Test_ListJobs_MaxJobs(t *testing.T) {
+	sagaCoord := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	scheduler := server.NewMockScheduler(mockCtrl)
+	scheduler.EXPECT().ListJobs(gomock.Any()).Return([]domain.JobSummary{{ID: "1"}, {ID: "2"}, {ID: "3"}}, nil)
+
+	maxJobs := int32(2)
+	includeHistory := true
+	req := scoot.NewListJobsRequest()
+	req.MaxJobs = &maxJobs
+	req.IncludeHistory = &includeHistory
+	resp, err := ListJobs(req, scheduler, sagaCoord, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error listing jobs: %v", err)
+	}
+	if len(resp.Jobs) != 2 {
+		t.Errorf("Expected 2 jobs, got %v", resp.Jobs)
+	}
+}
+
+func Test_ListJobs_InvalidRequest(t *testing.T) {
+	sagaCoord := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	scheduler := server.NewMockScheduler(mockCtrl)
+
+	priority := int32(5)
+	req := scoot.NewListJobsRequest()
+	req.Priority = &priority
+	if _, err := ListJobs(req, scheduler, sagaCoord, nil); err == nil {
+		t.Errorf("Expected an invalid priority to be rejected")
+	} else if _, ok := err.(*scoot.InvalidRequest); !ok {
+		t.Errorf("Expected InvalidRequest, got %v", err)
+	}
+}
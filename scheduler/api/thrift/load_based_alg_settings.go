@@ -12,8 +12,8 @@ func GetClassLoadPercents(scheduler server.Scheduler) (map[string]int32, error)
 }
 
 // SetClassLoadPercents set the target worker load % for each job class
-func SetClassLoadPercents(scheduler server.Scheduler, classLoads map[string]int32) error {
-	return scheduler.SetClassLoadPercents(classLoads)
+func SetClassLoadPercents(scheduler server.Scheduler, classLoads map[string]int32, requestor string) error {
+	return scheduler.SetClassLoadPercents(classLoads, requestor)
 }
 
 // GetRequestorToClassMap get map of requestor (reg exp) to class load pct
@@ -22,8 +22,8 @@ func GetRequestorToClassMap(scheduler server.Scheduler) (map[string]string, erro
 }
 
 // SetRequestorToClassMap set the map of requestor (requestor value is reg exp) to class name
-func SetRequestorToClassMap(scheduler server.Scheduler, requestorToClassMap map[string]string) error {
-	return scheduler.SetRequestorToClassMap(requestorToClassMap)
+func SetRequestorToClassMap(scheduler server.Scheduler, requestorToClassMap map[string]string, requestor string) error {
+	return scheduler.SetRequestorToClassMap(requestorToClassMap, requestor)
 }
 
 // GetRebalanceMinimumDuration get the duration (min) that the rebalance threshold must be exceeded before
@@ -34,8 +34,8 @@ func GetRebalanceMinimumDuration(scheduler server.Scheduler) (time.Duration, err
 
 // SetRebalanceMinimumDuration get the duration (min) that the rebalance threshold must be exceeded before
 // triggering rebalance.  <= 0 implies no rebalancing
-func SetRebalanceMinimumDuration(scheduler server.Scheduler, duration time.Duration) error {
-	return scheduler.SetRebalanceMinimumDuration(duration)
+func SetRebalanceMinimumDuration(scheduler server.Scheduler, duration time.Duration, requestor string) error {
+	return scheduler.SetRebalanceMinimumDuration(duration, requestor)
 }
 
 // GetRebalanceThreshold get the rebalance threshold.  The %s spread must exceed this for RebalanceMinimumDuration
@@ -46,6 +46,6 @@ func GetRebalanceThreshold(scheduler server.Scheduler) (int32, error) {
 
 // SetRebalanceThreshold get the rebalance threshold.  The %s spread must exceed this for RebalanceMinimumDuration
 // to trigger rebalance.  <= 0 implies no rebalancing
-func SetRebalanceThreshold(scheduler server.Scheduler, threshold int32) error {
-	return scheduler.SetRebalanceThreshold(threshold)
+func SetRebalanceThreshold(scheduler server.Scheduler, threshold int32, requestor string) error {
+	return scheduler.SetRebalanceThreshold(threshold, requestor)
 }
@@ -2,6 +2,7 @@ package thrift
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
 	"github.com/twitter/scoot/scheduler/domain"
@@ -20,7 +21,13 @@ func thriftOfflineReqToScoot(req *scoot.OfflineWorkerReq) (result domain.Offline
 	if req == nil {
 		return result, fmt.Errorf("nil OfflineWorkerRequest")
 	}
+	if req.GetDurationMs() < 0 {
+		return result, fmt.Errorf("negative offline duration %dms", req.GetDurationMs())
+	}
 	result.ID = req.GetID()
 	result.Requestor = req.GetRequestor()
+	result.Reason = req.GetReason()
+	result.Duration = time.Duration(req.GetDurationMs()) * time.Millisecond
+	result.IDIsPattern = req.GetIdIsPattern()
 	return result, nil
 }
@@ -22,5 +22,6 @@ func thriftReinstateReqToScoot(req *scoot.ReinstateWorkerReq) (result domain.Rei
 	}
 	result.ID = req.GetID()
 	result.Requestor = req.GetRequestor()
+	result.IDIsPattern = req.GetIdIsPattern()
 	return result, nil
 }
@@ -4,12 +4,21 @@ import (
 	"fmt"
 	"time"
 
+	log "github.com/sirupsen/logrus"
+
+	"github.com/twitter/scoot/common/errors"
 	"github.com/twitter/scoot/common/stats"
+	"github.com/twitter/scoot/runner"
+	"github.com/twitter/scoot/runner/execer"
 	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
 	"github.com/twitter/scoot/scheduler/domain"
 	"github.com/twitter/scoot/scheduler/server"
 )
 
+// How long clients of a standby scheduler should wait before retrying,
+// by which time they can resolve the elected leader.
+const notLeaderRetryAfterMs int64 = 1000
+
 // Implementation of the RunJob API
 func RunJob(scheduler server.Scheduler, def *scoot.JobDefinition, stat stats.StatsReceiver) (*scoot.JobId, error) {
 	jobDef, err := thriftJobToScoot(def)
@@ -25,7 +34,14 @@ func RunJob(scheduler server.Scheduler, def *scoot.JobDefinition, stat stats.Sta
 
 	id, err := scheduler.ScheduleJob(jobDef)
 
-	if err != nil {
+	if notLeader, ok := err.(*server.NotLeaderError); ok {
+		// a standby scheduler, the client should retry against the leader
+		log.Infof("Rejecting RunJob: %v", notLeader)
+		cnsn := scoot.NewCanNotScheduleNow()
+		retryAfterMs := notLeaderRetryAfterMs
+		cnsn.RetryAfterMs = &retryAfterMs
+		return nil, cnsn
+	} else if err != nil {
 		return nil, err //TODO: use or delete scoot.NewCanNotScheduleNow()
 	}
 
@@ -52,9 +68,21 @@ func thriftJobToScoot(def *scoot.JobDefinition) (result domain.JobDefinition, er
 		for k, v := range t.Command.EnvVars {
 			task.Command.EnvVars[k] = v
 		}
+		task.Command.EnvMode = execer.EnvMode(t.Command.GetEnvMode())
+		task.Command.EnvAllowlist = t.Command.EnvAllowlist
+		task.Command.SecretEnv = t.Command.SecretEnv
 		if t.SnapshotId != nil {
 			task.SnapshotID = *t.SnapshotId
 		}
+		task.OutputPaths = t.OutputPaths
+		if t.Resources != nil {
+			task.Resources = runner.Resources{
+				CPUMillis:   t.Resources.GetCpuMillis(),
+				MemoryBytes: t.Resources.GetMemoryBytes(),
+				DiskBytes:   t.Resources.GetDiskBytes(),
+			}
+		}
+		task.Dependencies = t.Dependencies
 		if t.TimeoutMs != nil && *t.TimeoutMs > 0 {
 			task.Command.Timeout = time.Duration(*t.TimeoutMs) * time.Millisecond
 		} else if def.DefaultTaskTimeoutMs != nil {
@@ -64,6 +92,16 @@ func thriftJobToScoot(def *scoot.JobDefinition) (result domain.JobDefinition, er
 			return result, fmt.Errorf("nil taskId")
 		}
 		task.TaskID = *t.TaskId
+		if task.RequiredLabels, err = domain.ParseLabelSelectors(t.RequiredLabels); err != nil {
+			return result, NewInvalidJobRequest(fmt.Sprintf("invalid task %s: %v", task.TaskID, err))
+		}
+		if task.PreferredLabels, err = domain.ParseLabelSelectors(t.PreferredLabels); err != nil {
+			return result, NewInvalidJobRequest(fmt.Sprintf("invalid task %s: %v", task.TaskID, err))
+		}
+		if task.Cleanup, err = thriftCleanupToScoot(t.Cleanup, task.SnapshotID, def.DefaultTaskTimeoutMs); err != nil {
+			return result, err
+		}
+		task.RetryPolicy = thriftRetryPolicyToScoot(t.RetryPolicy)
 
 		result.Tasks = append(result.Tasks, task)
 	}
@@ -83,6 +121,60 @@ func thriftJobToScoot(def *scoot.JobDefinition) (result domain.JobDefinition, er
 	if def.Priority != nil {
 		result.Priority = domain.Priority(*def.Priority)
 	}
+	if result.Cleanup, err = thriftCleanupToScoot(def.Cleanup, "", def.DefaultTaskTimeoutMs); err != nil {
+		return result, err
+	}
 
 	return result, nil
 }
+
+// Translates a thrift cleanup to the command to run, nil if there's no cleanup.
+// The snapshotID and default timeout are used unless the cleanup sets its own.
+func thriftCleanupToScoot(c *scoot.Cleanup, snapshotID string, defaultTimeoutMs *int32) (*runner.Command, error) {
+	if c == nil {
+		return nil, nil
+	}
+	if c.Command == nil {
+		return nil, fmt.Errorf("nil cleanup command")
+	}
+	cmd := &runner.Command{
+		Argv:       c.Command.Argv,
+		EnvVars:    make(map[string]string),
+		SnapshotID: snapshotID,
+	}
+	for k, v := range c.Command.EnvVars {
+		cmd.EnvVars[k] = v
+	}
+	cmd.EnvMode = execer.EnvMode(c.Command.GetEnvMode())
+	cmd.EnvAllowlist = c.Command.EnvAllowlist
+	cmd.SecretEnv = c.Command.SecretEnv
+	if c.SnapshotId != nil {
+		cmd.SnapshotID = *c.SnapshotId
+	}
+	if c.TimeoutMs != nil && *c.TimeoutMs > 0 {
+		cmd.Timeout = time.Duration(*c.TimeoutMs) * time.Millisecond
+	} else if defaultTimeoutMs != nil {
+		cmd.Timeout = time.Duration(*defaultTimeoutMs) * time.Millisecond
+	}
+	return cmd, nil
+}
+
+// Translates a thrift retry policy, nil if the task doesn't have one.
+func thriftRetryPolicyToScoot(p *scoot.RetryPolicy) *domain.RetryPolicy {
+	if p == nil {
+		return nil
+	}
+	policy := &domain.RetryPolicy{
+		MaxAttempts: int(p.GetMaxAttempts()),
+		Backoff:     time.Duration(p.GetBackoffMs()) * time.Millisecond,
+		MaxBackoff:  time.Duration(p.GetMaxBackoffMs()) * time.Millisecond,
+	}
+	for _, code := range p.RetryableExitCodes {
+		policy.RetryableExitCodes = append(policy.RetryableExitCodes, errors.ExitCode(code))
+	}
+	for _, state := range p.RetryableStates {
+		// RunStatusState values match runner.RunState's, ValidateJob rejects the ones a failed run can't end in.
+		policy.RetryableStates = append(policy.RetryableStates, runner.RunState(state))
+	}
+	return policy
+}
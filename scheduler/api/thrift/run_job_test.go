@@ -4,9 +4,11 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/twitter/scoot/common/stats"
+	"github.com/twitter/scoot/runner"
 	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
 	"github.com/twitter/scoot/scheduler/server"
 	"github.com/twitter/scoot/tests/testhelpers"
@@ -63,6 +65,134 @@ func Test_RunJob_InvalidTaskId(t *testing.T) {
 	}
 }
 
+// Jobs with Tasks whose output paths leave the checkout should return InvalidJobRequest error
+func Test_RunJob_InvalidOutputPaths(t *testing.T) {
+	jobDef := scoot.NewJobDefinition()
+	task := testhelpers.GenTask(testhelpers.NewRand(), "1", "")
+	task.OutputPaths = []string{"out", "../../etc"}
+	jobDef.Tasks = []*scoot.TaskDefinition{task}
+	jobId, err := RunJob(CreateSchedulerMock(t), jobDef, stats.NilStatsReceiver())
+
+	if !IsInvalidJobRequest(err) {
+		t.Errorf("expected error to be InvalidJobRequest not %v", reflect.TypeOf(err))
+	}
+
+	if jobId != nil {
+		t.Errorf("expected job Id to be nil when error occurs not %v", jobId)
+	}
+}
+
+// Jobs with Tasks requesting negative resources should return InvalidJobRequest error
+func Test_RunJob_InvalidResources(t *testing.T) {
+	jobDef := scoot.NewJobDefinition()
+	task := testhelpers.GenTask(testhelpers.NewRand(), "1", "")
+	cpuMillis := int64(-500)
+	task.Resources = &scoot.Resources{CpuMillis: &cpuMillis}
+	jobDef.Tasks = []*scoot.TaskDefinition{task}
+	jobId, err := RunJob(CreateSchedulerMock(t), jobDef, stats.NilStatsReceiver())
+
+	if !IsInvalidJobRequest(err) {
+		t.Errorf("expected error to be InvalidJobRequest not %v", reflect.TypeOf(err))
+	}
+
+	if jobId != nil {
+		t.Errorf("expected job Id to be nil when error occurs not %v", jobId)
+	}
+}
+
+func Test_RunJob_InvalidLabelSelector(t *testing.T) {
+	jobDef := scoot.NewJobDefinition()
+	task := testhelpers.GenTask(testhelpers.NewRand(), "1", "")
+	task.RequiredLabels = []string{"rack=r1", "=r3"}
+	jobDef.Tasks = []*scoot.TaskDefinition{task}
+	jobId, err := RunJob(CreateSchedulerMock(t), jobDef, stats.NilStatsReceiver())
+
+	if !IsInvalidJobRequest(err) {
+		t.Errorf("expected error to be InvalidJobRequest not %v", reflect.TypeOf(err))
+	}
+
+	if jobId != nil {
+		t.Errorf("expected job Id to be nil when error occurs not %v", jobId)
+	}
+}
+
+func Test_RunJob_InvalidCleanup(t *testing.T) {
+	jobDef := scoot.NewJobDefinition()
+	task := testhelpers.GenTask(testhelpers.NewRand(), "1", "")
+	task.Cleanup = &scoot.Cleanup{Command: scoot.NewCommand()}
+	jobDef.Tasks = []*scoot.TaskDefinition{task}
+	jobId, err := RunJob(CreateSchedulerMock(t), jobDef, stats.NilStatsReceiver())
+
+	if !IsInvalidJobRequest(err) {
+		t.Errorf("expected error to be InvalidJobRequest not %v", reflect.TypeOf(err))
+	}
+
+	if jobId != nil {
+		t.Errorf("expected job Id to be nil when error occurs not %v", jobId)
+	}
+}
+
+func Test_ThriftJobToScoot_CleanupDefaults(t *testing.T) {
+	jobDef := scoot.NewJobDefinition()
+	task := testhelpers.GenTask(testhelpers.NewRand(), "1", "snap1")
+	task.Cleanup = &scoot.Cleanup{Command: &scoot.Command{Argv: []string{"undo"}}}
+	jobDef.Tasks = []*scoot.TaskDefinition{task}
+	timeoutMs, jobTimeoutMs := int32(1000), int32(2000)
+	jobDef.DefaultTaskTimeoutMs = &timeoutMs
+	jobDef.Cleanup = &scoot.Cleanup{Command: &scoot.Command{Argv: []string{"notify"}}, TimeoutMs: &jobTimeoutMs}
+
+	result, err := thriftJobToScoot(jobDef)
+	if err != nil {
+		t.Fatalf("unexpected error translating job %v", err)
+	}
+	if c := result.Tasks[0].Cleanup; c == nil || c.SnapshotID != "snap1" || c.Timeout != time.Second {
+		t.Errorf("expected the task's cleanup to default to its snapshot and the job's task timeout, got %+v", c)
+	}
+	if c := result.Cleanup; c == nil || c.SnapshotID != "" || c.Timeout != 2*time.Second {
+		t.Errorf("expected the job's cleanup to use its own timeout, got %+v", c)
+	}
+}
+
+func Test_RunJob_InvalidRetryPolicy(t *testing.T) {
+	jobDef := scoot.NewJobDefinition()
+	task := testhelpers.GenTask(testhelpers.NewRand(), "1", "")
+	task.RetryPolicy = &scoot.RetryPolicy{RetryableStates: []scoot.RunStatusState{scoot.RunStatusState_COMPLETE}}
+	jobDef.Tasks = []*scoot.TaskDefinition{task}
+	jobId, err := RunJob(CreateSchedulerMock(t), jobDef, stats.NilStatsReceiver())
+
+	if !IsInvalidJobRequest(err) {
+		t.Errorf("expected error to be InvalidJobRequest not %v", reflect.TypeOf(err))
+	}
+
+	if jobId != nil {
+		t.Errorf("expected job Id to be nil when error occurs not %v", jobId)
+	}
+}
+
+func Test_ThriftJobToScoot_RetryPolicy(t *testing.T) {
+	jobDef := scoot.NewJobDefinition()
+	task := testhelpers.GenTask(testhelpers.NewRand(), "1", "")
+	maxAttempts, backoffMs := int32(3), int32(500)
+	task.RetryPolicy = &scoot.RetryPolicy{
+		MaxAttempts:        &maxAttempts,
+		RetryableExitCodes: []int32{75},
+		RetryableStates:    []scoot.RunStatusState{scoot.RunStatusState_TIMEDOUT},
+		BackoffMs:          &backoffMs,
+	}
+	jobDef.Tasks = []*scoot.TaskDefinition{task}
+
+	result, err := thriftJobToScoot(jobDef)
+	if err != nil {
+		t.Fatalf("unexpected error translating job %v", err)
+	}
+	p := result.Tasks[0].RetryPolicy
+	if p == nil || p.MaxAttempts != 3 || p.Backoff != 500*time.Millisecond || p.MaxBackoff != 0 ||
+		len(p.RetryableExitCodes) != 1 || p.RetryableExitCodes[0] != 75 ||
+		len(p.RetryableStates) != 1 || p.RetryableStates[0] != runner.TIMEDOUT {
+		t.Errorf("expected the task's retry policy to be translated, got %+v", p)
+	}
+}
+
 // Jobs with Tasks with no commands should return InvalidJobRequest error
 func Test_RunJob_NoCommand(t *testing.T) {
 	jobDef := scoot.NewJobDefinition()
@@ -113,3 +243,20 @@ func Test_RunJob_SchedulerError(t *testing.T) {
 		t.Errorf("expected job Id to be nil when error occurs not %v", jobId)
 	}
 }
+
+func Test_RunJob_NotLeader(t *testing.T) {
+	jobDef := testhelpers.GenJobDefinition(testhelpers.NewRand(), -1, "")
+
+	scheduler := CreateSchedulerMock(t)
+	scheduler.EXPECT().ScheduleJob(gomock.Any()).Return("", &server.NotLeaderError{Leader: "leader:9090"})
+
+	jobId, err := RunJob(scheduler, jobDef, stats.NilStatsReceiver())
+
+	if cnsn, ok := err.(*scoot.CanNotScheduleNow); !ok || cnsn.GetRetryAfterMs() <= 0 {
+		t.Errorf("expected a standby scheduler to return CanNotScheduleNow with a retry delay not %v", err)
+	}
+
+	if jobId != nil {
+		t.Errorf("expected job Id to be nil when error occurs not %v", jobId)
+	}
+}
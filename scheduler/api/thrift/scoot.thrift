@@ -48,12 +48,59 @@ struct RunStatus {
   8: optional string jobId
   9: optional string taskId
   10: optional string tag
+  # Earlier failed runs of the task that were retried, oldest first.
+  11: optional list<RunStatus> previousAttempts
 }
 
 
+# How much of the worker's environment a command inherits. The worker's base environment, if it has
+# one, and the command's envVars are added to it.
+enum EnvMode {
+  INHERIT = 0      # The worker's whole environment
+  ALLOWLIST = 1    # Only the worker's variables listed in the command's envAllowlist
+  CLEAN = 2        # None of the worker's variables
+}
+
 struct Command {
   1: list<string> argv
   2: optional map<string, string> envVars
+  3: optional EnvMode envMode          # Defaults to INHERIT.
+  4: optional list<string> envAllowlist
+  # Env vars set to the value of a named secret, which the worker resolves from its secrets file.
+  # Only the secrets' names are stored by Scoot, their values are redacted from the task's logs.
+  5: optional map<string, string> secretEnv
+}
+
+# Resources a task needs, tasks that declare them can share a worker with other tasks
+# when it advertises enough capacity. Tasks without resources get a whole worker.
+struct Resources {
+  1: optional i64 cpuMillis     # thousandths of a CPU core
+  2: optional i64 memoryBytes
+  3: optional i64 diskBytes
+}
+
+# A command run on a worker to undo the effects of a job that was killed or had a task fail.
+struct Cleanup {
+  1: required Command command
+  # Defaults to the task's snapshotId for a task's cleanup, or an empty snapshot for the job's cleanup.
+  2: optional string snapshotId
+  # Defaults to the job's defaultTaskTimeoutMs.
+  3: optional i32 timeoutMs
+}
+
+# How a task's failed runs are retried. Infrastructure failures (a worker failing to check out the
+# snapshot, run the command or save its output) are retried while attempts remain, other failures
+# are only retried if listed below.
+struct RetryPolicy {
+  # Maximum number of runs of the task, including the first. Defaults to the scheduler's setting.
+  1: optional i32 maxAttempts
+  # Exit codes of completed runs to retry.
+  2: optional list<i32> retryableExitCodes
+  # End states of runs to retry, e.g. TIMEDOUT.
+  3: optional list<RunStatusState> retryableStates
+  # Time to wait before the first retry, doubled for each retry after that up to maxBackoffMs.
+  4: optional i32 backoffMs
+  5: optional i32 maxBackoffMs
 }
 
 struct TaskDefinition {
@@ -62,6 +109,24 @@ struct TaskDefinition {
   # TaskId should generally be unique, otherwise previous tasks with the same Requestor and Tag will be stomped.
   3: optional string taskId
   4: optional i32 timeoutMs
+  # Paths relative to the checkout that are ingested into a new output snapshot after the task runs.
+  # Use "." to ingest the whole checkout. The resulting id is returned in RunStatus.snapshotId.
+  5: optional list<string> outputPaths
+  # TaskIds of other tasks in this job that must complete with exit code 0 before this task is scheduled.
+  # If any of them fails, this task is skipped. Dependencies must not form a cycle.
+  6: optional list<string> dependencies
+  7: optional Resources resources
+  # Label selectors, written as "key", "!key", "key=value" or "key!=value", that constrain the workers
+  # this task runs on. The task only runs on workers matching all of requiredLabels, a task that no
+  # worker matches is reported in JobStatus.unschedulableTasks. Workers matching more of
+  # preferredLabels are picked first.
+  8: optional list<string> requiredLabels
+  9: optional list<string> preferredLabels
+  # Run if the job is killed or any of its tasks fails, for this task once it has been sent to a worker.
+  # The job's status is then ROLLING_BACK until the cleanups are done, and ROLLED_BACK after.
+  10: optional Cleanup cleanup
+  # Without a retry policy, runs that time out and infrastructure failures are retried.
+  11: optional RetryPolicy retryPolicy
 }
 
 struct JobDefinition {
@@ -79,6 +144,8 @@ struct JobDefinition {
   7: optional string requestor
   # JobType is used for stats and does not affect scheduling.
   8: optional string jobType
+  # Run if the job is killed or any of its tasks fails, after the tasks' cleanups.
+  9: optional Cleanup cleanup
 }
 
 struct JobId {
@@ -109,17 +176,112 @@ struct JobStatus {
   1: required string id
   2: required Status status
   3: optional map<string, Status> taskStatus
+  # For a job that's rolling back, a task's cleanup status once its cleanup has run.
   4: optional map<string, RunStatus> taskData
+  # Waiting tasks that no worker can run, by taskId, with the reason.
+  5: optional map<string, string> unschedulableTasks
+}
+
+struct WatchJobRequest {
+  1: required string jobId
+  # Version returned by the previous WatchJob call, or 0 to get the full job status.
+  2: optional i64 sinceVersion
+  # How long to wait for the job to change before returning an empty update.
+  # Capped (and defaulted) by the server so the call returns before the client times out.
+  3: optional i32 timeoutMs
+}
+
+struct WatchJobResponse {
+  # The current job status, taskStatus and taskData only include tasks that changed since sinceVersion.
+  1: required JobStatus jobStatus
+  # Pass as sinceVersion to the next WatchJob call to wait for newer changes.
+  2: required i64 version
+}
+
+struct ListJobsRequest {
+  # Only return jobs with all of the given fields set, unset fields match any job.
+  1: optional string requestor
+  2: optional string tag
+  3: optional string basis
+  4: optional string jobType
+  5: optional i32 priority
+  6: optional Status status
+  # Also return jobs that are no longer running but are still recorded in the saga log.
+  7: optional bool includeHistory
+  # Maximum number of jobs to return, in progress jobs are returned first. Unlimited if unset or 0.
+  8: optional i32 maxJobs
+}
+
+struct JobSummary {
+  1: required string id
+  2: required Status status
+  3: optional string requestor
+  4: optional string tag
+  5: optional string basis
+  6: optional string jobType
+  7: optional i32 priority
+  8: optional i32 numTasks
+  9: optional i32 numRunningTasks
+  10: optional i32 numCompletedTasks
+}
+
+struct ListJobsResponse {
+  1: required list<JobSummary> jobs
+}
+
+# Why the scheduler didn't start a waiting task in its last scheduling iteration.
+enum WaitReason {
+  # The task was added after the last scheduling iteration.
+  NOT_EVALUATED = 0
+  # The task's dependencies haven't all succeeded.
+  DEPENDENCIES = 1
+  # The task failed and is held back until its retry backoff expires.
+  RETRY_BACKOFF = 2
+  # No node matches the task's required labels.
+  UNSCHEDULABLE = 3
+  # The scheduling algorithm gave the free nodes to other tasks, e.g. the requestor's class is at its entitlement.
+  NOT_SELECTED = 4
+  # Every node is busy running tasks.
+  NO_IDLE_NODES = 5
+  # There are no nodes, or they're all suspended or offlined.
+  NO_AVAILABLE_NODES = 6
+  # No idle node has the resources the task requests, or its required labels.
+  NO_FITTING_NODE = 7
+}
+
+struct TaskExplanation {
+  1: required string taskId
+  2: required WaitReason reason
+  3: optional string detail
+}
+
+struct JobExplanation {
+  1: required string jobId
+  2: required Status status
+  # The job's tasks that are waiting to start, with the reason each wasn't started.
+  3: required list<TaskExplanation> waitingTasks
+  # Scheduler wide conditions that may hold the job back.
+  4: optional list<string> notes
+  # When the reasons were captured, the time of the scheduler's last scheduling iteration in ms since the epoch.
+  5: optional i64 explainedAtMs
 }
 
 struct OfflineWorkerReq {
   1: required string id
   2: required string requestor
+  # Why the worker is offlined, reported by GetClusterState.
+  3: optional string reason
+  # How long the worker stays offline before it's reinstated, until ReinstateWorker if not set.
+  4: optional i64 durationMs
+  # The id is a regular expression, all the workers it matches are offlined.
+  5: optional bool idIsPattern
 }
 
 struct ReinstateWorkerReq {
   1: required string id
   2: required string requestor
+  # The id is a regular expression, all the offlined workers it matches are reinstated.
+  3: optional bool idIsPattern
 }
 
 struct SchedulerStatus {
@@ -127,6 +289,70 @@ struct SchedulerStatus {
   2: required i32 maxTasks
 }
 
+# The state of a worker node in the scheduler's cluster.
+enum WorkerState {
+  # Ready and able to take another task.
+  IDLE = 0
+  # Ready and running as many tasks as it can.
+  BUSY = 1
+  # Added to the cluster but hasn't passed its readiness check yet.
+  NOT_READY = 2
+  # Reported gone by the cluster, removed if it doesn't come back.
+  LOST = 3
+  # Sidelined for a while after errors talking to it.
+  FLAKY = 4
+  # Taken out of rotation by OfflineWorker until it's reinstated.
+  OFFLINED = 5
+  # Shutting down after it finishes or hands back its runs, removed once it leaves the cluster.
+  DRAINING = 6
+}
+
+struct WorkerSummary {
+  1: required string id
+  2: required WorkerState state
+  # The job and task last scheduled on the worker, if it's running tasks.
+  3: optional string runningJobId
+  4: optional string runningTaskId
+  5: required i32 numRunningTasks
+  # The snapshot of the last task run on the worker, tasks with the same snapshot prefer it.
+  6: optional string snapshotId
+  # How long the worker has been lost, flaky or offlined.
+  7: optional i64 inStateForMs
+  # The requestor that offlined the worker.
+  8: optional string offlinedBy
+  9: optional map<string, string> labels
+  # Why the worker was offlined, and how long until it's reinstated if the offlining expires.
+  10: optional string offlineReason
+  11: optional i64 offlineExpiresInMs
+}
+
+struct ClusterState {
+  1: required list<WorkerSummary> workers
+}
+
+# A version of the scheduler settings kept by the scheduler's settings persistor.
+struct SettingsVersion {
+  1: required i32 version
+  # When the version was persisted, in ms since the epoch.
+  2: required i64 persistedAtMs
+  # The requestor whose change the version was persisted for, and a description of the change.
+  3: optional string changedBy
+  4: optional string change
+  # The persisted settings as JSON.
+  5: required string settingsJson
+}
+
+struct SettingsHistory {
+  # Oldest version first.
+  1: required list<SettingsVersion> versions
+}
+
+struct RollbackSettingsReq {
+  # The version whose settings are restored, persisted as a new version.
+  1: required i32 version
+  2: required string requestor
+}
+
 service CloudScoot {
    JobId RunJob(1: JobDefinition job) throws (
     1: InvalidRequest ir
@@ -136,6 +362,18 @@ service CloudScoot {
     1: InvalidRequest ir
     2: ScootServerError err
   )
+  WatchJobResponse WatchJob(1: WatchJobRequest req) throws (
+    1: InvalidRequest ir
+    2: ScootServerError err
+  )
+  ListJobsResponse ListJobs(1: ListJobsRequest req) throws (
+    1: InvalidRequest ir
+    2: ScootServerError err
+  )
+  JobExplanation ExplainJob(1: string jobId) throws (
+    1: InvalidRequest ir
+    2: ScootServerError err
+  )
   JobStatus KillJob(1: string jobId) throws (
     1: InvalidRequest ir
     2: ScootServerError err
@@ -148,38 +386,49 @@ service CloudScoot {
     1: InvalidRequest ir
     2: ScootServerError err
   )
+  ClusterState GetClusterState() throws (
+    1: ScootServerError err
+  )
   SchedulerStatus GetSchedulerStatus() throws (
     1: ScootServerError err
   )
-  void SetSchedulerStatus(1: i32 maxTasks) throws (
+  void SetSchedulerStatus(1: i32 maxTasks, 2: string requestor) throws (
     1: InvalidRequest ir
     2: ScootServerError err
   )
   map<string, i32> GetClassLoadPercents() throws (
     1: InvalidRequest ir
   )
-  void SetClassLoadPercents (1: map<string, i32> loadPercents) throws (
+  void SetClassLoadPercents (1: map<string, i32> loadPercents, 2: string requestor) throws (
     1: InvalidRequest ir
     2: ScootServerError err
   )
   map<string, string> GetRequestorToClassMap() throws (
     1: InvalidRequest ir
   )
-  void SetRequestorToClassMap (1: map<string, string> requestorToClassMap) throws (
+  void SetRequestorToClassMap (1: map<string, string> requestorToClassMap, 2: string requestor) throws (
     1: InvalidRequest ir
     2: ScootServerError err
   )
   i32 GetRebalanceMinimumDuration() throws (
     1: InvalidRequest ir
   )
-  void SetRebalanceMinimumDuration(1: i32 durationMin) throws (
+  void SetRebalanceMinimumDuration(1: i32 durationMin, 2: string requestor) throws (
     1: InvalidRequest ir
     2: ScootServerError err
   )
   i32 GetRebalanceThreshold() throws (
     1: InvalidRequest ir
   )
-  void SetRebalanceThreshold(1: i32 threshold) throws (
+  void SetRebalanceThreshold(1: i32 threshold, 2: string requestor) throws (
+    1: InvalidRequest ir
+    2: ScootServerError err
+  )
+  SettingsHistory GetSettingsHistory() throws (
+    1: InvalidRequest ir
+    2: ScootServerError err
+  )
+  void RollbackSettings(1: RollbackSettingsReq req) throws (
     1: InvalidRequest ir
     2: ScootServerError err
   )
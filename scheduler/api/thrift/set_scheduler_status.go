@@ -7,6 +7,6 @@ import (
 /**
 throttle the scheduler - set the max number of tasks it will allow
 */
-func SetSchedulerStatus(scheduler server.Scheduler, maxTasks int32) error {
-	return scheduler.SetSchedulerStatus(int(maxTasks))
+func SetSchedulerStatus(scheduler server.Scheduler, maxTasks int32, requestor string) error {
+	return scheduler.SetSchedulerStatus(int(maxTasks), requestor)
 }
@@ -12,10 +12,10 @@ func Test_SetSchedulerStatus(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	s := server.NewMockScheduler(mockCtrl)
-	s.EXPECT().SetSchedulerStatus(10).Return(nil)
+	s.EXPECT().SetSchedulerStatus(10, "admin").Return(nil)
 
 	// test scheduler.SetSchedulerStatus returning a non-null error
-	err := SetSchedulerStatus(s, 10)
+	err := SetSchedulerStatus(s, 10, "admin")
 
 	if err != nil {
 		t.Fatalf("Expected nil, got: %s", err)
@@ -0,0 +1,69 @@
+package thrift
+
+import (
+	"encoding/json"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
+	"github.com/twitter/scoot/scheduler/server"
+)
+
+// Implementation of the GetSettingsHistory API, returns the versions of the settings kept by the
+// scheduler's settings persistor.
+func GetSettingsHistory(scheduler server.Scheduler) (*scoot.SettingsHistory, error) {
+	versions, err := scheduler.GetSettingsHistory()
+	if err != nil {
+		log.Errorf("GetSettingsHistory failed: %v", err)
+		ir := scoot.NewInvalidRequest()
+		msg := err.Error()
+		ir.Message = &msg
+		return nil, ir
+	}
+	resp := scoot.NewSettingsHistory()
+	resp.Versions = make([]*scoot.SettingsVersion, 0, len(versions))
+	for _, v := range versions {
+		sv, err := domainSettingsVersionToThrift(v)
+		if err != nil {
+			log.Errorf("GetSettingsHistory couldn't convert version %d: %v", v.Version, err)
+			return nil, scoot.NewScootServerError()
+		}
+		resp.Versions = append(resp.Versions, sv)
+	}
+	return resp, nil
+}
+
+// Implementation of the RollbackSettings API, restores the settings of a prior version.
+func RollbackSettings(req *scoot.RollbackSettingsReq, scheduler server.Scheduler) error {
+	if req == nil {
+		ir := scoot.NewInvalidRequest()
+		msg := "a settings version must be provided"
+		ir.Message = &msg
+		return ir
+	}
+	if err := scheduler.RollbackSettings(int(req.Version), req.Requestor); err != nil {
+		ir := scoot.NewInvalidRequest()
+		msg := err.Error()
+		ir.Message = &msg
+		return ir
+	}
+	return nil
+}
+
+func domainSettingsVersionToThrift(v server.SettingsVersion) (*scoot.SettingsVersion, error) {
+	// who changed the settings is reported in its own fields
+	settings := v.Settings
+	settings.ChangedBy, settings.Change = "", ""
+	asJSON, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	sv := scoot.NewSettingsVersion()
+	sv.Version = int32(v.Version)
+	sv.PersistedAtMs = v.PersistedAt.UnixNano() / int64(time.Millisecond)
+	sv.ChangedBy = optionalString(v.Settings.ChangedBy)
+	sv.Change = optionalString(v.Settings.Change)
+	sv.SettingsJson = string(asJSON)
+	return sv, nil
+}
@@ -0,0 +1,62 @@
+package thrift
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
+	"github.com/twitter/scoot/scheduler/server"
+)
+
+func Test_GetSettingsHistory(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	scheduler := server.NewMockScheduler(mockCtrl)
+	persistedAt := time.Now()
+	scheduler.EXPECT().GetSettingsHistory().Return([]server.SettingsVersion{
+		{Version: 3, PersistedAt: persistedAt, Settings: server.PersistedSettings{
+			Throttle: 10, ChangedBy: "admin", Change: "throttle set to 10"}},
+	}, nil)
+
+	resp, err := GetSettingsHistory(scheduler)
+	if err != nil {
+		t.Fatalf("Unexpected error getting the settings history: %v", err)
+	}
+	if len(resp.Versions) != 1 {
+		t.Fatalf("Expected 1 version, got %v", resp.Versions)
+	}
+	v := resp.Versions[0]
+	if v.Version != 3 || v.GetChangedBy() != "admin" || v.GetChange() != "throttle set to 10" ||
+		v.PersistedAtMs != persistedAt.UnixNano()/int64(time.Millisecond) {
+		t.Errorf("Unexpected version %v", v)
+	}
+	settings := server.PersistedSettings{}
+	if err := json.Unmarshal([]byte(v.SettingsJson), &settings); err != nil || settings.Throttle != 10 || settings.ChangedBy != "" {
+		t.Errorf("Unexpected settings %s, err: %v", v.SettingsJson, err)
+	}
+
+	scheduler.EXPECT().GetSettingsHistory().Return(nil, errors.New("no history"))
+	if _, err := GetSettingsHistory(scheduler); err == nil {
+		t.Errorf("Expected an error without a settings history")
+	}
+}
+
+func Test_RollbackSettings(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	scheduler := server.NewMockScheduler(mockCtrl)
+	scheduler.EXPECT().RollbackSettings(2, "admin").Return(nil)
+	if err := RollbackSettings(&scoot.RollbackSettingsReq{Version: 2, Requestor: "admin"}, scheduler); err != nil {
+		t.Errorf("Unexpected error rolling back the settings: %v", err)
+	}
+
+	scheduler.EXPECT().RollbackSettings(7, "admin").Return(errors.New("no settings version 7"))
+	err := RollbackSettings(&scoot.RollbackSettingsReq{Version: 7, Requestor: "admin"}, scheduler)
+	if _, ok := err.(*scoot.InvalidRequest); !ok {
+		t.Errorf("Expected an InvalidRequest rolling back to an unknown version, got %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package thrift
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/twitter/scoot/common"
+	s "github.com/twitter/scoot/saga"
+	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
+)
+
+// Implementation of the WatchJob API.  Waits until the job's saga changes after req.SinceVersion
+// or the watch times out, then returns the job status with only the tasks that changed.
+// A zero SinceVersion returns the full job status immediately.  A job whose saga
+// isn't made yet is waited for, as long as a SinceVersion is given.
+func WatchJob(req *scoot.WatchJobRequest, sc s.SagaCoordinator) (*scoot.WatchJobResponse, error) {
+	if req == nil || req.JobId == "" {
+		ir := scoot.NewInvalidRequest()
+		msg := "a job id must be provided"
+		ir.Message = &msg
+		return nil, ir
+	}
+	sinceVersion := req.GetSinceVersion()
+	if sinceVersion < 0 {
+		ir := scoot.NewInvalidRequest()
+		msg := fmt.Sprintf("invalid sinceVersion %d, must be >= 0", sinceVersion)
+		ir.Message = &msg
+		return nil, ir
+	}
+	timeout := common.MaxWatchJobTimeout
+	if req.TimeoutMs != nil && time.Duration(*req.TimeoutMs)*time.Millisecond < timeout {
+		timeout = time.Duration(*req.TimeoutMs) * time.Millisecond
+	}
+
+	update, err := sc.WatchSaga(req.JobId, sinceVersion, timeout)
+	if err != nil {
+		switch err.(type) {
+		case s.InvalidRequestError:
+			err = scoot.NewInvalidRequest()
+		case s.InternalLogError:
+			err = scoot.NewScootServerError()
+		}
+		return nil, err
+	}
+
+	resp := scoot.NewWatchJobResponse()
+	if update == nil {
+		// No Logged Saga Messages.  Job NotStarted yet
+		resp.JobStatus = scoot.NewJobStatus()
+		resp.JobStatus.ID = req.JobId
+		resp.JobStatus.Status = scoot.Status_NOT_STARTED
+		resp.JobStatus.TaskStatus = make(map[string]scoot.Status)
+		resp.JobStatus.TaskData = make(map[string]*scoot.RunStatus)
+		// the next watch with this version waits for the saga to be made
+		resp.Version = sinceVersion
+		if resp.Version == 0 {
+			resp.Version = s.InactiveSagaVersion
+		}
+		return resp, nil
+	}
+
+	resp.JobStatus = convertSagaStateToJobStatus(update.State)
+	resp.Version = update.Version
+	if sinceVersion > 0 {
+		changed := make(map[string]bool, len(update.ChangedTaskIds))
+		for _, id := range update.ChangedTaskIds {
+			changed[id] = true
+		}
+		for id := range resp.JobStatus.TaskStatus {
+			if !changed[id] {
+				delete(resp.JobStatus.TaskStatus, id)
+				delete(resp.JobStatus.TaskData, id)
+			}
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,80 @@
+package thrift
+
+import (
+	"testing"
+	"time"
+
+	"github.com/twitter/scoot/saga/sagalogs"
+	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
+	"github.com/twitter/scoot/scheduler/domain"
+	"github.com/twitter/scoot/tests/testhelpers"
+)
+
+func Test_WatchJob_ReturnsDeltas(t *testing.T) {
+	job := domain.GenJob(testhelpers.GenJobId(testhelpers.NewRand()), 3)
+	jobAsBytes, _ := job.Serialize()
+	sagaCoord := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	saga, _ := sagaCoord.MakeSaga(job.Id, jobAsBytes)
+	task1, task2 := job.Def.Tasks[0].TaskID, job.Def.Tasks[1].TaskID
+	saga.StartTask(task1, nil)
+
+	req := scoot.NewWatchJobRequest()
+	req.JobId = job.Id
+	resp, err := WatchJob(req, sagaCoord)
+	if err != nil {
+		t.Fatalf("Unexpected error watching job: %v", err)
+	}
+	if len(resp.JobStatus.TaskStatus) != len(job.Def.Tasks) {
+		t.Errorf("Expected the full job status without a sinceVersion, got %v", resp.JobStatus.TaskStatus)
+	}
+
+	saga.EndTask(task1, nil)
+	saga.StartTask(task2, nil)
+	req.SinceVersion = &resp.Version
+	resp, err = WatchJob(req, sagaCoord)
+	if err != nil {
+		t.Fatalf("Unexpected error watching job: %v", err)
+	}
+	expected := map[string]scoot.Status{task1: scoot.Status_COMPLETED, task2: scoot.Status_IN_PROGRESS}
+	if len(resp.JobStatus.TaskStatus) != len(expected) {
+		t.Errorf("Expected only changed tasks %v, got %v", expected, resp.JobStatus.TaskStatus)
+	}
+	for id, status := range expected {
+		if resp.JobStatus.TaskStatus[id] != status {
+			t.Errorf("Expected task %s to be %v, got %v", id, status, resp.JobStatus.TaskStatus[id])
+		}
+	}
+}
+
+func Test_WatchJob_InvalidRequest(t *testing.T) {
+	sagaCoord := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	if _, err := WatchJob(scoot.NewWatchJobRequest(), sagaCoord); err == nil {
+		t.Errorf("Expected an error watching a job without an id")
+	} else if _, ok := err.(*scoot.InvalidRequest); !ok {
+		t.Errorf("Expected InvalidRequest, got %v", err)
+	}
+}
+
+func Test_WatchJob_NotStartedJob(t *testing.T) {
+	sagaCoord := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	req := scoot.NewWatchJobRequest()
+	req.JobId = "notStarted"
+	resp, err := WatchJob(req, sagaCoord)
+	if err != nil {
+		t.Fatalf("Unexpected error watching job: %v", err)
+	}
+	if resp.JobStatus.Status != scoot.Status_NOT_STARTED || resp.Version == 0 {
+		t.Errorf("Expected a not started job with a version to watch it with, got %+v", resp)
+	}
+
+	// watching with that version waits for the job instead of returning right away
+	timeoutMs := int32(50)
+	req.SinceVersion, req.TimeoutMs = &resp.Version, &timeoutMs
+	start := time.Now()
+	if _, err := WatchJob(req, sagaCoord); err != nil {
+		t.Fatalf("Unexpected error watching job: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Expected the watch to wait for the timeout, returned after %s", elapsed)
+	}
+}
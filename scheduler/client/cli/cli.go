@@ -8,6 +8,7 @@ import (
 
 	commoncli "github.com/twitter/scoot/common/client"
 	"github.com/twitter/scoot/common/dialer"
+	"github.com/twitter/scoot/common/leader"
 	smoketest "github.com/twitter/scoot/integration-tests/smoketest"
 	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
 	"github.com/twitter/scoot/scheduler/client"
@@ -16,6 +17,7 @@ import (
 // SchedCLIClient includes fields required for CLI client handling
 type SchedCLIClient struct {
 	commoncli.SimpleClient
+	leaderLockFile string
 }
 
 // returnError extend the error with Invalid Request, Scoot server error, or Error getting status message
@@ -25,6 +27,8 @@ func returnError(err error) error {
 		return fmt.Errorf("Invalid Request: %v", err.GetMessage())
 	case *scoot.ScootServerError:
 		return fmt.Errorf("Scoot server error: %v", err.Error())
+	case *scoot.CanNotScheduleNow:
+		return fmt.Errorf("Can not schedule now, retry after %dms", err.GetRetryAfterMs())
 	default:
 		return fmt.Errorf("Error getting status: %v", err.Error())
 	}
@@ -47,6 +51,7 @@ func NewSimpleCLIClient(d dialer.Dialer) (commoncli.CLIClient, error) {
 	}
 	sched, _, _ := client.GetScootapiAddr() // ignore err & apiserver addr
 	c.RootCmd.PersistentFlags().StringVar(&c.Addr, "addr", sched, "Scoot server address. If unset, uses default value of first line of $HOME/.cloudscootaddr$SCOOT_ID")
+	c.RootCmd.PersistentFlags().StringVar(&c.leaderLockFile, "leader_lock_file", "", "If set, connects to the scheduler leader elected with this lock file instead of addr")
 	c.RootCmd.PersistentFlags().StringVar(&c.LogLevel, "log_level", "info", "Log everything at this level and above (error|info|debug)")
 
 	c.addCmd(&runJobCmd{})
@@ -54,12 +59,16 @@ func NewSimpleCLIClient(d dialer.Dialer) (commoncli.CLIClient, error) {
 	c.addCmd(&smoketest.SmokeTestCmd{})
 	c.addCmd(&watchJobCmd{})
 	c.addCmd(&killJobCmd{})
+	c.addCmd(&listJobsCmd{})
+	c.addCmd(&explainJobCmd{})
 	c.addCmd(&offlineWorkerCmd{})
 	c.addCmd(&reinstateWorkerCmd{})
+	c.addCmd(&listWorkersCmd{})
 	c.addCmd(&setSchedulerStatusCmd{})
 	c.addCmd(&getSchedulerStatusCmd{})
 	c.addCmd(&getLBSSchedAlgParams{})
 	c.addCmd(&setLbsSchedAlgParams{})
+	c.addCmd(&settingsHistoryCmd{})
 
 	return c, nil
 }
@@ -81,10 +90,15 @@ func (c *SchedCLIClient) Init(cmd *cobra.Command, args []string) error {
 	}
 	log.SetLevel(level)
 
+	var resolver dialer.Resolver
+	if c.leaderLockFile != "" {
+		resolver = leader.NewResolver(leader.NewFileLock(c.leaderLockFile))
+	}
 	c.ScootClient = client.NewCloudScootClient(
 		client.CloudScootClientConfig{
-			Addr:   c.Addr,
-			Dialer: c.Dial,
+			Addr:     c.Addr,
+			Dialer:   c.Dial,
+			Resolver: resolver,
 		})
 
 	return nil
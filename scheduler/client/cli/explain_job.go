@@ -0,0 +1,74 @@
+package cli
+
+/**
+implements the command line entry for the explain job command
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/twitter/scoot/common/client"
+)
+
+type explainJobCmd struct {
+	printAsJson bool
+}
+
+func (c *explainJobCmd) RegisterFlags() *cobra.Command {
+	r := &cobra.Command{
+		Use:   "explain_job",
+		Short: "ExplainJob, why the job's waiting tasks aren't running",
+	}
+	r.Flags().BoolVar(&c.printAsJson, "json", false, "Print out the explanation as JSON")
+	return r
+}
+
+func (c *explainJobCmd) Run(cl *client.SimpleClient, cmd *cobra.Command, args []string) error {
+
+	log.Info("Explaining Scoot Job", args)
+
+	if len(args) == 0 {
+		return errors.New("a job id must be provided")
+	}
+
+	jobId := args[0]
+
+	explanation, err := cl.ScootClient.ExplainJob(jobId)
+	if err != nil {
+		return returnError(err)
+	}
+
+	if c.printAsJson {
+		asJson, err := json.Marshal(explanation)
+		if err != nil {
+			return fmt.Errorf("Error converting explanation to JSON: %v", err.Error())
+		}
+		log.Infof("%s\n", asJson)
+		fmt.Printf("%s\n", asJson) // must also go to stdout in case caller looking in stdout for the results
+		return nil
+	}
+
+	lines := []string{fmt.Sprintf("%s %s waiting:%d", explanation.GetJobId(), explanation.GetStatus(), len(explanation.GetWaitingTasks()))}
+	if explanation.IsSetExplainedAtMs() {
+		explainedAt := time.Unix(0, explanation.GetExplainedAtMs()*int64(time.Millisecond))
+		lines = append(lines, fmt.Sprintf("explained at %s", explainedAt.Format(time.RFC3339)))
+	}
+	for _, task := range explanation.GetWaitingTasks() {
+		lines = append(lines, fmt.Sprintf("  %s %s %s", task.GetTaskId(), task.GetReason(), task.GetDetail()))
+	}
+	for _, note := range explanation.GetNotes() {
+		lines = append(lines, fmt.Sprintf("note: %s", note))
+	}
+	for _, line := range lines {
+		log.Info(line)
+		fmt.Println(line) // must also go to stdout in case caller looking in stdout for the results
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package cli
+
+/**
+implements the command line entry for the list jobs command
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/twitter/scoot/common/client"
+	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
+)
+
+type listJobsCmd struct {
+	requestor      string
+	tag            string
+	basis          string
+	jobType        string
+	priority       int
+	status         string
+	includeHistory bool
+	maxJobs        int
+	printAsJson    bool
+}
+
+func (c *listJobsCmd) RegisterFlags() *cobra.Command {
+	r := &cobra.Command{
+		Use:   "list_jobs",
+		Short: "ListJobs",
+	}
+	r.Flags().StringVar(&c.requestor, "requestor", "", "Only list jobs from this requestor")
+	r.Flags().StringVar(&c.tag, "tag", "", "Only list jobs with this tag")
+	r.Flags().StringVar(&c.basis, "basis", "", "Only list jobs with this basis")
+	r.Flags().StringVar(&c.jobType, "job_type", "", "Only list jobs of this job type")
+	r.Flags().IntVar(&c.priority, "priority", -1, "Only list jobs with this priority, -1 lists all priorities")
+	r.Flags().StringVar(&c.status, "status", "", "Only list jobs with this status, one of NOT_STARTED, IN_PROGRESS, COMPLETED, ROLLING_BACK, ROLLED_BACK")
+	r.Flags().BoolVar(&c.includeHistory, "history", false, "Also list jobs that are no longer running")
+	r.Flags().IntVar(&c.maxJobs, "max_jobs", 0, "Maximum number of jobs to list, 0 lists all jobs")
+	r.Flags().BoolVar(&c.printAsJson, "json", false, "Print out jobs as JSON")
+	return r
+}
+
+func (c *listJobsCmd) Run(cl *client.SimpleClient, cmd *cobra.Command, args []string) error {
+
+	log.Info("Listing Scoot Jobs", args)
+
+	req := scoot.NewListJobsRequest()
+	if c.requestor != "" {
+		req.Requestor = &c.requestor
+	}
+	if c.tag != "" {
+		req.Tag = &c.tag
+	}
+	if c.basis != "" {
+		req.Basis = &c.basis
+	}
+	if c.jobType != "" {
+		req.JobType = &c.jobType
+	}
+	if c.priority >= 0 {
+		priority := int32(c.priority)
+		req.Priority = &priority
+	}
+	if c.status != "" {
+		status, err := scoot.StatusFromString(strings.ToUpper(c.status))
+		if err != nil {
+			return fmt.Errorf("Invalid status %s: %v", c.status, err)
+		}
+		req.Status = &status
+	}
+	req.IncludeHistory = &c.includeHistory
+	maxJobs := int32(c.maxJobs)
+	req.MaxJobs = &maxJobs
+
+	resp, err := cl.ScootClient.ListJobs(req)
+	if err != nil {
+		return returnError(err)
+	}
+
+	if c.printAsJson {
+		asJson, err := json.Marshal(resp.GetJobs())
+		if err != nil {
+			return fmt.Errorf("Error converting jobs to JSON: %v", err.Error())
+		}
+		log.Infof("%s\n", asJson)
+		fmt.Printf("%s\n", asJson) // must also go to stdout in case caller looking in stdout for the results
+	} else {
+		for _, job := range resp.GetJobs() {
+			line := fmt.Sprintf("%s %s requestor:%s tag:%s basis:%s jobType:%s priority:%d tasks:%d running:%d completed:%d",
+				job.GetID(), job.GetStatus(), job.GetRequestor(), job.GetTag(), job.GetBasis(), job.GetJobType(),
+				job.GetPriority(), job.GetNumTasks(), job.GetNumRunningTasks(), job.GetNumCompletedTasks())
+			log.Info(line)
+			fmt.Println(line) // must also go to stdout in case caller looking in stdout for the results
+		}
+	}
+
+	return nil
+}
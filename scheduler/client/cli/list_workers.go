@@ -0,0 +1,90 @@
+package cli
+
+/**
+implements the command line entry for the list workers command
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/twitter/scoot/common/client"
+	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
+)
+
+type listWorkersCmd struct {
+	state       string
+	printAsJson bool
+}
+
+func (c *listWorkersCmd) RegisterFlags() *cobra.Command {
+	r := &cobra.Command{
+		Use:   "list_workers",
+		Short: "ListWorkers, the state of each of the scheduler's workers",
+	}
+	r.Flags().StringVar(&c.state, "state", "", "Only list workers in this state, one of IDLE, BUSY, NOT_READY, LOST, FLAKY, OFFLINED, DRAINING")
+	r.Flags().BoolVar(&c.printAsJson, "json", false, "Print out workers as JSON")
+	return r
+}
+
+func (c *listWorkersCmd) Run(cl *client.SimpleClient, cmd *cobra.Command, args []string) error {
+
+	log.Info("Listing Scoot Workers", args)
+
+	var state *scoot.WorkerState
+	if c.state != "" {
+		s, err := scoot.WorkerStateFromString(strings.ToUpper(c.state))
+		if err != nil {
+			return fmt.Errorf("Invalid state %s: %v", c.state, err)
+		}
+		state = &s
+	}
+
+	clusterState, err := cl.ScootClient.GetClusterState()
+	if err != nil {
+		return returnError(err)
+	}
+
+	workers := []*scoot.WorkerSummary{}
+	for _, worker := range clusterState.GetWorkers() {
+		if state == nil || worker.GetState() == *state {
+			workers = append(workers, worker)
+		}
+	}
+
+	if c.printAsJson {
+		asJson, err := json.Marshal(workers)
+		if err != nil {
+			return fmt.Errorf("Error converting workers to JSON: %v", err.Error())
+		}
+		log.Infof("%s\n", asJson)
+		fmt.Printf("%s\n", asJson) // must also go to stdout in case caller looking in stdout for the results
+	} else {
+		for _, worker := range workers {
+			line := fmt.Sprintf("%s %s job:%s task:%s running:%d snapshotId:%s",
+				worker.GetID(), worker.GetState(), worker.GetRunningJobId(), worker.GetRunningTaskId(),
+				worker.GetNumRunningTasks(), worker.GetSnapshotId())
+			if worker.IsSetInStateForMs() {
+				line += fmt.Sprintf(" for:%s", time.Duration(worker.GetInStateForMs())*time.Millisecond)
+			}
+			if worker.IsSetOfflinedBy() {
+				line += fmt.Sprintf(" offlinedBy:%s", worker.GetOfflinedBy())
+			}
+			if worker.IsSetOfflineReason() {
+				line += fmt.Sprintf(" reason:%q", worker.GetOfflineReason())
+			}
+			if worker.IsSetOfflineExpiresInMs() {
+				line += fmt.Sprintf(" reinstatedIn:%s", time.Duration(worker.GetOfflineExpiresInMs())*time.Millisecond)
+			}
+			log.Info(line)
+			fmt.Println(line) // must also go to stdout in case caller looking in stdout for the results
+		}
+	}
+
+	return nil
+}
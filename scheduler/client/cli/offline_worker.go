@@ -7,6 +7,7 @@ implements the command line entry for the offline worker job command
 import (
 	"fmt"
 	"os/user"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -16,6 +17,9 @@ import (
 )
 
 type offlineWorkerCmd struct {
+	reason    string
+	duration  time.Duration
+	isPattern bool
 }
 
 func (c *offlineWorkerCmd) RegisterFlags() *cobra.Command {
@@ -23,6 +27,9 @@ func (c *offlineWorkerCmd) RegisterFlags() *cobra.Command {
 		Use:   "offline_worker",
 		Short: "OfflineWorker",
 	}
+	r.Flags().StringVar(&c.reason, "reason", "", "Why the worker is offlined")
+	r.Flags().DurationVar(&c.duration, "duration", 0, "Reinstate the worker after this long, e.g. 2h, stays offline until reinstated if 0")
+	r.Flags().BoolVar(&c.isPattern, "pattern", false, "The worker id is a regular expression, offline all the workers it matches")
 	return r
 }
 
@@ -40,7 +47,18 @@ func (c *offlineWorkerCmd) Run(cl *client.SimpleClient, cmd *cobra.Command, args
 		return err
 	}
 
-	err = cl.ScootClient.OfflineWorker(&scoot.OfflineWorkerReq{ID: id, Requestor: requestor.Username})
+	req := &scoot.OfflineWorkerReq{ID: id, Requestor: requestor.Username}
+	if c.reason != "" {
+		req.Reason = &c.reason
+	}
+	if c.duration != 0 {
+		durationMs := int64(c.duration / time.Millisecond)
+		req.DurationMs = &durationMs
+	}
+	if c.isPattern {
+		req.IdIsPattern = &c.isPattern
+	}
+	err = cl.ScootClient.OfflineWorker(req)
 
 	if err != nil {
 		switch err := err.(type) {
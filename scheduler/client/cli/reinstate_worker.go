@@ -16,6 +16,7 @@ import (
 )
 
 type reinstateWorkerCmd struct {
+	isPattern bool
 }
 
 func (c *reinstateWorkerCmd) RegisterFlags() *cobra.Command {
@@ -23,6 +24,7 @@ func (c *reinstateWorkerCmd) RegisterFlags() *cobra.Command {
 		Use:   "reinstate_worker",
 		Short: "ReinstateWorker",
 	}
+	r.Flags().BoolVar(&c.isPattern, "pattern", false, "The worker id is a regular expression, reinstate all the offlined workers it matches")
 	return r
 }
 
@@ -40,7 +42,11 @@ func (c *reinstateWorkerCmd) Run(cl *client.SimpleClient, cmd *cobra.Command, ar
 		return err
 	}
 
-	err = cl.ScootClient.ReinstateWorker(&scoot.ReinstateWorkerReq{ID: id, Requestor: requestor.Username})
+	req := &scoot.ReinstateWorkerReq{ID: id, Requestor: requestor.Username}
+	if c.isPattern {
+		req.IdIsPattern = &c.isPattern
+	}
+	err = cl.ScootClient.ReinstateWorker(req)
 
 	if err != nil {
 		switch err := err.(type) {
@@ -16,10 +16,13 @@ import (
 )
 
 type runJobCmd struct {
-	streamName  string
-	snapshotId  string
-	jobFilePath string
-	tag         string
+	streamName      string
+	snapshotId      string
+	jobFilePath     string
+	tag             string
+	outputPaths     []string
+	requiredLabels  []string
+	preferredLabels []string
 }
 
 func (c *runJobCmd) RegisterFlags() *cobra.Command {
@@ -31,6 +34,9 @@ func (c *runJobCmd) RegisterFlags() *cobra.Command {
 	r.Flags().StringVar(&c.snapshotId, "snapshot_id", "", "Repo checkout id: <master-sha> OR <backend>-<kind>(-<additional information>)+")
 	r.Flags().StringVar(&c.jobFilePath, "job_def", "", "JSON file to read jobs from. Error if snapshot_id flag is also provided.")
 	r.Flags().StringVar(&c.tag, "tag", "", "Tag can be specified by requestor in order to more easily trace a job through logs")
+	r.Flags().StringSliceVar(&c.outputPaths, "output_paths", nil, "Checkout-relative paths to capture in an output snapshot, or '.' for the whole checkout")
+	r.Flags().StringSliceVar(&c.requiredLabels, "required_labels", nil, "Label selectors a worker must match to run the task: key, !key, key=value or key!=value")
+	r.Flags().StringSliceVar(&c.preferredLabels, "preferred_labels", nil, "Label selectors that workers are preferred for matching: key, !key, key=value or key!=value")
 	return r
 }
 
@@ -44,14 +50,62 @@ type CLIJobDef struct {
 	Basis                string
 	JobType              string
 	Requestor            string
+	Cleanup              *CleanupDef
 }
 
 type TaskDef struct {
+	Args         []string
+	EnvVars      map[string]string
+	SnapshotID   string
+	TimeoutMs    int32
+	TaskID       string
+	OutputPaths  []string
+	Dependencies []string
+	Resources    *scoot.Resources // e.g. {"cpuMillis": 500, "memoryBytes": 1073741824}
+	// Label selectors, e.g. ["android-sdk", "rack!=r3"]
+	RequiredLabels  []string
+	PreferredLabels []string
+	// Run if the job is killed or a task fails, SnapshotID defaults to the task's.
+	Cleanup *CleanupDef
+	// e.g. {"maxAttempts": 3, "retryableExitCodes": [75], "retryableStates": ["TIMEDOUT"], "backoffMs": 1000}
+	RetryPolicy *scoot.RetryPolicy
+	// How much of the worker's environment the task inherits, e.g. "CLEAN", or "ALLOWLIST" with EnvAllowlist ["HOME"]
+	EnvMode      *scoot.EnvMode
+	EnvAllowlist []string
+	// Env vars set to secrets in the worker's secrets file, e.g. {"DEPLOY_TOKEN": "deploy-token"}
+	SecretEnv map[string]string
+}
+
+type CleanupDef struct {
 	Args       []string
 	EnvVars    map[string]string
 	SnapshotID string
 	TimeoutMs  int32
-	TaskID     string
+
+	EnvMode      *scoot.EnvMode
+	EnvAllowlist []string
+	SecretEnv    map[string]string
+}
+
+// Returns the thrift cleanup, nil if there is no cleanup.
+func (c *CleanupDef) toThrift() *scoot.Cleanup {
+	if c == nil {
+		return nil
+	}
+	cleanup := scoot.NewCleanup()
+	cleanup.Command = scoot.NewCommand()
+	cleanup.Command.Argv = c.Args
+	cleanup.Command.EnvVars = c.EnvVars
+	cleanup.Command.EnvMode = c.EnvMode
+	cleanup.Command.EnvAllowlist = c.EnvAllowlist
+	cleanup.Command.SecretEnv = c.SecretEnv
+	if c.SnapshotID != "" {
+		cleanup.SnapshotId = &c.SnapshotID
+	}
+	if c.TimeoutMs > 0 {
+		cleanup.TimeoutMs = &c.TimeoutMs
+	}
+	return cleanup
 }
 
 func (c *runJobCmd) Run(cl *client.SimpleClient, cmd *cobra.Command, args []string) error {
@@ -76,6 +130,9 @@ func (c *runJobCmd) Run(cl *client.SimpleClient, cmd *cobra.Command, args []stri
 		task.Command.Argv = args
 		task.SnapshotId = &c.snapshotId
 		task.TaskId = &taskId
+		task.OutputPaths = c.outputPaths
+		task.RequiredLabels = c.requiredLabels
+		task.PreferredLabels = c.preferredLabels
 		jobDef.Tasks = []*scoot.TaskDefinition{task}
 	case c.jobFilePath != "":
 		f, err := os.Open(c.jobFilePath)
@@ -100,6 +157,7 @@ func (c *runJobCmd) Run(cl *client.SimpleClient, cmd *cobra.Command, args []stri
 		jobDef.JobType = &jsonJob.JobType
 		jobDef.Requestor = &jsonJob.Requestor
 		jobDef.Priority = &jsonJob.Priority
+		jobDef.Cleanup = jsonJob.Cleanup.toThrift()
 		jobDef.Tasks = []*scoot.TaskDefinition{}
 		for _, jsonTask := range jsonJob.Tasks {
 			jt := jsonTask
@@ -110,8 +168,18 @@ func (c *runJobCmd) Run(cl *client.SimpleClient, cmd *cobra.Command, args []stri
 			for k, v := range jt.EnvVars {
 				taskDef.Command.EnvVars[k] = v
 			}
+			taskDef.Command.EnvMode = jt.EnvMode
+			taskDef.Command.EnvAllowlist = jt.EnvAllowlist
+			taskDef.Command.SecretEnv = jt.SecretEnv
 			taskDef.SnapshotId = &jt.SnapshotID
 			taskDef.TaskId = &jt.TaskID
+			taskDef.OutputPaths = jt.OutputPaths
+			taskDef.Dependencies = jt.Dependencies
+			taskDef.Resources = jt.Resources
+			taskDef.RequiredLabels = jt.RequiredLabels
+			taskDef.PreferredLabels = jt.PreferredLabels
+			taskDef.Cleanup = jt.Cleanup.toThrift()
+			taskDef.RetryPolicy = jt.RetryPolicy
 			jobDef.Tasks = append(jobDef.Tasks, taskDef)
 			if jt.TimeoutMs > 0 {
 				taskDef.TimeoutMs = &jt.TimeoutMs
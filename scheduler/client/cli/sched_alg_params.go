@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/user"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -116,15 +117,20 @@ func (s *setLbsSchedAlgParams) RegisterFlags() *cobra.Command {
 func (s *setLbsSchedAlgParams) Run(cl *client.SimpleClient, cmd *cobra.Command, args []string) error {
 	log.Info("Setting Scheduling Algorithm Parameters", args)
 
+	requestor, err := user.Current()
+	if err != nil {
+		return err
+	}
+
 	if s.RebalanceMinimumDuration > -1 {
-		err := cl.ScootClient.SetRebalanceMinimumDuration(int32(s.RebalanceMinimumDuration))
+		err := cl.ScootClient.SetRebalanceMinimumDuration(int32(s.RebalanceMinimumDuration), requestor.Username)
 		if err != nil {
 			log.Errorf("%s", err)
 			return err
 		}
 	}
 	if s.RebalanceThreshold > -1 {
-		err := cl.ScootClient.SetRebalanceThreshold(int32(s.RebalanceThreshold))
+		err := cl.ScootClient.SetRebalanceThreshold(int32(s.RebalanceThreshold), requestor.Username)
 		if err != nil {
 			log.Errorf("%s", err)
 			return err
@@ -142,7 +148,7 @@ func (s *setLbsSchedAlgParams) Run(cl *client.SimpleClient, cmd *cobra.Command,
 			log.Errorf("%s", err)
 			return err
 		}
-		err = cl.ScootClient.SetClassLoadPercents(s.ClassLoadPercents)
+		err = cl.ScootClient.SetClassLoadPercents(s.ClassLoadPercents, requestor.Username)
 		if err != nil {
 			log.Errorf("%s", err)
 			return err
@@ -158,7 +164,7 @@ func (s *setLbsSchedAlgParams) Run(cl *client.SimpleClient, cmd *cobra.Command,
 		if err != nil {
 			return err
 		}
-		cl.ScootClient.SetRequestorToClassMap(s.RequestorMap)
+		err = cl.ScootClient.SetRequestorToClassMap(s.RequestorMap, requestor.Username)
 		if err != nil {
 			log.Errorf("%s", err)
 			return err
@@ -6,6 +6,7 @@ implements the command line entry for the throttle scheduler command
 
 import (
 	"fmt"
+	"os/user"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -35,7 +36,12 @@ func (c *setSchedulerStatusCmd) Run(cl *client.SimpleClient, cmd *cobra.Command,
 		" to run.  Note: the scheduler does not enforce this limit.  We expect the job"+
 		" requestor to adhere to it.", args)
 
-	err := cl.ScootClient.SetSchedulerStatus(int32(c.maxTasks))
+	requestor, err := user.Current()
+	if err != nil {
+		return err
+	}
+
+	err = cl.ScootClient.SetSchedulerStatus(int32(c.maxTasks), requestor.Username)
 
 	if err != nil {
 		switch err := err.(type) {
@@ -0,0 +1,79 @@
+package cli
+
+/**
+implements the command line entry for the settings history command
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/user"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/twitter/scoot/common/client"
+	"github.com/twitter/scoot/scheduler/api/thrift/gen-go/scoot"
+)
+
+type settingsHistoryCmd struct {
+	rollback     int
+	showSettings bool
+	printAsJson  bool
+}
+
+func (c *settingsHistoryCmd) RegisterFlags() *cobra.Command {
+	r := &cobra.Command{
+		Use:   "settings_history",
+		Short: "SettingsHistory, list the versions of the persisted scheduler settings, or roll back to one of them",
+	}
+	r.Flags().IntVar(&c.rollback, "rollback", 0, "Roll back to the settings of this version instead of listing the versions")
+	r.Flags().BoolVar(&c.showSettings, "settings", false, "Print the settings of each version")
+	r.Flags().BoolVar(&c.printAsJson, "json", false, "Print out the versions as JSON")
+	return r
+}
+
+func (c *settingsHistoryCmd) Run(cl *client.SimpleClient, cmd *cobra.Command, args []string) error {
+	if c.rollback > 0 {
+		log.Infof("Rolling back the scheduler settings to version %d", c.rollback)
+		requestor, err := user.Current()
+		if err != nil {
+			return err
+		}
+		req := &scoot.RollbackSettingsReq{Version: int32(c.rollback), Requestor: requestor.Username}
+		if err := cl.ScootClient.RollbackSettings(req); err != nil {
+			return returnError(err)
+		}
+		return nil
+	}
+
+	log.Info("Listing the scheduler settings versions", args)
+
+	history, err := cl.ScootClient.GetSettingsHistory()
+	if err != nil {
+		return returnError(err)
+	}
+
+	if c.printAsJson {
+		asJson, err := json.Marshal(history.GetVersions())
+		if err != nil {
+			return fmt.Errorf("Error converting settings versions to JSON: %v", err.Error())
+		}
+		log.Infof("%s\n", asJson)
+		fmt.Printf("%s\n", asJson) // must also go to stdout in case caller looking in stdout for the results
+	} else {
+		for _, v := range history.GetVersions() {
+			persistedAt := time.Unix(0, v.GetPersistedAtMs()*int64(time.Millisecond))
+			line := fmt.Sprintf("version:%d persistedAt:%s changedBy:%s change:%q",
+				v.GetVersion(), persistedAt.Format(time.RFC3339), v.GetChangedBy(), v.GetChange())
+			if c.showSettings {
+				line += fmt.Sprintf(" settings:%s", v.GetSettingsJson())
+			}
+			log.Info(line)
+			fmt.Println(line) // must also go to stdout in case caller looking in stdout for the results
+		}
+	}
+
+	return nil
+}
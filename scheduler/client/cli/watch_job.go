@@ -18,6 +18,7 @@ const (
 
 type watchJobCmd struct {
 	jobId string
+	poll  bool
 }
 
 func (c *watchJobCmd) RegisterFlags() *cobra.Command {
@@ -25,6 +26,7 @@ func (c *watchJobCmd) RegisterFlags() *cobra.Command {
 		Use:   "watch_job",
 		Short: "WatchJob",
 	}
+	r.Flags().BoolVar(&c.poll, "poll", false, "Poll GetStatus instead of waiting on WatchJob, for schedulers without WatchJob support")
 
 	return r
 }
@@ -39,6 +41,10 @@ func (c *watchJobCmd) Run(cl *client.SimpleClient, cmd *cobra.Command, args []st
 
 	jobId := args[0]
 
+	if !c.poll {
+		return WatchAndPrintStatus(jobId, cl.ScootClient)
+	}
+
 	for {
 		jobStatus, err := GetAndPrintStatus(jobId, cl.ScootClient)
 		if err != nil {
@@ -54,6 +60,37 @@ func (c *watchJobCmd) Run(cl *client.SimpleClient, cmd *cobra.Command, args []st
 
 }
 
+// Prints the full job status, then the tasks that change as reported by WatchJob,
+// until the job is done.
+func WatchAndPrintStatus(jobId string, thriftClient scoot.CloudScoot) error {
+	req := scoot.NewWatchJobRequest()
+	req.JobId = jobId
+	sinceVersion := int64(0)
+
+	for {
+		req.SinceVersion = &sinceVersion
+		resp, err := thriftClient.WatchJob(req)
+		if err != nil {
+			return returnError(err)
+		}
+
+		if sinceVersion == 0 || len(resp.JobStatus.TaskStatus) > 0 {
+			PrintJobStatus(resp.JobStatus)
+		}
+
+		if resp.JobStatus.Status == scoot.Status_COMPLETED || resp.JobStatus.Status == scoot.Status_ROLLED_BACK {
+			return nil
+		}
+
+		// A scheduler that returns the same version without waiting for the job (older schedulers did so
+		// until it was recovered) must not be spun on.
+		if resp.Version == sinceVersion {
+			time.Sleep(jobStatusSleepSeconds)
+		}
+		sinceVersion = resp.Version
+	}
+}
+
 func GetAndPrintStatus(jobId string, thriftClient scoot.CloudScoot) (*scoot.Status, error) {
 
 	status, err := thriftClient.GetStatus(jobId)
@@ -14,24 +14,27 @@ import (
 // connection for making requests to a CloudScootClient.
 // This client can only serve one request at a time.
 type CloudScootClient struct {
-	addr   string
-	dialer dialer.Dialer
-	client *scoot.CloudScootClient
+	addr     string
+	dialer   dialer.Dialer
+	resolver dialer.Resolver
+	client   *scoot.CloudScootClient
 }
 
 // Parameters to configure a CloudScootClient connection
 type CloudScootClientConfig struct {
-	Addr   string        //Address to connect to
-	Dialer dialer.Dialer // dialer to use to connect to address
+	Addr     string          //Address to connect to
+	Dialer   dialer.Dialer   // dialer to use to connect to address
+	Resolver dialer.Resolver // if set, resolves the address for each new connection instead of using Addr
 }
 
 // Creates a CloudScootClient.  Returns a client object which can
 // be used to execute calls to Scoot Cloud Exec.
 func NewCloudScootClient(config CloudScootClientConfig) *CloudScootClient {
 	return &CloudScootClient{
-		addr:   config.Addr,
-		dialer: config.Dialer,
-		client: nil,
+		addr:     config.Addr,
+		dialer:   config.Dialer,
+		resolver: config.Resolver,
+		client:   nil,
 	}
 }
 
@@ -73,6 +76,61 @@ func (c *CloudScootClient) GetStatus(jobId string) (r *scoot.JobStatus, err erro
 	return jobStatus, err
 }
 
+// WatchJob API. Blocks until the specified job changes after req.SinceVersion (or the
+// server's watch timeout expires) and returns the status of the tasks that changed.
+func (c *CloudScootClient) WatchJob(req *scoot.WatchJobRequest) (r *scoot.WatchJobResponse, err error) {
+	err = c.checkForClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.WatchJob(req)
+	// if an error occurred reset the connection, could be a broken pipe or other
+	// unrecoverable error.  reset connection so a new clean one gets created
+	// on the next request
+	if err != nil {
+		// this could cause an error when closing transport
+		// but we don't care do our best effort and move on
+		c.closeConnection()
+	}
+	return resp, err
+}
+
+// ListJobs API. Returns the jobs matching the request's filter.
+func (c *CloudScootClient) ListJobs(req *scoot.ListJobsRequest) (r *scoot.ListJobsResponse, err error) {
+	err = c.checkForClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.ListJobs(req)
+	// if an error occurred reset the connection, could be a broken pipe or other
+	// unrecoverable error.  reset connection so a new clean one gets created
+	// on the next request
+	if err != nil {
+		// this could cause an error when closing transport
+		// but we don't care do our best effort and move on
+		c.closeConnection()
+	}
+	return resp, err
+}
+
+// ExplainJob API. Returns why the job's waiting tasks haven't started.
+func (c *CloudScootClient) ExplainJob(jobId string) (r *scoot.JobExplanation, err error) {
+	err = c.checkForClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.ExplainJob(jobId)
+	// if an error occurred reset the connection, could be a broken pipe or other
+	// unrecoverable error.  reset connection so a new clean one gets created
+	// on the next request
+	if err != nil {
+		// this could cause an error when closing transport
+		// but we don't care do our best effort and move on
+		c.closeConnection()
+	}
+	return resp, err
+}
+
 // Close any open Transport associated with this ScootClient
 func (c *CloudScootClient) Close() error {
 	if c.client != nil {
@@ -133,7 +191,7 @@ func (c *CloudScootClient) ReinstateWorker(req *scoot.ReinstateWorkerReq) error
 	return err
 }
 
-func (c *CloudScootClient) SetSchedulerStatus(maxTasks int32) error {
+func (c *CloudScootClient) SetSchedulerStatus(maxTasks int32, requestor string) error {
 	// validation is also implemented in sched/definitions.go.  We cannot use it here because it
 	// causes a circular dependency.  The two implementations can be consolidated when the code
 	// is restructured
@@ -146,7 +204,7 @@ func (c *CloudScootClient) SetSchedulerStatus(maxTasks int32) error {
 		return err
 	}
 
-	return c.client.SetSchedulerStatus(maxTasks)
+	return c.client.SetSchedulerStatus(maxTasks, requestor)
 }
 
 func (c *CloudScootClient) GetSchedulerStatus() (*scoot.SchedulerStatus, error) {
@@ -166,6 +224,24 @@ func (c *CloudScootClient) GetSchedulerStatus() (*scoot.SchedulerStatus, error)
 	return schedulerStatus, err
 }
 
+// GetClusterState get the state of each of the scheduler's workers
+func (c *CloudScootClient) GetClusterState() (*scoot.ClusterState, error) {
+	err := c.checkForClient()
+	if err != nil {
+		return nil, err
+	}
+	clusterState, err := c.client.GetClusterState()
+	// if an error occurred reset the connection, could be a broken pipe or other
+	// unrecoverable error.  reset connection so a new clean one gets created
+	// on the next request
+	if err != nil {
+		// this could cause an error when closing transport
+		// but we don't care do our best effort and move on
+		c.closeConnection()
+	}
+	return clusterState, err
+}
+
 // GetClassLoadPercents get the target load pcts for the classes
 func (c *CloudScootClient) GetClassLoadPercents() (map[string]int32, error) {
 	if err := c.checkForClient(); err != nil {
@@ -184,12 +260,12 @@ func (c *CloudScootClient) GetClassLoadPercents() (map[string]int32, error) {
 }
 
 // SetClassLoadPercents set the target worker load % for each job class
-func (c *CloudScootClient) SetClassLoadPercents(classLoads map[string]int32) error {
+func (c *CloudScootClient) SetClassLoadPercents(classLoads map[string]int32, requestor string) error {
 	if err := c.checkForClient(); err != nil {
 		return err
 	}
 
-	err := c.client.SetClassLoadPercents(classLoads)
+	err := c.client.SetClassLoadPercents(classLoads, requestor)
 	// if an error occurred reset the connection, could be a broken pipe or other
 	// unrecoverable error. reset connection so a new clean one gets created
 	// on the next request
@@ -219,12 +295,12 @@ func (c *CloudScootClient) GetRequestorToClassMap() (map[string]string, error) {
 }
 
 // SetRequestorToClassMap set the map of requestor (requestor value is reg exp) to class name
-func (c *CloudScootClient) SetRequestorToClassMap(requestorToClassMap map[string]string) error {
+func (c *CloudScootClient) SetRequestorToClassMap(requestorToClassMap map[string]string, requestor string) error {
 	if err := c.checkForClient(); err != nil {
 		return err
 	}
 
-	err := c.client.SetRequestorToClassMap(requestorToClassMap)
+	err := c.client.SetRequestorToClassMap(requestorToClassMap, requestor)
 	// if an error occurred reset the connection, could be a broken pipe or other
 	// unrecoverable error. reset connection so a new clean one gets created
 	// on the next request
@@ -257,12 +333,12 @@ func (c *CloudScootClient) GetRebalanceMinimumDuration() (int32, error) {
 
 // SetRebalanceMinimumDuration set the minimum time the scheduler load % must we over the re-balance
 // threshold before re-balancing
-func (c *CloudScootClient) SetRebalanceMinimumDuration(durationMin int32) error {
+func (c *CloudScootClient) SetRebalanceMinimumDuration(durationMin int32, requestor string) error {
 	if err := c.checkForClient(); err != nil {
 		return err
 	}
 
-	err := c.client.SetRebalanceMinimumDuration(durationMin)
+	err := c.client.SetRebalanceMinimumDuration(durationMin, requestor)
 	// if an error occurred reset the connection, could be a broken pipe or other
 	// unrecoverable error. reset connection so a new clean one gets created
 	// on the next request
@@ -295,12 +371,12 @@ func (c *CloudScootClient) GetRebalanceThreshold() (int32, error) {
 
 // SetRebalanceThreshold set the minimum difference between under/over allocated %s that will trigger
 // re-balancing
-func (c *CloudScootClient) SetRebalanceThreshold(threshold int32) error {
+func (c *CloudScootClient) SetRebalanceThreshold(threshold int32, requestor string) error {
 	if err := c.checkForClient(); err != nil {
 		return err
 	}
 
-	err := c.client.SetRebalanceThreshold(threshold)
+	err := c.client.SetRebalanceThreshold(threshold, requestor)
 	// if an error occurred reset the connection, could be a broken pipe or other
 	// unrecoverable error. reset connection so a new clean one gets created
 	// on the next request
@@ -312,10 +388,58 @@ func (c *CloudScootClient) SetRebalanceThreshold(threshold int32) error {
 	return err
 }
 
-// helper method to check for a non-nil client / create one
+// GetSettingsHistory get the versions of the settings kept by the scheduler's settings persistor
+func (c *CloudScootClient) GetSettingsHistory() (*scoot.SettingsHistory, error) {
+	if err := c.checkForClient(); err != nil {
+		return nil, err
+	}
+
+	history, err := c.client.GetSettingsHistory()
+	// if an error occurred reset the connection, could be a broken pipe or other
+	// unrecoverable error. reset connection so a new clean one gets created
+	// on the next request
+	if err != nil {
+		// this could cause an error when closing transport
+		// but we don't care do our best effort and move on
+		c.closeConnection()
+	}
+	return history, err
+}
+
+// RollbackSettings restore the settings of a prior version
+func (c *CloudScootClient) RollbackSettings(req *scoot.RollbackSettingsReq) error {
+	if err := c.checkForClient(); err != nil {
+		return err
+	}
+
+	err := c.client.RollbackSettings(req)
+	// if an error occurred reset the connection, could be a broken pipe or other
+	// unrecoverable error. reset connection so a new clean one gets created
+	// on the next request
+	if err != nil {
+		// this could cause an error when closing transport
+		// but we don't care do our best effort and move on
+		c.closeConnection()
+	}
+	return err
+}
+
+// helper method to check for a non-nil client / create one.
+// Connections are closed after errors, so resolving the address for each new
+// connection follows the scheduler leader when it changes.
 func (c *CloudScootClient) checkForClient() (err error) {
 	if c.client == nil {
-		c.client, err = createClient(c.addr, c.dialer)
+		addr := c.addr
+		if c.resolver != nil {
+			addr, err = c.resolver.Resolve()
+			if err != nil {
+				return fmt.Errorf("Error resolving scheduler address: %v", err)
+			}
+			if addr == "" {
+				return fmt.Errorf("Error resolving scheduler address: no scheduler found")
+			}
+		}
+		c.client, err = createClient(addr, c.dialer)
 		if err != nil {
 			return err
 		}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/twitter/scoot/common/errors"
 	"github.com/twitter/scoot/common/log/tags"
 	"github.com/twitter/scoot/common/thrifthelpers"
 	"github.com/twitter/scoot/runner"
+	"github.com/twitter/scoot/runner/execer"
 	schedthrift "github.com/twitter/scoot/scheduler/domain/gen-go/sched"
 )
 
@@ -37,8 +39,7 @@ func DeserializeJob(input []byte) (*Job, error) {
 		return nil, err
 	}
 
-	job := makeDomainJobFromThriftJob(thriftJob)
-	return job, nil
+	return makeDomainJobFromThriftJob(thriftJob)
 }
 
 // JobDefinition is the definition the client sent us
@@ -49,6 +50,25 @@ type JobDefinition struct {
 	Tag       string
 	Priority  Priority
 	Tasks     []TaskDefinition
+
+	// Run on a worker if the job is killed or any of its tasks fails, after the tasks' cleanups.
+	Cleanup *runner.Command
+}
+
+// JobCleanupTaskID is the TaskID the job's cleanup is logged as in the SagaLog, no task in a job with a cleanup may use it.
+const JobCleanupTaskID = "job.cleanup"
+
+// HasCleanup returns true if the job or any of its tasks has a cleanup command.
+func (jd *JobDefinition) HasCleanup() bool {
+	if jd.Cleanup != nil {
+		return true
+	}
+	for _, task := range jd.Tasks {
+		if task.Cleanup != nil {
+			return true
+		}
+	}
+	return false
 }
 
 func (jd *JobDefinition) String() string {
@@ -58,16 +78,184 @@ func (jd *JobDefinition) String() string {
 // Task is one task to run
 type TaskDefinition struct {
 	runner.Command
+
+	// TaskIDs of tasks in the same job that must complete with exit code 0 before this task can be scheduled.
+	Dependencies []string
+
+	// The task only runs on nodes whose labels match all of RequiredLabels,
+	// and prefers nodes matching more of PreferredLabels.
+	RequiredLabels  []LabelSelector
+	PreferredLabels []LabelSelector
+
+	// Run on a worker to undo the task's effects if the job is killed or any of its tasks fails,
+	// once this task has been sent to a worker.
+	Cleanup *runner.Command
+
+	// How the task's failed runs are retried, DefaultRetryPolicy if nil.
+	RetryPolicy *RetryPolicy
 }
 
 type OfflineWorkerReq struct {
 	ID        string
 	Requestor string
+	Reason    string
+	// How long the worker stays offline before it's reinstated, until ReinstateWorker if zero
+	Duration time.Duration
+	// ID is a regular expression, all the workers it matches are offlined
+	IDIsPattern bool
 }
 
 type ReinstateWorkerReq struct {
 	ID        string
 	Requestor string
+	// ID is a regular expression, all the offlined workers it matches are reinstated
+	IDIsPattern bool
+}
+
+// WorkerState the state of a worker node in the scheduler's cluster
+type WorkerState int
+
+const (
+	// Ready and able to take another task
+	WorkerIdle WorkerState = iota
+
+	// Ready and running as many tasks as it can
+	WorkerBusy
+
+	// Added to the cluster but hasn't passed its readiness check yet
+	WorkerNotReady
+
+	// Reported gone by the cluster, removed if it doesn't come back
+	WorkerLost
+
+	// Sidelined for a while after errors talking to it
+	WorkerFlaky
+
+	// Taken out of rotation by OfflineWorker until it's reinstated
+	WorkerOfflined
+
+	// Shutting down after it finishes or hands back its runs, removed once it leaves the cluster
+	WorkerDraining
+)
+
+func (w WorkerState) String() string {
+	asString := [7]string{"Idle", "Busy", "NotReady", "Lost", "Flaky", "Offlined", "Draining"}
+	return asString[w]
+}
+
+// WorkerSummary describes a worker node in the scheduler's cluster and what it's running
+type WorkerSummary struct {
+	ID    string
+	State WorkerState
+
+	// The job and task last scheduled on the worker, empty if it isn't running tasks
+	RunningJobID    string
+	RunningTaskID   string
+	NumRunningTasks int
+
+	SnapshotID string
+	// When the worker was lost, flaky or offlined, zero in the other states
+	InStateSince time.Time
+	Labels       map[string]string
+
+	// Who offlined the worker and why, and when it's reinstated, zero if it's offline until reinstated
+	OfflinedBy    string
+	OfflineReason string
+	OfflinedUntil time.Time
+}
+
+// JobSummary describes a job and the progress of its tasks, without the task definitions
+type JobSummary struct {
+	ID                string
+	Status            Status
+	Requestor         string
+	Tag               string
+	Basis             string
+	JobType           string
+	Priority          Priority
+	NumTasks          int
+	NumRunningTasks   int
+	NumCompletedTasks int
+
+	// Waiting tasks that no node can run, by TaskID, with the reason.
+	UnschedulableTasks map[string]string
+}
+
+// JobFilter selects jobs for listing. Empty or nil fields match any job.
+type JobFilter struct {
+	ID        string
+	Requestor string
+	Tag       string
+	Basis     string
+	JobType   string
+	Priority  *Priority
+	Status    *Status
+}
+
+// Matches returns true if the job summary has all of the fields set in the filter
+func (f JobFilter) Matches(s JobSummary) bool {
+	return (f.ID == "" || f.ID == s.ID) &&
+		(f.Requestor == "" || f.Requestor == s.Requestor) &&
+		(f.Tag == "" || f.Tag == s.Tag) &&
+		(f.Basis == "" || f.Basis == s.Basis) &&
+		(f.JobType == "" || f.JobType == s.JobType) &&
+		(f.Priority == nil || *f.Priority == s.Priority) &&
+		(f.Status == nil || *f.Status == s.Status)
+}
+
+// WaitReason why the scheduler didn't start a waiting task in its last scheduling iteration
+type WaitReason int
+
+const (
+	// The task was added after the last scheduling iteration
+	WaitNotEvaluated WaitReason = iota
+
+	// The task's dependencies haven't all succeeded
+	WaitDependencies
+
+	// The task failed and is held back until its retry backoff expires
+	WaitRetryBackoff
+
+	// No node matches the task's required labels
+	WaitUnschedulable
+
+	// The scheduling algorithm gave the free nodes to other tasks
+	WaitNotSelected
+
+	// Every node is busy running tasks
+	WaitNoIdleNodes
+
+	// There are no nodes, or they're all suspended or offlined
+	WaitNoAvailableNodes
+
+	// No idle node has the resources the task requests, or its required labels
+	WaitNoFittingNode
+)
+
+func (r WaitReason) String() string {
+	asString := [8]string{"NotEvaluated", "Dependencies", "RetryBackoff", "Unschedulable", "NotSelected",
+		"NoIdleNodes", "NoAvailableNodes", "NoFittingNode"}
+	return asString[r]
+}
+
+// TaskExplanation why a waiting task wasn't started, Detail adds the specifics of the Reason
+type TaskExplanation struct {
+	TaskID string
+	Reason WaitReason
+	Detail string
+}
+
+// JobExplanation why the waiting tasks of a job weren't started in the scheduler's last scheduling iteration
+type JobExplanation struct {
+	ID     string
+	Status Status
+	Tasks  []TaskExplanation
+
+	// Scheduler wide conditions that may hold the job back
+	Notes []string
+
+	// When the reasons were captured, zero if the scheduler hasn't had tasks to schedule
+	ExplainedAt time.Time
 }
 
 // Status for Job & Tasks
@@ -94,7 +282,7 @@ const (
 )
 
 func (s Status) String() string {
-	asString := [6]string{"NotStarted", "InProgress", "Completed", "Killed", "RollingBack", "RolledBack"}
+	asString := [5]string{"NotStarted", "InProgress", "Completed", "RollingBack", "RolledBack"}
 	return asString[s]
 }
 
@@ -112,9 +300,9 @@ const (
 )
 
 // transforms a thrift Job into a scheduler Job
-func makeDomainJobFromThriftJob(thriftJob *schedthrift.Job) *Job {
+func makeDomainJobFromThriftJob(thriftJob *schedthrift.Job) (*Job, error) {
 	if thriftJob == nil {
-		return nil
+		return nil, nil
 	}
 	jobType := ""
 	priority := int32(0)
@@ -128,21 +316,30 @@ func makeDomainJobFromThriftJob(thriftJob *schedthrift.Job) *Job {
 	domainTasks := make([]TaskDefinition, 0)
 	if thriftJobDef != nil {
 		for _, task := range thriftJobDef.GetTasks() {
-			cmd := task.GetCommand()
-
-			command := runner.Command{
-				Argv:       cmd.GetArgv(),
-				EnvVars:    cmd.GetEnvVars(),
-				Timeout:    time.Duration(cmd.GetTimeout()),
-				SnapshotID: cmd.GetSnapshotId(),
-				LogTags: tags.LogTags{
-					JobID:  jobID,
-					TaskID: task.GetTaskId(),
-					Tag:    thriftJobDef.GetTag(),
-				},
+			logTags := tags.LogTags{
+				JobID:  jobID,
+				TaskID: task.GetTaskId(),
+				Tag:    thriftJobDef.GetTag(),
 			}
+			command := makeDomainCommandFromThrift(task.GetCommand(), logTags)
 
-			domainTasks = append(domainTasks, TaskDefinition{command})
+			required, err := ParseLabelSelectors(task.GetRequiredLabels())
+			if err != nil {
+				return nil, err
+			}
+			preferred, err := ParseLabelSelectors(task.GetPreferredLabels())
+			if err != nil {
+				return nil, err
+			}
+
+			domainTasks = append(domainTasks, TaskDefinition{
+				Command:         command,
+				Dependencies:    task.GetDependencies(),
+				RequiredLabels:  required,
+				PreferredLabels: preferred,
+				Cleanup:         makeDomainCleanupFromThrift(task.GetCleanup(), logTags),
+				RetryPolicy:     makeDomainRetryPolicyFromThrift(task.GetRetryPolicy()),
+			})
 		}
 
 		jobType = thriftJobDef.GetJobType()
@@ -160,10 +357,69 @@ func makeDomainJobFromThriftJob(thriftJob *schedthrift.Job) *Job {
 		Requestor: requestor,
 		Tag:       tag,
 	}
+	if thriftJobDef != nil {
+		domainJobDef.Cleanup = makeDomainCleanupFromThrift(thriftJobDef.GetCleanup(),
+			tags.LogTags{JobID: jobID, TaskID: JobCleanupTaskID, Tag: tag})
+	}
 
 	return &Job{
 		Id:  jobID,
 		Def: domainJobDef,
+	}, nil
+}
+
+func makeDomainCommandFromThrift(cmd *schedthrift.Command, logTags tags.LogTags) runner.Command {
+	return runner.Command{
+		Argv:        cmd.GetArgv(),
+		EnvVars:     cmd.GetEnvVars(),
+		Timeout:     time.Duration(cmd.GetTimeout()),
+		SnapshotID:  cmd.GetSnapshotId(),
+		OutputPaths: cmd.GetOutputPaths(),
+		Resources:   makeDomainResourcesFromThrift(cmd.GetResources()),
+		LogTags:     logTags,
+
+		EnvMode:      execer.EnvMode(cmd.GetEnvMode()),
+		EnvAllowlist: cmd.GetEnvAllowlist(),
+		SecretEnv:    cmd.GetSecretEnv(),
+	}
+}
+
+// Returns nil if there is no cleanup command.
+func makeDomainCleanupFromThrift(cmd *schedthrift.Command, logTags tags.LogTags) *runner.Command {
+	if cmd == nil {
+		return nil
+	}
+	cleanup := makeDomainCommandFromThrift(cmd, logTags)
+	return &cleanup
+}
+
+// Returns nil if there is no retry policy.
+func makeDomainRetryPolicyFromThrift(thriftPolicy *schedthrift.RetryPolicy) *RetryPolicy {
+	if thriftPolicy == nil {
+		return nil
+	}
+	policy := &RetryPolicy{
+		MaxAttempts: int(thriftPolicy.GetMaxAttempts()),
+		Backoff:     time.Duration(thriftPolicy.GetBackoff()),
+		MaxBackoff:  time.Duration(thriftPolicy.GetMaxBackoff()),
+	}
+	for _, code := range thriftPolicy.GetRetryableExitCodes() {
+		policy.RetryableExitCodes = append(policy.RetryableExitCodes, errors.ExitCode(code))
+	}
+	for _, state := range thriftPolicy.GetRetryableStates() {
+		policy.RetryableStates = append(policy.RetryableStates, runner.RunState(state))
+	}
+	return policy
+}
+
+func makeDomainResourcesFromThrift(thriftResources *schedthrift.Resources) runner.Resources {
+	if thriftResources == nil {
+		return runner.Resources{}
+	}
+	return runner.Resources{
+		CPUMillis:   thriftResources.GetCpuMillis(),
+		MemoryBytes: thriftResources.GetMemoryBytes(),
+		DiskBytes:   thriftResources.GetDiskBytes(),
 	}
 }
 
@@ -175,16 +431,21 @@ func makeThriftJobFromDomainJob(domainJob *Job) (*schedthrift.Job, error) {
 
 	thriftTasks := make([]*schedthrift.TaskDefinition, 0)
 	for _, domainTask := range domainJob.Def.Tasks {
-		to := int64(domainTask.Timeout)
-		cmd := schedthrift.Command{
-			Argv:       domainTask.Argv,
-			EnvVars:    domainTask.EnvVars,
-			Timeout:    &to,
-			SnapshotId: domainTask.SnapshotID,
-		}
 		taskId := domainTask.TaskID
 
-		thriftTask := schedthrift.TaskDefinition{Command: &cmd, TaskId: &taskId}
+		thriftTask := schedthrift.TaskDefinition{
+			Command:         makeThriftCommandFromDomain(domainTask.Command),
+			TaskId:          &taskId,
+			Dependencies:    domainTask.Dependencies,
+			RequiredLabels:  labelSelectorStrings(domainTask.RequiredLabels),
+			PreferredLabels: labelSelectorStrings(domainTask.PreferredLabels),
+		}
+		if domainTask.Cleanup != nil {
+			thriftTask.Cleanup = makeThriftCommandFromDomain(*domainTask.Cleanup)
+		}
+		if domainTask.RetryPolicy != nil {
+			thriftTask.RetryPolicy = makeThriftRetryPolicyFromDomain(*domainTask.RetryPolicy)
+		}
 		thriftTasks = append(thriftTasks, &thriftTask)
 	}
 
@@ -197,6 +458,9 @@ func makeThriftJobFromDomainJob(domainJob *Job) (*schedthrift.Job, error) {
 		Basis:     &(domainJob).Def.Basis,
 		Requestor: &(domainJob).Def.Requestor,
 	}
+	if domainJob.Def.Cleanup != nil {
+		thriftJobDefinition.Cleanup = makeThriftCommandFromDomain(*domainJob.Def.Cleanup)
+	}
 
 	thriftJob := schedthrift.Job{
 		ID:            domainJob.Id,
@@ -206,6 +470,46 @@ func makeThriftJobFromDomainJob(domainJob *Job) (*schedthrift.Job, error) {
 	return &thriftJob, nil
 }
 
+func makeThriftCommandFromDomain(domainCmd runner.Command) *schedthrift.Command {
+	to := int64(domainCmd.Timeout)
+	cmd := schedthrift.Command{
+		Argv:        domainCmd.Argv,
+		EnvVars:     domainCmd.EnvVars,
+		Timeout:     &to,
+		SnapshotId:  domainCmd.SnapshotID,
+		OutputPaths: domainCmd.OutputPaths,
+
+		EnvAllowlist: domainCmd.EnvAllowlist,
+		SecretEnv:    domainCmd.SecretEnv,
+	}
+	if domainCmd.EnvMode != execer.EnvInherit {
+		mode := int32(domainCmd.EnvMode)
+		cmd.EnvMode = &mode
+	}
+	if !domainCmd.Resources.IsZero() {
+		r := domainCmd.Resources
+		cmd.Resources = &schedthrift.Resources{CpuMillis: &r.CPUMillis, MemoryBytes: &r.MemoryBytes, DiskBytes: &r.DiskBytes}
+	}
+	return &cmd
+}
+
+func makeThriftRetryPolicyFromDomain(policy RetryPolicy) *schedthrift.RetryPolicy {
+	maxAttempts := int32(policy.MaxAttempts)
+	backoff, maxBackoff := int64(policy.Backoff), int64(policy.MaxBackoff)
+	thriftPolicy := &schedthrift.RetryPolicy{
+		MaxAttempts: &maxAttempts,
+		Backoff:     &backoff,
+		MaxBackoff:  &maxBackoff,
+	}
+	for _, code := range policy.RetryableExitCodes {
+		thriftPolicy.RetryableExitCodes = append(thriftPolicy.RetryableExitCodes, int32(code))
+	}
+	for _, state := range policy.RetryableStates {
+		thriftPolicy.RetryableStates = append(thriftPolicy.RetryableStates, int32(state))
+	}
+	return thriftPolicy
+}
+
 // Validate a job, returning an *InvalidJobRequest if invalid.
 func ValidateJob(job JobDefinition) error {
 	if len(job.Tasks) == 0 {
@@ -218,6 +522,91 @@ func ValidateJob(job JobDefinition) error {
 		if len(task.Command.Argv) == 0 {
 			return fmt.Errorf("invalid task.Command.Argv. Must have at least one argument; was empty")
 		}
+		if err := runner.ValidateOutputPaths(task.OutputPaths); err != nil {
+			return fmt.Errorf("invalid task %s: %v", task.TaskID, err)
+		}
+		if err := task.Resources.Validate(); err != nil {
+			return fmt.Errorf("invalid task %s: %v", task.TaskID, err)
+		}
+		if err := task.Command.ValidateEnv(); err != nil {
+			return fmt.Errorf("invalid task %s: %v", task.TaskID, err)
+		}
+		if err := validateCleanup(task.Cleanup); err != nil {
+			return fmt.Errorf("invalid task %s: %v", task.TaskID, err)
+		}
+		if task.RetryPolicy != nil {
+			if err := task.RetryPolicy.Validate(); err != nil {
+				return fmt.Errorf("invalid task %s: %v", task.TaskID, err)
+			}
+		}
+		if job.Cleanup != nil && task.TaskID == JobCleanupTaskID {
+			return fmt.Errorf("invalid task id %q, reserved for the job's cleanup.", task.TaskID)
+		}
+	}
+	if err := validateCleanup(job.Cleanup); err != nil {
+		return fmt.Errorf("invalid job: %v", err)
+	}
+	return validateDependencies(job.Tasks)
+}
+
+// Checks that a cleanup command, if any, has something to run.
+func validateCleanup(cleanup *runner.Command) error {
+	if cleanup == nil {
+		return nil
+	}
+	if len(cleanup.Argv) == 0 {
+		return fmt.Errorf("invalid cleanup.Argv. Must have at least one argument; was empty")
+	}
+	if err := cleanup.ValidateEnv(); err != nil {
+		return err
+	}
+	return cleanup.Resources.Validate()
+}
+
+// Checks that every dependency refers to another task in the job and that the dependency graph is acyclic.
+func validateDependencies(tasks []TaskDefinition) error {
+	deps := make(map[string][]string, len(tasks))
+	for _, task := range tasks {
+		deps[task.TaskID] = task.Dependencies
+	}
+	for _, task := range tasks {
+		for _, dep := range task.Dependencies {
+			if dep == task.TaskID {
+				return fmt.Errorf("invalid task %s: depends on itself", task.TaskID)
+			}
+			if _, ok := deps[dep]; !ok {
+				return fmt.Errorf("invalid task %s: unknown dependency %s", task.TaskID, dep)
+			}
+		}
+	}
+
+	// Depth first search, reaching a task that is still on the stack means we've found a cycle.
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	marks := make(map[string]int, len(tasks))
+	var visit func(taskID string) error
+	visit = func(taskID string) error {
+		switch marks[taskID] {
+		case visiting:
+			return fmt.Errorf("invalid job. Task dependencies form a cycle through %s", taskID)
+		case visited:
+			return nil
+		}
+		marks[taskID] = visiting
+		for _, dep := range deps[taskID] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		marks[taskID] = visited
+		return nil
+	}
+	for _, task := range tasks {
+		if err := visit(task.TaskID); err != nil {
+			return err
+		}
 	}
 	return nil
 }
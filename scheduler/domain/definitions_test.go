@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/twitter/scoot/common/thrifthelpers"
+	"github.com/twitter/scoot/runner"
+	"github.com/twitter/scoot/runner/execer"
 	schedthrift "github.com/twitter/scoot/scheduler/domain/gen-go/sched"
 )
 
@@ -35,3 +37,183 @@ func Test_DeserializeJob_MinThrift(t *testing.T) {
 		t.Errorf("unexpected error converting to Scheduler Job %+v", err)
 	}
 }
+
+func Test_ValidateJob_Dependencies(t *testing.T) {
+	makeJob := func(deps map[string][]string, order ...string) JobDefinition {
+		job := JobDefinition{}
+		for _, id := range order {
+			task := TaskDefinition{Dependencies: deps[id]}
+			task.TaskID = id
+			task.Argv = []string{"true"}
+			job.Tasks = append(job.Tasks, task)
+		}
+		return job
+	}
+
+	valid := makeJob(map[string][]string{"b": {"a"}, "c": {"a", "b"}}, "a", "b", "c")
+	if err := ValidateJob(valid); err != nil {
+		t.Errorf("expected acyclic dependencies to be valid, got %v", err)
+	}
+
+	invalid := map[string]JobDefinition{
+		"self":    makeJob(map[string][]string{"a": {"a"}}, "a"),
+		"unknown": makeJob(map[string][]string{"a": {"z"}}, "a"),
+		"cycle":   makeJob(map[string][]string{"a": {"c"}, "b": {"a"}, "c": {"b"}}, "a", "b", "c"),
+	}
+	for name, job := range invalid {
+		if err := ValidateJob(job); err == nil {
+			t.Errorf("expected %s dependencies to be rejected", name)
+		}
+	}
+}
+
+func Test_SerializeJob_Dependencies(t *testing.T) {
+	job := Job{Id: "job1", Def: JobDefinition{Tasks: []TaskDefinition{{Dependencies: []string{"task0"}}}}}
+	job.Def.Tasks[0].TaskID = "task1"
+
+	asBytes, err := job.Serialize()
+	if err != nil {
+		t.Fatalf("unexpected error serializing job %v", err)
+	}
+	result, err := DeserializeJob(asBytes)
+	if err != nil {
+		t.Fatalf("unexpected error deserializing job %v", err)
+	}
+	if deps := result.Def.Tasks[0].Dependencies; len(deps) != 1 || deps[0] != "task0" {
+		t.Errorf("expected dependencies to round trip, got %v", deps)
+	}
+}
+
+func Test_SerializeJob_Resources(t *testing.T) {
+	job := Job{Id: "job1", Def: JobDefinition{Tasks: []TaskDefinition{{}, {}}}}
+	job.Def.Tasks[0].TaskID = "task0"
+	job.Def.Tasks[1].TaskID = "task1"
+	job.Def.Tasks[1].Resources = runner.Resources{CPUMillis: 500, MemoryBytes: 1 << 30, DiskBytes: 1 << 20}
+
+	asBytes, err := job.Serialize()
+	if err != nil {
+		t.Fatalf("unexpected error serializing job %v", err)
+	}
+	result, err := DeserializeJob(asBytes)
+	if err != nil {
+		t.Fatalf("unexpected error deserializing job %v", err)
+	}
+	if r := result.Def.Tasks[0].Resources; !r.IsZero() {
+		t.Errorf("expected unset resources to stay zero, got %s", r)
+	}
+	if r := result.Def.Tasks[1].Resources; r != job.Def.Tasks[1].Resources {
+		t.Errorf("expected resources to round trip, got %s", r)
+	}
+}
+
+func Test_SerializeJob_EnvMode(t *testing.T) {
+	job := Job{Id: "job1", Def: JobDefinition{Tasks: []TaskDefinition{{}, {}}}}
+	job.Def.Tasks[0].TaskID = "task0"
+	job.Def.Tasks[1].TaskID = "task1"
+	job.Def.Tasks[1].EnvMode = execer.EnvAllowlist
+	job.Def.Tasks[1].EnvAllowlist = []string{"HOME", "USER"}
+	job.Def.Tasks[1].SecretEnv = map[string]string{"TOKEN": "deploy-token"}
+
+	asBytes, err := job.Serialize()
+	if err != nil {
+		t.Fatalf("unexpected error serializing job %v", err)
+	}
+	result, err := DeserializeJob(asBytes)
+	if err != nil {
+		t.Fatalf("unexpected error deserializing job %v", err)
+	}
+	if task := result.Def.Tasks[0]; task.EnvMode != execer.EnvInherit || task.EnvAllowlist != nil {
+		t.Errorf("expected an unset env mode to inherit, got %s %v", task.EnvMode, task.EnvAllowlist)
+	}
+	if task := result.Def.Tasks[1]; task.EnvMode != execer.EnvAllowlist || len(task.EnvAllowlist) != 2 {
+		t.Errorf("expected the env mode to round trip, got %s %v", task.EnvMode, task.EnvAllowlist)
+	}
+	if task := result.Def.Tasks[1]; task.SecretEnv["TOKEN"] != "deploy-token" {
+		t.Errorf("expected the secret env to round trip, got %v", task.SecretEnv)
+	}
+}
+
+func Test_ValidateJob_EnvMode(t *testing.T) {
+	task := TaskDefinition{}
+	task.TaskID = "task1"
+	task.Argv = []string{"true"}
+	task.EnvMode = execer.EnvAllowlist
+	task.EnvAllowlist = []string{"HOME"}
+	job := JobDefinition{Tasks: []TaskDefinition{task}}
+	if err := ValidateJob(job); err != nil {
+		t.Errorf("expected an allowlist to be valid, got %v", err)
+	}
+
+	job.Tasks[0].EnvAllowlist = []string{"HOME=/root"}
+	if err := ValidateJob(job); err == nil {
+		t.Errorf("expected an allowlist entry that isn't a name to be rejected")
+	}
+	job.Tasks[0].EnvMode = execer.EnvClean
+	job.Tasks[0].EnvAllowlist = []string{"HOME"}
+	if err := ValidateJob(job); err == nil {
+		t.Errorf("expected an allowlist without the allowlist env mode to be rejected")
+	}
+	job.Tasks[0].EnvAllowlist = nil
+	job.Cleanup = &runner.Command{Argv: []string{"notify"}, EnvMode: execer.EnvMode(7)}
+	if err := ValidateJob(job); err == nil {
+		t.Errorf("expected an unknown env mode to be rejected")
+	}
+}
+
+func Test_SerializeJob_Cleanup(t *testing.T) {
+	job := Job{Id: "job1", Def: JobDefinition{Tasks: []TaskDefinition{{}, {}}}}
+	job.Def.Tasks[0].TaskID = "task0"
+	job.Def.Tasks[1].TaskID = "task1"
+	job.Def.Tasks[1].Cleanup = &runner.Command{Argv: []string{"rm", "-rf", "out"}, SnapshotID: "snap1", Timeout: 5}
+	job.Def.Cleanup = &runner.Command{Argv: []string{"notify", "failed"}}
+
+	asBytes, err := job.Serialize()
+	if err != nil {
+		t.Fatalf("unexpected error serializing job %v", err)
+	}
+	result, err := DeserializeJob(asBytes)
+	if err != nil {
+		t.Fatalf("unexpected error deserializing job %v", err)
+	}
+	if c := result.Def.Tasks[0].Cleanup; c != nil {
+		t.Errorf("expected no cleanup for task0, got %+v", c)
+	}
+	if c := result.Def.Tasks[1].Cleanup; c == nil || c.SnapshotID != "snap1" || c.Timeout != 5 || len(c.Argv) != 3 || c.TaskID != "task1" {
+		t.Errorf("expected task1's cleanup to round trip, got %+v", c)
+	}
+	if c := result.Def.Cleanup; c == nil || len(c.Argv) != 2 || c.TaskID != JobCleanupTaskID {
+		t.Errorf("expected the job's cleanup to round trip, got %+v", c)
+	}
+}
+
+func Test_ValidateJob_Cleanup(t *testing.T) {
+	task := TaskDefinition{}
+	task.TaskID = "task1"
+	task.Argv = []string{"true"}
+	task.Cleanup = &runner.Command{Argv: []string{"undo"}}
+	job := JobDefinition{Tasks: []TaskDefinition{task}, Cleanup: &runner.Command{Argv: []string{"notify"}}}
+	if err := ValidateJob(job); err != nil {
+		t.Errorf("expected cleanups to be valid, got %v", err)
+	}
+	if !job.HasCleanup() {
+		t.Errorf("expected job to have a cleanup")
+	}
+
+	job.Tasks[0].Cleanup = &runner.Command{}
+	if err := ValidateJob(job); err == nil {
+		t.Errorf("expected an empty task cleanup to be rejected")
+	}
+
+	job.Tasks[0].Cleanup = nil
+	job.Tasks[0].TaskID = JobCleanupTaskID
+	if err := ValidateJob(job); err == nil {
+		t.Errorf("expected a task using the job cleanup's TaskID to be rejected")
+	}
+	job.Cleanup = nil
+	if err := ValidateJob(job); err != nil {
+		t.Errorf("expected the job cleanup's TaskID to be usable without a job cleanup, got %v", err)
+	}
+	if job.HasCleanup() {
+		t.Errorf("expected job to have no cleanup")
+	}
+}
@@ -16,16 +16,220 @@ var _ = bytes.Equal
 
 var GoUnusedProtection__ int
 
+// Attributes:
+//  - CpuMillis
+//  - MemoryBytes
+//  - DiskBytes
+type Resources struct {
+	CpuMillis   *int64 `thrift:"cpuMillis,1" json:"cpuMillis,omitempty"`
+	MemoryBytes *int64 `thrift:"memoryBytes,2" json:"memoryBytes,omitempty"`
+	DiskBytes   *int64 `thrift:"diskBytes,3" json:"diskBytes,omitempty"`
+}
+
+func NewResources() *Resources {
+	return &Resources{}
+}
+
+var Resources_CpuMillis_DEFAULT int64
+
+func (p *Resources) GetCpuMillis() int64 {
+	if !p.IsSetCpuMillis() {
+		return Resources_CpuMillis_DEFAULT
+	}
+	return *p.CpuMillis
+}
+
+var Resources_MemoryBytes_DEFAULT int64
+
+func (p *Resources) GetMemoryBytes() int64 {
+	if !p.IsSetMemoryBytes() {
+		return Resources_MemoryBytes_DEFAULT
+	}
+	return *p.MemoryBytes
+}
+
+var Resources_DiskBytes_DEFAULT int64
+
+func (p *Resources) GetDiskBytes() int64 {
+	if !p.IsSetDiskBytes() {
+		return Resources_DiskBytes_DEFAULT
+	}
+	return *p.DiskBytes
+}
+func (p *Resources) IsSetCpuMillis() bool {
+	return p.CpuMillis != nil
+}
+
+func (p *Resources) IsSetMemoryBytes() bool {
+	return p.MemoryBytes != nil
+}
+
+func (p *Resources) IsSetDiskBytes() bool {
+	return p.DiskBytes != nil
+}
+
+func (p *Resources) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Resources) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.CpuMillis = &v
+	}
+	return nil
+}
+
+func (p *Resources) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.MemoryBytes = &v
+	}
+	return nil
+}
+
+func (p *Resources) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.DiskBytes = &v
+	}
+	return nil
+}
+
+func (p *Resources) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("Resources"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Resources) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCpuMillis() {
+		if err := oprot.WriteFieldBegin("cpuMillis", thrift.I64, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cpuMillis: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.CpuMillis)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.cpuMillis (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cpuMillis: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Resources) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMemoryBytes() {
+		if err := oprot.WriteFieldBegin("memoryBytes", thrift.I64, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:memoryBytes: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.MemoryBytes)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.memoryBytes (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:memoryBytes: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Resources) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiskBytes() {
+		if err := oprot.WriteFieldBegin("diskBytes", thrift.I64, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:diskBytes: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.DiskBytes)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.diskBytes (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:diskBytes: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Resources) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Resources(%+v)", *p)
+}
+
 // Attributes:
 //  - Argv
 //  - EnvVars
 //  - Timeout
 //  - SnapshotId
+//  - OutputPaths
+//  - Resources
+//  - EnvMode
+//  - EnvAllowlist
+//  - SecretEnv
 type Command struct {
-	Argv       []string          `thrift:"argv,1,required" json:"argv"`
-	EnvVars    map[string]string `thrift:"envVars,2" json:"envVars,omitempty"`
-	Timeout    *int64            `thrift:"timeout,3" json:"timeout,omitempty"`
-	SnapshotId string            `thrift:"snapshotId,4,required" json:"snapshotId"`
+	Argv         []string          `thrift:"argv,1,required" json:"argv"`
+	EnvVars      map[string]string `thrift:"envVars,2" json:"envVars,omitempty"`
+	Timeout      *int64            `thrift:"timeout,3" json:"timeout,omitempty"`
+	SnapshotId   string            `thrift:"snapshotId,4,required" json:"snapshotId"`
+	OutputPaths  []string          `thrift:"outputPaths,5" json:"outputPaths,omitempty"`
+	Resources    *Resources        `thrift:"resources,6" json:"resources,omitempty"`
+	EnvMode      *int32            `thrift:"envMode,7" json:"envMode,omitempty"`
+	EnvAllowlist []string          `thrift:"envAllowlist,8" json:"envAllowlist,omitempty"`
+	SecretEnv    map[string]string `thrift:"secretEnv,9" json:"secretEnv,omitempty"`
 }
 
 func NewCommand() *Command {
@@ -54,6 +258,42 @@ func (p *Command) GetTimeout() int64 {
 func (p *Command) GetSnapshotId() string {
 	return p.SnapshotId
 }
+
+var Command_OutputPaths_DEFAULT []string
+
+func (p *Command) GetOutputPaths() []string {
+	return p.OutputPaths
+}
+
+var Command_Resources_DEFAULT *Resources
+
+func (p *Command) GetResources() *Resources {
+	if !p.IsSetResources() {
+		return Command_Resources_DEFAULT
+	}
+	return p.Resources
+}
+
+var Command_EnvMode_DEFAULT int32
+
+func (p *Command) GetEnvMode() int32 {
+	if !p.IsSetEnvMode() {
+		return Command_EnvMode_DEFAULT
+	}
+	return *p.EnvMode
+}
+
+var Command_EnvAllowlist_DEFAULT []string
+
+func (p *Command) GetEnvAllowlist() []string {
+	return p.EnvAllowlist
+}
+
+var Command_SecretEnv_DEFAULT map[string]string
+
+func (p *Command) GetSecretEnv() map[string]string {
+	return p.SecretEnv
+}
 func (p *Command) IsSetEnvVars() bool {
 	return p.EnvVars != nil
 }
@@ -62,6 +302,26 @@ func (p *Command) IsSetTimeout() bool {
 	return p.Timeout != nil
 }
 
+func (p *Command) IsSetOutputPaths() bool {
+	return p.OutputPaths != nil
+}
+
+func (p *Command) IsSetResources() bool {
+	return p.Resources != nil
+}
+
+func (p *Command) IsSetEnvMode() bool {
+	return p.EnvMode != nil
+}
+
+func (p *Command) IsSetEnvAllowlist() bool {
+	return p.EnvAllowlist != nil
+}
+
+func (p *Command) IsSetSecretEnv() bool {
+	return p.SecretEnv != nil
+}
+
 func (p *Command) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -97,6 +357,26 @@ func (p *Command) Read(iprot thrift.TProtocol) error {
 				return err
 			}
 			issetSnapshotId = true
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
+		case 7:
+			if err := p.readField7(iprot); err != nil {
+				return err
+			}
+		case 8:
+			if err := p.readField8(iprot); err != nil {
+				return err
+			}
+		case 9:
+			if err := p.readField9(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -186,6 +466,95 @@ func (p *Command) readField4(iprot thrift.TProtocol) error {
 	return nil
 }
 
+func (p *Command) readField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.OutputPaths = tSlice
+	for i := 0; i < size; i++ {
+		var _elem3 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem3 = v
+		}
+		p.OutputPaths = append(p.OutputPaths, _elem3)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *Command) readField6(iprot thrift.TProtocol) error {
+	p.Resources = &Resources{}
+	if err := p.Resources.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Resources), err)
+	}
+	return nil
+}
+
+func (p *Command) readField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.EnvMode = &v
+	}
+	return nil
+}
+
+func (p *Command) readField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.EnvAllowlist = tSlice
+	for i := 0; i < size; i++ {
+		var _elem4 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem4 = v
+		}
+		p.EnvAllowlist = append(p.EnvAllowlist, _elem4)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *Command) readField9(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.PrependError("error reading map begin: ", err)
+	}
+	tMap := make(map[string]string, size)
+	p.SecretEnv = tMap
+	for i := 0; i < size; i++ {
+		var _key5 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_key5 = v
+		}
+		var _val6 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_val6 = v
+		}
+		p.SecretEnv[_key5] = _val6
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return thrift.PrependError("error reading map end: ", err)
+	}
+	return nil
+}
+
 func (p *Command) Write(oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin("Command"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -202,6 +571,21 @@ func (p *Command) Write(oprot thrift.TProtocol) error {
 	if err := p.writeField4(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField8(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField9(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -232,73 +616,507 @@ func (p *Command) writeField1(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
-func (p *Command) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEnvVars() {
-		if err := oprot.WriteFieldBegin("envVars", thrift.MAP, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:envVars: ", p), err)
+func (p *Command) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnvVars() {
+		if err := oprot.WriteFieldBegin("envVars", thrift.MAP, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:envVars: ", p), err)
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.EnvVars)); err != nil {
+			return thrift.PrependError("error writing map begin: ", err)
+		}
+		for k, v := range p.EnvVars {
+			if err := oprot.WriteString(string(k)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return thrift.PrependError("error writing map end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:envVars: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Command) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTimeout() {
+		if err := oprot.WriteFieldBegin("timeout", thrift.I64, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:timeout: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.Timeout)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.timeout (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:timeout: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Command) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("snapshotId", thrift.STRING, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:snapshotId: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.SnapshotId)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.snapshotId (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:snapshotId: ", p), err)
+	}
+	return err
+}
+
+func (p *Command) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputPaths() {
+		if err := oprot.WriteFieldBegin("outputPaths", thrift.LIST, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:outputPaths: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.OutputPaths)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.OutputPaths {
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:outputPaths: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Command) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResources() {
+		if err := oprot.WriteFieldBegin("resources", thrift.STRUCT, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:resources: ", p), err)
+		}
+		if err := p.Resources.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Resources), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:resources: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Command) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnvMode() {
+		if err := oprot.WriteFieldBegin("envMode", thrift.I32, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:envMode: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.EnvMode)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.envMode (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:envMode: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Command) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnvAllowlist() {
+		if err := oprot.WriteFieldBegin("envAllowlist", thrift.LIST, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:envAllowlist: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.EnvAllowlist)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.EnvAllowlist {
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:envAllowlist: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Command) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSecretEnv() {
+		if err := oprot.WriteFieldBegin("secretEnv", thrift.MAP, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:secretEnv: ", p), err)
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.SecretEnv)); err != nil {
+			return thrift.PrependError("error writing map begin: ", err)
+		}
+		for k, v := range p.SecretEnv {
+			if err := oprot.WriteString(string(k)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return thrift.PrependError("error writing map end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:secretEnv: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Command) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Command(%+v)", *p)
+}
+
+// Attributes:
+//  - MaxAttempts
+//  - RetryableExitCodes
+//  - RetryableStates
+//  - Backoff
+//  - MaxBackoff
+type RetryPolicy struct {
+	MaxAttempts        *int32  `thrift:"maxAttempts,1" json:"maxAttempts,omitempty"`
+	RetryableExitCodes []int32 `thrift:"retryableExitCodes,2" json:"retryableExitCodes,omitempty"`
+	RetryableStates    []int32 `thrift:"retryableStates,3" json:"retryableStates,omitempty"`
+	Backoff            *int64  `thrift:"backoff,4" json:"backoff,omitempty"`
+	MaxBackoff         *int64  `thrift:"maxBackoff,5" json:"maxBackoff,omitempty"`
+}
+
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{}
+}
+
+var RetryPolicy_MaxAttempts_DEFAULT int32
+
+func (p *RetryPolicy) GetMaxAttempts() int32 {
+	if !p.IsSetMaxAttempts() {
+		return RetryPolicy_MaxAttempts_DEFAULT
+	}
+	return *p.MaxAttempts
+}
+
+var RetryPolicy_RetryableExitCodes_DEFAULT []int32
+
+func (p *RetryPolicy) GetRetryableExitCodes() []int32 {
+	return p.RetryableExitCodes
+}
+
+var RetryPolicy_RetryableStates_DEFAULT []int32
+
+func (p *RetryPolicy) GetRetryableStates() []int32 {
+	return p.RetryableStates
+}
+
+var RetryPolicy_Backoff_DEFAULT int64
+
+func (p *RetryPolicy) GetBackoff() int64 {
+	if !p.IsSetBackoff() {
+		return RetryPolicy_Backoff_DEFAULT
+	}
+	return *p.Backoff
+}
+
+var RetryPolicy_MaxBackoff_DEFAULT int64
+
+func (p *RetryPolicy) GetMaxBackoff() int64 {
+	if !p.IsSetMaxBackoff() {
+		return RetryPolicy_MaxBackoff_DEFAULT
+	}
+	return *p.MaxBackoff
+}
+func (p *RetryPolicy) IsSetMaxAttempts() bool {
+	return p.MaxAttempts != nil
+}
+
+func (p *RetryPolicy) IsSetRetryableExitCodes() bool {
+	return p.RetryableExitCodes != nil
+}
+
+func (p *RetryPolicy) IsSetRetryableStates() bool {
+	return p.RetryableStates != nil
+}
+
+func (p *RetryPolicy) IsSetBackoff() bool {
+	return p.Backoff != nil
+}
+
+func (p *RetryPolicy) IsSetMaxBackoff() bool {
+	return p.MaxBackoff != nil
+}
+
+func (p *RetryPolicy) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *RetryPolicy) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.MaxAttempts = &v
+	}
+	return nil
+}
+
+func (p *RetryPolicy) readField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]int32, 0, size)
+	p.RetryableExitCodes = tSlice
+	for i := 0; i < size; i++ {
+		var _elem7 int32
+		if v, err := iprot.ReadI32(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem7 = v
+		}
+		p.RetryableExitCodes = append(p.RetryableExitCodes, _elem7)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *RetryPolicy) readField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]int32, 0, size)
+	p.RetryableStates = tSlice
+	for i := 0; i < size; i++ {
+		var _elem8 int32
+		if v, err := iprot.ReadI32(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem8 = v
+		}
+		p.RetryableStates = append(p.RetryableStates, _elem8)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *RetryPolicy) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Backoff = &v
+	}
+	return nil
+}
+
+func (p *RetryPolicy) readField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.MaxBackoff = &v
+	}
+	return nil
+}
+
+func (p *RetryPolicy) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("RetryPolicy"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *RetryPolicy) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxAttempts() {
+		if err := oprot.WriteFieldBegin("maxAttempts", thrift.I32, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:maxAttempts: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.MaxAttempts)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.maxAttempts (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:maxAttempts: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *RetryPolicy) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRetryableExitCodes() {
+		if err := oprot.WriteFieldBegin("retryableExitCodes", thrift.LIST, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:retryableExitCodes: ", p), err)
 		}
-		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.EnvVars)); err != nil {
-			return thrift.PrependError("error writing map begin: ", err)
+		if err := oprot.WriteListBegin(thrift.I32, len(p.RetryableExitCodes)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
 		}
-		for k, v := range p.EnvVars {
-			if err := oprot.WriteString(string(k)); err != nil {
+		for _, v := range p.RetryableExitCodes {
+			if err := oprot.WriteI32(int32(v)); err != nil {
 				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 			}
-			if err := oprot.WriteString(string(v)); err != nil {
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:retryableExitCodes: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *RetryPolicy) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRetryableStates() {
+		if err := oprot.WriteFieldBegin("retryableStates", thrift.LIST, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:retryableStates: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.I32, len(p.RetryableStates)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.RetryableStates {
+			if err := oprot.WriteI32(int32(v)); err != nil {
 				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 			}
 		}
-		if err := oprot.WriteMapEnd(); err != nil {
-			return thrift.PrependError("error writing map end: ", err)
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:envVars: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:retryableStates: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *Command) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTimeout() {
-		if err := oprot.WriteFieldBegin("timeout", thrift.I64, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:timeout: ", p), err)
+func (p *RetryPolicy) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBackoff() {
+		if err := oprot.WriteFieldBegin("backoff", thrift.I64, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:backoff: ", p), err)
 		}
-		if err := oprot.WriteI64(int64(*p.Timeout)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.timeout (3) field write error: ", p), err)
+		if err := oprot.WriteI64(int64(*p.Backoff)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.backoff (4) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:timeout: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:backoff: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *Command) writeField4(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("snapshotId", thrift.STRING, 4); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:snapshotId: ", p), err)
-	}
-	if err := oprot.WriteString(string(p.SnapshotId)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.snapshotId (4) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:snapshotId: ", p), err)
+func (p *RetryPolicy) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxBackoff() {
+		if err := oprot.WriteFieldBegin("maxBackoff", thrift.I64, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:maxBackoff: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.MaxBackoff)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.maxBackoff (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:maxBackoff: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *Command) String() string {
+func (p *RetryPolicy) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Command(%+v)", *p)
+	return fmt.Sprintf("RetryPolicy(%+v)", *p)
 }
 
 // Attributes:
 //  - Command
 //  - TaskId
+//  - Dependencies
+//  - RequiredLabels
+//  - PreferredLabels
+//  - Cleanup
+//  - RetryPolicy
 type TaskDefinition struct {
-	Command *Command `thrift:"command,1,required" json:"command"`
-	TaskId  *string  `thrift:"taskId,2" json:"taskId,omitempty"`
+	Command         *Command     `thrift:"command,1,required" json:"command"`
+	TaskId          *string      `thrift:"taskId,2" json:"taskId,omitempty"`
+	Dependencies    []string     `thrift:"dependencies,3" json:"dependencies,omitempty"`
+	RequiredLabels  []string     `thrift:"requiredLabels,4" json:"requiredLabels,omitempty"`
+	PreferredLabels []string     `thrift:"preferredLabels,5" json:"preferredLabels,omitempty"`
+	Cleanup         *Command     `thrift:"cleanup,6" json:"cleanup,omitempty"`
+	RetryPolicy     *RetryPolicy `thrift:"retryPolicy,7" json:"retryPolicy,omitempty"`
 }
 
 func NewTaskDefinition() *TaskDefinition {
@@ -322,6 +1140,42 @@ func (p *TaskDefinition) GetTaskId() string {
 	}
 	return *p.TaskId
 }
+
+var TaskDefinition_Dependencies_DEFAULT []string
+
+func (p *TaskDefinition) GetDependencies() []string {
+	return p.Dependencies
+}
+
+var TaskDefinition_RequiredLabels_DEFAULT []string
+
+func (p *TaskDefinition) GetRequiredLabels() []string {
+	return p.RequiredLabels
+}
+
+var TaskDefinition_PreferredLabels_DEFAULT []string
+
+func (p *TaskDefinition) GetPreferredLabels() []string {
+	return p.PreferredLabels
+}
+
+var TaskDefinition_Cleanup_DEFAULT *Command
+
+func (p *TaskDefinition) GetCleanup() *Command {
+	if !p.IsSetCleanup() {
+		return TaskDefinition_Cleanup_DEFAULT
+	}
+	return p.Cleanup
+}
+
+var TaskDefinition_RetryPolicy_DEFAULT *RetryPolicy
+
+func (p *TaskDefinition) GetRetryPolicy() *RetryPolicy {
+	if !p.IsSetRetryPolicy() {
+		return TaskDefinition_RetryPolicy_DEFAULT
+	}
+	return p.RetryPolicy
+}
 func (p *TaskDefinition) IsSetCommand() bool {
 	return p.Command != nil
 }
@@ -330,6 +1184,26 @@ func (p *TaskDefinition) IsSetTaskId() bool {
 	return p.TaskId != nil
 }
 
+func (p *TaskDefinition) IsSetDependencies() bool {
+	return p.Dependencies != nil
+}
+
+func (p *TaskDefinition) IsSetRequiredLabels() bool {
+	return p.RequiredLabels != nil
+}
+
+func (p *TaskDefinition) IsSetPreferredLabels() bool {
+	return p.PreferredLabels != nil
+}
+
+func (p *TaskDefinition) IsSetCleanup() bool {
+	return p.Cleanup != nil
+}
+
+func (p *TaskDefinition) IsSetRetryPolicy() bool {
+	return p.RetryPolicy != nil
+}
+
 func (p *TaskDefinition) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -355,6 +1229,26 @@ func (p *TaskDefinition) Read(iprot thrift.TProtocol) error {
 			if err := p.readField2(iprot); err != nil {
 				return err
 			}
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
+		case 7:
+			if err := p.readField7(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -390,6 +1284,88 @@ func (p *TaskDefinition) readField2(iprot thrift.TProtocol) error {
 	return nil
 }
 
+func (p *TaskDefinition) readField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.Dependencies = tSlice
+	for i := 0; i < size; i++ {
+		var _elem9 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem9 = v
+		}
+		p.Dependencies = append(p.Dependencies, _elem9)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *TaskDefinition) readField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.RequiredLabels = tSlice
+	for i := 0; i < size; i++ {
+		var _elem10 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem10 = v
+		}
+		p.RequiredLabels = append(p.RequiredLabels, _elem10)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *TaskDefinition) readField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.PreferredLabels = tSlice
+	for i := 0; i < size; i++ {
+		var _elem11 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem11 = v
+		}
+		p.PreferredLabels = append(p.PreferredLabels, _elem11)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *TaskDefinition) readField6(iprot thrift.TProtocol) error {
+	p.Cleanup = &Command{}
+	if err := p.Cleanup.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Cleanup), err)
+	}
+	return nil
+}
+
+func (p *TaskDefinition) readField7(iprot thrift.TProtocol) error {
+	p.RetryPolicy = &RetryPolicy{}
+	if err := p.RetryPolicy.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.RetryPolicy), err)
+	}
+	return nil
+}
+
 func (p *TaskDefinition) Write(oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin("TaskDefinition"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -400,6 +1376,21 @@ func (p *TaskDefinition) Write(oprot thrift.TProtocol) error {
 	if err := p.writeField2(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -437,6 +1428,105 @@ func (p *TaskDefinition) writeField2(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
+func (p *TaskDefinition) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDependencies() {
+		if err := oprot.WriteFieldBegin("dependencies", thrift.LIST, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:dependencies: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.Dependencies)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.Dependencies {
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:dependencies: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TaskDefinition) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRequiredLabels() {
+		if err := oprot.WriteFieldBegin("requiredLabels", thrift.LIST, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:requiredLabels: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.RequiredLabels)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.RequiredLabels {
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:requiredLabels: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TaskDefinition) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPreferredLabels() {
+		if err := oprot.WriteFieldBegin("preferredLabels", thrift.LIST, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:preferredLabels: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.PreferredLabels)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.PreferredLabels {
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:preferredLabels: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TaskDefinition) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCleanup() {
+		if err := oprot.WriteFieldBegin("cleanup", thrift.STRUCT, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:cleanup: ", p), err)
+		}
+		if err := p.Cleanup.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Cleanup), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:cleanup: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TaskDefinition) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRetryPolicy() {
+		if err := oprot.WriteFieldBegin("retryPolicy", thrift.STRUCT, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:retryPolicy: ", p), err)
+		}
+		if err := p.RetryPolicy.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.RetryPolicy), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:retryPolicy: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *TaskDefinition) String() string {
 	if p == nil {
 		return "<nil>"
@@ -451,6 +1541,7 @@ func (p *TaskDefinition) String() string {
 //  - Tag
 //  - Basis
 //  - Requestor
+//  - Cleanup
 type JobDefinition struct {
 	JobType   *string           `thrift:"jobType,1" json:"jobType,omitempty"`
 	Tasks     []*TaskDefinition `thrift:"tasks,2" json:"tasks,omitempty"`
@@ -458,6 +1549,7 @@ type JobDefinition struct {
 	Tag       *string           `thrift:"tag,4" json:"tag,omitempty"`
 	Basis     *string           `thrift:"basis,5" json:"basis,omitempty"`
 	Requestor *string           `thrift:"requestor,6" json:"requestor,omitempty"`
+	Cleanup   *Command          `thrift:"cleanup,7" json:"cleanup,omitempty"`
 }
 
 func NewJobDefinition() *JobDefinition {
@@ -514,6 +1606,15 @@ func (p *JobDefinition) GetRequestor() string {
 	}
 	return *p.Requestor
 }
+
+var JobDefinition_Cleanup_DEFAULT *Command
+
+func (p *JobDefinition) GetCleanup() *Command {
+	if !p.IsSetCleanup() {
+		return JobDefinition_Cleanup_DEFAULT
+	}
+	return p.Cleanup
+}
 func (p *JobDefinition) IsSetJobType() bool {
 	return p.JobType != nil
 }
@@ -538,6 +1639,10 @@ func (p *JobDefinition) IsSetRequestor() bool {
 	return p.Requestor != nil
 }
 
+func (p *JobDefinition) IsSetCleanup() bool {
+	return p.Cleanup != nil
+}
+
 func (p *JobDefinition) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -576,6 +1681,10 @@ func (p *JobDefinition) Read(iprot thrift.TProtocol) error {
 			if err := p.readField6(iprot); err != nil {
 				return err
 			}
+		case 7:
+			if err := p.readField7(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -608,11 +1717,11 @@ func (p *JobDefinition) readField2(iprot thrift.TProtocol) error {
 	tSlice := make([]*TaskDefinition, 0, size)
 	p.Tasks = tSlice
 	for i := 0; i < size; i++ {
-		_elem3 := &TaskDefinition{}
-		if err := _elem3.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem3), err)
+		_elem12 := &TaskDefinition{}
+		if err := _elem12.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem12), err)
 		}
-		p.Tasks = append(p.Tasks, _elem3)
+		p.Tasks = append(p.Tasks, _elem12)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -656,6 +1765,14 @@ func (p *JobDefinition) readField6(iprot thrift.TProtocol) error {
 	return nil
 }
 
+func (p *JobDefinition) readField7(iprot thrift.TProtocol) error {
+	p.Cleanup = &Command{}
+	if err := p.Cleanup.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Cleanup), err)
+	}
+	return nil
+}
+
 func (p *JobDefinition) Write(oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin("JobDefinition"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -678,6 +1795,9 @@ func (p *JobDefinition) Write(oprot thrift.TProtocol) error {
 	if err := p.writeField6(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -785,6 +1905,21 @@ func (p *JobDefinition) writeField6(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
+func (p *JobDefinition) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCleanup() {
+		if err := oprot.WriteFieldBegin("cleanup", thrift.STRUCT, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:cleanup: ", p), err)
+		}
+		if err := p.Cleanup.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Cleanup), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:cleanup: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *JobDefinition) String() string {
 	if p == nil {
 		return "<nil>"
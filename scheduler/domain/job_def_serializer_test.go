@@ -99,8 +99,8 @@ func ValidateSerialization(domainJob *Job, useJson bool) bool {
 
 			// compare the orig and generated task definitions
 		} else {
-			newDomainJob = makeDomainJobFromThriftJob(newThriftJob)
-			if !reflect.DeepEqual(domainJob, newDomainJob) || !reflect.DeepEqual(thriftJob, newThriftJob) {
+			newDomainJob, err = makeDomainJobFromThriftJob(newThriftJob)
+			if err != nil || !reflect.DeepEqual(domainJob, newDomainJob) || !reflect.DeepEqual(thriftJob, newThriftJob) {
 				log.Info("Serialize/deserialize test didn't return equivalent value:")
 				log.Infof("Original job:\n\n%+v\n", domainJob)
 				log.Infof("Deserialized to:\n\n%+v\n", newDomainJob)
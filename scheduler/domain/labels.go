@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LabelOp is how a LabelSelector compares a node's label to its value.
+type LabelOp int
+
+const (
+	// The node has the label, with any value.
+	LabelExists LabelOp = iota
+	// The node doesn't have the label.
+	LabelNotExists
+	// The node has the label with the given value.
+	LabelEquals
+	// The node doesn't have the label with the given value, including not having the label at all.
+	LabelNotEquals
+)
+
+// LabelSelector constrains the nodes a task can run on by the nodes' labels.
+// Selectors are written as "key", "!key", "key=value" or "key!=value".
+type LabelSelector struct {
+	Key   string
+	Op    LabelOp
+	Value string
+}
+
+// ParseLabelSelector parses a selector written as "key", "!key", "key=value" or "key!=value".
+func ParseLabelSelector(s string) (LabelSelector, error) {
+	var sel LabelSelector
+	switch {
+	case strings.Contains(s, "!="):
+		parts := strings.SplitN(s, "!=", 2)
+		sel = LabelSelector{Key: parts[0], Op: LabelNotEquals, Value: parts[1]}
+	case strings.Contains(s, "="):
+		parts := strings.SplitN(s, "=", 2)
+		sel = LabelSelector{Key: parts[0], Op: LabelEquals, Value: parts[1]}
+	case strings.HasPrefix(s, "!"):
+		sel = LabelSelector{Key: s[1:], Op: LabelNotExists}
+	default:
+		sel = LabelSelector{Key: s, Op: LabelExists}
+	}
+	sel.Key = strings.TrimSpace(sel.Key)
+	sel.Value = strings.TrimSpace(sel.Value)
+	if sel.Key == "" || strings.ContainsAny(sel.Key, "!=") {
+		return LabelSelector{}, fmt.Errorf("invalid label selector %q, must be one of key, !key, key=value or key!=value", s)
+	}
+	return sel, nil
+}
+
+// ParseLabelSelectors parses each of the given selectors, returning nil if there are none.
+func ParseLabelSelectors(ss []string) ([]LabelSelector, error) {
+	if len(ss) == 0 {
+		return nil, nil
+	}
+	sels := make([]LabelSelector, 0, len(ss))
+	for _, s := range ss {
+		sel, err := ParseLabelSelector(s)
+		if err != nil {
+			return nil, err
+		}
+		sels = append(sels, sel)
+	}
+	return sels, nil
+}
+
+// Matches returns true if a node with the given labels satisfies the selector.
+func (sel LabelSelector) Matches(labels map[string]string) bool {
+	value, ok := labels[sel.Key]
+	switch sel.Op {
+	case LabelExists:
+		return ok
+	case LabelNotExists:
+		return !ok
+	case LabelEquals:
+		return ok && value == sel.Value
+	case LabelNotEquals:
+		return !ok || value != sel.Value
+	}
+	return false
+}
+
+func (sel LabelSelector) String() string {
+	switch sel.Op {
+	case LabelNotExists:
+		return "!" + sel.Key
+	case LabelEquals:
+		return sel.Key + "=" + sel.Value
+	case LabelNotEquals:
+		return sel.Key + "!=" + sel.Value
+	}
+	return sel.Key
+}
+
+// MatchesAllLabels returns true if a node with the given labels satisfies every selector.
+func MatchesAllLabels(sels []LabelSelector, labels map[string]string) bool {
+	for _, sel := range sels {
+		if !sel.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// NumMatchingLabels returns the number of selectors satisfied by a node with the given labels.
+func NumMatchingLabels(sels []LabelSelector, labels map[string]string) int {
+	n := 0
+	for _, sel := range sels {
+		if sel.Matches(labels) {
+			n++
+		}
+	}
+	return n
+}
+
+// Returns the string form of each selector, nil if there are none.
+func labelSelectorStrings(sels []LabelSelector) []string {
+	if len(sels) == 0 {
+		return nil
+	}
+	ss := make([]string, 0, len(sels))
+	for _, sel := range sels {
+		ss = append(ss, sel.String())
+	}
+	return ss
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_ParseLabelSelector(t *testing.T) {
+	valid := map[string]LabelSelector{
+		"android-sdk":  {Key: "android-sdk", Op: LabelExists},
+		"!gpu":         {Key: "gpu", Op: LabelNotExists},
+		"rack=r1":      {Key: "rack", Op: LabelEquals, Value: "r1"},
+		"rack!=r3":     {Key: "rack", Op: LabelNotEquals, Value: "r3"},
+		" os = linux ": {Key: "os", Op: LabelEquals, Value: "linux"},
+		"empty=":       {Key: "empty", Op: LabelEquals},
+	}
+	for s, expected := range valid {
+		sel, err := ParseLabelSelector(s)
+		if err != nil || sel != expected {
+			t.Errorf("Expected %q to parse as %+v, got %+v, %v", s, expected, sel, err)
+		}
+	}
+	for _, s := range []string{"", "!", "=r1", "!=r3", "!rack=r1"} {
+		if sel, err := ParseLabelSelector(s); err == nil {
+			t.Errorf("Expected %q to be invalid, got %+v", s, sel)
+		}
+	}
+}
+
+func Test_LabelSelector_Matches(t *testing.T) {
+	labels := map[string]string{"rack": "r1", "gpu": ""}
+	for s, expected := range map[string]bool{
+		"gpu":      true,
+		"os":       false,
+		"!gpu":     false,
+		"!os":      true,
+		"rack=r1":  true,
+		"rack=r3":  false,
+		"rack!=r3": true,
+		"rack!=r1": false,
+		"os!=r1":   true,
+	} {
+		sel, _ := ParseLabelSelector(s)
+		if sel.Matches(labels) != expected {
+			t.Errorf("Expected %s matching %v to be %t", sel, labels, expected)
+		}
+		if sel.String() != s {
+			t.Errorf("Expected %q to format as itself, got %q", s, sel)
+		}
+	}
+
+	sels, _ := ParseLabelSelectors([]string{"gpu", "rack=r3", "!os"})
+	if MatchesAllLabels(sels, labels) || NumMatchingLabels(sels, labels) != 2 {
+		t.Errorf("Expected %v to match 2 of the labels %v", sels, labels)
+	}
+	if !MatchesAllLabels(nil, labels) {
+		t.Errorf("Expected no selectors to match any labels")
+	}
+}
+
+func Test_SerializeJob_LabelSelectors(t *testing.T) {
+	required, _ := ParseLabelSelectors([]string{"android-sdk", "rack!=r3"})
+	preferred, _ := ParseLabelSelectors([]string{"zone=z1"})
+	job := Job{Id: "job1", Def: JobDefinition{Tasks: []TaskDefinition{{}, {}}}}
+	job.Def.Tasks[0].TaskID = "task0"
+	job.Def.Tasks[1].TaskID = "task1"
+	job.Def.Tasks[1].RequiredLabels = required
+	job.Def.Tasks[1].PreferredLabels = preferred
+
+	asBytes, err := job.Serialize()
+	if err != nil {
+		t.Fatalf("unexpected error serializing job %v", err)
+	}
+	result, err := DeserializeJob(asBytes)
+	if err != nil {
+		t.Fatalf("unexpected error deserializing job %v", err)
+	}
+	if task := result.Def.Tasks[0]; task.RequiredLabels != nil || task.PreferredLabels != nil {
+		t.Errorf("expected unset label selectors to stay nil, got %v %v", task.RequiredLabels, task.PreferredLabels)
+	}
+	if task := result.Def.Tasks[1]; !reflect.DeepEqual(task.RequiredLabels, required) || !reflect.DeepEqual(task.PreferredLabels, preferred) {
+		t.Errorf("expected label selectors to round trip, got %v %v", task.RequiredLabels, task.PreferredLabels)
+	}
+}
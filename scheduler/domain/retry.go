@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/twitter/scoot/common/errors"
+	"github.com/twitter/scoot/runner"
+)
+
+// FailureKind distinguishes runs that failed because of Scoot or its workers from runs whose command failed.
+type FailureKind int
+
+const (
+	// The run completed with exit code 0.
+	NoFailure FailureKind = iota
+
+	// The command failed, by exiting with a nonzero exit code or timing out.
+	UserFailure
+
+	// The run failed before or after its command ran, e.g. the worker couldn't check out the snapshot,
+	// exec the command or save its output, or the worker couldn't be reached.
+	InfrastructureFailure
+)
+
+func (k FailureKind) String() string {
+	switch k {
+	case NoFailure:
+		return "none"
+	case UserFailure:
+		return "user"
+	case InfrastructureFailure:
+		return "infrastructure"
+	}
+	return fmt.Sprintf("FailureKind(%d)", int(k))
+}
+
+// ClassifyRun returns the kind of failure, if any, of a run with the given status.
+// runnerErr is the error, if any, from sending the run to the worker or querying its status.
+// Scoot's own failures are FAILED runs, a COMPLETE run's exit code is always the command's,
+// even when it is in the range Scoot uses for its failures.
+func ClassifyRun(st runner.RunStatus, runnerErr error) FailureKind {
+	switch {
+	case runnerErr != nil:
+		return InfrastructureFailure
+	case st.State == runner.COMPLETE && st.ExitCode == 0:
+		return NoFailure
+	case st.State == runner.COMPLETE, st.State == runner.TIMEDOUT:
+		return UserFailure
+	}
+	// FAILED, UNKNOWN, or ABORTED by the worker rather than the scheduler
+	return InfrastructureFailure
+}
+
+// RetryPolicy controls which of a task's failed runs are retried, how many times and how soon.
+// Infrastructure failures are retried while attempts remain, other failures only if listed.
+type RetryPolicy struct {
+	// Maximum number of runs of the task, including the first. Zero uses the scheduler's MaxRetriesPerTask.
+	MaxAttempts int
+
+	// Exit codes of completed runs to retry.
+	RetryableExitCodes []errors.ExitCode
+
+	// End states of runs to retry, e.g. TIMEDOUT.
+	RetryableStates []runner.RunState
+
+	// How long to wait before the first retry, doubled for each retry after that, up to MaxBackoff if set.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is used for tasks without a retry policy, runs that time out are retried
+// along with infrastructure failures.
+var DefaultRetryPolicy = RetryPolicy{RetryableStates: []runner.RunState{runner.TIMEDOUT}}
+
+// Retryable returns true if a run with the given status and kind of failure may be retried.
+func (p RetryPolicy) Retryable(st runner.RunStatus, kind FailureKind) bool {
+	switch kind {
+	case NoFailure:
+		return false
+	case InfrastructureFailure:
+		return true
+	}
+	for _, state := range p.RetryableStates {
+		if st.State == state {
+			return true
+		}
+	}
+	if st.State == runner.COMPLETE {
+		for _, code := range p.RetryableExitCodes {
+			if st.ExitCode == code {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// BackoffBefore returns how long to wait before starting the given attempt, 2 being the first retry.
+func (p RetryPolicy) BackoffBefore(attempt int) time.Duration {
+	backoff := p.Backoff
+	for i := 2; i < attempt && backoff > 0; i++ {
+		if p.MaxBackoff > 0 && backoff >= p.MaxBackoff {
+			break
+		}
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return backoff
+}
+
+// Validate returns an error if the policy's settings are out of range.
+func (p RetryPolicy) Validate() error {
+	if p.MaxAttempts < 0 {
+		return fmt.Errorf("invalid retry policy, MaxAttempts must be >= 0; was %d", p.MaxAttempts)
+	}
+	if p.Backoff < 0 || p.MaxBackoff < 0 {
+		return fmt.Errorf("invalid retry policy, backoffs must be >= 0; were %s and %s", p.Backoff, p.MaxBackoff)
+	}
+	for _, state := range p.RetryableStates {
+		switch state {
+		case runner.UNKNOWN, runner.FAILED, runner.ABORTED, runner.TIMEDOUT:
+		default:
+			return fmt.Errorf("invalid retry policy, state %d is not one a failed run ends in", int(state))
+		}
+	}
+	return nil
+}
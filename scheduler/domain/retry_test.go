@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	scooterrors "github.com/twitter/scoot/common/errors"
+	"github.com/twitter/scoot/runner"
+)
+
+func Test_ClassifyRun(t *testing.T) {
+	tests := []struct {
+		st        runner.RunStatus
+		runnerErr error
+		expected  FailureKind
+	}{
+		{runner.RunStatus{State: runner.COMPLETE}, nil, NoFailure},
+		{runner.RunStatus{State: runner.COMPLETE, ExitCode: 1}, nil, UserFailure},
+		{runner.RunStatus{State: runner.TIMEDOUT}, nil, UserFailure},
+		{runner.RunStatus{State: runner.FAILED, ExitCode: scooterrors.GenericCheckoutFailureExitCode}, nil, InfrastructureFailure},
+		{runner.RunStatus{State: runner.FAILED, ExitCode: scooterrors.PostExecFailureExitCode}, nil, InfrastructureFailure},
+		{runner.RunStatus{State: runner.FAILED, ExitCode: scooterrors.OutputIngestFailureExitCode}, nil, InfrastructureFailure},
+		{runner.RunStatus{State: runner.COMPLETE, ExitCode: scooterrors.PostExecFailureExitCode}, nil, UserFailure},
+		{runner.RunStatus{State: runner.UNKNOWN}, nil, InfrastructureFailure},
+		{runner.RunStatus{}, errors.New("connection refused"), InfrastructureFailure},
+	}
+	for _, test := range tests {
+		if kind := ClassifyRun(test.st, test.runnerErr); kind != test.expected {
+			t.Errorf("Expected %s, %v to be a %s failure, got %s", test.st, test.runnerErr, test.expected, kind)
+		}
+	}
+}
+
+func Test_RetryPolicy_Retryable(t *testing.T) {
+	policy := RetryPolicy{RetryableExitCodes: []scooterrors.ExitCode{75}}
+	tests := []struct {
+		st       runner.RunStatus
+		expected bool
+	}{
+		{runner.RunStatus{State: runner.COMPLETE}, false},
+		{runner.RunStatus{State: runner.COMPLETE, ExitCode: 1}, false},
+		{runner.RunStatus{State: runner.COMPLETE, ExitCode: 75}, true},
+		{runner.RunStatus{State: runner.TIMEDOUT}, false},
+		{runner.RunStatus{State: runner.FAILED, ExitCode: scooterrors.CheckoutFailureExitCode}, true},
+		{runner.RunStatus{State: runner.COMPLETE, ExitCode: 255}, false},
+	}
+	for _, test := range tests {
+		if retry := policy.Retryable(test.st, ClassifyRun(test.st, nil)); retry != test.expected {
+			t.Errorf("Expected Retryable(%s) to be %t", test.st, test.expected)
+		}
+	}
+
+	timedOut := runner.RunStatus{State: runner.TIMEDOUT}
+	if !DefaultRetryPolicy.Retryable(timedOut, UserFailure) {
+		t.Errorf("Expected the default policy to retry runs that time out")
+	}
+}
+
+func Test_RetryPolicy_BackoffBefore(t *testing.T) {
+	policy := RetryPolicy{Backoff: time.Second, MaxBackoff: 5 * time.Second}
+	expected := map[int]time.Duration{1: time.Second, 2: time.Second, 3: 2 * time.Second, 4: 4 * time.Second, 5: 5 * time.Second, 10: 5 * time.Second}
+	for attempt, backoff := range expected {
+		if b := policy.BackoffBefore(attempt); b != backoff {
+			t.Errorf("Expected a backoff of %s before attempt %d, got %s", backoff, attempt, b)
+		}
+	}
+	if b := DefaultRetryPolicy.BackoffBefore(3); b != 0 {
+		t.Errorf("Expected no backoff by default, got %s", b)
+	}
+}
+
+func Test_RetryPolicy_Validate(t *testing.T) {
+	valid := RetryPolicy{MaxAttempts: 3, RetryableStates: []runner.RunState{runner.TIMEDOUT}, Backoff: time.Second}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Expected %+v to be valid, got %v", valid, err)
+	}
+	invalid := []RetryPolicy{
+		{MaxAttempts: -1},
+		{Backoff: -time.Second},
+		{RetryableStates: []runner.RunState{runner.COMPLETE}},
+		{RetryableStates: []runner.RunState{runner.RunState(42)}},
+	}
+	for _, policy := range invalid {
+		if err := policy.Validate(); err == nil {
+			t.Errorf("Expected %+v to be invalid", policy)
+		}
+	}
+}
+
+func Test_SerializeJob_RetryPolicy(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:        4,
+		RetryableExitCodes: []scooterrors.ExitCode{75},
+		RetryableStates:    []runner.RunState{runner.TIMEDOUT},
+		Backoff:            time.Second,
+		MaxBackoff:         time.Minute,
+	}
+	job := Job{Id: "job1", Def: JobDefinition{Tasks: []TaskDefinition{{}, {RetryPolicy: &policy}}}}
+	job.Def.Tasks[0].TaskID = "task0"
+	job.Def.Tasks[1].TaskID = "task1"
+
+	asBytes, err := job.Serialize()
+	if err != nil {
+		t.Fatalf("unexpected error serializing job %v", err)
+	}
+	result, err := DeserializeJob(asBytes)
+	if err != nil {
+		t.Fatalf("unexpected error deserializing job %v", err)
+	}
+	if p := result.Def.Tasks[0].RetryPolicy; p != nil {
+		t.Errorf("expected no retry policy for task0, got %+v", p)
+	}
+	p := result.Def.Tasks[1].RetryPolicy
+	if p == nil || p.MaxAttempts != 4 || p.Backoff != time.Second || p.MaxBackoff != time.Minute ||
+		len(p.RetryableExitCodes) != 1 || p.RetryableExitCodes[0] != 75 ||
+		len(p.RetryableStates) != 1 || p.RetryableStates[0] != runner.TIMEDOUT {
+		t.Errorf("expected the retry policy to round trip, got %+v", p)
+	}
+}
@@ -7,16 +7,40 @@
 # To Generate files, run from top level (github.com/twitter/scoot) repo directory:
 #     $ make thrift-sched-go
 
+struct Resources {
+  1: optional i64 cpuMillis
+  2: optional i64 memoryBytes
+  3: optional i64 diskBytes
+}
+
 struct Command {
   1: required list<string> argv
   2: optional map<string, string> envVars
   3: optional i64 timeout
   4: required string snapshotId
+  5: optional list<string> outputPaths
+  6: optional Resources resources
+  7: optional i32 envMode
+  8: optional list<string> envAllowlist
+  9: optional map<string, string> secretEnv
+}
+
+struct RetryPolicy {
+  1: optional i32 maxAttempts
+  2: optional list<i32> retryableExitCodes
+  3: optional list<i32> retryableStates
+  4: optional i64 backoff
+  5: optional i64 maxBackoff
 }
 
 struct TaskDefinition {
   1: required Command command
   2: optional string taskId
+  3: optional list<string> dependencies
+  4: optional list<string> requiredLabels
+  5: optional list<string> preferredLabels
+  6: optional Command cleanup
+  7: optional RetryPolicy retryPolicy
 }
 
 struct JobDefinition {
@@ -26,6 +50,7 @@ struct JobDefinition {
   4: optional string tag
   5: optional string basis
   6: optional string requestor
+  7: optional Command cleanup
 }
 
 struct Job {
@@ -9,7 +9,9 @@ import (
 
 	"github.com/twitter/scoot/cloud/cluster"
 	"github.com/twitter/scoot/cloud/cluster/local"
+	"github.com/twitter/scoot/cloud/cluster/membership"
 	"github.com/twitter/scoot/common"
+	"github.com/twitter/scoot/common/leader"
 	"github.com/twitter/scoot/scheduler/server"
 	"github.com/twitter/scoot/worker/client"
 )
@@ -23,6 +25,9 @@ const DefaultRunnerRetryInterval = time.Second
 // How long to wait between runner status queries to determine [init] status.
 const DefaultReadyFnBackoff = 5 * time.Second
 
+// How long to wait between runner status queries to determine whether nodes are draining.
+const DefaultDrainCheckInterval = 10 * time.Second
+
 // SchedulerServerConfig config structure holding original json configs
 type JSONConfigs struct {
 	Cluster   ClusterJSONConfig              `json:"Cluster"`
@@ -36,24 +41,28 @@ func (s JSONConfigs) String() string {
 }
 
 type ClusterJSONConfig struct {
-	Type  string `json:"Type"`  // cluster type: local, memory
+	Type  string `json:"Type"`  // cluster type: local, memory, membership
 	Count int    `json:"Count"` // default to 10 for Type == memory
+
+	// How long a worker can go without a heartbeat before it's removed, for Type == membership.
+	HeartbeatExpiry string `json:"HeartbeatExpiry"` // default to 15s
 }
 
 func (c ClusterJSONConfig) String() string {
-	return fmt.Sprintf("ClusterJSONConfig: Type: %s, Count: %d", c.Type, c.Count)
+	return fmt.Sprintf("ClusterJSONConfig: Type: %s, Count: %d, HeartbeatExpiry: %s", c.Type, c.Count, c.HeartbeatExpiry)
 }
 
 type SagaLogJSONConfig struct {
-	Type          string `json:"Type"`          // file
-	Directory     string `json:"Directory"`     // default to .scootdata/filesagalog
-	ExpirationSec int    `json:"ExpirationSec"` // default to 0
-	GCIntervalSec int    `json:"GCIntervalSec"` // default to 1
+	Type               string   `json:"Type"`               // file, kv (a single indexed record file, see sagalogs.kvSagaLog) or memory
+	Directory          string   `json:"Directory"`          // default to .scootdata/filesagalog
+	ReplicaDirectories []string `json:"ReplicaDirectories"` // kv only, default to none
+	ExpirationSec      int      `json:"ExpirationSec"`      // default to 0
+	GCIntervalSec      int      `json:"GCIntervalSec"`      // default to 1
 }
 
 func (s SagaLogJSONConfig) String() string {
-	return fmt.Sprintf("SagaLogJSONConfig: Type:%s, Directory: %s, ExpirationSec: %d, GCIntervalSec: %d",
-		s.Type, s.Directory, s.ExpirationSec, s.GCIntervalSec)
+	return fmt.Sprintf("SagaLogJSONConfig: Type:%s, Directory: %s, ReplicaDirectories: %v, ExpirationSec: %d, GCIntervalSec: %d",
+		s.Type, s.Directory, s.ReplicaDirectories, s.ExpirationSec, s.GCIntervalSec)
 }
 
 type SchedulerJSONConfig struct {
@@ -64,12 +73,29 @@ type SchedulerJSONConfig struct {
 	DebugMode            bool   `json:"DebugMode"`            // default to false
 	RecoverJobsOnStartup bool   `json:"RecoverJobsOnStartup"` // default to true
 	DefaultTaskTimeout   string `json:"DefaultTaskTimeout"`   // default to 30m
+	LeaderLockFile       string `json:"LeaderLockFile"`       // default to "", no leader election
+	LeaderLeaseTTL       string `json:"LeaderLeaseTTL"`       // default to 10s
+
+	// Speculative execution of straggling tasks, see server.SchedulerConfiguration.
+	SpeculativeMultiple    float64 `json:"SpeculativeMultiple"`    // default to 0, disabled
+	SpeculativeMinDuration string  `json:"SpeculativeMinDuration"` // default to 0s
+
+	// Scheduling algorithm and its config, see server.SchedulingAlgorithmNames for the supported ones.
+	SchedAlg       string          `json:"SchedAlg"`       // default to load_based
+	SchedAlgConfig json.RawMessage `json:"SchedAlgConfig"` // default to the algorithm's defaults
+
+	// File the settings changed through the API are persisted to, with their prior versions.
+	SettingsFile        string `json:"SettingsFile"`        // default to "", the settings aren't persisted
+	MaxSettingsVersions int    `json:"MaxSettingsVersions"` // default to server.DefaultMaxSettingsVersions
 }
 
 func (sc SchedulerJSONConfig) String() string {
 	return fmt.Sprintf("SchedulerJSONConfig: Type: %s, MaxRetriesPerTask: %d, MaxRequestors: %d, MaxJobsPerRequestor: %d, DebugMode: %t, "+
-		"RecoverJobsOnStartup: %t, DefaultTaskTimeout: %s",
-		sc.Type, sc.MaxRetriesPerTask, sc.MaxRequestors, sc.MaxJobsPerRequestor, sc.DebugMode, sc.RecoverJobsOnStartup, sc.DefaultTaskTimeout)
+		"RecoverJobsOnStartup: %t, DefaultTaskTimeout: %s, LeaderLockFile: %s, LeaderLeaseTTL: %s, SpeculativeMultiple: %g, "+
+		"SpeculativeMinDuration: %s, SchedAlg: %s, SchedAlgConfig: %s, SettingsFile: %s, MaxSettingsVersions: %d",
+		sc.Type, sc.MaxRetriesPerTask, sc.MaxRequestors, sc.MaxJobsPerRequestor, sc.DebugMode, sc.RecoverJobsOnStartup, sc.DefaultTaskTimeout,
+		sc.LeaderLockFile, sc.LeaderLeaseTTL, sc.SpeculativeMultiple, sc.SpeculativeMinDuration, sc.SchedAlg, sc.SchedAlgConfig,
+		sc.SettingsFile, sc.MaxSettingsVersions)
 }
 
 func GetConfigText(configSelector string) ([]byte, error) {
@@ -101,11 +127,50 @@ func (jc *SchedulerJSONConfig) CreateSchedulerConfig() (*server.SchedulerConfigu
 	serverConfig.RunnerRetryTimeout = DefaultRunnerRetryTimeout
 	serverConfig.RunnerRetryInterval = DefaultRunnerRetryInterval
 	serverConfig.ReadyFnBackoff = DefaultReadyFnBackoff
+	serverConfig.DrainCheckInterval = DefaultDrainCheckInterval
 	serverConfig.MaxRequestors = jc.MaxRequestors
 	serverConfig.MaxJobsPerRequestor = jc.MaxJobsPerRequestor
+	serverConfig.SpeculativeMultiple = jc.SpeculativeMultiple
+	if jc.SpeculativeMinDuration != "" {
+		serverConfig.SpeculativeMinDuration, err = time.ParseDuration(jc.SpeculativeMinDuration)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := server.ValidateSchedulingAlgorithm(jc.SchedAlg, jc.SchedAlgConfig); err != nil {
+		return nil, err
+	}
+	serverConfig.SchedAlgName = jc.SchedAlg
+	serverConfig.SchedAlgConfig = jc.SchedAlgConfig
 	return serverConfig, nil
 }
 
+// CreateElector creates the Elector for a scheduler reachable at addr, or returns nil
+// if leader election is not configured.  Schedulers electing a leader must share the
+// LeaderLockFile and a file or kv saga log directory.
+func (jc *SchedulerJSONConfig) CreateElector(addr string) (*leader.Elector, error) {
+	if jc.LeaderLockFile == "" {
+		return nil, nil
+	}
+	ttl := leader.DefaultLeaseTTL
+	if jc.LeaderLeaseTTL != "" {
+		var err error
+		if ttl, err = time.ParseDuration(jc.LeaderLeaseTTL); err != nil {
+			return nil, err
+		}
+	}
+	return leader.NewElector(leader.NewFileLock(jc.LeaderLockFile), addr, ttl), nil
+}
+
+// CreatePersistor creates the Persistor of the scheduler settings, or returns nil if no
+// SettingsFile is configured.  Schedulers electing a leader must share the SettingsFile.
+func (jc *SchedulerJSONConfig) CreatePersistor() (server.Persistor, error) {
+	if jc.SettingsFile == "" {
+		return nil, nil
+	}
+	return server.NewFilePersistor(jc.SettingsFile, jc.MaxSettingsVersions)
+}
+
 // GetSchedulerConfig get the scheduler config
 func GetSchedulerConfigs(configName string) (*JSONConfigs, error) {
 	// get the default values, these will override any of the config
@@ -175,6 +240,20 @@ func (c *ClusterLocalConfig) Create() (chan []cluster.NodeUpdate, error) {
 	return nuc, nil
 }
 
+// Parameters for configuring a Scoot cluster of workers that register with the scheduler by sending
+// heartbeats to the membership registry's http handlers.  With leader election every scheduler serves
+// its own registry, so workers must send their heartbeats to all of them.
+// Expiry - how long a worker can go without a heartbeat before it's removed, the registry's default if zero.
+type ClusterMembershipConfig struct {
+	Expiry time.Duration
+}
+
+func (c *ClusterMembershipConfig) Create() (chan []cluster.NodeUpdate, *membership.Registry, error) {
+	r := membership.NewRegistry(c.Expiry)
+	nuc, _ := cluster.NewCluster(nil, r, true, time.Second, common.DefaultClusterChanSize)
+	return nuc, r, nil
+}
+
 // MemoryFetcher is a fetcher that always returns a fixed set of nodes
 type MemoryFetcher struct {
 	nodes []cluster.Node
@@ -1,10 +1,17 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/twitter/scoot/scheduler/server"
 )
 
 var tests = []string{"local.memory", "local.local"}
@@ -35,3 +42,52 @@ func TestCreatingConfigStruct(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, config.RecoverJobsOnStartup)
 }
+
+func TestCreatingSpeculativeConfig(t *testing.T) {
+	jc := SchedulerJSONConfig{SpeculativeMultiple: 2.5, SpeculativeMinDuration: "1m"}
+	config, err := jc.CreateSchedulerConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, 2.5, config.SpeculativeMultiple)
+	assert.Equal(t, time.Minute, config.SpeculativeMinDuration)
+
+	jc.SpeculativeMinDuration = "soon"
+	_, err = jc.CreateSchedulerConfig()
+	assert.NotNil(t, err)
+}
+
+func TestCreatingSchedAlgConfig(t *testing.T) {
+	config, err := (&SchedulerJSONConfig{}).CreateSchedulerConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, "", config.SchedAlgName)
+
+	jc := SchedulerJSONConfig{
+		SchedAlg:       "fair_share",
+		SchedAlgConfig: json.RawMessage(`{"Weights": {"land.*": 3}, "MinShares": {"diff.*": 10}}`),
+	}
+	config, err = jc.CreateSchedulerConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, "fair_share", config.SchedAlgName)
+	assert.Equal(t, jc.SchedAlgConfig, config.SchedAlgConfig)
+
+	jc.SchedAlgConfig = json.RawMessage(`{"Weight": {"land.*": 3}}`)
+	_, err = jc.CreateSchedulerConfig()
+	assert.NotNil(t, err, "expected unknown fields to be rejected")
+
+	jc = SchedulerJSONConfig{SchedAlg: "fifo"}
+	_, err = jc.CreateSchedulerConfig()
+	assert.NotNil(t, err, "expected unknown algorithms to be rejected")
+}
+
+func TestCreatingPersistor(t *testing.T) {
+	persistor, err := (&SchedulerJSONConfig{}).CreatePersistor()
+	assert.Nil(t, err)
+	assert.Nil(t, persistor, "expected no persistor without a settings file")
+
+	tmpDir, err := ioutil.TempDir("", "settings")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpDir)
+	jc := SchedulerJSONConfig{SettingsFile: filepath.Join(tmpDir, "scheduler", "settings.json"), MaxSettingsVersions: 5}
+	persistor, err = jc.CreatePersistor()
+	assert.Nil(t, err)
+	assert.IsType(t, &server.FilePersistor{}, persistor)
+}
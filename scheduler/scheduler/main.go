@@ -25,6 +25,7 @@ func main() {
 	// Set Flags Needed by this Server
 	thriftAddr := flag.String("thrift_addr", scheduler.DefaultSched_Thrift, "Bind address for api server")
 	httpAddr := flag.String("http_addr", scheduler.DefaultSched_HTTP, "Bind address for http server")
+	advertiseAddr := flag.String("advertise_addr", "", "Address clients use to reach the api server when this scheduler is the leader, defaults to thrift_addr")
 	configFlag := flag.String("config", "local.memory", "Scheduler Config (either a filename like local.memory or JSON text")
 	logLevelFlag := flag.String("log_level", "info", "Log everything at this level and above (error|info|debug)")
 	flag.Parse()
@@ -44,21 +45,43 @@ func main() {
 		log.Fatalf("error creating schedule server config.  Scheduler not started. %s", err)
 	}
 
+	if *advertiseAddr == "" {
+		*advertiseAddr = *thriftAddr
+	}
+	elector, err := schedulerJSONConfigs.Scheduler.CreateElector(*advertiseAddr)
+	if err != nil {
+		log.Fatalf("error creating leader elector.  Scheduler not started. %s", err)
+	}
+	if elector != nil {
+		log.Infof("Electing the scheduler leader as %s with %s", *advertiseAddr, schedulerJSONConfigs.Scheduler.LeaderLockFile)
+		schedulerConfig.Elector = elector
+		elector.Start()
+	}
+
+	persistor, err := schedulerJSONConfigs.Scheduler.CreatePersistor()
+	if err != nil {
+		log.Fatalf("error creating settings persistor.  Scheduler not started. %s", err)
+	}
+
 	thriftServerSocket, err := thrift.NewTServerSocket(*thriftAddr)
 	if err != nil {
 		log.Fatalf("error creating thrift server socket.  Scheduler not started. %s", err)
 	}
 
-	statsReceiver := endpoints.MakeStatsReceiver("scheduler").Precision(time.Millisecond)
-	httpServer := endpoints.NewTwitterServer(endpoints.Addr(*httpAddr), statsReceiver, nil)
-
-	nodesUpdatesCh, err := starter.StartCluster(schedulerJSONConfigs.Cluster)
+	// workers of a membership cluster register through the cluster's handlers on the http server
+	nodesUpdatesCh, clusterHandlers, err := starter.StartCluster(schedulerJSONConfigs.Cluster)
 	if err != nil {
 		log.Fatalf("%s. Scheduler not started", err)
 	}
 
+	statsReceiver := endpoints.MakeStatsReceiver("scheduler").Precision(time.Millisecond)
+	httpServer := endpoints.NewTwitterServer(endpoints.Addr(*httpAddr), statsReceiver, clusterHandlers)
+
 	log.Infof("Starting Cloud Scoot API Server & Scheduler on %s with %s", *thriftAddr, *configFlag)
-	starter.StartServer(*schedulerConfig, schedulerJSONConfigs.SagaLog, schedulerJSONConfigs.Workers,
+	err = starter.StartServer(*schedulerConfig, schedulerJSONConfigs.SagaLog, schedulerJSONConfigs.Workers,
 		thriftServerSocket, &statsReceiver, common.DefaultClientTimeout, httpServer,
-		nil, nopDurationKeyExtractor, nodesUpdatesCh)
+		persistor, nopDurationKeyExtractor, nodesUpdatesCh)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
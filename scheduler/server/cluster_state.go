@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
@@ -10,6 +11,8 @@ import (
 	cc "github.com/twitter/scoot/cloud/cluster"
 	"github.com/twitter/scoot/common"
 	"github.com/twitter/scoot/common/stats"
+	"github.com/twitter/scoot/runner"
+	"github.com/twitter/scoot/scheduler/domain"
 )
 
 const noJob = ""
@@ -19,24 +22,44 @@ const defaultMaxFlakyDuration = 15 * time.Minute
 
 var nilTime = time.Time{}
 
-// Cluster will use this function to determine if newly added nodes are ready to be used.
-type ReadyFn func(cc.Node) (ready bool, backoffDuration time.Duration)
+// Cluster will use this function to determine if newly added nodes are ready to be used,
+// and what a ready node reports about itself.
+type ReadyFn func(cc.Node) (ready bool, info NodeInfo, backoffDuration time.Duration)
 
-// clusterState maintains a cluster of nodes and information about what task is running on each node.
+// NodeInfo is what a ready node reports about itself.
+type NodeInfo struct {
+	// Resources shared by tasks running concurrently on the node, zero if it runs one task at a time.
+	Capacity runner.Resources
+	// Labels added to the ones the node was fetched with, replacing them if the keys are the same.
+	Labels map[string]string
+}
+
+// clusterState maintains a cluster of nodes and information about what tasks are running on each node.
 // nodeGroups is for node affinity where we want to remember which node last ran with what snapshot.
+// A node is idle in its group while it can accept another task, nodes that advertise capacity
+// can run several tasks at once as long as the resources the tasks declare fit.
 // NOTE: a node can be both running in scheduler and suspended here (distributed system eventual consistency...)
 type clusterState struct {
 	nodesUpdatesCh   chan []cc.NodeUpdate
 	nodes            map[cc.NodeId]*nodeState // All healthy nodes.
-	suspendedNodes   map[cc.NodeId]*nodeState // All new, lost, or flaky nodes, disjoint from 'nodes'.
+	suspendedNodes   map[cc.NodeId]*nodeState // All new, lost, flaky or draining nodes, disjoint from 'nodes'.
 	offlinedNodes    map[cc.NodeId]*nodeState // All User initiated offline nodes. Disjoint from 'nodes' & 'suspendedNodes'
 	nodeGroups       map[string]*nodeGroup    // key is a snapshotId.
 	maxLostDuration  time.Duration            // after which we remove a node from the cluster entirely
 	maxFlakyDuration time.Duration            // after which we mark it not flaky and put it back in rotation.
 	readyFn          ReadyFn                  // If provided, new nodes will be suspended until this returns true.
-	numRunning       int                      // Number of running nodes. running + free + suspended ~= allNodes (may lag)
+	numRunning       int                      // Number of running tasks, which may be packed several to a node.
 	stats            stats.StatsReceiver      // for collecting stats about node availability
 	nopUpdateCnt     int
+
+	// Why nodes are offlined, kept while they're out of the cluster, see offline.go.
+	offlineRecords map[cc.NodeId]*OfflinedWorker
+
+	// Checks whether nodes are draining every drainCheckInterval if drainingFn is set, see drain.go.
+	drainingFn         DrainingFn
+	drainCheckInterval time.Duration
+	lastDrainCheck     time.Time
+	drainCheckCh       chan drainCheck
 }
 
 func (c *clusterState) isOfflined(ns *nodeState) bool {
@@ -58,24 +81,118 @@ func newNodeGroup() *nodeGroup {
 // The State of A Node in the Cluster
 type nodeState struct {
 	node        cc.Node
-	runningJob  string
-	runningTask string
+	runningJob  string                      // Job of the last task scheduled on the node, noJob once it runs no tasks.
+	runningTask string                      // Last task scheduled on the node, noTask once it runs no tasks.
+	running     map[string]runner.Resources // Resources taken by each running task, keyed by taskKey().
+	capacity    runner.Resources            // Resources shared by running tasks, zero if the node runs one task at a time.
+	allocated   runner.Resources            // Sum of the resources taken by running tasks.
+	labels      map[string]string           // Labels matched against tasks' label selectors.
 	snapshotId  string
 	timeLost    time.Time        // Time when node was marked lost, if set (lost and flaky are mutually exclusive).
 	timeFlaky   time.Time        // Time when node was marked flaky, if set (lost and flaky are mutually exclusive).
 	readyCh     chan interface{} // We create goroutines for each new node which will close this channel once the node is ready.
+	readyInfo   NodeInfo         // Reported by the ReadyFn, set before readyCh is closed.
 	removedCh   chan interface{} // We send nil when a node has been removed and we want the above goroutine to exit.
+
+	// Who offlined the node, when and why, set while it's in offlinedNodes.
+	offline *OfflinedWorker
+
+	// Time when the node reported it's draining, if set it takes no new tasks until it leaves the cluster.
+	timeDraining time.Time
+	// Set while a drain check of the node is outstanding.
+	checkingDrain bool
 }
 
 func (n *nodeState) String() string {
-	return fmt.Sprintf("{node:%s, jobId:%s, taskId:%s, snapshotId:%s, timeLost:%v, timeFlaky:%v, ready:%t}",
-		spew.Sdump(n.node), n.runningJob, n.runningTask, n.snapshotId, n.timeLost, n.timeFlaky, (n.readyCh == nil))
+	return fmt.Sprintf("{node:%s, jobId:%s, taskId:%s, numRunning:%d, capacity:%s, allocated:%s, labels:%v, snapshotId:%s, timeLost:%v, timeFlaky:%v, timeDraining:%v, ready:%t}",
+		spew.Sdump(n.node), n.runningJob, n.runningTask, len(n.running), n.capacity, n.allocated, n.labels, n.snapshotId, n.timeLost, n.timeFlaky, n.timeDraining, (n.readyCh == nil))
+}
+
+func taskKey(jobId, taskId string) string {
+	return jobId + "/" + taskId
+}
+
+// Returns the resources a task takes from this node, the whole node unless the task declares its own.
+func (ns *nodeState) resourcesFor(resources runner.Resources) runner.Resources {
+	if resources.IsZero() {
+		return ns.capacity
+	}
+	return resources
+}
+
+// Returns true if a task needing the given resources can be scheduled on this node.  An idle node
+// accepts any task, even one needing more than its capacity, so that the task isn't starved.
+// Otherwise the node must advertise capacity and the task must fit in what's left of it.
+func (ns *nodeState) canRun(resources runner.Resources) bool {
+	if len(ns.running) == 0 {
+		return true
+	}
+	if ns.capacity.IsZero() || resources.IsZero() {
+		return false
+	}
+	return resources.Fits(ns.capacity.Sub(ns.allocated))
+}
+
+// Returns true if the node's labels match all of the given selectors.
+func (ns *nodeState) matches(required []domain.LabelSelector) bool {
+	return domain.MatchesAllLabels(required, ns.labels)
+}
+
+// Applies what the node reported once it's ready, labels it reports are added to the ones it was fetched with.
+func (ns *nodeState) setInfo(info NodeInfo) {
+	ns.capacity = info.Capacity
+	ns.setLabels(info.Labels)
+}
+
+// Sets the node's labels to the ones it was fetched with, plus the reported ones.
+func (ns *nodeState) setLabels(reported map[string]string) {
+	ns.labels = ns.node.Labels()
+	if len(reported) == 0 {
+		return
+	}
+	labels := make(map[string]string, len(ns.labels)+len(reported))
+	for k, v := range ns.node.Labels() {
+		labels[k] = v
+	}
+	for k, v := range reported {
+		labels[k] = v
+	}
+	ns.labels = labels
+}
+
+// Replaces the node with the one it was added with again, as its labels may have changed.
+func (ns *nodeState) setNode(node cc.Node) {
+	ns.node = node
+	if ns.readyCh == nil {
+		// not waiting for readiness, the labels it reported once ready are set again
+		ns.setLabels(ns.readyInfo.Labels)
+	}
+}
+
+// Returns true if this node can't accept any more tasks: it has no capacity to share, or a
+// resource its capacity declares is used up, so no task declaring resources fits in what's left.
+func (ns *nodeState) full() bool {
+	if len(ns.running) == 0 {
+		return false
+	}
+	if ns.capacity.IsZero() {
+		return true
+	}
+	left := ns.capacity.Sub(ns.allocated)
+	usedUp := func(capacity, left int64) bool {
+		return capacity > 0 && left <= 0
+	}
+	return usedUp(ns.capacity.CPUMillis, left.CPUMillis) ||
+		usedUp(ns.capacity.MemoryBytes, left.MemoryBytes) ||
+		usedUp(ns.capacity.DiskBytes, left.DiskBytes) ||
+		left.Validate() != nil
 }
 
 // This node was either reported lost by a NodeUpdate and we keep it around for a bit in case it revives,
-// or it experienced connection related errors so we sideline it for a little while.
+// or it experienced connection related errors so we sideline it for a little while,
+// or it's draining and won't take new tasks.
 func (ns *nodeState) suspended() bool {
-	return ns.readyCh != nil || ns.timeLost != nilTime || ns.timeFlaky != nilTime
+	return ns.readyCh != nil || ns.timeLost != nilTime || ns.timeFlaky != nilTime || ns.timeDraining != nilTime
 }
 
 // This node is ready if the readyCh has been closed, either upon creation or in the startReadyLoop() goroutine.
@@ -95,10 +212,12 @@ func (ns *nodeState) ready() bool {
 // Starts a goroutine loop checking node readiness, waiting 'backoff' time between checks, and exiting if node is fully removed.
 func (ns *nodeState) startReadyLoop(rfn ReadyFn) {
 	ns.readyCh = make(chan interface{})
+	node := ns.node
 	go func() {
 		done := false
 		for !done {
-			if ready, backoff := rfn(ns.node); ready {
+			if ready, info, backoff := rfn(node); ready {
+				ns.readyInfo = info
 				close(ns.readyCh)
 				done = true
 			} else if backoff == 0 {
@@ -121,6 +240,8 @@ func newNodeState(node cc.Node) *nodeState {
 		node:        node,
 		runningJob:  noJob,
 		runningTask: noTask,
+		running:     map[string]runner.Resources{},
+		labels:      node.Labels(),
 		snapshotId:  "",
 		timeLost:    nilTime,
 		timeFlaky:   nilTime,
@@ -140,6 +261,7 @@ func newClusterState(nodesUpdatesCh chan []cc.NodeUpdate, rfn ReadyFn, stats sta
 		nodes:            make(map[cc.NodeId]*nodeState),
 		suspendedNodes:   map[cc.NodeId]*nodeState{},
 		offlinedNodes:    make(map[cc.NodeId]*nodeState),
+		offlineRecords:   map[cc.NodeId]*OfflinedWorker{},
 		nodeGroups:       map[string]*nodeGroup{"": newNodeGroup()},
 		maxLostDuration:  defaultMaxLostDuration,
 		maxFlakyDuration: defaultMaxFlakyDuration,
@@ -150,39 +272,58 @@ func newClusterState(nodesUpdatesCh chan []cc.NodeUpdate, rfn ReadyFn, stats sta
 	return cs
 }
 
-// return the number of free nodes that are not in a suspended state and not running tasks.
+// return the number of free nodes that are not in a suspended state and can accept another task.
+// Nodes with capacity may accept several more tasks but are counted once.
 // Note: we assume numFree() is called from methods that have already created a (sync) lock
 func (c *clusterState) numFree() int {
+	free := len(c.nodes) - c.numRunning
+	for _, ns := range c.nodes {
+		if n := len(ns.running); n > 0 {
+			// tasks packed on a node take one node between them, and the node is free if it can take more.
+			free += n - 1
+			if !ns.full() {
+				free++
+			}
+		}
+	}
 	// This can go negative due to lost nodes, set lower bound at zero.
-	return max(0, len(c.nodes)-c.numRunning)
+	return max(0, free)
 }
 
 // update ClusterState to reflect that a task has been scheduled on a particular node
-// SnapshotId should be the value from the task definition associated with the given taskId.
-func (c *clusterState) taskScheduled(nodeId cc.NodeId, jobId, taskId, snapshotId string) {
+// SnapshotId and resources should be the values from the task definition associated with the given taskId.
+func (c *clusterState) taskScheduled(nodeId cc.NodeId, jobId, taskId, snapshotId string, resources runner.Resources) {
 	ns := c.nodes[nodeId]
 
 	delete(c.nodeGroups[ns.snapshotId].idle, nodeId)
+	delete(c.nodeGroups[ns.snapshotId].busy, nodeId)
 	empty := len(c.nodeGroups[ns.snapshotId].idle) == 0 && len(c.nodeGroups[ns.snapshotId].busy) == 0
 	if ns.snapshotId != "" && empty {
 		delete(c.nodeGroups, ns.snapshotId)
 	}
 
-	if _, ok := c.nodeGroups[snapshotId]; !ok {
-		c.nodeGroups[snapshotId] = newNodeGroup()
-	}
-	c.nodeGroups[snapshotId].busy[nodeId] = ns
-
 	ns.runningJob = jobId
 	ns.runningTask = taskId
 	ns.snapshotId = snapshotId
+	resources = ns.resourcesFor(resources)
+	ns.running[taskKey(jobId, taskId)] = resources
+	ns.allocated = ns.allocated.Add(resources)
 	c.numRunning++
+
+	if _, ok := c.nodeGroups[snapshotId]; !ok {
+		c.nodeGroups[snapshotId] = newNodeGroup()
+	}
+	if ns.full() {
+		c.nodeGroups[snapshotId].busy[nodeId] = ns
+	} else {
+		c.nodeGroups[snapshotId].idle[nodeId] = ns
+	}
 }
 
 // update ClusterState to reflect that a task has finished running on
 // a particular node, whether successfully or unsuccessfully.
 // If the node isn't found then the node was already suspended and deleted, just decrement numRunning.
-func (c *clusterState) taskCompleted(nodeId cc.NodeId, flaky bool) {
+func (c *clusterState) taskCompleted(nodeId cc.NodeId, jobId, taskId string, flaky bool) {
 	var ns *nodeState
 	var ok bool
 	if ns, ok = c.nodes[nodeId]; !ok {
@@ -195,16 +336,46 @@ func (c *clusterState) taskCompleted(nodeId cc.NodeId, flaky bool) {
 			c.suspendedNodes[nodeId] = ns
 			ns.timeFlaky = time.Now()
 		}
-		ns.runningJob = noJob
-		ns.runningTask = noTask
-		delete(c.nodeGroups[ns.snapshotId].busy, nodeId)
-		c.nodeGroups[ns.snapshotId].idle[nodeId] = ns
+		key := taskKey(jobId, taskId)
+		ns.allocated = ns.allocated.Sub(ns.running[key])
+		delete(ns.running, key)
+		if len(ns.running) == 0 {
+			ns.runningJob = noJob
+			ns.runningTask = noTask
+		}
+		if !ns.full() {
+			delete(c.nodeGroups[ns.snapshotId].busy, nodeId)
+			c.nodeGroups[ns.snapshotId].idle[nodeId] = ns
+		}
 	} else {
 		log.Infof("TaskCompleted specified an unknown node: %v (flaky=%t) (likely reaped already)", nodeId, flaky)
 	}
 	c.numRunning--
 }
 
+// Returns true if a healthy or suspended node matches all of the given selectors.
+// Suspended nodes are included since they're expected to come back, offlined nodes aren't.
+func (c *clusterState) hasMatchingNode(required []domain.LabelSelector) bool {
+	for _, nodes := range []map[cc.NodeId]*nodeState{c.nodes, c.suspendedNodes} {
+		for _, ns := range nodes {
+			if ns.matches(required) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Returns true if some node can be assigned tasks, one that isn't suspended or offlined.
+func (c *clusterState) hasAvailableNode() bool {
+	for _, ns := range c.nodes {
+		if !ns.suspended() && !c.isOfflined(ns) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *clusterState) getNodeState(nodeId cc.NodeId) (*nodeState, bool) {
 	ns, ok := c.nodes[nodeId]
 	return ns, ok
@@ -241,14 +412,20 @@ func (c *clusterState) update(updates []cc.NodeUpdate) {
 			adds += 1
 			if update.UserInitiated {
 				log.Infof("NodeAdded: Reinstating offlined node %s", update.Id)
-				if ns, ok := c.offlinedNodes[update.Id]; ok {
-					c.nodes[update.Id] = ns
-					delete(c.offlinedNodes, update.Id)
+				if _, ok := c.offlinedNodes[update.Id]; ok {
+					c.reinstateNode(update.Id)
 				} else {
 					log.Errorf("NodeAdded: Unable to reinstate node %s, not present in offlinedNodes", update.Id)
 				}
 			} else if ns, ok := c.suspendedNodes[update.Id]; ok {
-				if !ns.ready() {
+				if update.Node != nil {
+					// a node whose labels changed is removed and added again
+					ns.setNode(update.Node)
+				}
+				if ns.timeDraining != nilTime {
+					// Leave it suspended until it leaves the cluster or a drain check finds it's no longer draining.
+					log.Infof("NodeAdded: Ignoring NodeAdded event for draining node. %v (%s)", update.Id, ns)
+				} else if !ns.ready() {
 					// Adding a node that's already suspended as non-ready, leave it in that state until ready.
 					log.Infof("NodeAdded: Suspended node re-added but still awaiting readiness check %v (%s)", update.Id, ns)
 				} else if ns.timeLost != nilTime {
@@ -265,6 +442,16 @@ func (c *clusterState) update(updates []cc.NodeUpdate) {
 				if _, ok := c.offlinedNodes[update.Id]; ok {
 					// the nodes was manually offlined, leave it offlined
 					log.Infof("NodeAdded: Ignoring NodeAdded event for offlined node: %v (%#v), %s", update.Id, update.Node, c.status())
+				} else if offline, ok := c.offlineRecords[update.Id]; ok {
+					// the node was offlined before it left the cluster, or before the scheduler restarted
+					newNode = newNodeState(update.Node)
+					newNode.offline = offline
+					c.offlinedNodes[update.Id] = newNode
+					if c.readyFn != nil {
+						// it's suspended until ready once it's reinstated
+						newNode.startReadyLoop(c.readyFn)
+					}
+					log.Infof("NodeAdded: Added new node as offlined: %v (%#v), %s", update.Id, update.Node, c.status())
 				} else if c.readyFn == nil {
 					// We're not checking readiness, skip suspended state and add this as a healthy node.
 					newNode = newNodeState(update.Node)
@@ -288,15 +475,15 @@ func (c *clusterState) update(updates []cc.NodeUpdate) {
 			removals += 1
 			if update.UserInitiated {
 				log.Infof("NodeRemoved: Offlining node %s", update.Id)
-				if ns, ok := c.nodes[update.Id]; ok {
-					c.offlinedNodes[update.Id] = ns
-					delete(c.nodes, update.Id)
-				} else if ns, ok := c.suspendedNodes[update.Id]; ok {
-					c.offlinedNodes[update.Id] = ns
-					delete(c.suspendedNodes, update.Id)
-				} else {
+				offline := &OfflinedWorker{ID: string(update.Id), Requestor: update.Requestor, Since: time.Now()}
+				if _, ok := c.offlinedNodes[update.Id]; ok {
+					log.Infof("NodeRemoved: Node %s already offlined", update.Id)
+				} else if !c.offlineNode(offline) {
 					log.Errorf("NodeRemoved: Unable to offline node %s, not present in nodes or suspendedNodes", update.Id)
 				}
+			} else if ns, ok := c.suspendedNodes[update.Id]; ok && ns.timeDraining != nilTime {
+				// The node was draining, it left the cluster as planned.
+				c.removeDrainedNode(ns)
 			} else if ns, ok := c.suspendedNodes[update.Id]; ok {
 				// Node already suspended, make sure it's now marked as lost and not flaky (keep readiness status intact).
 				log.Infof("NodeRemoved: Already suspended node marked as removed: %v (was %s)", update.Id, ns)
@@ -309,7 +496,8 @@ func (c *clusterState) update(updates []cc.NodeUpdate) {
 				delete(c.nodes, update.Id)
 				log.Infof("NodeRemoved: Removing node by marking as lost: %v (%s), %s", update.Id, ns, c.status())
 			} else if ns, ok := c.offlinedNodes[update.Id]; ok {
-				// the node was manually offlined, remove it from offlined list
+				// the node was manually offlined, remove it from offlined list,
+				// its offline record is kept to offline it again if it comes back
 				delete(c.offlinedNodes, update.Id)
 				log.Infof("NodeRemoved: Removing offlined node: %v (%s), %s", update.Id, ns, c.status())
 			} else {
@@ -329,11 +517,13 @@ func (c *clusterState) update(updates []cc.NodeUpdate) {
 	}
 
 	// Clean up lost nodes that haven't recovered in time, add flaky nodes back into rotation after some time,
-	// and check if newly added non-ready nodes are ready to be put into rotation.
+	// check if newly added non-ready nodes are ready to be put into rotation, and apply the drain checks.
+	c.processDrainChecks()
 	now := time.Now()
 	for _, ns := range c.suspendedNodes {
-		if ns.ready() {
+		if ns.readyCh != nil && ns.ready() {
 			ns.readyCh = nil
+			ns.setInfo(ns.readyInfo)
 		}
 		if !ns.suspended() {
 			// This node is initialized, remove it from suspended nodes and add it to the healthy node pool.
@@ -343,15 +533,8 @@ func (c *clusterState) update(updates []cc.NodeUpdate) {
 			log.Infof("SuspendedNode: Node now ready, adding to rotation: %v (%s), %s", ns.node.Id(), ns, c.status())
 		} else if ns.timeLost != nilTime && time.Since(ns.timeLost) > c.maxLostDuration {
 			// This node has been missing too long, delete all references to it.
-			delete(c.suspendedNodes, ns.node.Id())
-			delete(c.nodeGroups[ns.snapshotId].idle, ns.node.Id())
-			delete(c.nodeGroups[ns.snapshotId].busy, ns.node.Id())
+			c.deleteSuspendedNode(ns)
 			log.Infof("SuspendedNode: Deleting lost node: %v (%s), %s", ns.node.Id(), ns, c.status())
-			// Try to notify this node's goroutine about removal so it can stop checking readiness if necessary.
-			select {
-			case ns.removedCh <- nil:
-			default:
-			}
 		} else if ns.timeFlaky != nilTime && now.Sub(ns.timeFlaky) > c.maxFlakyDuration {
 			// This flaky node has been suspended long enough, try adding it back to the healthy node pool.
 			// We process this using the startReadyLoop/readyFn if present to reapply any side-effects,
@@ -379,10 +562,25 @@ func (c *clusterState) update(updates []cc.NodeUpdate) {
 		c.nopUpdateCnt++
 	}
 
+	c.startDrainChecks()
+
 	c.stats.Gauge(stats.ClusterAvailableNodes).Update(int64(len(c.nodes)))
 	c.stats.Gauge(stats.ClusterFreeNodes).Update(int64(c.numFree()))
 	c.stats.Gauge(stats.ClusterRunningNodes).Update(int64(c.numRunning))
 	c.stats.Gauge(stats.ClusterLostNodes).Update(int64(len(c.suspendedNodes)))
+	c.stats.Gauge(stats.ClusterDrainingNodes).Update(int64(c.numDraining()))
+}
+
+// Deletes all references to a suspended node.
+func (c *clusterState) deleteSuspendedNode(ns *nodeState) {
+	delete(c.suspendedNodes, ns.node.Id())
+	delete(c.nodeGroups[ns.snapshotId].idle, ns.node.Id())
+	delete(c.nodeGroups[ns.snapshotId].busy, ns.node.Id())
+	// Try to notify this node's goroutine about removal so it can stop checking readiness if necessary.
+	select {
+	case ns.removedCh <- nil:
+	default:
+	}
 }
 
 func (c *clusterState) status() string {
@@ -391,24 +589,59 @@ func (c *clusterState) status() string {
 }
 
 // the following functions implement (async) user initiated onlining and offlining a node
-func (c *clusterState) HasOnlineNode(nodeId cc.NodeId) bool {
-	_, ok := c.nodes[nodeId]
-	return ok
-}
-
-func (c *clusterState) IsOfflined(nodeId cc.NodeId) bool {
-	_, ok := c.offlinedNodes[nodeId]
-	return ok
-}
-
 func (c *clusterState) OnlineNode(nodeId cc.NodeId) {
 	log.Infof("Onlining node %s", nodeId)
 	nodeUpdate := cc.NewUserInitiatedAdd(cc.NewIdNode(string(nodeId)))
 	c.nodesUpdatesCh <- []cc.NodeUpdate{nodeUpdate}
 }
 
-func (c *clusterState) OfflineNode(nodeId cc.NodeId) {
-	log.Infof("Offlining node %s", nodeId)
+func (c *clusterState) OfflineNode(nodeId cc.NodeId, requestor string) {
+	log.Infof("Offlining node %s for %s", nodeId, requestor)
 	nodeUpdate := cc.NewUserInitiatedRemove(nodeId)
+	nodeUpdate.Requestor = requestor
 	c.nodesUpdatesCh <- []cc.NodeUpdate{nodeUpdate}
 }
+
+// Returns the state of every node in the cluster, healthy, suspended or offlined, ordered by node id.
+func (c *clusterState) getWorkerSummaries() []domain.WorkerSummary {
+	summaries := []domain.WorkerSummary{}
+	for _, nodes := range []map[cc.NodeId]*nodeState{c.nodes, c.suspendedNodes, c.offlinedNodes} {
+		for _, ns := range nodes {
+			summaries = append(summaries, c.getWorkerSummary(ns))
+		}
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].ID < summaries[j].ID
+	})
+	return summaries
+}
+
+func (c *clusterState) getWorkerSummary(ns *nodeState) domain.WorkerSummary {
+	summary := domain.WorkerSummary{
+		ID:              string(ns.node.Id()),
+		NumRunningTasks: len(ns.running),
+		SnapshotID:      ns.snapshotId,
+		Labels:          ns.labels,
+	}
+	if len(ns.running) > 0 {
+		summary.RunningJobID, summary.RunningTaskID = ns.runningJob, ns.runningTask
+	}
+	switch {
+	case c.isOfflined(ns):
+		summary.State, summary.InStateSince = domain.WorkerOfflined, ns.offline.Since
+		summary.OfflinedBy, summary.OfflineReason, summary.OfflinedUntil = ns.offline.Requestor, ns.offline.Reason, ns.offline.Until
+	case ns.timeLost != nilTime:
+		summary.State, summary.InStateSince = domain.WorkerLost, ns.timeLost
+	case ns.timeFlaky != nilTime:
+		summary.State, summary.InStateSince = domain.WorkerFlaky, ns.timeFlaky
+	case ns.timeDraining != nilTime:
+		summary.State, summary.InStateSince = domain.WorkerDraining, ns.timeDraining
+	case ns.readyCh != nil:
+		summary.State = domain.WorkerNotReady
+	case ns.full():
+		summary.State = domain.WorkerBusy
+	default:
+		summary.State = domain.WorkerIdle
+	}
+	return summary
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -10,6 +11,8 @@ import (
 	cc "github.com/twitter/scoot/cloud/cluster"
 	"github.com/twitter/scoot/common"
 	"github.com/twitter/scoot/common/stats"
+	"github.com/twitter/scoot/runner"
+	"github.com/twitter/scoot/scheduler/domain"
 )
 
 // ensures nodes can be added and removed
@@ -61,7 +64,7 @@ func Test_ClusterState_RemoveNotTrackedNode(t *testing.T) {
 func Test_ClusterState_DuplicateNodeAdd(t *testing.T) {
 	cs, nodesUpdatesCh, _ := setupTestClusterState(nil, "node1")
 
-	cs.taskScheduled("node1", "job1", "task1", "")
+	cs.taskScheduled("node1", "job1", "task1", "", runner.Resources{})
 
 	// readd node to cluster
 	addNode("node1", nodesUpdatesCh)
@@ -89,7 +92,7 @@ func Test_ClusterState_OfflineNode(t *testing.T) {
 	if len(cs.nodes) != 1 {
 		t.Errorf("Expected len(cs.nodes) to be 1, was %d", len(cs.nodes))
 	}
-	cs.OfflineNode(cNodeId)
+	cs.OfflineNode(cNodeId, "")
 	cs.updateCluster()
 	if _, ok := cs.nodes[cNodeId]; ok {
 		t.Errorf("Expected %s to be offlined", nodeID)
@@ -114,7 +117,7 @@ func Test_ClusterState_ReinstateNode(t *testing.T) {
 	}
 
 	// offline the node
-	cs.OfflineNode(cNodeId)
+	cs.OfflineNode(cNodeId, "")
 	cs.updateCluster()
 	if _, ok := cs.nodes[cNodeId]; ok {
 		t.Errorf("Expected %s to be offlined", cNodeId)
@@ -140,6 +143,40 @@ func Test_ClusterState_ReinstateNode(t *testing.T) {
 	}
 }
 
+func Test_ClusterState_WorkerSummaries(t *testing.T) {
+	cs, nodeUpdateCh, _ := setupTestClusterState(nil, "node1", "node2", "node3", "node4")
+	cs.taskScheduled("node1", "job1", "task1", "snap1", runner.Resources{})
+	cs.OfflineNode("node3", "admin")
+	removeNode("node4", nodeUpdateCh)
+	cs.updateCluster()
+
+	summaries := cs.getWorkerSummaries()
+	if len(summaries) != 4 {
+		t.Fatalf("Expected 4 workers, got %v", summaries)
+	}
+	busy, idle, offlined, lost := summaries[0], summaries[1], summaries[2], summaries[3]
+	if busy.ID != "node1" || busy.State != domain.WorkerBusy || busy.RunningJobID != "job1" ||
+		busy.RunningTaskID != "task1" || busy.NumRunningTasks != 1 || busy.SnapshotID != "snap1" {
+		t.Errorf("Unexpected busy worker %+v", busy)
+	}
+	if idle.ID != "node2" || idle.State != domain.WorkerIdle || idle.RunningJobID != "" || !idle.InStateSince.IsZero() {
+		t.Errorf("Unexpected idle worker %+v", idle)
+	}
+	if offlined.ID != "node3" || offlined.State != domain.WorkerOfflined || offlined.OfflinedBy != "admin" || offlined.InStateSince.IsZero() {
+		t.Errorf("Unexpected offlined worker %+v", offlined)
+	}
+	if lost.ID != "node4" || lost.State != domain.WorkerLost || lost.InStateSince.IsZero() {
+		t.Errorf("Unexpected lost worker %+v", lost)
+	}
+
+	// a reinstated worker is no longer offlined by anyone
+	cs.OnlineNode("node3")
+	cs.updateCluster()
+	if reinstated := cs.getWorkerSummaries()[2]; reinstated.State != domain.WorkerIdle || reinstated.OfflinedBy != "" {
+		t.Errorf("Unexpected reinstated worker %+v", reinstated)
+	}
+}
+
 func Test_ClusterState_OfflineNodeAlreadyOffline(t *testing.T) {
 	nodeID := "node1"
 	cNodeId := cluster.NodeId(nodeID)
@@ -152,7 +189,7 @@ func Test_ClusterState_OfflineNodeAlreadyOffline(t *testing.T) {
 	}
 
 	// offline the node
-	cs.OfflineNode(cNodeId)
+	cs.OfflineNode(cNodeId, "")
 	cs.updateCluster()
 	if _, ok := cs.nodes[cNodeId]; ok {
 		t.Errorf("Expected %s to be offlined", nodeID)
@@ -165,7 +202,7 @@ func Test_ClusterState_OfflineNodeAlreadyOffline(t *testing.T) {
 	}
 
 	// offline the node
-	cs.OfflineNode(cNodeId)
+	cs.OfflineNode(cNodeId, "")
 	cs.updateCluster()
 	if _, ok := cs.nodes[cluster.NodeId(nodeID)]; ok {
 		t.Errorf("Expected %s to still be offlined", nodeID)
@@ -193,7 +230,7 @@ func Test_ClusterState_ReinstateNodeAlreadyReinstated(t *testing.T) {
 	}
 
 	// offline the node
-	cs.OfflineNode(cNodeId)
+	cs.OfflineNode(cNodeId, "")
 	cs.updateCluster()
 	if _, ok := cs.nodes[cluster.NodeId(nodeID)]; ok {
 		t.Errorf("Expected %s to be offlined", nodeID)
@@ -229,7 +266,7 @@ func Test_ClusterState_ReinstateNodeAlreadyReinstated(t *testing.T) {
 func Test_ClusterState_TaskStarted(t *testing.T) {
 	cs, _, _ := setupTestClusterState(nil, "node1")
 
-	cs.taskScheduled("node1", "job1", "task1", "")
+	cs.taskScheduled("node1", "job1", "task1", "", runner.Resources{})
 	ns, _ := cs.getNodeState("node1")
 
 	if ns.runningTask != "task1" {
@@ -240,10 +277,10 @@ func Test_ClusterState_TaskStarted(t *testing.T) {
 func Test_ClusterState_TaskCompleted(t *testing.T) {
 	cs, _, _ := setupTestClusterState(nil, "node1")
 
-	cs.taskScheduled("node1", "job1", "task1", "")
+	cs.taskScheduled("node1", "job1", "task1", "", runner.Resources{})
 	ns, _ := cs.getNodeState("node1")
 
-	cs.taskCompleted("node1", false)
+	cs.taskCompleted("node1", "job1", "task1", false)
 	if ns.runningTask != noTask {
 		t.Errorf("Expected Node1 to be running task1")
 	}
@@ -257,12 +294,12 @@ func Test_ClusterState_NodeGroups(t *testing.T) {
 		"node1": make(chan interface{}), "node2": make(chan interface{}),
 		"node3": make(chan interface{}), "node4": make(chan interface{}),
 	}
-	readyFn := func(node cluster.Node) (bool, time.Duration) {
+	readyFn := func(node cluster.Node) (bool, NodeInfo, time.Duration) {
 		select {
 		case <-ready[string(node.Id())]:
-			return true, time.Duration(0)
+			return true, NodeInfo{}, time.Duration(0)
 		default:
-			return false, time.Millisecond
+			return false, NodeInfo{}, time.Millisecond
 		}
 	}
 	setReady := func(node string) {
@@ -348,9 +385,9 @@ func Test_ClusterState_NodeGroups(t *testing.T) {
 		t.Fatal("stats check did not pass.")
 	}
 	// Test the the right idle/busy maps are filled out for each snapshotId.
-	cs.taskScheduled("node1", "job1", "task1", "snapA")
-	cs.taskScheduled("node2", "job1", "task2", "snapA")
-	cs.taskScheduled("node3", "job1", "task3", "snapB")
+	cs.taskScheduled("node1", "job1", "task1", "snapA", runner.Resources{})
+	cs.taskScheduled("node2", "job1", "task2", "snapA", runner.Resources{})
+	cs.taskScheduled("node3", "job1", "task3", "snapB", runner.Resources{})
 	expectedGroups := map[string]*nodeGroup{
 		"": {
 			idle: map[cluster.NodeId]*nodeState{
@@ -377,7 +414,7 @@ func Test_ClusterState_NodeGroups(t *testing.T) {
 	}
 
 	// Test that finishing a jobs moves it to the idle list for its snapshotId.
-	cs.taskCompleted("node1", false)
+	cs.taskCompleted("node1", "job1", "task1", false)
 	expectedGroups["snapA"].idle["node1"] = cs.nodes["node1"]
 	delete(expectedGroups["snapA"].busy, "node1")
 	if !reflect.DeepEqual(cs.nodeGroups, expectedGroups) {
@@ -385,7 +422,7 @@ func Test_ClusterState_NodeGroups(t *testing.T) {
 	}
 
 	// Test the rescheduling a task moves it correctly from an idle list to a busy one.
-	cs.taskScheduled("node1", "job1", "task1", "snapB")
+	cs.taskScheduled("node1", "job1", "task1", "snapB", runner.Resources{})
 	expectedGroups["snapB"].busy["node1"] = cs.nodes["node1"]
 	delete(expectedGroups["snapA"].idle, "node1")
 	if !reflect.DeepEqual(cs.nodeGroups, expectedGroups) {
@@ -393,7 +430,7 @@ func Test_ClusterState_NodeGroups(t *testing.T) {
 	}
 
 	// Task finished and is marked as flaky
-	cs.taskCompleted("node1", true)
+	cs.taskCompleted("node1", "job1", "task1", true)
 	if _, ok := cs.nodes["node1"]; ok {
 		t.Fatalf("Flaky node was not moved out of cs.nodes")
 	} else if _, ok := cs.suspendedNodes["node1"]; !ok {
@@ -454,6 +491,158 @@ func Test_ClusterState_NodeGroups(t *testing.T) {
 
 }
 
+func Test_ClusterState_PacksTasksByResources(t *testing.T) {
+	capacity := runner.Resources{CPUMillis: 1000, MemoryBytes: 4000}
+	readyFn := func(node cluster.Node) (bool, NodeInfo, time.Duration) {
+		if node.Id() == "packed" {
+			return true, NodeInfo{Capacity: capacity}, 0
+		}
+		return true, NodeInfo{}, 0
+	}
+	cs, _, _ := setupTestClusterState(readyFn, "packed", "single")
+	for start := time.Now(); len(cs.nodes) != 2; cs.updateCluster() {
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("Timed out waiting for nodes to be ready, got %s", cs.status())
+		}
+	}
+	packed, single := cs.nodes["packed"], cs.nodes["single"]
+	if packed.capacity != capacity || !single.capacity.IsZero() {
+		t.Fatalf("Expected nodes to take the capacity reported when ready, got %s and %s", packed.capacity, single.capacity)
+	}
+
+	half := runner.Resources{CPUMillis: 500, MemoryBytes: 1000}
+	cs.taskScheduled("packed", "job1", "task1", "snapA", half)
+	cs.taskScheduled("single", "job1", "task2", "snapA", half)
+	if _, ok := cs.nodeGroups["snapA"].idle["packed"]; !ok || packed.full() {
+		t.Errorf("Expected a half used node to remain idle, got %s", packed)
+	}
+	if _, ok := cs.nodeGroups["snapA"].busy["single"]; !ok || !single.full() {
+		t.Errorf("Expected a node without capacity to be busy with one task, got %s", single)
+	}
+	if cs.numFree() != 1 || cs.numRunning != 2 {
+		t.Errorf("Expected 1 free node and 2 running tasks, got %s", cs.status())
+	}
+	if packed.canRun(runner.Resources{}) || packed.canRun(runner.Resources{CPUMillis: 600}) {
+		t.Errorf("Expected a half used node to refuse tasks needing the whole node or more than is left")
+	}
+
+	cs.taskScheduled("packed", "job1", "task3", "snapB", half)
+	if _, ok := cs.nodeGroups["snapB"].busy["packed"]; !ok || !packed.full() || cs.numFree() != 0 {
+		t.Errorf("Expected a fully used node to be busy in its new group, got %s", packed)
+	}
+	if packed.allocated != half.Add(half) || packed.runningTask != "task3" {
+		t.Errorf("Expected both tasks to be allocated, got %s", packed)
+	}
+
+	cs.taskCompleted("packed", "job1", "task1", false)
+	if _, ok := cs.nodeGroups["snapB"].idle["packed"]; !ok || packed.allocated != half || packed.runningTask != "task3" {
+		t.Errorf("Expected completing a task to free its resources, got %s", packed)
+	}
+	cs.taskCompleted("packed", "job1", "task3", false)
+	if !packed.allocated.IsZero() || packed.runningTask != noTask || !packed.canRun(runner.Resources{}) {
+		t.Errorf("Expected a node without running tasks to be free, got %s", packed)
+	}
+}
+
+func Test_ClusterState_Labels(t *testing.T) {
+	readyFn := func(node cluster.Node) (bool, NodeInfo, time.Duration) {
+		if node.Id() == "android" {
+			return true, NodeInfo{Labels: map[string]string{"android-sdk": "28", "rack": "r2"}}, 0
+		}
+		return true, NodeInfo{}, 0
+	}
+	nodeUpdateCh := make(chan []cc.NodeUpdate, common.DefaultClusterChanSize)
+	cs := newClusterState(nodeUpdateCh, readyFn, stats.NilStatsReceiver())
+	nodeUpdateCh <- []cc.NodeUpdate{
+		cc.NewAdd(cc.NewLabeledNode("android", map[string]string{"rack": "r1", "zone": "z1"})),
+		cc.NewAdd(cc.NewLabeledNode("plain", map[string]string{"rack": "r3"})),
+	}
+	for start := time.Now(); len(cs.nodes) != 2; cs.updateCluster() {
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("Timed out waiting for nodes to be ready, got %s", cs.status())
+		}
+	}
+
+	expected := map[string]string{"android-sdk": "28", "rack": "r2", "zone": "z1"}
+	if labels := cs.nodes["android"].labels; !reflect.DeepEqual(labels, expected) {
+		t.Errorf("Expected the labels reported when ready to be added to the fetched labels, got %v", labels)
+	}
+	if labels := cs.nodes["plain"].labels; !reflect.DeepEqual(labels, map[string]string{"rack": "r3"}) {
+		t.Errorf("Expected the fetched labels, got %v", labels)
+	}
+
+	sdk := []domain.LabelSelector{{Key: "android-sdk", Op: domain.LabelExists}}
+	if !cs.hasMatchingNode(sdk) || cs.nodes["plain"].matches(sdk) {
+		t.Errorf("Expected only the android node to match %v", sdk)
+	}
+
+	// a node whose labels changed is removed and added again, and stays in rotation
+	cs.update([]cc.NodeUpdate{
+		cc.NewRemove("android"),
+		cc.NewAdd(cc.NewLabeledNode("android", map[string]string{"rack": "r1", "zone": "z2"})),
+	})
+	expected = map[string]string{"android-sdk": "28", "rack": "r2", "zone": "z2"}
+	if ns, ok := cs.nodes["android"]; !ok || !reflect.DeepEqual(ns.labels, expected) {
+		t.Errorf("Expected the relabeled node in rotation with labels %v, got %s", expected, cs.status())
+	}
+	cs.update([]cc.NodeUpdate{cc.NewUserInitiatedRemove("android")})
+	if cs.hasMatchingNode(sdk) {
+		t.Errorf("Expected offlined nodes not to match %v", sdk)
+	}
+}
+
+func Test_ClusterState_DrainingNodes(t *testing.T) {
+	cs, nodesUpdatesCh, _ := setupTestClusterState(nil, "node1", "node2")
+	var mu sync.Mutex
+	draining := map[cc.NodeId]bool{}
+	setDraining := func(id cc.NodeId, d bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		draining[id] = d
+	}
+	cs.setDrainingFn(func(node cc.Node) (bool, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		return draining[node.Id()], nil
+	}, 0)
+	waitFor := func(cond func() bool, msg string) {
+		for start := time.Now(); !cond(); cs.updateCluster() {
+			if time.Since(start) > 5*time.Second {
+				t.Fatalf("Timed out waiting for %s, got %s", msg, cs.status())
+			}
+		}
+	}
+
+	// a draining node is taken out of rotation
+	setDraining("node1", true)
+	waitFor(func() bool { return cs.suspendedNodes["node1"] != nil }, "node1 to drain")
+	if !cs.suspendedNodes["node1"].suspended() || len(cs.nodes) != 1 {
+		t.Errorf("Expected node1 to be suspended while draining, got %s", cs.status())
+	}
+	if summaries := cs.getWorkerSummaries(); summaries[0].State != domain.WorkerDraining || summaries[0].InStateSince.IsZero() {
+		t.Errorf("Expected node1 to be reported as draining, got %+v", summaries[0])
+	}
+
+	// and deleted right away once it leaves the cluster, as opposed to a lost node
+	removeNode("node1", nodesUpdatesCh)
+	cs.updateCluster()
+	if _, ok := cs.suspendedNodes["node1"]; ok {
+		t.Errorf("Expected the drained node to be deleted, got %s", cs.suspendedNodes["node1"])
+	}
+	if _, ok := cs.nodeGroups[""].idle["node1"]; ok {
+		t.Errorf("Expected the drained node to be deleted from its node group")
+	}
+
+	// a node that stops draining goes back in rotation
+	setDraining("node2", true)
+	waitFor(func() bool { return len(cs.nodes) == 0 }, "node2 to drain")
+	setDraining("node2", false)
+	waitFor(func() bool { return cs.nodes["node2"] != nil }, "node2 to be back in rotation")
+	if cs.nodes["node2"].timeDraining != nilTime || len(cs.suspendedNodes) != 0 {
+		t.Errorf("Expected node2 to no longer be draining, got %s", cs.nodes["node2"])
+	}
+}
+
 func initTestCluster(nodesUpdateCh chan []cc.NodeUpdate, nodes ...string) {
 	nodeUpdates := []cc.NodeUpdate{}
 	for _, n := range nodes {
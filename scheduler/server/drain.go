@@ -0,0 +1,113 @@
+package server
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	cc "github.com/twitter/scoot/cloud/cluster"
+	"github.com/twitter/scoot/common"
+	"github.com/twitter/scoot/common/stats"
+)
+
+// Cluster will use this function to check whether a node is draining: shutting down after it
+// finishes or hands back its current runs.  Draining nodes aren't assigned new tasks.
+type DrainingFn func(cc.Node) (draining bool, err error)
+
+// The result of checking whether a node is draining.
+type drainCheck struct {
+	ns       *nodeState
+	draining bool
+	err      error
+}
+
+// Checks whether nodes are draining every interval using drainingFn, nil disables the checks.
+func (c *clusterState) setDrainingFn(drainingFn DrainingFn, interval time.Duration) {
+	c.drainingFn = drainingFn
+	c.drainCheckInterval = interval
+	c.drainCheckCh = make(chan drainCheck, common.DefaultClusterChanSize)
+}
+
+// Starts checking whether the healthy and draining nodes are draining if the interval has passed since
+// the last checks.  Each node is checked in its own goroutine, at most one check per node at a time.
+func (c *clusterState) startDrainChecks() {
+	if c.drainingFn == nil || time.Since(c.lastDrainCheck) < c.drainCheckInterval {
+		return
+	}
+	c.lastDrainCheck = time.Now()
+	for _, ns := range c.nodes {
+		c.startDrainCheck(ns)
+	}
+	for _, ns := range c.suspendedNodes {
+		if ns.timeDraining != nilTime {
+			c.startDrainCheck(ns)
+		}
+	}
+}
+
+func (c *clusterState) startDrainCheck(ns *nodeState) {
+	if ns.checkingDrain {
+		return
+	}
+	ns.checkingDrain = true
+	go func() {
+		draining, err := c.drainingFn(ns.node)
+		c.drainCheckCh <- drainCheck{ns: ns, draining: draining, err: err}
+	}()
+}
+
+// Applies the results of the finished drain checks: healthy nodes that are draining are suspended until
+// they leave the cluster, draining nodes that no longer are go back in rotation.
+func (c *clusterState) processDrainChecks() {
+	for {
+		select {
+		case check := <-c.drainCheckCh:
+			c.drainChecked(check)
+		default:
+			return
+		}
+	}
+}
+
+func (c *clusterState) drainChecked(check drainCheck) {
+	ns := check.ns
+	ns.checkingDrain = false
+	id := ns.node.Id()
+	if check.err != nil {
+		// errors talking to the node are handled when tasks run on it, and removed nodes by node updates
+		log.Debugf("Drain check of node %s failed: %v", id, check.err)
+		return
+	}
+	if check.draining && ns.timeDraining == nilTime {
+		if c.nodes[id] != ns {
+			// the node was suspended, offlined or removed while it was being checked
+			return
+		}
+		ns.timeDraining = time.Now()
+		delete(c.nodes, id)
+		c.suspendedNodes[id] = ns
+		log.Infof("DrainingNode: Node is draining, taking it out of rotation: %v (%s), %s", id, ns, c.status())
+	} else if !check.draining && ns.timeDraining != nilTime && c.suspendedNodes[id] == ns {
+		// put back in rotation by update() unless it's suspended for another reason
+		ns.timeDraining = nilTime
+		log.Infof("DrainingNode: Node is no longer draining: %v (%s)", id, ns)
+	}
+}
+
+// Deletes a draining node that left the cluster, its removal was planned so it isn't kept around as lost.
+func (c *clusterState) removeDrainedNode(ns *nodeState) {
+	c.deleteSuspendedNode(ns)
+	c.stats.Counter(stats.ClusterDrainedNodes).Inc(1)
+	log.Infof("NodeRemoved: Removed drained node: %v (%s), %s", ns.node.Id(), ns, c.status())
+}
+
+// Returns the number of draining nodes.
+func (c *clusterState) numDraining() int {
+	n := 0
+	for _, ns := range c.suspendedNodes {
+		if ns.timeDraining != nilTime {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/twitter/scoot/scheduler/domain"
+)
+
+// Each scheduling iteration records why the waiting tasks weren't started: the tasks the scheduling algorithm
+// didn't select are explained by their dependencies, backoff, labels, the lack of free nodes, or by the
+// algorithm itself, and assign() explains the selected tasks it couldn't place on a node.
+// ExplainJob reports the reasons recorded for a job's waiting tasks.
+
+// waitExplainer is implemented by the scheduling algorithms that can tell why their last
+// GetTasksToBeAssigned call didn't select the waiting tasks of a job.
+type waitExplainer interface {
+	explainWait(job *jobState) string
+}
+
+// contains the job to explain and callback for the explanation, nil if the job isn't being scheduled
+type jobExplainRequest struct {
+	jobId      string
+	responseCh chan *domain.JobExplanation
+}
+
+func (t *taskState) setWaitReason(reason domain.WaitReason, detail string) {
+	t.WaitReason = reason
+	t.WaitDetail = detail
+}
+
+// Records why each waiting task the scheduling algorithm didn't select wasn't started, and clears
+// the reasons of the selected tasks.
+func (s *statefulScheduler) recordWaitReasons(selected []*taskState) {
+	now := time.Now()
+	s.waitReasonsUpdated = now
+	isSelected := make(map[*taskState]bool, len(selected))
+	for _, task := range selected {
+		isSelected[task] = true
+	}
+	numFree := s.clusterState.numFree()
+	noNodeReason, noNodeDetail := domain.WaitNotEvaluated, ""
+	explainer, _ := s.config.SchedAlg.(waitExplainer)
+
+	for _, job := range s.inProgressJobs {
+		notSelected := ""
+		for _, task := range job.Tasks {
+			if task.Status != domain.NotStarted {
+				continue
+			}
+			switch {
+			case isSelected[task]:
+				task.setWaitReason(domain.WaitNotEvaluated, "")
+			case task.Unschedulable != "":
+				task.setWaitReason(domain.WaitUnschedulable, task.Unschedulable)
+			case !job.dependenciesSucceeded(task):
+				task.setWaitReason(domain.WaitDependencies, fmt.Sprintf("waiting on tasks %v", job.unmetDependencies(task)))
+			case now.Before(task.RetryAfter):
+				task.setWaitReason(domain.WaitRetryBackoff, fmt.Sprintf("retrying at %s", task.RetryAfter.Format(time.RFC3339)))
+			case numFree == 0:
+				if noNodeReason == domain.WaitNotEvaluated {
+					noNodeReason, noNodeDetail = s.explainNoNode()
+				}
+				task.setWaitReason(noNodeReason, noNodeDetail)
+			default:
+				if notSelected == "" {
+					notSelected = "the free nodes were given to other tasks"
+					if explainer != nil {
+						notSelected = explainer.explainWait(job)
+					}
+				}
+				task.setWaitReason(domain.WaitNotSelected, notSelected)
+			}
+		}
+	}
+}
+
+// Returns why tasks can't start for lack of an idle node: every node is suspended or offlined, or busy.
+func (s *statefulScheduler) explainNoNode() (domain.WaitReason, string) {
+	cs := s.clusterState
+	if !cs.hasAvailableNode() {
+		return domain.WaitNoAvailableNodes, fmt.Sprintf("%d nodes, %d suspended, %d offlined",
+			len(cs.nodes)+len(cs.suspendedNodes)+len(cs.offlinedNodes), len(cs.suspendedNodes), len(cs.offlinedNodes))
+	}
+	return domain.WaitNoIdleNodes, fmt.Sprintf("%d tasks running on %d nodes", cs.numRunning, len(cs.nodes))
+}
+
+// Returns the dependencies of the task that haven't succeeded yet.
+func (j *jobState) unmetDependencies(task *taskState) []string {
+	unmet := []string{}
+	for _, dep := range task.Def.Dependencies {
+		if depTask := j.getTask(dep); depTask == nil || depTask.Status != domain.Completed || !depTask.Succeeded {
+			unmet = append(unmet, dep)
+		}
+	}
+	return unmet
+}
+
+// Put the explain request on channel that is processed by the main
+// scheduler loop, and wait for the explanation.
+// Returns nil if the job isn't being scheduled, because it finished or doesn't exist.
+func (s *statefulScheduler) ExplainJob(jobId string) (*domain.JobExplanation, error) {
+	if err := s.checkLeader(); err != nil {
+		return nil, err
+	}
+	req := jobExplainRequest{jobId: jobId, responseCh: make(chan *domain.JobExplanation, 1)}
+	s.explainJobCh <- req
+
+	return <-req.responseCh, nil
+}
+
+// answer all pending explain requests with the reasons recorded for the jobs' waiting tasks.
+//
+// this function is part of the main scheduler loop
+func (s *statefulScheduler) explainJobs() {
+	for {
+		select {
+		case req := <-s.explainJobCh:
+			job := s.getJob(req.jobId)
+			if job == nil {
+				req.responseCh <- nil
+				continue
+			}
+			explanation := s.explainJob(job)
+			req.responseCh <- &explanation
+		default:
+			return
+		}
+	}
+}
+
+func (s *statefulScheduler) explainJob(job *jobState) domain.JobExplanation {
+	explanation := domain.JobExplanation{
+		ID:          job.Job.Id,
+		Status:      job.getSummary().Status,
+		Tasks:       []domain.TaskExplanation{},
+		ExplainedAt: s.waitReasonsUpdated,
+	}
+	for _, task := range job.Tasks {
+		if task.Status == domain.NotStarted {
+			explanation.Tasks = append(explanation.Tasks,
+				domain.TaskExplanation{TaskID: task.TaskId, Reason: task.WaitReason, Detail: task.WaitDetail})
+		}
+	}
+
+	// The throttle and job limits are only enforced as jobs are submitted, they don't hold back
+	// jobs that were accepted, but they do hold back the requestor's next jobs.
+	if numTasks, throttle := s.GetSchedulerStatus(); throttle >= 0 && numTasks >= throttle {
+		explanation.Notes = append(explanation.Notes, fmt.Sprintf(
+			"the scheduler has %d tasks, at or over its task throttle of %d, requestors may hold back new jobs", numTasks, throttle))
+	}
+	requestor := job.Job.Def.Requestor
+	if numJobs := len(s.requestorMap[requestor]); numJobs >= s.config.MaxJobsPerRequestor {
+		explanation.Notes = append(explanation.Notes, fmt.Sprintf(
+			"requestor %s has %d jobs, at its limit of %d, its new jobs are rejected", requestor, numJobs, s.config.MaxJobsPerRequestor))
+	}
+	if len(s.requestorMap) >= s.config.MaxRequestors {
+		explanation.Notes = append(explanation.Notes, fmt.Sprintf(
+			"the scheduler has jobs from %d requestors, at its limit of %d, jobs from other requestors are rejected",
+			len(s.requestorMap), s.config.MaxRequestors))
+	}
+	return explanation
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"testing"
+
+	cc "github.com/twitter/scoot/cloud/cluster"
+	"github.com/twitter/scoot/common"
+	"github.com/twitter/scoot/scheduler/domain"
+)
+
+// explainJob asks the scheduler to explain the job, stepping the scheduler until it answers.
+func explainJob(t *testing.T, s *statefulScheduler, jobId string) *domain.JobExplanation {
+	respCh := make(chan *domain.JobExplanation)
+	go func() {
+		explanation, err := s.ExplainJob(jobId)
+		if err != nil {
+			t.Errorf("Unexpected error explaining job %s: %v", jobId, err)
+		}
+		respCh <- explanation
+	}()
+	for {
+		select {
+		case explanation := <-respCh:
+			return explanation
+		default:
+			s.step()
+		}
+	}
+}
+
+func Test_StatefulScheduler_ExplainJob(t *testing.T) {
+	deps := getDefaultSchedDeps()
+	// cluster without nodes
+	deps.nodesUpdatesCh = make(chan []cc.NodeUpdate, common.DefaultClusterChanSize)
+	s := makeStatefulSchedulerDeps(deps)
+
+	jobDef := domain.GenJobDef(2)
+	jobDef.Tasks[0].TaskID = "task0"
+	jobDef.Tasks[1].TaskID = "task1"
+	jobDef.Tasks[1].Dependencies = []string{"task0"}
+	jobId := scheduleJobDef(t, s, jobDef)
+	s.step()
+
+	explanation := explainJob(t, s, jobId)
+	if explanation == nil || explanation.ID != jobId || len(explanation.Tasks) != 2 {
+		t.Fatalf("Expected an explanation of the 2 waiting tasks of job %s, got %+v", jobId, explanation)
+	}
+	if explanation.ExplainedAt.IsZero() {
+		t.Errorf("Expected the time the reasons were recorded")
+	}
+	for _, task := range explanation.Tasks {
+		expected := domain.WaitNoAvailableNodes
+		if task.TaskID == "task1" {
+			expected = domain.WaitDependencies
+		}
+		if task.Reason != expected {
+			t.Errorf("Expected task %s to wait for %s, got %s: %s", task.TaskID, expected, task.Reason, task.Detail)
+		}
+	}
+
+	// a node joins, task0 runs and task1 waits on it
+	deps.nodesUpdatesCh <- []cc.NodeUpdate{cc.NewAdd(cc.NewIdNode("node1"))}
+	for s.getJob(jobId).getTask("task0").Status == domain.NotStarted {
+		s.step()
+	}
+	if task1 := s.getJob(jobId).getTask("task1"); task1.Status == domain.NotStarted && task1.WaitReason != domain.WaitDependencies {
+		t.Errorf("Expected task1 to wait on task0, got %s: %s", task1.WaitReason, task1.WaitDetail)
+	}
+
+	if explanation := explainJob(t, s, "unknown"); explanation != nil {
+		t.Errorf("Expected no explanation of a job that isn't being scheduled, got %+v", explanation)
+	}
+}
@@ -0,0 +1,241 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/twitter/scoot/common/stats"
+)
+
+// FairShareAlgConfig the fair share algorithm's JSON config.  Requestors are matched by regular
+// expression as in the load based algorithm's RequestorToClassMap, when several expressions match
+// a requestor the first in lexical order is used.
+type FairShareAlgConfig struct {
+	// requestor regular expression to the weight of each matching requestor
+	Weights map[string]float64 `json:"Weights"`
+	// requestor regular expression to the number of workers each matching requestor is guaranteed
+	MinShares map[string]int `json:"MinShares"`
+	// weight of the requestors no Weights expression matches, default to 1
+	DefaultWeight float64 `json:"DefaultWeight"`
+}
+
+// FairShareAlg the scheduling algorithm shares the workers across requestors in proportion to their weights,
+// without the fixed class percents of the LoadBasedAlg: the workers a requestor doesn't use go to the others.
+//
+// Idle workers are handed out one at a time, each to the requestor with waiting tasks that is furthest
+// below its share: first the requestors running fewer tasks than their min share, then the requestor with
+// the fewest tasks per unit of weight.  Running tasks are never stopped, a requestor over its share
+// gives workers back as its tasks complete, so min shares are met as soon as workers free up.
+//
+// Within a requestor, tasks are started round robin from the jobs with the fewest running tasks, in the
+// order they're defined in their job.
+type FairShareAlg struct {
+	weights       []requestorRe
+	minShares     []requestorRe
+	defaultWeight float64
+
+	// the requestors' shares computed by the last GetTasksToBeAssigned call, to explain why tasks are waiting
+	lastShares map[string]*requestorShare
+}
+
+// requestorRe a compiled requestor regular expression and its value
+type requestorRe struct {
+	re    *regexp.Regexp
+	value float64
+}
+
+// NewFairShareAlg allocate a new FairShareAlg object, returns an error if the config is invalid.
+func NewFairShareAlg(config FairShareAlgConfig) (*FairShareAlg, error) {
+	fs := &FairShareAlg{defaultWeight: config.DefaultWeight}
+	if fs.defaultWeight == 0 {
+		fs.defaultWeight = 1
+	}
+	if fs.defaultWeight < 0 {
+		return nil, fmt.Errorf("negative default weight %g", fs.defaultWeight)
+	}
+	for _, reqRe := range sortedKeys(config.Weights) {
+		weight := config.Weights[reqRe]
+		if weight <= 0 {
+			return nil, fmt.Errorf("weight %g of %s isn't positive", weight, reqRe)
+		}
+		re, err := regexp.Compile(reqRe)
+		if err != nil {
+			return nil, fmt.Errorf("invalid requestor regular expression %s: %v", reqRe, err)
+		}
+		fs.weights = append(fs.weights, requestorRe{re: re, value: weight})
+	}
+	minShares := map[string]float64{}
+	for reqRe, minShare := range config.MinShares {
+		if minShare < 0 {
+			return nil, fmt.Errorf("negative min share %d of %s", minShare, reqRe)
+		}
+		minShares[reqRe] = float64(minShare)
+	}
+	for _, reqRe := range sortedKeys(minShares) {
+		re, err := regexp.Compile(reqRe)
+		if err != nil {
+			return nil, fmt.Errorf("invalid requestor regular expression %s: %v", reqRe, err)
+		}
+		fs.minShares = append(fs.minShares, requestorRe{re: re, value: minShares[reqRe]})
+	}
+	return fs, nil
+}
+
+// newFairShareAlgFromJSON the SchedAlgFactory of the fair share algorithm.
+func newFairShareAlgFromJSON(config json.RawMessage, deps SchedAlgDeps) (SchedulingAlgorithm, error) {
+	fc := FairShareAlgConfig{}
+	if err := decodeSchedAlgConfig(config, &fc); err != nil {
+		return nil, err
+	}
+	return NewFairShareAlg(fc)
+}
+
+// requestorShare the state of a requestor's jobs used to compute the tasks to start for the requestor.
+type requestorShare struct {
+	requestor   string
+	weight      float64
+	minShare    int
+	numRunning  int
+	numWaiting  int
+	numStarting int
+	jobs        []*fairShareJob
+}
+
+// fairShareJob a job's waiting tasks, in the order they should be started
+type fairShareJob struct {
+	jobState     *jobState
+	waitingTasks []*taskState
+	numStarting  int
+}
+
+// GetTasksToBeAssigned - the entry point to the fair share scheduling algorithm, returns the tasks to
+// start on the cluster's free workers.  It never returns tasks to stop.
+func (fs *FairShareAlg) GetTasksToBeAssigned(jobsNotUsed []*jobState, stat stats.StatsReceiver, cs *clusterState,
+	jobsByRequestor map[string][]*jobState) ([]*taskState, []*taskState) {
+	shares := []*requestorShare{}
+	for requestor, jobs := range jobsByRequestor {
+		rs := &requestorShare{
+			requestor: requestor,
+			weight:    fs.getWeight(requestor),
+			minShare:  fs.getMinShare(requestor),
+		}
+		for _, job := range jobs {
+			rs.numRunning += job.TasksRunning
+			if waiting := job.getUnScheduledTasks(); len(waiting) > 0 {
+				rs.jobs = append(rs.jobs, &fairShareJob{jobState: job, waitingTasks: waiting})
+				rs.numWaiting += len(waiting)
+			}
+		}
+		if rs.numWaiting > 0 {
+			shares = append(shares, rs)
+		}
+	}
+	// order the requestors so ties are broken the same way every iteration
+	sort.Slice(shares, func(i, j int) bool {
+		return shares[i].requestor < shares[j].requestor
+	})
+	fs.lastShares = map[string]*requestorShare{}
+	for _, rs := range shares {
+		fs.lastShares[rs.requestor] = rs
+	}
+
+	numFree := cs.numFree()
+	for i := 0; i < numFree; i++ {
+		var next *requestorShare
+		for _, rs := range shares {
+			if rs.numStarting < rs.numWaiting && (next == nil || rs.furtherBelowShare(next)) {
+				next = rs
+			}
+		}
+		if next == nil {
+			break
+		}
+		next.numStarting++
+	}
+
+	tasksToStart := []*taskState{}
+	for _, rs := range shares {
+		tasksToStart = append(tasksToStart, rs.tasksToStart()...)
+	}
+	if len(tasksToStart) > 0 {
+		log.Debugf("Returning %d start tasks for %d requestors, %d free nodes out of %d total nodes",
+			len(tasksToStart), len(shares), numFree, len(cs.nodes))
+	}
+	return tasksToStart, nil
+}
+
+// explainWait tells why the last GetTasksToBeAssigned call didn't start the job's waiting tasks.
+func (fs *FairShareAlg) explainWait(job *jobState) string {
+	requestor := job.Job.Def.Requestor
+	rs, ok := fs.lastShares[requestor]
+	if !ok {
+		return fmt.Sprintf("requestor %s had no waiting tasks when the workers were shared", requestor)
+	}
+	return fmt.Sprintf("requestor %s is at its share with %d tasks running and %d starting, weight %g, min share %d",
+		requestor, rs.numRunning, rs.numStarting, rs.weight, rs.minShare)
+}
+
+// Returns true if rs should be given the next worker before other: requestors below their min share
+// come first, then the requestor with the fewest tasks per unit of weight.
+func (rs *requestorShare) furtherBelowShare(other *requestorShare) bool {
+	numTasks, otherNumTasks := rs.numRunning+rs.numStarting, other.numRunning+other.numStarting
+	belowMin, otherBelowMin := numTasks < rs.minShare, otherNumTasks < other.minShare
+	if belowMin != otherBelowMin {
+		return belowMin
+	}
+	return float64(numTasks)/rs.weight < float64(otherNumTasks)/other.weight
+}
+
+// Returns the requestor's numStarting tasks, taking each from the job with the fewest tasks running or starting.
+func (rs *requestorShare) tasksToStart() []*taskState {
+	tasks := []*taskState{}
+	for len(tasks) < rs.numStarting {
+		var next *fairShareJob
+		for _, job := range rs.jobs {
+			if job.numStarting < len(job.waitingTasks) &&
+				(next == nil || job.jobState.TasksRunning+job.numStarting < next.jobState.TasksRunning+next.numStarting) {
+				next = job
+			}
+		}
+		tasks = append(tasks, next.waitingTasks[next.numStarting])
+		next.numStarting++
+	}
+	return tasks
+}
+
+// getWeight returns the weight of the requestor.
+func (fs *FairShareAlg) getWeight(requestor string) float64 {
+	if weight, ok := matchRequestorRe(fs.weights, requestor); ok {
+		return weight
+	}
+	return fs.defaultWeight
+}
+
+// getMinShare returns the number of workers the requestor is guaranteed.
+func (fs *FairShareAlg) getMinShare(requestor string) int {
+	minShare, _ := matchRequestorRe(fs.minShares, requestor)
+	return int(minShare)
+}
+
+// matchRequestorRe returns the value of the first of res matching the requestor.
+func matchRequestorRe(res []requestorRe, requestor string) (float64, bool) {
+	for _, r := range res {
+		if r.re.MatchString(requestor) {
+			return r.value, true
+		}
+	}
+	return 0, false
+}
+
+func sortedKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
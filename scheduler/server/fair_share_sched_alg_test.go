@@ -0,0 +1,155 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/twitter/scoot/common/stats"
+	"github.com/twitter/scoot/scheduler/domain"
+)
+
+// makeFairShareJob make a requestor's job with numRunning running tasks and numWaiting tasks waiting to start.
+func makeFairShareJob(jobId, requestor string, numRunning, numWaiting int) *jobState {
+	defs, ts := makeTestTasks(jobId, numWaiting)
+	return &jobState{
+		Job: &domain.Job{
+			Id:  jobId,
+			Def: domain.JobDefinition{Requestor: requestor, Tasks: defs},
+		},
+		Tasks:        ts,
+		TasksRunning: numRunning,
+	}
+}
+
+func makeFairShareCluster(numWorkers, numRunning int) *clusterState {
+	cs := &clusterState{nodeGroups: makeIdleGroup(numWorkers), numRunning: numRunning}
+	cs.nodes = cs.nodeGroups["idle"].idle
+	return cs
+}
+
+// countTasksToStart returns the number of tasks to start by requestor.
+func countTasksToStart(t *testing.T, config FairShareAlgConfig, cs *clusterState, jobs ...*jobState) map[string]int {
+	fs, err := NewFairShareAlg(config)
+	assert.Nil(t, err)
+	jobsByRequestor := map[string][]*jobState{}
+	jobsByID := map[string]*jobState{}
+	for _, j := range jobs {
+		jobsByRequestor[j.Job.Def.Requestor] = append(jobsByRequestor[j.Job.Def.Requestor], j)
+		jobsByID[j.Job.Id] = j
+	}
+	tasks, stopTasks := fs.GetTasksToBeAssigned(nil, stats.NilStatsReceiver(), cs, jobsByRequestor)
+	assert.Empty(t, stopTasks)
+	counts := map[string]int{}
+	for _, task := range tasks {
+		counts[jobsByID[task.JobId].Job.Def.Requestor]++
+	}
+	return counts
+}
+
+func TestFairShareWeights(t *testing.T) {
+	config := FairShareAlgConfig{Weights: map[string]float64{"land.*": 3}}
+	counts := countTasksToStart(t, config, makeFairShareCluster(8, 0),
+		makeFairShareJob("job1", "land", 0, 100),
+		makeFairShareJob("job2", "diff", 0, 100))
+	assert.Equal(t, map[string]int{"land": 6, "diff": 2}, counts)
+
+	// the running tasks count towards a requestor's share
+	counts = countTasksToStart(t, config, makeFairShareCluster(8, 4),
+		makeFairShareJob("job1", "land", 4, 100),
+		makeFairShareJob("job2", "diff", 0, 100))
+	assert.Equal(t, map[string]int{"land": 2, "diff": 2}, counts)
+
+	// the share a requestor doesn't use goes to the others
+	counts = countTasksToStart(t, config, makeFairShareCluster(8, 0),
+		makeFairShareJob("job1", "land", 0, 100),
+		makeFairShareJob("job2", "diff", 0, 1))
+	assert.Equal(t, map[string]int{"land": 7, "diff": 1}, counts)
+}
+
+func TestFairShareMinShares(t *testing.T) {
+	config := FairShareAlgConfig{Weights: map[string]float64{"land.*": 10}, MinShares: map[string]int{"diff.*": 3}}
+	counts := countTasksToStart(t, config, makeFairShareCluster(4, 0),
+		makeFairShareJob("job1", "land", 0, 100),
+		makeFairShareJob("job2", "diff", 0, 100))
+	assert.Equal(t, map[string]int{"land": 1, "diff": 3}, counts)
+
+	// running tasks aren't stopped, the min share is met as workers free up
+	counts = countTasksToStart(t, config, makeFairShareCluster(4, 3),
+		makeFairShareJob("job1", "land", 3, 100),
+		makeFairShareJob("job2", "diff", 0, 100))
+	assert.Equal(t, map[string]int{"diff": 1}, counts)
+}
+
+func TestFairShareRoundRobinJobs(t *testing.T) {
+	job1 := makeFairShareJob("job1", "land", 2, 10)
+	job2 := makeFairShareJob("job2", "land", 0, 10)
+	fs, err := NewFairShareAlg(FairShareAlgConfig{})
+	assert.Nil(t, err)
+	tasks, _ := fs.GetTasksToBeAssigned(nil, stats.NilStatsReceiver(), makeFairShareCluster(5, 2),
+		map[string][]*jobState{"land": {job1, job2}})
+
+	// job2 catches up with job1 first, the jobs' tasks start in the order they're defined
+	var ids []string
+	for _, task := range tasks {
+		ids = append(ids, fmt.Sprintf("%s/%s", task.JobId, task.TaskId))
+	}
+	assert.Equal(t, []string{"job2/0", "job2/1", "job1/0"}, ids)
+}
+
+func TestNewSchedulingAlgorithm(t *testing.T) {
+	sa, err := NewSchedulingAlgorithm("", nil, SchedAlgDeps{})
+	assert.Nil(t, err)
+	lbs, ok := sa.(*LoadBasedAlg)
+	assert.True(t, ok, "expected the load based algorithm by default, got %T", sa)
+	assert.Equal(t, DefaultLoadBasedSchedulerClassPercents, lbs.getClassLoadPercents())
+
+	sa, err = NewSchedulingAlgorithm(LoadBasedAlgName,
+		json.RawMessage(`{"ClassLoadPercents": {"land": 70, "diff": 30}, "RebalanceThreshold": 20}`), SchedAlgDeps{})
+	assert.Nil(t, err)
+	lbs = sa.(*LoadBasedAlg)
+	assert.Equal(t, map[string]int32{"land": 70, "diff": 30}, lbs.getClassLoadPercents())
+	assert.Equal(t, 20, lbs.getRebalanceThreshold())
+	assert.Equal(t, DefaultMinRebalanceTime, lbs.getRebalanceMinimumDuration())
+
+	sa, err = NewSchedulingAlgorithm(FairShareAlgName, json.RawMessage(`{"DefaultWeight": 2}`), SchedAlgDeps{})
+	assert.Nil(t, err)
+	assert.IsType(t, &FairShareAlg{}, sa)
+
+	for _, c := range []struct{ name, config string }{
+		{"fifo", ""},
+		{FairShareAlgName, `{"Weights": {"land(": 1}}`},
+		{FairShareAlgName, `{"Weights": {"land.*": 0}}`},
+		{FairShareAlgName, `{"MinShares": {"diff.*": -1}}`},
+		{LoadBasedAlgName, `{"RebalanceMinimumDuration": "soon"}`},
+	} {
+		_, err := NewSchedulingAlgorithm(c.name, json.RawMessage(c.config), SchedAlgDeps{})
+		assert.NotNil(t, err, "expected an error creating %s from %s", c.name, c.config)
+	}
+}
+
+func Test_StatefulScheduler_InvalidSchedAlg(t *testing.T) {
+	deps := getDefaultSchedDeps()
+	deps.config.SchedAlgName = "fifo"
+	assert.Panics(t, func() { makeStatefulSchedulerDeps(deps) },
+		"expected creating a scheduler with an unknown algorithm to panic")
+}
+
+func Test_StatefulScheduler_FairShareAlg(t *testing.T) {
+	deps := getDefaultSchedDeps()
+	deps.config.SchedAlgName = FairShareAlgName
+	s := makeStatefulSchedulerDeps(deps)
+	if _, ok := s.config.SchedAlg.(*FairShareAlg); !ok {
+		t.Fatalf("Expected the fair share algorithm, got %T", s.config.SchedAlg)
+	}
+	if _, err := s.GetClassLoadPercents(); err == nil {
+		t.Errorf("Expected no class load percents without the load based algorithm")
+	}
+
+	jobId := scheduleJobDef(t, s, domain.GenJobDef(2))
+	for s.getJob(jobId) != nil {
+		s.step()
+	}
+}
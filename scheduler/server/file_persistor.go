@@ -0,0 +1,153 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultMaxSettingsVersions the number of settings versions a FilePersistor keeps by default
+const DefaultMaxSettingsVersions = 50
+
+// SettingsVersion a version of the persisted settings, numbered from 1 in the order they were persisted
+type SettingsVersion struct {
+	Version     int               `json:"version"`
+	PersistedAt time.Time         `json:"persistedAt"`
+	Settings    PersistedSettings `json:"settings"`
+}
+
+// SettingsHistory is implemented by the Persistors that keep the prior versions of the settings,
+// so the scheduler can list them and roll back to one of them.
+type SettingsHistory interface {
+	Persistor
+	// the versions kept, oldest first
+	ListSettingsVersions() ([]SettingsVersion, error)
+}
+
+// settingsFile the contents of a FilePersistor's file
+type settingsFile struct {
+	Versions []SettingsVersion `json:"versions"`
+}
+
+// FilePersistor persists the settings as JSON to a file, keeping the last maxVersions versions.
+// The file is replaced atomically: the new contents are written and fsynced to a temp file in the
+// same directory that is renamed over the file, so a crash leaves either the old or the new settings.
+type FilePersistor struct {
+	fileName    string
+	maxVersions int
+	mu          sync.Mutex
+}
+
+// NewFilePersistor creates a FilePersistor for fileName, creating its directory if needed.
+// maxVersions <= 0 keeps DefaultMaxSettingsVersions versions.
+func NewFilePersistor(fileName string, maxVersions int) (*FilePersistor, error) {
+	if fileName == "" {
+		return nil, fmt.Errorf("no settings file name")
+	}
+	if maxVersions <= 0 {
+		maxVersions = DefaultMaxSettingsVersions
+	}
+	if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+		return nil, err
+	}
+	return &FilePersistor{fileName: fileName, maxVersions: maxVersions}, nil
+}
+
+// PersistSettings adds the settings as the latest version, dropping the oldest versions over maxVersions.
+func (p *FilePersistor) PersistSettings(settings *PersistedSettings) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	sf, err := p.read()
+	if err != nil {
+		return err
+	}
+	version := 1
+	if n := len(sf.Versions); n > 0 {
+		version = sf.Versions[n-1].Version + 1
+	}
+	sf.Versions = append(sf.Versions, SettingsVersion{Version: version, PersistedAt: time.Now(), Settings: *settings})
+	if n := len(sf.Versions); n > p.maxVersions {
+		sf.Versions = sf.Versions[n-p.maxVersions:]
+	}
+	if err := p.write(sf); err != nil {
+		return err
+	}
+	log.Infof("persisted settings version %d to %s, changed by %s: %s", version, p.fileName, settings.ChangedBy, settings.Change)
+	return nil
+}
+
+// LoadSettings returns the latest version of the settings, nil if none were persisted.
+func (p *FilePersistor) LoadSettings() (*PersistedSettings, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	sf, err := p.read()
+	if err != nil || len(sf.Versions) == 0 {
+		return nil, err
+	}
+	return &sf.Versions[len(sf.Versions)-1].Settings, nil
+}
+
+// ListSettingsVersions returns the versions kept, oldest first.
+func (p *FilePersistor) ListSettingsVersions() ([]SettingsVersion, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	sf, err := p.read()
+	if err != nil {
+		return nil, err
+	}
+	return sf.Versions, nil
+}
+
+// read the file, returns no versions if the file doesn't exist yet.
+func (p *FilePersistor) read() (*settingsFile, error) {
+	sf := &settingsFile{}
+	content, err := ioutil.ReadFile(p.fileName)
+	if os.IsNotExist(err) {
+		return sf, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(content, sf); err != nil {
+		return nil, fmt.Errorf("couldn't parse settings file %s: %s", p.fileName, err)
+	}
+	return sf, nil
+}
+
+// write the file atomically, fsyncing the temp file before it's renamed and the directory after.
+func (p *FilePersistor) write(sf *settingsFile) error {
+	content, err := json.MarshalIndent(sf, "", "  ")
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(p.fileName)
+	tmp, err := ioutil.TempFile(dir, filepath.Base(p.fileName)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), p.fileName); err != nil {
+		return err
+	}
+	if d, err := os.Open(dir); err == nil {
+		d.Sync()
+		d.Close()
+	}
+	return nil
+}
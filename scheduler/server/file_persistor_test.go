@@ -0,0 +1,125 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func makeTestFilePersistor(t *testing.T, maxVersions int) (*FilePersistor, func()) {
+	tmpDir, err := ioutil.TempDir("", "file_persistor")
+	if err != nil {
+		t.Fatalf("Unexpected error creating a temp dir: %v", err)
+	}
+	p, err := NewFilePersistor(filepath.Join(tmpDir, "settings.json"), maxVersions)
+	if err != nil {
+		t.Fatalf("Unexpected error creating the file persistor: %v", err)
+	}
+	return p, func() { os.RemoveAll(tmpDir) }
+}
+
+func TestFilePersistor(t *testing.T) {
+	p, cleanup := makeTestFilePersistor(t, 3)
+	defer cleanup()
+
+	if settings, err := p.LoadSettings(); settings != nil || err != nil {
+		t.Fatalf("Expected no settings before any were persisted, got %+v, err: %v", settings, err)
+	}
+	for throttle := 1; throttle <= 4; throttle++ {
+		if err := p.PersistSettings(&PersistedSettings{Throttle: throttle, ChangedBy: "admin"}); err != nil {
+			t.Fatalf("Unexpected error persisting settings: %v", err)
+		}
+	}
+
+	// a new persistor on the same file reads the latest version, the oldest version was dropped
+	reopened, err := NewFilePersistor(p.fileName, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error reopening the file persistor: %v", err)
+	}
+	settings, err := reopened.LoadSettings()
+	if err != nil || settings == nil || settings.Throttle != 4 || settings.ChangedBy != "admin" {
+		t.Errorf("Expected the latest settings, got %+v, err: %v", settings, err)
+	}
+	versions, err := reopened.ListSettingsVersions()
+	if err != nil || len(versions) != 3 || versions[0].Version != 2 || versions[2].Version != 4 {
+		t.Fatalf("Expected versions 2 to 4, got %+v, err: %v", versions, err)
+	}
+	if versions[0].Settings.Throttle != 2 || versions[0].PersistedAt.IsZero() {
+		t.Errorf("Unexpected version %+v", versions[0])
+	}
+
+	// no temp files are left behind
+	if files, _ := ioutil.ReadDir(filepath.Dir(p.fileName)); len(files) != 1 {
+		t.Errorf("Expected only the settings file, got %d files", len(files))
+	}
+
+	if err := ioutil.WriteFile(p.fileName, []byte("{"), 0644); err != nil {
+		t.Fatalf("Unexpected error corrupting the settings file: %v", err)
+	}
+	if _, err := p.LoadSettings(); err == nil {
+		t.Errorf("Expected an error loading a corrupt settings file")
+	}
+}
+
+func Test_StatefulScheduler_RollbackSettings(t *testing.T) {
+	p, cleanup := makeTestFilePersistor(t, 0)
+	defer cleanup()
+	s := makeDefaultStatefulScheduler()
+
+	if _, err := s.GetSettingsHistory(); err == nil {
+		t.Errorf("Expected an error getting the history without a file persistor")
+	}
+	s.SetPersistor(p)
+	threshold, _ := s.GetRebalanceThreshold()
+
+	if err := s.SetSchedulerStatus(10, "alice"); err != nil {
+		t.Fatalf("Unexpected error setting the throttle: %v", err)
+	}
+	if err := s.SetRebalanceThreshold(25, "bob"); err != nil {
+		t.Fatalf("Unexpected error setting the rebalance threshold: %v", err)
+	}
+	if err := s.SetSchedulerStatus(20, ""); err != nil {
+		t.Fatalf("Unexpected error setting the throttle: %v", err)
+	}
+
+	versions, err := s.GetSettingsHistory()
+	if err != nil || len(versions) != 3 {
+		t.Fatalf("Expected 3 versions, got %+v, err: %v", versions, err)
+	}
+	if v := versions[1].Settings; v.ChangedBy != "bob" || v.Change != "rebalance threshold set to 25" || v.Throttle != 10 {
+		t.Errorf("Unexpected version 2 %+v", v)
+	}
+	if v := versions[2].Settings; v.ChangedBy != "unknown" || v.Throttle != 20 {
+		t.Errorf("Unexpected version 3 %+v", v)
+	}
+
+	if err := s.RollbackSettings(1, "carol"); err != nil {
+		t.Fatalf("Unexpected error rolling back: %v", err)
+	}
+	if _, throttle := s.GetSchedulerStatus(); throttle != 10 {
+		t.Errorf("Expected the throttle of version 1, got %d", throttle)
+	}
+	if rolledBack, _ := s.GetRebalanceThreshold(); rolledBack != threshold {
+		t.Errorf("Expected the rebalance threshold of version 1, got %d", rolledBack)
+	}
+	versions, _ = s.GetSettingsHistory()
+	if latest := versions[len(versions)-1]; latest.Version != 4 || latest.Settings.ChangedBy != "carol" ||
+		latest.Settings.Change != "rolled back to version 1" {
+		t.Errorf("Expected the rollback to be persisted as version 4, got %+v", latest)
+	}
+	if err := s.RollbackSettings(9, "carol"); err == nil {
+		t.Errorf("Expected an error rolling back to an unknown version")
+	}
+
+	// a restarted scheduler uses the latest version without persisting a new one
+	restarted := makeDefaultStatefulScheduler()
+	restarted.SetPersistor(p)
+	restarted.loadSettings()
+	if _, throttle := restarted.GetSchedulerStatus(); throttle != 10 {
+		t.Errorf("Expected the persisted throttle after a restart, got %d", throttle)
+	}
+	if versions, _ := restarted.GetSettingsHistory(); len(versions) != 4 {
+		t.Errorf("Expected no new version on restart, got %d versions", len(versions))
+	}
+}
@@ -7,8 +7,10 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/twitter/scoot/runner"
 	"github.com/twitter/scoot/saga"
 	"github.com/twitter/scoot/scheduler/domain"
+	worker "github.com/twitter/scoot/worker/domain"
 )
 
 type taskStateByTaskID map[string]*taskState
@@ -22,6 +24,8 @@ type jobState struct {
 	EndingSaga     bool         //denotes whether an EndSagaMsg is in progress or not
 	TasksCompleted int          //number of tasks that've been marked completed so far.
 	TasksRunning   int          //number of tasks that've been scheduled or started.
+	RollingBack    bool         //denotes whether the job was aborted and its cleanup tasks are being run
+	CleanupTasks   []*taskState //the cleanup tasks run while rolling back, see rollback.go
 	stateMu        sync.RWMutex
 	JobKilled      bool      //indicates the job was killed
 	TimeCreated    time.Time //when was this job first created
@@ -41,6 +45,20 @@ type taskState struct {
 	NumTimesTried int
 	TaskRunner    *taskRunner
 	AvgDuration   time.Duration //average duration for previous runs with this taskId, if any.
+	Succeeded     bool          //true if the task completed with exit code 0, dependent tasks are only run after success.
+	Unschedulable string        //why no node can run the task, "" if some node matches its required labels.
+
+	// Statuses of the task's earlier, failed runs that were retried, oldest first,
+	// and when the next run can start, per the backoff in the task's retry policy.
+	Attempts   []runner.RunStatus
+	RetryAfter time.Time
+
+	// A duplicate attempt of the current run, started once the run straggles, see speculative.go.
+	SpeculativeRunner *taskRunner
+
+	// Why the waiting task wasn't started in the last scheduling iteration, see explain.go.
+	WaitReason domain.WaitReason
+	WaitDetail string
 }
 
 type taskStatesByDuration []*taskState
@@ -110,14 +128,28 @@ func newJobState(job *domain.Job, jobClass string, saga *saga.Saga, taskDuration
 	}
 
 	// Assumes Forward Recovery only, tasks are either
-	// done or not done.  Scheduler currently doesn't support
-	// scheduling compensating tasks.  In Progress tasks
+	// done or not done.  In Progress tasks
 	// are considered not done and will be rescheduled.
+	// The logged end status tells us whether a completed task
+	// succeeded, which determines if its dependents can run.
+	// An aborted saga's tasks are all done, its cleanup tasks
+	// are picked up again once the job is checked for completion.
+	// In Progress tasks keep the attempts logged with their
+	// start status, so they're retried per their retry policy.
 	sagaState := saga.GetState()
 	for _, taskId := range sagaState.GetTaskIds() {
+		task := j.getTask(taskId)
+		if task == nil {
+			// the job's cleanup, which isn't one of its tasks
+			continue
+		}
 		if sagaState.IsTaskCompleted(taskId) {
-			j.getTask(taskId).Status = domain.Completed
+			task.Status = domain.Completed
+			task.Succeeded = runSucceeded(sagaState.GetEndTaskData(taskId))
 			j.TasksCompleted++
+		} else if sagaState.IsTaskStarted(taskId) {
+			task.Attempts = recoverAttempts(sagaState.GetStartTaskData(taskId))
+			task.NumTimesTried = len(task.Attempts)
 		}
 	}
 
@@ -134,12 +166,29 @@ func (j *jobState) getTask(taskId string) *taskState {
 	return nil
 }
 
+// Returns true if the serialized RunStatus logged with an EndTask message describes a successful run.
+func runSucceeded(endTaskData []byte) bool {
+	if endTaskData == nil {
+		return false
+	}
+	st, err := worker.DeserializeProcessStatus(endTaskData)
+	if err != nil {
+		log.Errorf("Couldn't deserialize end task status, treating task as failed: %v", err)
+		return false
+	}
+	return st.State == runner.COMPLETE && st.ExitCode == 0
+}
+
 // Returns a list of taskIds that can be scheduled currently.
+// A task can be scheduled once it's not started, all of its dependencies have succeeded,
+// some node matches its required labels, and the backoff before retrying it, if any, has passed.
 func (j *jobState) getUnScheduledTasks() []*taskState {
 	var tasksToRun []*taskState
+	now := time.Now()
 
 	for _, state := range j.Tasks {
-		if state.Status == domain.NotStarted {
+		if state.Status == domain.NotStarted && state.Unschedulable == "" && !now.Before(state.RetryAfter) &&
+			j.dependenciesSucceeded(state) {
 			tasksToRun = append(tasksToRun, state)
 		}
 	}
@@ -147,6 +196,46 @@ func (j *jobState) getUnScheduledTasks() []*taskState {
 	return tasksToRun
 }
 
+// Returns true if every dependency of the given task has completed successfully.
+func (j *jobState) dependenciesSucceeded(task *taskState) bool {
+	for _, dep := range task.Def.Dependencies {
+		depTask := j.getTask(dep)
+		if depTask == nil || depTask.Status != domain.Completed || !depTask.Succeeded {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the first dependency of the given task that completed without succeeding, or "" if there is none.
+func (j *jobState) getFailedDependency(task *taskState) string {
+	for _, dep := range task.Def.Dependencies {
+		depTask := j.getTask(dep)
+		if depTask != nil && depTask.Status == domain.Completed && !depTask.Succeeded {
+			return dep
+		}
+	}
+	return ""
+}
+
+// Returns the not started tasks that can never run because a dependency failed.
+// Skipping a task fails it in turn, so the tasks that depend on it are included as well.
+// Note: the returned tasks are marked completed (without success) before returning.
+func (j *jobState) skipTasksWithFailedDependencies() []*taskState {
+	var skipped []*taskState
+	for found := true; found; {
+		found = false
+		for _, task := range j.Tasks {
+			if task.Status == domain.NotStarted && j.getFailedDependency(task) != "" {
+				j.taskCompleted(task.TaskId, false, false)
+				skipped = append(skipped, task)
+				found = true
+			}
+		}
+	}
+	return skipped
+}
+
 // Update JobState to reflect that a Task has been started
 func (j *jobState) taskStarted(taskId string, tr *taskRunner) {
 	taskState := j.getTask(taskId)
@@ -168,10 +257,12 @@ func (j *jobState) taskStarted(taskId string, tr *taskRunner) {
 
 // Update JobState to reflect that a Task has been completed
 // Running param: true if taskStarted was called for this taskId.
-func (j *jobState) taskCompleted(taskId string, running bool) {
+// Succeeded param: true if the task's command completed with exit code 0.
+func (j *jobState) taskCompleted(taskId string, running bool, succeeded bool) {
 	taskState := j.getTask(taskId)
 	startTimeSec := taskState.TimeStarted.Truncate(time.Second)
 	taskState.Status = domain.Completed
+	taskState.Succeeded = succeeded
 	taskState.TimeStarted = nilTime
 	taskState.TaskRunner = nil
 	j.stateMu.Lock()
@@ -226,6 +317,44 @@ func (j *jobState) getJobStatus() domain.Status {
 	return domain.InProgress
 }
 
+// getSummary returns the job's definition fields and current progress.
+// A job none of whose tasks have been scheduled yet is NotStarted.
+func (j *jobState) getSummary() domain.JobSummary {
+	status := j.getJobStatus()
+	if status == domain.InProgress && j.TasksRunning == 0 && j.TasksCompleted == 0 {
+		status = domain.NotStarted
+	} else if j.RollingBack {
+		status = domain.RollingBack
+	}
+	return domain.JobSummary{
+		ID:                 j.Job.Id,
+		Status:             status,
+		Requestor:          j.Job.Def.Requestor,
+		Tag:                j.Job.Def.Tag,
+		Basis:              j.Job.Def.Basis,
+		JobType:            j.Job.Def.JobType,
+		Priority:           j.Job.Def.Priority,
+		NumTasks:           len(j.Tasks),
+		NumRunningTasks:    j.TasksRunning,
+		NumCompletedTasks:  j.TasksCompleted,
+		UnschedulableTasks: j.getUnschedulableTasks(),
+	}
+}
+
+// Returns the reason each waiting task can't be scheduled, by TaskID, nil if all of them can.
+func (j *jobState) getUnschedulableTasks() map[string]string {
+	var unschedulable map[string]string
+	for _, task := range j.Tasks {
+		if task.Status == domain.NotStarted && task.Unschedulable != "" {
+			if unschedulable == nil {
+				unschedulable = map[string]string{}
+			}
+			unschedulable[task.TaskId] = task.Unschedulable
+		}
+	}
+	return unschedulable
+}
+
 // addTaskToStartTimeMap add the running task to the map that bins running tasks by their class and start time
 func (j *jobState) addTaskToStartTimeMap(jobClass string, task *taskState, startTimeSec time.Time) {
 	if j.tasksByJobClassAndStartTimeSec == nil {
@@ -1,11 +1,17 @@
 package server
 
 import (
+	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/twitter/scoot/common/errors"
+	"github.com/twitter/scoot/common/log/tags"
+	"github.com/twitter/scoot/runner"
 	"github.com/twitter/scoot/saga/sagalogs"
 	"github.com/twitter/scoot/scheduler/domain"
 	"github.com/twitter/scoot/tests/testhelpers"
+	worker "github.com/twitter/scoot/worker/domain"
 )
 
 func Test_GetUnscheduledTasks_ReturnsAllUnscheduledTasks(t *testing.T) {
@@ -56,3 +62,88 @@ func Test_NewJobState_PreviousProgress_CompletedTasks(t *testing.T) {
 		t.Errorf("Expected all Tasks to be completed")
 	}
 }
+
+// Builds a job whose tasks form the chain task0 <- task1 <- task2, plus an independent task3.
+func genDependentJob() domain.Job {
+	job := domain.GenJob(testhelpers.GenJobId(testhelpers.NewRand()), 4)
+	for i := range job.Def.Tasks {
+		job.Def.Tasks[i].TaskID = fmt.Sprintf("task%d", i)
+	}
+	job.Def.Tasks[1].Dependencies = []string{"task0"}
+	job.Def.Tasks[2].Dependencies = []string{"task1"}
+	return job
+}
+
+func getTaskIds(tasks []*taskState) []string {
+	ids := []string{}
+	for _, task := range tasks {
+		ids = append(ids, task.TaskId)
+	}
+	return ids
+}
+
+func Test_GetUnscheduledTasks_Dependencies(t *testing.T) {
+	job := genDependentJob()
+	jobAsBytes, _ := job.Serialize()
+	saga, _ := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil).MakeSaga(job.Id, jobAsBytes)
+	jobState := newJobState(&job, "", saga, nil, nil, nopDurationKeyExtractor)
+
+	if ids := getTaskIds(jobState.getUnScheduledTasks()); !reflect.DeepEqual(ids, []string{"task0", "task3"}) {
+		t.Errorf("Expected only tasks without dependencies to be unscheduled, got %v", ids)
+	}
+
+	jobState.taskCompleted("task0", false, true)
+	if ids := getTaskIds(jobState.getUnScheduledTasks()); !reflect.DeepEqual(ids, []string{"task1", "task3"}) {
+		t.Errorf("Expected task1 to be released after task0 succeeded, got %v", ids)
+	}
+	if skipped := jobState.skipTasksWithFailedDependencies(); len(skipped) != 0 {
+		t.Errorf("Expected no tasks to be skipped, got %v", getTaskIds(skipped))
+	}
+}
+
+func Test_SkipTasksWithFailedDependencies(t *testing.T) {
+	job := genDependentJob()
+	jobAsBytes, _ := job.Serialize()
+	saga, _ := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil).MakeSaga(job.Id, jobAsBytes)
+	jobState := newJobState(&job, "", saga, nil, nil, nopDurationKeyExtractor)
+
+	jobState.taskCompleted("task0", false, false)
+	if ids := getTaskIds(jobState.getUnScheduledTasks()); !reflect.DeepEqual(ids, []string{"task3"}) {
+		t.Errorf("Expected dependents of a failed task not to be scheduled, got %v", ids)
+	}
+
+	skipped := jobState.skipTasksWithFailedDependencies()
+	if ids := getTaskIds(skipped); !reflect.DeepEqual(ids, []string{"task1", "task2"}) {
+		t.Errorf("Expected transitive dependents of task0 to be skipped, got %v", ids)
+	}
+	if jobState.GetNumCompleted() != 3 {
+		t.Errorf("Expected skipped tasks to be completed, got %d completed", jobState.GetNumCompleted())
+	}
+}
+
+func Test_NewJobState_PreviousProgress_Dependencies(t *testing.T) {
+	job := genDependentJob()
+	jobAsBytes, _ := job.Serialize()
+	saga, _ := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil).MakeSaga(job.Id, jobAsBytes)
+
+	// task0 succeeded and task1 failed before the scheduler restarted.
+	endTask := func(taskId string, exitCode int) {
+		st := runner.CompleteStatus("run", "", errors.ExitCode(exitCode), tags.LogTags{JobID: job.Id, TaskID: taskId})
+		asBytes, _ := worker.SerializeProcessStatus(st)
+		saga.StartTask(taskId, nil)
+		saga.EndTask(taskId, asBytes)
+	}
+	endTask("task0", 0)
+	endTask("task1", 1)
+	jobState := newJobState(&job, "", saga, nil, nil, nopDurationKeyExtractor)
+
+	if !jobState.getTask("task0").Succeeded || jobState.getTask("task1").Succeeded {
+		t.Errorf("Expected task success to be recovered from the saga log")
+	}
+	if ids := getTaskIds(jobState.getUnScheduledTasks()); !reflect.DeepEqual(ids, []string{"task3"}) {
+		t.Errorf("Expected only task3 to be scheduled after recovery, got %v", ids)
+	}
+	if ids := getTaskIds(jobState.skipTasksWithFailedDependencies()); !reflect.DeepEqual(ids, []string{"task2"}) {
+		t.Errorf("Expected task2 to be skipped after recovery, got %v", ids)
+	}
+}
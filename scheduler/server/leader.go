@@ -0,0 +1,72 @@
+package server
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/twitter/scoot/common/stats"
+)
+
+// LeaderElector elects one of several schedulers sharing a saga log to schedule jobs,
+// the others stand by to take over.  Implemented by leader.Elector.
+type LeaderElector interface {
+	// Elected returns a channel that is closed once this scheduler is elected.
+	Elected() <-chan struct{}
+	// Deposed returns a channel that is closed once this scheduler stops being the leader.
+	Deposed() <-chan struct{}
+	// IsLeader returns true if this scheduler is currently the leader.
+	IsLeader() bool
+	// Leader returns the address of the current leader, or "" if there is none.
+	Leader() (string, error)
+}
+
+// NotLeaderError is returned for requests made to a scheduler that is standing by.
+type NotLeaderError struct {
+	Leader string // address of the current leader, "" while it is being elected
+}
+
+func (e *NotLeaderError) Error() string {
+	if e.Leader == "" {
+		return "scheduler is not the leader, and no leader is currently elected"
+	}
+	return fmt.Sprintf("scheduler is not the leader, the leader is %s", e.Leader)
+}
+
+// Returns a NotLeaderError unless the scheduler is the leader or leader election is not configured.
+func (s *statefulScheduler) checkLeader() error {
+	if s.config.Elector == nil || s.config.Elector.IsLeader() {
+		return nil
+	}
+	leader, err := s.config.Elector.Leader()
+	if err != nil {
+		log.Errorf("Error getting the scheduler leader: %v", err)
+	}
+	return &NotLeaderError{Leader: leader}
+}
+
+// Waits to be elected, then takes over scheduling by loading the saga log, recovering
+// its jobs and starting the scheduler loop.  A deposed scheduler's state can't be trusted
+// once another scheduler takes over its jobs, so it calls leadershipLostFn.
+func (s *statefulScheduler) runWhenElected() {
+	log.Info("Standing by until elected scheduler leader")
+	<-s.config.Elector.Elected()
+
+	log.Info("Elected scheduler leader, taking over scheduling")
+	s.stat.Counter(stats.SchedLeaderTakeoverCounter).Inc(1)
+	// the previous leader may have changed the settings since they were loaded at startup
+	s.loadSettings()
+	// a log that indexes the sagas in memory is only loaded now, once the previous leader stopped writing to it
+	if err := s.sagaCoord.LoadLog(); err != nil {
+		log.Fatalf("Error loading the saga log after being elected scheduler leader: %v", err)
+	}
+	s.start(true)
+
+	<-s.config.Elector.Deposed()
+	s.leadershipLostFn()
+}
+
+// By default a deposed scheduler exits, so it can be restarted as a follower.
+func exitOnLeadershipLost() {
+	log.Fatal("Lost scheduler leadership, exiting so the new leader is the only scheduler running jobs")
+}
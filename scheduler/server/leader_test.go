@@ -0,0 +1,144 @@
+package server
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/twitter/scoot/saga/sagalogs"
+	"github.com/twitter/scoot/scheduler/domain"
+)
+
+// LeaderElector that is elected and deposed by the test.
+type fakeElector struct {
+	electedCh chan struct{}
+	deposedCh chan struct{}
+	leader    bool
+	mutex     sync.Mutex
+}
+
+func newFakeElector() *fakeElector {
+	return &fakeElector{electedCh: make(chan struct{}), deposedCh: make(chan struct{})}
+}
+
+func (e *fakeElector) Elected() <-chan struct{} { return e.electedCh }
+func (e *fakeElector) Deposed() <-chan struct{} { return e.deposedCh }
+
+func (e *fakeElector) IsLeader() bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.leader
+}
+
+func (e *fakeElector) Leader() (string, error) {
+	if e.IsLeader() {
+		return "self:9090", nil
+	}
+	return "other:9090", nil
+}
+
+func (e *fakeElector) setLeader(leader bool) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.leader = leader
+	if leader {
+		close(e.electedCh)
+	} else {
+		close(e.deposedCh)
+	}
+}
+
+// Ends the leadership without deposing, as when the lease expired before the elector noticed.
+func (e *fakeElector) expire() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.leader = false
+}
+
+func Test_StatefulScheduler_StandbyTakesOverWhenElected(t *testing.T) {
+	deps := getDefaultSchedDeps()
+	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	deps.sc = sc
+	elector := newFakeElector()
+	deps.config.Elector = elector
+
+	// a job started by the previous leader
+	job := domain.GenJob("previousLeaderJob", 2)
+	jobData, _ := (&job).Serialize()
+	sc.MakeSaga(job.Id, jobData)
+
+	s := makeStatefulSchedulerDeps(deps)
+	persistor := &memPersistor{}
+	s.SetPersistor(persistor)
+	deposed := make(chan struct{})
+	s.leadershipLostFn = func() { close(deposed) }
+
+	_, err := s.ScheduleJob(domain.GenJobDef(1))
+	if notLeader, ok := err.(*NotLeaderError); !ok || notLeader.Leader != "other:9090" {
+		t.Fatalf("Expected a standby scheduler to reject jobs with the leader's address, got %v", err)
+	}
+	if err := s.KillJob(job.Id); err == nil {
+		t.Errorf("Expected a standby scheduler to reject kill requests")
+	}
+	if _, err := s.ListJobs(domain.JobFilter{}); err == nil {
+		t.Errorf("Expected a standby scheduler to reject list requests")
+	}
+	if err := s.OfflineWorker(domain.OfflineWorkerReq{ID: "node1"}); err == nil {
+		t.Errorf("Expected a standby scheduler to reject offline requests")
+	}
+	if err := s.ReinstateWorker(domain.ReinstateWorkerReq{ID: "node1"}); err == nil {
+		t.Errorf("Expected a standby scheduler to reject reinstate requests")
+	}
+	if err := s.SetSchedulerStatus(5, "alice"); err == nil {
+		t.Errorf("Expected a standby scheduler to reject throttle changes")
+	}
+	if err := s.SetRebalanceThreshold(5, "alice"); err == nil {
+		t.Errorf("Expected a standby scheduler to reject rebalance threshold changes")
+	}
+	if err := s.RollbackSettings(1, "alice"); err == nil {
+		t.Errorf("Expected a standby scheduler to reject settings rollbacks")
+	}
+	if persistor.settings != nil {
+		t.Errorf("Expected a standby scheduler not to persist settings, got %+v", persistor.settings)
+	}
+	s.step()
+	if len(s.inProgressJobs) != 0 {
+		t.Fatalf("Expected a standby scheduler not to recover jobs, got %d jobs", len(s.inProgressJobs))
+	}
+
+	// settings the previous leader persisted after this scheduler started
+	persistor.settings = &PersistedSettings{Throttle: 7, OfflinedWorkers: []OfflinedWorker{{ID: "node2"}}}
+	elector.setLeader(true)
+	for start := time.Now(); s.getJob(job.Id) == nil; s.step() {
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("Timed out waiting for the new leader to recover job %s", job.Id)
+		}
+	}
+	if s.checkLeader() != nil {
+		t.Errorf("Expected the elected scheduler to accept requests")
+	}
+	if _, throttle := s.GetSchedulerStatus(); throttle != 7 {
+		t.Errorf("Expected the new leader to reload the persisted throttle, got %d", throttle)
+	}
+	if offlined := s.getOfflinedWorkers(); len(offlined) != 1 || offlined[0].ID != "node2" {
+		t.Errorf("Expected the new leader to reload the offlined workers, got %+v", offlined)
+	}
+
+	// a leader whose lease expired stops scheduling before it's deposed
+	elector.expire()
+	job2 := domain.GenJob("lateJob", 1)
+	job2Data, _ := (&job2).Serialize()
+	saga2, _ := sc.MakeSaga(job2.Id, job2Data)
+	s.addJobCh <- jobAddedMsg{job: &job2, saga: saga2}
+	s.step()
+	if s.getJob(job2.Id) != nil {
+		t.Errorf("Expected a scheduler whose lease expired not to add jobs")
+	}
+
+	elector.setLeader(false)
+	select {
+	case <-deposed:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for the deposed scheduler to give up leadership")
+	}
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"regexp"
@@ -11,7 +12,6 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/twitter/scoot/common/stats"
-	"github.com/twitter/scoot/scheduler/domain"
 )
 
 const (
@@ -114,6 +114,53 @@ func NewLoadBasedAlg(config *LoadBasedAlgConfig, tasksByJobClassAndStartTimeSec
 	return lbs
 }
 
+// LoadBasedAlgJSONConfig the load based algorithm's JSON config.  Settings loaded by the scheduler's
+// Persistor take precedence over these when the scheduler starts.
+type LoadBasedAlgJSONConfig struct {
+	ClassLoadPercents        map[string]int32  `json:"ClassLoadPercents"`        // default to DefaultLoadBasedSchedulerClassPercents
+	RequestorToClassMap      map[string]string `json:"RequestorToClassMap"`      // default to DefaultRequestorToClassMap
+	RebalanceMinimumDuration string            `json:"RebalanceMinimumDuration"` // default to 4m
+	RebalanceThreshold       int               `json:"RebalanceThreshold"`       // default to 0, no rebalancing
+}
+
+// newLoadBasedAlgFromJSON the SchedAlgFactory of the load based algorithm.
+func newLoadBasedAlgFromJSON(config json.RawMessage, deps SchedAlgDeps) (SchedulingAlgorithm, error) {
+	jc := LoadBasedAlgJSONConfig{}
+	if err := decodeSchedAlgConfig(config, &jc); err != nil {
+		return nil, err
+	}
+	if len(jc.ClassLoadPercents) == 0 {
+		jc.ClassLoadPercents = DefaultLoadBasedSchedulerClassPercents
+	}
+	for className, pct := range jc.ClassLoadPercents {
+		if pct < 0 {
+			return nil, fmt.Errorf("negative load percent %d for class %s", pct, className)
+		}
+	}
+	if jc.RequestorToClassMap == nil {
+		jc.RequestorToClassMap = DefaultRequestorToClassMap
+	}
+	for reqRe := range jc.RequestorToClassMap {
+		if _, err := regexp.Compile(reqRe); err != nil {
+			return nil, fmt.Errorf("invalid requestor regular expression %s: %v", reqRe, err)
+		}
+	}
+	rebalanceMinDuration := DefaultMinRebalanceTime
+	if jc.RebalanceMinimumDuration != "" {
+		var err error
+		if rebalanceMinDuration, err = time.ParseDuration(jc.RebalanceMinimumDuration); err != nil {
+			return nil, err
+		}
+	}
+
+	sa := NewLoadBasedAlg(&LoadBasedAlgConfig{stat: deps.Stat}, deps.tasksByJobClassAndStartTimeSec)
+	sa.setClassLoadPercents(jc.ClassLoadPercents)
+	sa.setRequestorToClassMap(jc.RequestorToClassMap)
+	sa.setRebalanceMinimumDuration(rebalanceMinDuration)
+	sa.setRebalanceThreshold(jc.RebalanceThreshold)
+	return sa, nil
+}
+
 // jobWaitingTasks waiting task ids (in the order they should be started) for a job
 type jobWaitingTasks struct {
 	jobState     *jobState
@@ -287,11 +334,9 @@ func (lbs *LoadBasedAlg) initJobClassesMap(jobsByRequestor map[string][]*jobStat
 				jc.jobsByNumRunningTasks[job.TasksRunning] = []jobWaitingTasks{}
 			}
 			waitingTasks := []*taskState{}
-			for _, taskState := range job.Tasks {
-				if taskState.Status == domain.NotStarted {
-					waitingTasks = append(waitingTasks, taskState)
-					jc.origNumWaitingTasks++
-				}
+			for _, taskState := range job.getUnScheduledTasks() {
+				waitingTasks = append(waitingTasks, taskState)
+				jc.origNumWaitingTasks++
 			}
 			jc.jobsByNumRunningTasks[job.TasksRunning] = append(jc.jobsByNumRunningTasks[job.TasksRunning], jobWaitingTasks{jobState: job, waitingTasks: waitingTasks})
 			if job.TasksRunning > jc.maxTaskRunningMapIndex {
@@ -316,6 +361,28 @@ func GetRequestorClass(requestor string, requestorToClassMap map[string]string)
 	return ""
 }
 
+// explainWait tells why the last GetTasksToBeAssigned call didn't start the job's waiting tasks,
+// using the state of the job's class.
+func (lbs *LoadBasedAlg) explainWait(job *jobState) string {
+	if len(lbs.classByDescLoadPct) == 0 {
+		return "the load based algorithm hasn't run"
+	}
+	className := GetRequestorClass(job.Job.Def.Requestor, lbs.requestorReToClassMap)
+	jc, ok := lbs.jobClasses[className]
+	if !ok {
+		jc = lbs.jobClasses[lbs.classByDescLoadPct[len(lbs.classByDescLoadPct)-1]]
+	}
+	if jc.origTargetLoadPct == 0 {
+		return fmt.Sprintf("class %s has a 0%% load percent", jc.className)
+	}
+	if jc.numTasksToStart < 0 {
+		return fmt.Sprintf("class %s is over its %d%% load percent, stopping %d tasks to rebalance",
+			jc.className, jc.origTargetLoadPct, -jc.numTasksToStart)
+	}
+	return fmt.Sprintf("class %s has %d tasks running and %d starting, its %d%% load percent targets %d workers",
+		jc.className, jc.origNumRunningTasks, jc.numTasksToStart, jc.origTargetLoadPct, jc.origNumTargetedWorkers)
+}
+
 // computeNumTasksToStart - computes the the number of tasks to start for each class.
 // Perform the entitlement calculation first and if there are still unallocated wokers
 // and tasks waiting to start, compute the loan calculation.
@@ -0,0 +1,292 @@
+package server
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	cc "github.com/twitter/scoot/cloud/cluster"
+	"github.com/twitter/scoot/scheduler/domain"
+)
+
+// Workers are offlined and reinstated by the scheduler loop.  Each offlined worker has an OfflinedWorker
+// record saying who offlined it, why, and until when.  The records are persisted with the scheduler's
+// settings, and kept while the workers are out of the cluster, so that offlined workers stay offline
+// when they come back or the scheduler restarts.  Workers offlined for a duration are reinstated by
+// the scheduler loop once their offlining expires.
+
+// OfflinedWorker why and until when a worker is offline.
+type OfflinedWorker struct {
+	ID        string    `json:"id"`
+	Requestor string    `json:"requestor"`
+	Reason    string    `json:"reason,omitempty"`
+	Since     time.Time `json:"since"`
+	Until     time.Time `json:"until,omitempty"` // zero if the worker stays offline until it's reinstated
+}
+
+func (o *OfflinedWorker) expired(now time.Time) bool {
+	return !o.Until.IsZero() && !now.Before(o.Until)
+}
+
+// contains the offline or reinstate request and callback for the result of processing it
+type workerOfflineRequest struct {
+	offline    *domain.OfflineWorkerReq
+	reinstate  *domain.ReinstateWorkerReq
+	responseCh chan error
+}
+
+// Takes the node out of rotation and records why, returns false if the node isn't in the cluster.
+// An offlined node's record is updated.
+func (c *clusterState) offlineNode(offline *OfflinedWorker) bool {
+	id := cc.NodeId(offline.ID)
+	ns, ok := c.nodes[id]
+	if ok {
+		delete(c.nodes, id)
+	} else if ns, ok = c.suspendedNodes[id]; ok {
+		delete(c.suspendedNodes, id)
+	} else if ns, ok = c.offlinedNodes[id]; !ok {
+		return false
+	}
+	ns.offline = offline
+	c.offlinedNodes[id] = ns
+	c.offlineRecords[id] = offline
+	return true
+}
+
+// Puts the node back in rotation, suspended if it's lost, flaky or not ready, and forgets its offline
+// record.  Returns false if the node wasn't offlined.
+func (c *clusterState) reinstateNode(id cc.NodeId) bool {
+	_, recorded := c.offlineRecords[id]
+	delete(c.offlineRecords, id)
+	ns, ok := c.offlinedNodes[id]
+	if !ok {
+		return recorded
+	}
+	ns.offline = nil
+	delete(c.offlinedNodes, id)
+	if ns.suspended() {
+		c.suspendedNodes[id] = ns
+	} else {
+		c.nodes[id] = ns
+	}
+	return true
+}
+
+// Returns the offline records ordered by worker id.
+func (c *clusterState) getOfflineRecords() []OfflinedWorker {
+	records := make([]OfflinedWorker, 0, len(c.offlineRecords))
+	for _, offline := range c.offlineRecords {
+		records = append(records, *offline)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].ID < records[j].ID
+	})
+	return records
+}
+
+// Returns the ids of the known nodes matching the request, in the order of their ids.  When offlined is
+// true only offlined nodes, including those that are out of the cluster, match.
+func (c *clusterState) matchNodeIds(id string, isPattern bool, offlined bool) ([]cc.NodeId, error) {
+	var re *regexp.Regexp
+	if isPattern {
+		var err error
+		if re, err = regexp.Compile(id); err != nil {
+			return nil, fmt.Errorf("invalid worker id pattern %s: %v", id, err)
+		}
+	}
+	candidates := map[cc.NodeId]bool{}
+	if offlined {
+		for nodeId := range c.offlineRecords {
+			candidates[nodeId] = true
+		}
+		for nodeId := range c.offlinedNodes {
+			candidates[nodeId] = true
+		}
+	} else {
+		for _, nodes := range []map[cc.NodeId]*nodeState{c.nodes, c.suspendedNodes, c.offlinedNodes} {
+			for nodeId := range nodes {
+				candidates[nodeId] = true
+			}
+		}
+	}
+	ids := []cc.NodeId{}
+	for nodeId := range candidates {
+		if (re == nil && string(nodeId) == id) || (re != nil && re.MatchString(string(nodeId))) {
+			ids = append(ids, nodeId)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	return ids, nil
+}
+
+func (s *statefulScheduler) OfflineWorker(req domain.OfflineWorkerReq) error {
+	if err := s.checkLeader(); err != nil {
+		return err
+	}
+	if !stringInSlice(req.Requestor, s.config.Admins) && len(s.config.Admins) != 0 {
+		return fmt.Errorf("requestor %s unauthorized to offline worker", req.Requestor)
+	}
+	if req.Duration < 0 {
+		return fmt.Errorf("negative offline duration %s", req.Duration)
+	}
+	return s.sendOfflineRequest(workerOfflineRequest{offline: &req})
+}
+
+func (s *statefulScheduler) ReinstateWorker(req domain.ReinstateWorkerReq) error {
+	if err := s.checkLeader(); err != nil {
+		return err
+	}
+	if !stringInSlice(req.Requestor, s.config.Admins) && len(s.config.Admins) != 0 {
+		return fmt.Errorf("requestor %s unauthorized to reinstate worker", req.Requestor)
+	}
+	return s.sendOfflineRequest(workerOfflineRequest{reinstate: &req})
+}
+
+// Put the offline or reinstate request on channel that is processed by the main
+// scheduler loop, and wait for the result.  Only the leader runs the loop, callers
+// must check they're the leader first or they'd wait forever.
+func (s *statefulScheduler) sendOfflineRequest(req workerOfflineRequest) error {
+	req.responseCh = make(chan error, 1)
+	s.offlineCh <- req
+	return <-req.responseCh
+}
+
+// process all pending offline and reinstate requests, then reinstate the workers whose offlining
+// expired.  The offline records are persisted when they change.
+//
+// this function is part of the main scheduler loop
+func (s *statefulScheduler) offlineWorkers() {
+	// who changed the offline records and how, for the settings history
+	changedBy, changes := []string{}, []string{}
+	for done := false; !done; {
+		select {
+		case req := <-s.offlineCh:
+			var err error
+			if req.offline != nil {
+				err = s.offlineMatchingWorkers(req.offline)
+				if err == nil {
+					changedBy = append(changedBy, req.offline.Requestor)
+					changes = append(changes, fmt.Sprintf("offlined %s", req.offline.ID))
+				}
+			} else {
+				err = s.reinstateMatchingWorkers(req.reinstate)
+				if err == nil {
+					changedBy = append(changedBy, req.reinstate.Requestor)
+					changes = append(changes, fmt.Sprintf("reinstated %s", req.reinstate.ID))
+				}
+			}
+			req.responseCh <- err
+		default:
+			done = true
+		}
+	}
+
+	now := time.Now()
+	for nodeId, offline := range s.clusterState.offlineRecords {
+		if offline.expired(now) {
+			log.Infof("Reinstating worker %s, offlined by %s until %s", nodeId, offline.Requestor, offline.Until)
+			s.clusterState.reinstateNode(nodeId)
+			changedBy = append(changedBy, "scheduler")
+			changes = append(changes, fmt.Sprintf("reinstated %s, its offlining expired", nodeId))
+		}
+	}
+
+	if len(changes) > 0 {
+		s.setOfflinedWorkers(s.clusterState.getOfflineRecords())
+		if err := s.persistSettings(strings.Join(uniqueStrings(changedBy), ","), strings.Join(changes, "; ")); err != nil {
+			log.Errorf("offlined workers not persisted, they may be reinstated when the scheduler restarts. %s", err)
+		}
+	}
+}
+
+func (s *statefulScheduler) offlineMatchingWorkers(req *domain.OfflineWorkerReq) error {
+	ids, err := s.clusterState.matchNodeIds(req.ID, req.IDIsPattern, false)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 && req.IDIsPattern {
+		return fmt.Errorf("no node matches %s, none can be offlined", req.ID)
+	} else if len(ids) == 0 {
+		return fmt.Errorf("node %s was not present in nodes. It can't be offlined", req.ID)
+	}
+	now := time.Now()
+	for _, nodeId := range ids {
+		offline := &OfflinedWorker{ID: string(nodeId), Requestor: req.Requestor, Reason: req.Reason, Since: now}
+		if req.Duration > 0 {
+			offline.Until = now.Add(req.Duration)
+		}
+		log.Infof("Offlining worker %s for %s until %s: %s", nodeId, req.Requestor, offline.Until, req.Reason)
+		s.clusterState.offlineNode(offline)
+	}
+	return nil
+}
+
+func (s *statefulScheduler) reinstateMatchingWorkers(req *domain.ReinstateWorkerReq) error {
+	ids, err := s.clusterState.matchNodeIds(req.ID, req.IDIsPattern, true)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 && req.IDIsPattern {
+		return fmt.Errorf("no node matches %s, none can be reinstated", req.ID)
+	} else if len(ids) == 0 {
+		return fmt.Errorf("node %s was not present in offlinedNodes. It can't be reinstated", req.ID)
+	}
+	for _, nodeId := range ids {
+		log.Infof("Reinstating worker %s for %s", nodeId, req.Requestor)
+		s.clusterState.reinstateNode(nodeId)
+	}
+	return nil
+}
+
+// Restores the persisted offline records, offlining the workers that are in the cluster, and
+// reinstates the workers whose offline records were removed since they were last restored.
+func (s *statefulScheduler) restoreOfflinedWorkers(records []OfflinedWorker) {
+	persisted := map[cc.NodeId]bool{}
+	for _, offline := range records {
+		persisted[cc.NodeId(offline.ID)] = true
+	}
+	for nodeId := range s.clusterState.offlineRecords {
+		if !persisted[nodeId] {
+			s.clusterState.reinstateNode(nodeId)
+		}
+	}
+	for i := range records {
+		offline := records[i]
+		if !s.clusterState.offlineNode(&offline) {
+			// the worker is offlined once it joins the cluster
+			s.clusterState.offlineRecords[cc.NodeId(offline.ID)] = &offline
+		}
+	}
+	s.setOfflinedWorkers(s.clusterState.getOfflineRecords())
+	log.Infof("restored %d offlined workers", len(records))
+}
+
+// The offline records are copied for persistSettings, which may be called outside the scheduler loop.
+func (s *statefulScheduler) getOfflinedWorkers() []OfflinedWorker {
+	s.offlinedWorkersMu.RLock()
+	defer s.offlinedWorkersMu.RUnlock()
+	return s.offlinedWorkers
+}
+
+func (s *statefulScheduler) setOfflinedWorkers(records []OfflinedWorker) {
+	s.offlinedWorkersMu.Lock()
+	defer s.offlinedWorkersMu.Unlock()
+	s.offlinedWorkers = records
+}
+
+// returns the strings without duplicates, in the order they first appear
+func uniqueStrings(strs []string) []string {
+	unique := []string{}
+	for _, str := range strs {
+		if !stringInSlice(str, unique) {
+			unique = append(unique, str)
+		}
+	}
+	return unique
+}
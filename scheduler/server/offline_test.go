@@ -0,0 +1,131 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	cc "github.com/twitter/scoot/cloud/cluster"
+	"github.com/twitter/scoot/scheduler/domain"
+)
+
+// memPersistor keeps the persisted settings in memory
+type memPersistor struct {
+	settings *PersistedSettings
+}
+
+func (p *memPersistor) PersistSettings(settings *PersistedSettings) error {
+	p.settings = settings
+	return nil
+}
+
+func (p *memPersistor) LoadSettings() (*PersistedSettings, error) {
+	return p.settings, nil
+}
+
+// sendOffline runs the offline or reinstate request, stepping the scheduler until it's processed.
+func sendOffline(s *statefulScheduler, offline *domain.OfflineWorkerReq, reinstate *domain.ReinstateWorkerReq) error {
+	errCh := make(chan error)
+	go func() {
+		if offline != nil {
+			errCh <- s.OfflineWorker(*offline)
+		} else {
+			errCh <- s.ReinstateWorker(*reinstate)
+		}
+	}()
+	for {
+		select {
+		case err := <-errCh:
+			return err
+		default:
+			s.step()
+		}
+	}
+}
+
+func getOfflinedIds(s *statefulScheduler) []string {
+	ids := []string{}
+	for _, worker := range s.clusterState.getWorkerSummaries() {
+		if worker.State == domain.WorkerOfflined {
+			ids = append(ids, worker.ID)
+		}
+	}
+	return ids
+}
+
+func Test_StatefulScheduler_OfflineWorkers(t *testing.T) {
+	persistor := &memPersistor{}
+	s := makeDefaultStatefulScheduler()
+	s.SetPersistor(persistor)
+	s.step()
+
+	// offline a rack of workers
+	err := sendOffline(s, &domain.OfflineWorkerReq{ID: "node[12]", Requestor: "admin", Reason: "rack maintenance", IDIsPattern: true}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error offlining workers: %v", err)
+	}
+	if ids := getOfflinedIds(s); len(ids) != 2 || ids[0] != "node1" || ids[1] != "node2" {
+		t.Fatalf("Expected node1 and node2 to be offlined, got %v", ids)
+	}
+	if offlined := s.clusterState.getWorkerSummaries()[0]; offlined.OfflinedBy != "admin" || offlined.OfflineReason != "rack maintenance" {
+		t.Errorf("Unexpected offlined worker %+v", offlined)
+	}
+	if persistor.settings == nil || len(persistor.settings.OfflinedWorkers) != 2 {
+		t.Fatalf("Expected the offlined workers to be persisted, got %+v", persistor.settings)
+	}
+
+	if err := sendOffline(s, &domain.OfflineWorkerReq{ID: "rack2.*", Requestor: "admin", IDIsPattern: true}, nil); err == nil {
+		t.Errorf("Expected an error offlining workers matching no node")
+	}
+	if err := sendOffline(s, &domain.OfflineWorkerReq{ID: "node(", Requestor: "admin", IDIsPattern: true}, nil); err == nil {
+		t.Errorf("Expected an error offlining workers matching an invalid pattern")
+	}
+
+	// a timed offlining expires
+	err = sendOffline(s, &domain.OfflineWorkerReq{ID: "node3", Requestor: "admin", Duration: time.Millisecond}, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error offlining node3: %v", err)
+	}
+	time.Sleep(2 * time.Millisecond)
+	s.step()
+	if ids := getOfflinedIds(s); len(ids) != 2 {
+		t.Errorf("Expected node3 to be reinstated once its offlining expired, got %v offlined", ids)
+	}
+
+	// the offlined workers stay offline once the scheduler restarts and they rejoin the cluster
+	deps := getDefaultSchedDeps()
+	restarted := makeStatefulSchedulerDeps(deps)
+	restarted.SetPersistor(persistor)
+	restarted.loadSettings()
+	restarted.step()
+	if ids := getOfflinedIds(restarted); len(ids) != 2 || ids[0] != "node1" || ids[1] != "node2" {
+		t.Fatalf("Expected node1 and node2 to still be offlined after a restart, got %v", ids)
+	}
+
+	// a worker leaving the cluster is offlined again when it's back
+	deps.nodesUpdatesCh <- []cc.NodeUpdate{cc.NewRemove("node1")}
+	restarted.step()
+	deps.nodesUpdatesCh <- []cc.NodeUpdate{cc.NewAdd(cc.NewIdNode("node1"))}
+	restarted.step()
+	if ids := getOfflinedIds(restarted); len(ids) != 2 {
+		t.Errorf("Expected node1 to be offlined when it rejoined, got %v offlined", ids)
+	}
+
+	if err := sendOffline(restarted, nil, &domain.ReinstateWorkerReq{ID: "node.*", Requestor: "admin", IDIsPattern: true}); err != nil {
+		t.Fatalf("Unexpected error reinstating workers: %v", err)
+	}
+	if ids := getOfflinedIds(restarted); len(ids) != 0 {
+		t.Errorf("Expected all the workers to be reinstated, got %v offlined", ids)
+	}
+	if len(persistor.settings.OfflinedWorkers) != 0 {
+		t.Errorf("Expected no persisted offlined workers, got %v", persistor.settings.OfflinedWorkers)
+	}
+	if err := sendOffline(restarted, nil, &domain.ReinstateWorkerReq{ID: "node1", Requestor: "admin"}); err == nil {
+		t.Errorf("Expected an error reinstating a worker that isn't offlined")
+	}
+
+	// reloading the settings reinstates the workers another scheduler reinstated
+	s.loadSettings()
+	if ids := getOfflinedIds(s); len(ids) != 0 {
+		t.Errorf("Expected the reloaded settings to reinstate the workers, got %v offlined", ids)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+
+	"github.com/twitter/scoot/scheduler/domain"
 )
 
 // Persistor interface for persisting scheduler settings and initializing the scheduler
@@ -21,6 +23,11 @@ type PersistedSettings struct {
 	RebalanceMinimumDurationMinutes int               `json:"rebalanceMinimumDurationMinutes"`
 	RebalanceThreshold              int               `json:"rebalanceThreshold"`
 	Throttle                        int               `json:"throttle"`
+	OfflinedWorkers                 []OfflinedWorker  `json:"offlinedWorkers,omitempty"`
+
+	// the requestor whose change the settings were persisted for, and a description of the change
+	ChangedBy string `json:"changedBy,omitempty"`
+	Change    string `json:"change,omitempty"`
 }
 
 // nopPersistor provides nop implementations of persist and load functions
@@ -34,23 +41,24 @@ func (p *nopPersistor) LoadSettings() (*PersistedSettings, error) {
 	return nil, nil
 }
 
-func (s *statefulScheduler) persistSettings() error {
+// persist the current settings, changedBy is the requestor and change the description of the change
+// the settings are persisted for.
+func (s *statefulScheduler) persistSettings(changedBy, change string) error {
 	if s.persistor == nil {
 		log.Infof("setting persistor is nil, scheduler will use default settings on restart")
 		return nil
 	}
-	sa, ok := s.config.SchedAlg.(*LoadBasedAlg)
-	if !ok {
-		log.Errorf("not using load based scheduler, settings ignored")
-		return nil
+	if changedBy == "" {
+		changedBy = "unknown"
 	}
 	_, throttle := s.GetSchedulerStatus()
-	ps := &PersistedSettings{
-		ClassLoadPercents:               sa.getClassLoadPercents(),
-		RequestorToClassMap:             sa.getRequestorToClassMap(),
-		RebalanceMinimumDurationMinutes: int(sa.getRebalanceMinimumDuration().Minutes()),
-		RebalanceThreshold:              sa.getRebalanceThreshold(),
-		Throttle:                        throttle,
+	ps := &PersistedSettings{Throttle: throttle, OfflinedWorkers: s.getOfflinedWorkers(), ChangedBy: changedBy, Change: change}
+	// the other settings are the load based scheduler's
+	if sa, ok := s.config.SchedAlg.(*LoadBasedAlg); ok {
+		ps.ClassLoadPercents = sa.getClassLoadPercents()
+		ps.RequestorToClassMap = sa.getRequestorToClassMap()
+		ps.RebalanceMinimumDurationMinutes = int(sa.getRebalanceMinimumDuration().Minutes())
+		ps.RebalanceThreshold = sa.getRebalanceThreshold()
 	}
 
 	err := s.persistor.PersistSettings(ps)
@@ -74,15 +82,57 @@ func (s *statefulScheduler) loadSettings() {
 		log.Infof("no persisted settings found. Scheduler will use default values")
 		return
 	}
-	sa, ok := s.config.SchedAlg.(*LoadBasedAlg)
-	if !ok {
-		log.Errorf("not using load based scheduler, settings ignored")
+	log.Infof("loaded persisted settings, last changed by %s: %s", settings.ChangedBy, settings.Change)
+	s.restoreOfflinedWorkers(settings.OfflinedWorkers)
+	s.applySettings(settings)
+}
+
+// apply the persisted throttle and load based scheduler settings, the offlined workers are left as they are.
+func (s *statefulScheduler) applySettings(settings *PersistedSettings) {
+	// settings persisted while using another scheduling algorithm have no class load percents
+	if sa, ok := s.config.SchedAlg.(*LoadBasedAlg); ok && len(settings.ClassLoadPercents) > 0 {
+		sa.setClassLoadPercents(settings.ClassLoadPercents)
+		sa.setRequestorToClassMap(settings.RequestorToClassMap)
+		sa.setRebalanceMinimumDuration(time.Duration(settings.RebalanceMinimumDurationMinutes) * time.Minute)
+		sa.setRebalanceThreshold(settings.RebalanceThreshold)
+	} else if ok {
+		log.Infof("no persisted load based scheduler settings, using the configured ones")
+	}
+	if err := domain.ValidateMaxTasks(settings.Throttle); err != nil {
+		log.Errorf("ignoring the persisted throttle. %s", err)
 		return
 	}
-	log.Info("loaded persisted settings")
-	sa.setClassLoadPercents(settings.ClassLoadPercents)
-	sa.setRequestorToClassMap(settings.RequestorToClassMap)
-	sa.setRebalanceMinimumDuration(time.Duration(settings.RebalanceMinimumDurationMinutes) * time.Minute)
-	sa.setRebalanceThreshold(settings.RebalanceThreshold)
-	s.SetSchedulerStatus(settings.Throttle)
+	s.setThrottle(settings.Throttle)
+}
+
+// GetSettingsHistory returns the versions of the settings kept by the persistor, oldest first.
+func (s *statefulScheduler) GetSettingsHistory() ([]SettingsVersion, error) {
+	history, ok := s.persistor.(SettingsHistory)
+	if !ok {
+		return nil, fmt.Errorf("the scheduler's settings persistor keeps no history")
+	}
+	return history.ListSettingsVersions()
+}
+
+// RollbackSettings restores the throttle and load based scheduler settings of a prior version,
+// and persists them as a new version.  The offlined workers aren't rolled back.
+func (s *statefulScheduler) RollbackSettings(version int, requestor string) error {
+	if err := s.checkLeader(); err != nil {
+		return err
+	}
+	if !stringInSlice(requestor, s.config.Admins) && len(s.config.Admins) != 0 {
+		return fmt.Errorf("requestor %s unauthorized to roll back the settings", requestor)
+	}
+	versions, err := s.GetSettingsHistory()
+	if err != nil {
+		return err
+	}
+	for _, v := range versions {
+		if v.Version == version {
+			log.Infof("rolling back the settings to version %d for %s", version, requestor)
+			s.applySettings(&v.Settings)
+			return s.persistSettings(requestor, fmt.Sprintf("rolled back to version %d", version))
+		}
+	}
+	return fmt.Errorf("no settings version %d, the history has %d versions", version, len(versions))
 }
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/twitter/scoot/runner"
+	"github.com/twitter/scoot/scheduler/domain"
+	worker "github.com/twitter/scoot/worker/domain"
+)
+
+// A task's failed runs are retried per its retry policy, or domain.DefaultRetryPolicy if it has none.
+// The status of each failed run is logged as the task's start data before it's retried, and every later
+// status logged for the task carries the earlier attempts, so a recovered task keeps its attempts.
+
+// Returns the task's retry policy, or the default one if it has none.
+func (t *taskState) retryPolicy() domain.RetryPolicy {
+	if t.Def.RetryPolicy != nil {
+		return *t.Def.RetryPolicy
+	}
+	return domain.DefaultRetryPolicy
+}
+
+// Returns the max number of runs of the task, per its retry policy or else the scheduler's MaxRetriesPerTask.
+func (s *statefulScheduler) maxAttempts(t *taskState) int {
+	if t.Def.RetryPolicy != nil && t.Def.RetryPolicy.MaxAttempts > 0 {
+		return t.Def.RetryPolicy.MaxAttempts
+	}
+	return s.config.MaxRetriesPerTask + 1
+}
+
+// Records the failed run that's being retried, and holds the task back for the backoff before its next attempt.
+func (t *taskState) retryAfter(st runner.RunStatus, policy domain.RetryPolicy) {
+	t.Attempts = append(t.Attempts, st)
+	if backoff := policy.BackoffBefore(t.NumTimesTried + 1); backoff > 0 {
+		t.RetryAfter = time.Now().Add(backoff)
+	}
+}
+
+// Returns the failed attempts of a started task from the serialized RunStatus logged with its StartTask message.
+// The logged status is itself a failed attempt if it's done, the task was waiting to be retried.
+func recoverAttempts(startTaskData []byte) []runner.RunStatus {
+	if startTaskData == nil {
+		return nil
+	}
+	previous, err := worker.DeserializePreviousAttempts(startTaskData)
+	if err != nil {
+		log.Errorf("Couldn't deserialize start task status, ignoring the task's earlier attempts: %v", err)
+		return nil
+	}
+	st, err := worker.DeserializeProcessStatus(startTaskData)
+	if err == nil && st.State.IsDone() {
+		previous = append(previous, st)
+	}
+	return previous
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/twitter/scoot/common/errors"
+	"github.com/twitter/scoot/runner"
+	"github.com/twitter/scoot/saga"
+	"github.com/twitter/scoot/saga/sagalogs"
+	"github.com/twitter/scoot/scheduler/domain"
+	workerdomain "github.com/twitter/scoot/worker/domain"
+)
+
+func Test_StatefulScheduler_RetryPolicyRetriesExitCode(t *testing.T) {
+	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	s, _, _ := initializeServices(sc, false)
+
+	jobDef := domain.GenJobDef(1)
+	jobDef.Tasks[0].Argv = []string{"complete 75"}
+	jobDef.Tasks[0].RetryPolicy = &domain.RetryPolicy{MaxAttempts: 3, RetryableExitCodes: []errors.ExitCode{75}}
+	taskId := jobDef.Tasks[0].TaskID
+	jobId := scheduleJobDef(t, s, jobDef)
+
+	// the job is added on the next step
+	for s.getJob(jobId) == nil || s.getJob(jobId).getJobStatus() != domain.Completed {
+		s.step()
+	}
+	task := s.getJob(jobId).getTask(taskId)
+	if task.NumTimesTried != 3 || task.Succeeded {
+		t.Fatalf("Expected the task to fail after 3 tries, got %d tries, succeeded: %t", task.NumTimesTried, task.Succeeded)
+	}
+	for s.getJob(jobId) != nil {
+		s.step()
+	}
+
+	state, err := sc.GetSagaState(jobId)
+	if err != nil {
+		t.Fatalf("Unexpected error getting saga state: %v", err)
+	}
+	previous, err := workerdomain.DeserializePreviousAttempts(state.GetEndTaskData(taskId))
+	if err != nil {
+		t.Fatalf("Unexpected error deserializing end task data: %v", err)
+	}
+	if len(previous) != 2 {
+		t.Fatalf("Expected the 2 retried attempts to be logged with the last one, got %v", previous)
+	}
+	for i, st := range previous {
+		if st.State != runner.COMPLETE || st.ExitCode != 75 {
+			t.Errorf("Expected attempt %d to have completed with exit code 75, got %s", i, st)
+		}
+	}
+}
+
+func Test_StatefulScheduler_RetryPolicyDoesNotRetryUserFailure(t *testing.T) {
+	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	s, _, _ := initializeServices(sc, false)
+
+	jobDef := domain.GenJobDef(1)
+	jobDef.Tasks[0].Argv = []string{"complete 1"}
+	jobDef.Tasks[0].RetryPolicy = &domain.RetryPolicy{MaxAttempts: 3, RetryableExitCodes: []errors.ExitCode{75}}
+	taskId := jobDef.Tasks[0].TaskID
+	jobId := scheduleJobDef(t, s, jobDef)
+
+	// the job is added on the next step
+	for s.getJob(jobId) == nil || s.getJob(jobId).getJobStatus() != domain.Completed {
+		s.step()
+	}
+	if task := s.getJob(jobId).getTask(taskId); task.NumTimesTried != 1 || len(task.Attempts) != 0 {
+		t.Errorf("Expected the task not to be retried, got %d tries", task.NumTimesTried)
+	}
+}
+
+func Test_JobState_RetryBackoff(t *testing.T) {
+	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	job := domain.GenJob("job1", 1)
+	jobData, _ := (&job).Serialize()
+	sg, _ := sc.MakeSaga(job.Id, jobData)
+	j := newJobState(&job, "", sg, nil, nil, nopDurationKeyExtractor)
+	task := j.Tasks[0]
+	task.NumTimesTried = 1
+
+	policy := domain.RetryPolicy{Backoff: time.Hour}
+	task.retryAfter(runner.RunStatus{State: runner.TIMEDOUT}, policy)
+	if len(task.Attempts) != 1 {
+		t.Errorf("Expected the failed run to be kept as an attempt, got %v", task.Attempts)
+	}
+	if tasks := j.getUnScheduledTasks(); len(tasks) != 0 {
+		t.Errorf("Expected the task to be held back during its backoff, got %v", tasks)
+	}
+
+	task.RetryAfter = time.Now().Add(-time.Second)
+	if tasks := j.getUnScheduledTasks(); len(tasks) != 1 {
+		t.Errorf("Expected the task to be schedulable after its backoff, got %v", tasks)
+	}
+}
+
+func Test_JobState_RecoverAttempts(t *testing.T) {
+	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	job := domain.GenJob("job1", 2)
+	jobData, _ := (&job).Serialize()
+	sg, _ := sc.MakeSaga(job.Id, jobData)
+
+	// task0 failed twice and was waiting to be retried, task1 was running its second attempt.
+	task0, task1 := job.Def.Tasks[0].TaskID, job.Def.Tasks[1].TaskID
+	first := runner.RunStatus{RunID: "run1", State: runner.FAILED, ExitCode: errors.GenericCheckoutFailureExitCode}
+	second := runner.RunStatus{RunID: "run2", State: runner.TIMEDOUT}
+	waiting, _ := workerdomain.SerializeProcessStatusWithAttempts(second, []runner.RunStatus{first})
+	running, _ := workerdomain.SerializeProcessStatusWithAttempts(
+		runner.RunningStatus("run3", "", "", job.Def.Tasks[1].LogTags), []runner.RunStatus{first})
+	err := sg.BulkMessage([]saga.SagaMessage{
+		saga.MakeStartTaskMessage(job.Id, task0, waiting),
+		saga.MakeStartTaskMessage(job.Id, task1, running),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error logging saga messages: %v", err)
+	}
+
+	j := newJobState(&job, "", sg, nil, nil, nopDurationKeyExtractor)
+	if task := j.getTask(task0); task.NumTimesTried != 2 || len(task.Attempts) != 2 || task.Attempts[1].RunID != "run2" {
+		t.Errorf("Expected task0 to recover both of its attempts, got %v", task.Attempts)
+	}
+	if task := j.getTask(task1); task.NumTimesTried != 1 || len(task.Attempts) != 1 || task.Attempts[0].RunID != "run1" {
+		t.Errorf("Expected task1 to recover its first attempt, got %v", task.Attempts)
+	}
+}
@@ -0,0 +1,267 @@
+package server
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/twitter/scoot/common/log/tags"
+	"github.com/twitter/scoot/common/stats"
+	"github.com/twitter/scoot/runner"
+	"github.com/twitter/scoot/saga"
+	"github.com/twitter/scoot/scheduler/domain"
+	worker "github.com/twitter/scoot/worker/domain"
+)
+
+// A job with cleanup commands that is killed or has a task fail is rolled back once all of its tasks are done.
+// Its saga is aborted, then each task that was sent to a worker has its cleanup run as its compensating task,
+// followed by the job's cleanup.  Tasks with nothing to clean up have an empty compensating task logged.
+// The job is RollingBack until its cleanups complete, and RolledBack once its saga is ended.
+
+// Returns true if the job has to be rolled back rather than completed once its tasks are done.
+func (j *jobState) needsRollback() bool {
+	if j.RollingBack {
+		return true
+	}
+	if !j.Job.Def.HasCleanup() {
+		return false
+	}
+	if j.JobKilled {
+		return true
+	}
+	for _, task := range j.Tasks {
+		if task.Status == domain.Completed && !task.Succeeded {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the cleanup tasks that can be started, the job's cleanup only starts once the tasks' cleanups are done.
+func (j *jobState) getUnScheduledCleanupTasks() []*taskState {
+	var tasksToRun []*taskState
+	taskCleanupsDone := true
+	for _, task := range j.CleanupTasks {
+		if task.TaskId != domain.JobCleanupTaskID && task.Status != domain.Completed {
+			taskCleanupsDone = false
+		}
+		if task.Status == domain.NotStarted && (task.TaskId != domain.JobCleanupTaskID || taskCleanupsDone) {
+			tasksToRun = append(tasksToRun, task)
+		}
+	}
+	return tasksToRun
+}
+
+// Returns true once all of the job's cleanup tasks have completed.
+func (j *jobState) cleanupsCompleted() bool {
+	for _, task := range j.CleanupTasks {
+		if task.Status != domain.Completed {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the task state for running the cleanup of the given task, which may also be the job's cleanup.
+// The cleanup runs on the same kind of node as the task.
+func newCleanupTaskState(j *jobState, def domain.TaskDefinition, cleanup runner.Command) *taskState {
+	taskID := def.TaskID
+	def.Command = cleanup
+	def.JobID = j.Job.Id
+	def.TaskID = taskID
+	def.Tag = j.Job.Def.Tag
+	def.Dependencies = nil
+	def.Cleanup = nil
+	return &taskState{
+		JobId:       j.Job.Id,
+		TaskId:      taskID,
+		Def:         def,
+		Status:      domain.NotStarted,
+		TimeStarted: nilTime,
+	}
+}
+
+// Returns true if the serialized RunStatus logged with an EndTask message shows the task was sent to a worker.
+// Tasks that were skipped, or killed before they were scheduled, are logged without a RunID.
+func sentToWorker(endTaskData []byte) bool {
+	if endTaskData == nil {
+		return false
+	}
+	st, err := worker.DeserializeProcessStatus(endTaskData)
+	if err != nil {
+		log.Errorf("Couldn't deserialize end task status, assuming the task ran: %v", err)
+		return true
+	}
+	return st.RunID != ""
+}
+
+// Returns true once the job's saga can be ended: right away for jobs that don't need to be rolled back,
+// otherwise once all of their cleanup tasks have completed.  The first call for a job that needs to be
+// rolled back starts the rollback.
+func (s *statefulScheduler) rollbackCompleted(j *jobState) bool {
+	if !j.needsRollback() {
+		return true
+	}
+	if !j.RollingBack {
+		if err := s.startRollback(j); err != nil {
+			log.WithFields(
+				log.Fields{
+					"jobID":     j.Job.Id,
+					"err":       err,
+					"requestor": j.Job.Def.Requestor,
+					"jobType":   j.Job.Def.JobType,
+					"tag":       j.Job.Def.Tag,
+				}).Error("Failed to start rolling back job, will retry")
+			return false
+		}
+	}
+	return j.cleanupsCompleted()
+}
+
+// Aborts the job's saga and sets up the cleanup tasks to run, logging an empty compensating task for each
+// task that has nothing to clean up.  The cleanups already logged as completed, by this scheduler or one
+// before it restarted, are not run again.
+func (s *statefulScheduler) startRollback(j *jobState) error {
+	sagaID := j.Saga.ID()
+	state := j.Saga.GetState()
+	var msgs []saga.SagaMessage
+	var cleanupTasks []*taskState
+
+	if j.Job.Def.Cleanup != nil && !state.IsTaskStarted(domain.JobCleanupTaskID) {
+		// Compensating tasks need a started task, log one for the job's cleanup before the saga is aborted.
+		msgs = append(msgs, saga.MakeStartTaskMessage(sagaID, domain.JobCleanupTaskID, nil))
+	}
+	if !state.IsSagaAborted() {
+		msgs = append(msgs, saga.MakeAbortSagaMessage(sagaID))
+	}
+	for _, task := range j.Tasks {
+		if !state.IsTaskStarted(task.TaskId) || state.IsCompTaskCompleted(task.TaskId) {
+			continue
+		}
+		if task.Def.Cleanup != nil && (task.NumTimesTried > 0 || sentToWorker(state.GetEndTaskData(task.TaskId))) {
+			cleanupTasks = append(cleanupTasks, newCleanupTaskState(j, task.Def, *task.Def.Cleanup))
+		} else {
+			msgs = append(msgs,
+				saga.MakeStartCompTaskMessage(sagaID, task.TaskId, nil),
+				saga.MakeEndCompTaskMessage(sagaID, task.TaskId, nil))
+		}
+	}
+	if j.Job.Def.Cleanup != nil && !state.IsCompTaskCompleted(domain.JobCleanupTaskID) {
+		def := domain.TaskDefinition{}
+		def.TaskID = domain.JobCleanupTaskID
+		cleanupTasks = append(cleanupTasks, newCleanupTaskState(j, def, *j.Job.Def.Cleanup))
+	}
+
+	if len(msgs) > 0 {
+		if err := j.Saga.BulkMessage(msgs); err != nil {
+			return err
+		}
+	}
+	j.RollingBack = true
+	j.CleanupTasks = cleanupTasks
+
+	log.WithFields(
+		log.Fields{
+			"jobID":           j.Job.Id,
+			"killed":          j.JobKilled,
+			"numCleanupTasks": len(cleanupTasks),
+			"requestor":       j.Job.Def.Requestor,
+			"jobType":         j.Job.Def.JobType,
+			"tag":             j.Job.Def.Tag,
+		}).Info("Rolling back job")
+	return nil
+}
+
+// Starts the cleanup tasks of the jobs being rolled back on idle nodes.  Cleanups go ahead of new tasks,
+// without being subject to the scheduling algorithm or the max number of tasks.
+//
+// this function is part of the main scheduler loop
+func (s *statefulScheduler) runCleanupTasks() {
+	var tasks []*taskState
+	for _, jobState := range s.inProgressJobs {
+		if jobState.RollingBack && !jobState.EndingSaga {
+			tasks = append(tasks, jobState.getUnScheduledCleanupTasks()...)
+		}
+	}
+	if len(tasks) == 0 {
+		return
+	}
+	for _, ta := range s.assign(tasks) {
+		s.runCleanupTask(ta.task, ta.nodeSt)
+	}
+}
+
+// Runs the cleanup task on the assigned node, logging it as the compensating task of the task it cleans up.
+// Failing to run the cleanup is retried like a task's run is, while a cleanup command that exits with an
+// error is done, the error is reported in the job's status.
+func (s *statefulScheduler) runCleanupTask(task *taskState, nodeSt *nodeState) {
+	jobState := s.getJob(task.JobId)
+	rs := s.runnerFactory(nodeSt.node)
+	preventRetries := bool(task.NumTimesTried >= s.config.MaxRetriesPerTask)
+	logFields := log.Fields{
+		"jobID":     task.JobId,
+		"taskID":    task.TaskId,
+		"node":      nodeSt.node,
+		"requestor": jobState.Job.Def.Requestor,
+		"jobType":   jobState.Job.Def.JobType,
+		"tag":       jobState.Job.Def.Tag,
+	}
+	log.WithFields(logFields).Info("Starting cleanup task")
+
+	tRunner := &taskRunner{
+		saga:   jobState.Saga,
+		runner: rs,
+		stat:   s.stat,
+
+		defaultTaskTimeout:    s.config.DefaultTaskTimeout,
+		taskTimeoutOverhead:   s.config.TaskTimeoutOverhead,
+		runnerRetryTimeout:    s.config.RunnerRetryTimeout,
+		runnerRetryInterval:   s.config.RunnerRetryInterval,
+		markCompleteOnFailure: preventRetries,
+		compensating:          true,
+
+		LogTags: tags.LogTags{
+			JobID:  task.JobId,
+			TaskID: task.TaskId,
+			Tag:    jobState.Job.Def.Tag,
+		},
+
+		task:   task.Def,
+		nodeSt: nodeSt,
+
+		abortCh:      make(chan abortReq, 1),
+		queryAbortCh: make(chan interface{}, 1),
+
+		startTime: time.Now(),
+	}
+	task.Status = domain.InProgress
+	task.TimeStarted = tRunner.startTime
+	task.TaskRunner = tRunner
+	task.NumTimesTried++
+	s.stat.Counter(stats.SchedCleanupTaskCounter).Inc(1)
+
+	s.asyncRunner.RunAsync(
+		tRunner.run,
+		func(err error) {
+			defer rs.Release()
+
+			// Free the node, using a fake id if it was lost or re-added so its new assignments aren't clobbered.
+			nodeId := nodeSt.node.Id()
+			if nodeStInstance, ok := s.clusterState.getNodeState(nodeId); !ok || nodeStInstance != nodeSt {
+				nodeId = nodeId + ":ERROR"
+			}
+			s.clusterState.taskCompleted(nodeId, task.JobId, task.TaskId, false)
+
+			task.TimeStarted = nilTime
+			task.TaskRunner = nil
+			if err != nil && (!preventRetries || err.(*taskError).sagaErr != nil) {
+				logFields["err"] = err
+				log.WithFields(logFields).Info("Error running cleanup task (will be retried):")
+				task.Status = domain.NotStarted
+				return
+			}
+			logFields["result"] = tRunner.result
+			log.WithFields(logFields).Info("Cleanup task completed")
+			task.Status = domain.Completed
+		})
+}
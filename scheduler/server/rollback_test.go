@@ -0,0 +1,179 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/twitter/scoot/runner"
+	"github.com/twitter/scoot/saga"
+	"github.com/twitter/scoot/saga/sagalogs"
+	"github.com/twitter/scoot/scheduler/domain"
+	workerdomain "github.com/twitter/scoot/worker/domain"
+)
+
+func Test_StatefulScheduler_FailedJobRollsBack(t *testing.T) {
+	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	s, _, _ := initializeServices(sc, false)
+
+	// task0 succeeds, task1 fails and task2 is skipped since it depends on task1.
+	jobDef := domain.GenJobDef(3)
+	for i := range jobDef.Tasks {
+		jobDef.Tasks[i].Argv = []string{"complete 0"}
+		jobDef.Tasks[i].Cleanup = &runner.Command{Argv: []string{"complete 0"}, SnapshotID: jobDef.Tasks[i].SnapshotID}
+	}
+	jobDef.Tasks[1].Argv = []string{"complete 1"}
+	jobDef.Tasks[2].Dependencies = []string{jobDef.Tasks[1].TaskID}
+	jobDef.Cleanup = &runner.Command{Argv: []string{"complete 0"}}
+	jobId := scheduleJobDef(t, s, jobDef)
+
+	// the job is added on the next step
+	for s.getJob(jobId) == nil {
+		s.step()
+	}
+	rollingBack := false
+	for s.getJob(jobId) != nil {
+		if summary := s.getJob(jobId).getSummary(); summary.Status == domain.RollingBack {
+			rollingBack = true
+		}
+		s.step()
+	}
+	if !rollingBack {
+		t.Errorf("Expected the job to be RollingBack while its cleanups run")
+	}
+
+	state := getRolledBackSagaState(t, sc, jobId)
+	for i, id := range []string{jobDef.Tasks[0].TaskID, jobDef.Tasks[1].TaskID, domain.JobCleanupTaskID} {
+		if state.GetEndCompTaskData(id) == nil {
+			t.Errorf("Expected cleanup %d (%s) to have run", i, id)
+		}
+	}
+	if state.GetEndCompTaskData(jobDef.Tasks[2].TaskID) != nil {
+		t.Errorf("Expected the skipped task's cleanup not to run")
+	}
+}
+
+func Test_StatefulScheduler_KilledJobRollsBack(t *testing.T) {
+	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	s, _, _ := initializeServices(sc, false)
+
+	jobDef := domain.GenJobDef(1)
+	jobDef.Tasks[0].Argv = []string{"pause"}
+	jobDef.Tasks[0].Cleanup = &runner.Command{Argv: []string{"complete 0"}, SnapshotID: jobDef.Tasks[0].SnapshotID}
+	taskId := jobDef.Tasks[0].TaskID
+	jobId := scheduleJobDef(t, s, jobDef)
+
+	for s.getJob(jobId) == nil || s.getJob(jobId).getTask(taskId).Status == domain.NotStarted {
+		s.step()
+	}
+	if err := waitForResponse(sendKillRequest(jobId, s), s); err != nil {
+		t.Fatalf("Expected no error from killJob request, instead got: %v", err)
+	}
+	for s.getJob(jobId) != nil {
+		s.step()
+	}
+
+	state := getRolledBackSagaState(t, sc, jobId)
+	if state.GetEndCompTaskData(taskId) == nil {
+		t.Errorf("Expected the killed task's cleanup to have run")
+	}
+}
+
+func Test_StatefulScheduler_SucceededJobNotRolledBack(t *testing.T) {
+	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	s, _, _ := initializeServices(sc, false)
+
+	jobDef := domain.GenJobDef(2)
+	for i := range jobDef.Tasks {
+		jobDef.Tasks[i].Argv = []string{"complete 0"}
+		jobDef.Tasks[i].Cleanup = &runner.Command{Argv: []string{"complete 0"}}
+	}
+	jobDef.Cleanup = &runner.Command{Argv: []string{"complete 0"}}
+	jobId := scheduleJobDef(t, s, jobDef)
+
+	for s.getJob(jobId) == nil {
+		s.step()
+	}
+	for s.getJob(jobId) != nil {
+		s.step()
+	}
+
+	state, err := sc.GetSagaState(jobId)
+	if err != nil {
+		t.Fatalf("Unexpected error getting saga state: %v", err)
+	}
+	if !state.IsSagaCompleted() || state.IsSagaAborted() {
+		t.Errorf("Expected the job to complete without being rolled back")
+	}
+}
+
+func Test_JobState_RecoverRollingBackJob(t *testing.T) {
+	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	job := domain.GenJob("job1", 2)
+	for i := range job.Def.Tasks {
+		job.Def.Tasks[i].Cleanup = &runner.Command{Argv: []string{"complete 0"}}
+	}
+	job.Def.Cleanup = &runner.Command{Argv: []string{"complete 0"}}
+	jobData, _ := (&job).Serialize()
+	sg, _ := sc.MakeSaga(job.Id, jobData)
+
+	// both tasks ran and failed, task0 was already cleaned up before the scheduler restarted.
+	task0, task1 := job.Def.Tasks[0].TaskID, job.Def.Tasks[1].TaskID
+	failed := runner.CompleteStatus(runner.RunID("run1"), "", 1, job.Def.Tasks[0].LogTags)
+	failedData, _ := workerdomain.SerializeProcessStatus(failed)
+	err := sg.BulkMessage([]saga.SagaMessage{
+		saga.MakeStartTaskMessage(job.Id, task0, nil),
+		saga.MakeEndTaskMessage(job.Id, task0, failedData),
+		saga.MakeStartTaskMessage(job.Id, task1, nil),
+		saga.MakeEndTaskMessage(job.Id, task1, failedData),
+		saga.MakeStartTaskMessage(job.Id, domain.JobCleanupTaskID, nil),
+		saga.MakeAbortSagaMessage(job.Id),
+		saga.MakeStartCompTaskMessage(job.Id, task0, nil),
+		saga.MakeEndCompTaskMessage(job.Id, task0, failedData),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error logging saga messages: %v", err)
+	}
+
+	s := makeDefaultStatefulScheduler()
+	j := newJobState(&job, "", sg, nil, nil, nopDurationKeyExtractor)
+	if j.getJobStatus() != domain.Completed || !j.needsRollback() {
+		t.Fatalf("Expected the recovered job's tasks to be done and to need a rollback")
+	}
+	if s.rollbackCompleted(j) {
+		t.Fatalf("Expected the recovered job's cleanups not to be completed")
+	}
+	var cleanups []string
+	for _, task := range j.CleanupTasks {
+		cleanups = append(cleanups, task.TaskId)
+	}
+	if len(cleanups) != 2 || cleanups[0] != task1 || cleanups[1] != domain.JobCleanupTaskID {
+		t.Errorf("Expected task1's and the job's cleanups to be run, got %v", cleanups)
+	}
+	if tasks := j.getUnScheduledCleanupTasks(); len(tasks) != 1 || tasks[0].TaskId != task1 {
+		t.Errorf("Expected the job's cleanup to wait for task1's, got %v", tasks)
+	}
+}
+
+// Schedules the job definition as is, returning its id.
+func scheduleJobDef(t *testing.T, s *statefulScheduler, jobDef domain.JobDefinition) string {
+	go func() {
+		checkJobMsg := <-s.checkJobCh
+		checkJobMsg.resultCh <- nil
+	}()
+	jobId, err := s.ScheduleJob(jobDef)
+	if err != nil {
+		t.Fatalf("Unexpected error scheduling job: %v", err)
+	}
+	return jobId
+}
+
+// Returns the saga state of a job that's done, checking that it was rolled back.
+func getRolledBackSagaState(t *testing.T, sc saga.SagaCoordinator, jobId string) *saga.SagaState {
+	state, err := sc.GetSagaState(jobId)
+	if err != nil {
+		t.Fatalf("Unexpected error getting saga state: %v", err)
+	}
+	if !state.IsSagaAborted() || !state.IsSagaCompleted() {
+		t.Fatalf("Expected the job to be rolled back, aborted: %t, completed: %t", state.IsSagaAborted(), state.IsSagaCompleted())
+	}
+	return state
+}
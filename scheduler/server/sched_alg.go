@@ -0,0 +1,105 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/twitter/scoot/common/stats"
+)
+
+// Scheduling algorithms are registered by name.  The scheduler creates the algorithm named by
+// SchedulerConfiguration.SchedAlgName, configured from SchedulerConfiguration.SchedAlgConfig.
+
+const (
+	LoadBasedAlgName    = "load_based"
+	FairShareAlgName    = "fair_share"
+	DefaultSchedAlgName = LoadBasedAlgName
+)
+
+// SchedAlgDeps the scheduler state handed to a scheduling algorithm when it's created
+type SchedAlgDeps struct {
+	Stat stats.StatsReceiver
+
+	// the scheduler's running tasks by job class and start time, kept up to date by the jobStates
+	tasksByJobClassAndStartTimeSec map[taskClassAndStartKey]taskStateByJobIDTaskID
+}
+
+// SchedAlgFactory creates a scheduling algorithm from its JSON config, which is empty if none was configured.
+type SchedAlgFactory func(config json.RawMessage, deps SchedAlgDeps) (SchedulingAlgorithm, error)
+
+var (
+	schedAlgsMu sync.RWMutex
+	schedAlgs   = map[string]SchedAlgFactory{
+		LoadBasedAlgName: newLoadBasedAlgFromJSON,
+		FairShareAlgName: newFairShareAlgFromJSON,
+	}
+)
+
+// RegisterSchedulingAlgorithm makes a scheduling algorithm available by name.
+// It panics if the name is already registered or factory is nil.
+func RegisterSchedulingAlgorithm(name string, factory SchedAlgFactory) {
+	schedAlgsMu.Lock()
+	defer schedAlgsMu.Unlock()
+	if factory == nil {
+		panic(fmt.Sprintf("nil factory for scheduling algorithm %s", name))
+	}
+	if _, ok := schedAlgs[name]; ok {
+		panic(fmt.Sprintf("scheduling algorithm %s registered twice", name))
+	}
+	schedAlgs[name] = factory
+}
+
+// SchedulingAlgorithmNames returns the sorted names of the registered scheduling algorithms.
+func SchedulingAlgorithmNames() []string {
+	schedAlgsMu.RLock()
+	defer schedAlgsMu.RUnlock()
+	names := make([]string, 0, len(schedAlgs))
+	for name := range schedAlgs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewSchedulingAlgorithm creates the scheduling algorithm registered as name, DefaultSchedAlgName if name is "".
+func NewSchedulingAlgorithm(name string, config json.RawMessage, deps SchedAlgDeps) (SchedulingAlgorithm, error) {
+	if name == "" {
+		name = DefaultSchedAlgName
+	}
+	schedAlgsMu.RLock()
+	factory, ok := schedAlgs[name]
+	schedAlgsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown scheduling algorithm %s, supported values are %v", name, SchedulingAlgorithmNames())
+	}
+	if deps.Stat == nil {
+		deps.Stat = stats.NilStatsReceiver()
+	}
+	sa, err := factory(config, deps)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s scheduling algorithm config: %v", name, err)
+	}
+	return sa, nil
+}
+
+// ValidateSchedulingAlgorithm returns an error if the named scheduling algorithm doesn't exist
+// or can't be created from config.
+func ValidateSchedulingAlgorithm(name string, config json.RawMessage) error {
+	_, err := NewSchedulingAlgorithm(name, config, SchedAlgDeps{})
+	return err
+}
+
+// decodeSchedAlgConfig decodes an algorithm's JSON config into v, rejecting unknown fields.
+// v is left as is when there is no config.
+func decodeSchedAlgConfig(config json.RawMessage, v interface{}) error {
+	config = bytes.TrimSpace(config)
+	if len(config) == 0 || bytes.Equal(config, []byte("null")) {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(config))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
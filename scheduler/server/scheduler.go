@@ -16,31 +16,43 @@ type Scheduler interface {
 
 	KillJob(jobId string) error
 
+	ListJobs(filter domain.JobFilter) ([]domain.JobSummary, error)
+
+	ExplainJob(jobId string) (*domain.JobExplanation, error)
+
+	GetUnschedulableTasks(jobId string) map[string]string
+
 	GetSagaCoord() saga.SagaCoordinator
 
+	GetClusterState() ([]domain.WorkerSummary, error)
+
 	OfflineWorker(req domain.OfflineWorkerReq) error
 
 	ReinstateWorker(req domain.ReinstateWorkerReq) error
 
-	SetSchedulerStatus(maxTasks int) error
+	SetSchedulerStatus(maxTasks int, requestor string) error
 
 	GetSchedulerStatus() (int, int)
 
 	GetClassLoadPercents() (map[string]int32, error)
 
-	SetClassLoadPercents(classLoads map[string]int32) error
+	SetClassLoadPercents(classLoads map[string]int32, requestor string) error
 
 	GetRequestorToClassMap() (map[string]string, error)
 
-	SetRequestorToClassMap(requestorToClassMap map[string]string) error
+	SetRequestorToClassMap(requestorToClassMap map[string]string, requestor string) error
 
 	GetRebalanceMinimumDuration() (time.Duration, error)
 
-	SetRebalanceMinimumDuration(durationMin time.Duration) error
+	SetRebalanceMinimumDuration(durationMin time.Duration, requestor string) error
 
 	GetRebalanceThreshold() (int32, error)
 
-	SetRebalanceThreshold(durationMin int32) error
+	SetRebalanceThreshold(durationMin int32, requestor string) error
+
+	GetSettingsHistory() ([]SettingsVersion, error)
+
+	RollbackSettings(version int, requestor string) error
 }
 
 // SchedulingAlgorithm interface for the scheduling algorithm.  Implementations will compute the list of
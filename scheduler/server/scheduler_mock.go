@@ -61,6 +61,44 @@ func (mr *MockSchedulerMockRecorder) KillJob(jobId interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KillJob", reflect.TypeOf((*MockScheduler)(nil).KillJob), jobId)
 }
 
+// ListJobs mocks base method
+func (m *MockScheduler) ListJobs(filter domain.JobFilter) ([]domain.JobSummary, error) {
+	ret := m.ctrl.Call(m, "ListJobs", filter)
+	ret0, _ := ret[0].([]domain.JobSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs
+func (mr *MockSchedulerMockRecorder) ListJobs(filter interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockScheduler)(nil).ListJobs), filter)
+}
+
+// ExplainJob mocks base method
+func (m *MockScheduler) ExplainJob(jobId string) (*domain.JobExplanation, error) {
+	ret := m.ctrl.Call(m, "ExplainJob", jobId)
+	ret0, _ := ret[0].(*domain.JobExplanation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainJob indicates an expected call of ExplainJob
+func (mr *MockSchedulerMockRecorder) ExplainJob(jobId interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainJob", reflect.TypeOf((*MockScheduler)(nil).ExplainJob), jobId)
+}
+
+// GetUnschedulableTasks mocks base method
+func (m *MockScheduler) GetUnschedulableTasks(jobId string) map[string]string {
+	ret := m.ctrl.Call(m, "GetUnschedulableTasks", jobId)
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// GetUnschedulableTasks indicates an expected call of GetUnschedulableTasks
+func (mr *MockSchedulerMockRecorder) GetUnschedulableTasks(jobId interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnschedulableTasks", reflect.TypeOf((*MockScheduler)(nil).GetUnschedulableTasks), jobId)
+}
+
 // GetSagaCoord mocks base method
 func (m *MockScheduler) GetSagaCoord() saga.SagaCoordinator {
 	ret := m.ctrl.Call(m, "GetSagaCoord")
@@ -73,6 +111,19 @@ func (mr *MockSchedulerMockRecorder) GetSagaCoord() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSagaCoord", reflect.TypeOf((*MockScheduler)(nil).GetSagaCoord))
 }
 
+// GetClusterState mocks base method
+func (m *MockScheduler) GetClusterState() ([]domain.WorkerSummary, error) {
+	ret := m.ctrl.Call(m, "GetClusterState")
+	ret0, _ := ret[0].([]domain.WorkerSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterState indicates an expected call of GetClusterState
+func (mr *MockSchedulerMockRecorder) GetClusterState() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterState", reflect.TypeOf((*MockScheduler)(nil).GetClusterState))
+}
+
 // OfflineWorker mocks base method
 func (m *MockScheduler) OfflineWorker(req domain.OfflineWorkerReq) error {
 	ret := m.ctrl.Call(m, "OfflineWorker", req)
@@ -98,15 +149,15 @@ func (mr *MockSchedulerMockRecorder) ReinstateWorker(req interface{}) *gomock.Ca
 }
 
 // SetSchedulerStatus mocks base method
-func (m *MockScheduler) SetSchedulerStatus(maxTasks int) error {
-	ret := m.ctrl.Call(m, "SetSchedulerStatus", maxTasks)
+func (m *MockScheduler) SetSchedulerStatus(maxTasks int, requestor string) error {
+	ret := m.ctrl.Call(m, "SetSchedulerStatus", maxTasks, requestor)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetSchedulerStatus indicates an expected call of SetSchedulerStatus
-func (mr *MockSchedulerMockRecorder) SetSchedulerStatus(maxTasks interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSchedulerStatus", reflect.TypeOf((*MockScheduler)(nil).SetSchedulerStatus), maxTasks)
+func (mr *MockSchedulerMockRecorder) SetSchedulerStatus(maxTasks, requestor interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSchedulerStatus", reflect.TypeOf((*MockScheduler)(nil).SetSchedulerStatus), maxTasks, requestor)
 }
 
 // GetSchedulerStatus mocks base method
@@ -136,15 +187,15 @@ func (mr *MockSchedulerMockRecorder) GetClassLoadPercents() *gomock.Call {
 }
 
 // SetClassLoadPercents mocks base method
-func (m *MockScheduler) SetClassLoadPercents(classLoads map[string]int32) error {
-	ret := m.ctrl.Call(m, "SetClassLoadPercents", classLoads)
+func (m *MockScheduler) SetClassLoadPercents(classLoads map[string]int32, requestor string) error {
+	ret := m.ctrl.Call(m, "SetClassLoadPercents", classLoads, requestor)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetClassLoadPercents indicates an expected call of SetClassLoadPercents
-func (mr *MockSchedulerMockRecorder) SetClassLoadPercents(classLoads interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClassLoadPercents", reflect.TypeOf((*MockScheduler)(nil).SetClassLoadPercents), classLoads)
+func (mr *MockSchedulerMockRecorder) SetClassLoadPercents(classLoads, requestor interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClassLoadPercents", reflect.TypeOf((*MockScheduler)(nil).SetClassLoadPercents), classLoads, requestor)
 }
 
 // GetRequestorToClassMap mocks base method
@@ -161,15 +212,15 @@ func (mr *MockSchedulerMockRecorder) GetRequestorToClassMap() *gomock.Call {
 }
 
 // SetRequestorToClassMap mocks base method
-func (m *MockScheduler) SetRequestorToClassMap(requestorToClassMap map[string]string) error {
-	ret := m.ctrl.Call(m, "SetRequestorToClassMap", requestorToClassMap)
+func (m *MockScheduler) SetRequestorToClassMap(requestorToClassMap map[string]string, requestor string) error {
+	ret := m.ctrl.Call(m, "SetRequestorToClassMap", requestorToClassMap, requestor)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetRequestorToClassMap indicates an expected call of SetRequestorToClassMap
-func (mr *MockSchedulerMockRecorder) SetRequestorToClassMap(requestorToClassMap interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRequestorToClassMap", reflect.TypeOf((*MockScheduler)(nil).SetRequestorToClassMap), requestorToClassMap)
+func (mr *MockSchedulerMockRecorder) SetRequestorToClassMap(requestorToClassMap, requestor interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRequestorToClassMap", reflect.TypeOf((*MockScheduler)(nil).SetRequestorToClassMap), requestorToClassMap, requestor)
 }
 
 // GetRebalanceMinimumDuration mocks base method
@@ -186,15 +237,15 @@ func (mr *MockSchedulerMockRecorder) GetRebalanceMinimumDuration() *gomock.Call
 }
 
 // SetRebalanceMinimumDuration mocks base method
-func (m *MockScheduler) SetRebalanceMinimumDuration(durationMin time.Duration) error {
-	ret := m.ctrl.Call(m, "SetRebalanceMinimumDuration", durationMin)
+func (m *MockScheduler) SetRebalanceMinimumDuration(durationMin time.Duration, requestor string) error {
+	ret := m.ctrl.Call(m, "SetRebalanceMinimumDuration", durationMin, requestor)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetRebalanceMinimumDuration indicates an expected call of SetRebalanceMinimumDuration
-func (mr *MockSchedulerMockRecorder) SetRebalanceMinimumDuration(durationMin interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRebalanceMinimumDuration", reflect.TypeOf((*MockScheduler)(nil).SetRebalanceMinimumDuration), durationMin)
+func (mr *MockSchedulerMockRecorder) SetRebalanceMinimumDuration(durationMin, requestor interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRebalanceMinimumDuration", reflect.TypeOf((*MockScheduler)(nil).SetRebalanceMinimumDuration), durationMin, requestor)
 }
 
 // GetRebalanceThreshold mocks base method
@@ -211,15 +262,40 @@ func (mr *MockSchedulerMockRecorder) GetRebalanceThreshold() *gomock.Call {
 }
 
 // SetRebalanceThreshold mocks base method
-func (m *MockScheduler) SetRebalanceThreshold(durationMin int32) error {
-	ret := m.ctrl.Call(m, "SetRebalanceThreshold", durationMin)
+func (m *MockScheduler) SetRebalanceThreshold(durationMin int32, requestor string) error {
+	ret := m.ctrl.Call(m, "SetRebalanceThreshold", durationMin, requestor)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetRebalanceThreshold indicates an expected call of SetRebalanceThreshold
-func (mr *MockSchedulerMockRecorder) SetRebalanceThreshold(durationMin interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRebalanceThreshold", reflect.TypeOf((*MockScheduler)(nil).SetRebalanceThreshold), durationMin)
+func (mr *MockSchedulerMockRecorder) SetRebalanceThreshold(durationMin, requestor interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRebalanceThreshold", reflect.TypeOf((*MockScheduler)(nil).SetRebalanceThreshold), durationMin, requestor)
+}
+
+// GetSettingsHistory mocks base method
+func (m *MockScheduler) GetSettingsHistory() ([]SettingsVersion, error) {
+	ret := m.ctrl.Call(m, "GetSettingsHistory")
+	ret0, _ := ret[0].([]SettingsVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettingsHistory indicates an expected call of GetSettingsHistory
+func (mr *MockSchedulerMockRecorder) GetSettingsHistory() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettingsHistory", reflect.TypeOf((*MockScheduler)(nil).GetSettingsHistory))
+}
+
+// RollbackSettings mocks base method
+func (m *MockScheduler) RollbackSettings(version int, requestor string) error {
+	ret := m.ctrl.Call(m, "RollbackSettings", version, requestor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackSettings indicates an expected call of RollbackSettings
+func (mr *MockSchedulerMockRecorder) RollbackSettings(version, requestor interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSettings", reflect.TypeOf((*MockScheduler)(nil).RollbackSettings), version, requestor)
 }
 
 // MockSchedulingAlgorithm is a mock of SchedulingAlgorithm interface
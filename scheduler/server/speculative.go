@@ -0,0 +1,239 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/twitter/scoot/common/stats"
+)
+
+// With speculative execution enabled, a task that runs for longer than SpeculativeMultiple times its
+// average duration has a duplicate attempt started on an idle node other than the one running it.
+// The two attempts race: the first to finish logs the task's end to the saga and its result is the task's,
+// the other is aborted.  The speculative attempt doesn't log to the saga unless it finishes first.
+
+// Error for attempts aborted because another attempt of the same run finished first.
+const SupersededErrStr = "Superseded"
+
+// Suffix of the task id a speculative attempt is tracked by in clusterState, so it isn't confused
+// with the task's own run.
+const speculativeTaskSuffix = ":speculative"
+
+// attemptRace is shared by a run and its speculative attempt, if any.
+type attemptRace struct {
+	mu      sync.Mutex
+	runners []*taskRunner // the racing attempts, the original run first
+	winner  *taskRunner
+	done    chan struct{} // closed once the winner's result is logged
+	err     error         // the winner's error, set before done is closed
+}
+
+func newAttemptRace(r *taskRunner) *attemptRace {
+	return &attemptRace{runners: []*taskRunner{r}, done: make(chan struct{})}
+}
+
+// Adds a speculative attempt to the race, returns false if an attempt already finished.
+func (a *attemptRace) add(r *taskRunner) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.winner != nil {
+		return false
+	}
+	a.runners = append(a.runners, r)
+	return true
+}
+
+// Returns true if r is the first attempt to finish.
+func (a *attemptRace) claim(r *taskRunner) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.winner != nil {
+		return false
+	}
+	a.winner = r
+	return true
+}
+
+// Called by the winner once its result is logged, releasing the other attempt.
+func (a *attemptRace) finish(err error) {
+	a.err = err
+	close(a.done)
+}
+
+// Waits for the winner's result and takes it on as the losing attempt's own.
+func (a *attemptRace) outcome(r *taskRunner) error {
+	<-a.done
+	r.result = a.winner.result
+	r.retry = a.winner.retry
+	return a.err
+}
+
+// Returns true if r finished first.
+func (a *attemptRace) won(r *taskRunner) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.winner == r
+}
+
+// Aborts the attempts that are still running once r finished first.
+func (a *attemptRace) abortLosers(r *taskRunner) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.winner != r {
+		return
+	}
+	for _, runner := range a.runners {
+		if runner != r {
+			runner.abortSuperseded()
+		}
+	}
+}
+
+// Returns true if the task's current run has been running long enough, compared to the average duration
+// of its earlier runs, to start a speculative attempt.
+func (s *statefulScheduler) isStraggler(task *taskState, now time.Time) bool {
+	if task.TaskRunner == nil || task.TaskRunner.race == nil || task.SpeculativeRunner != nil {
+		return false
+	}
+	elapsed := now.Sub(task.TimeStarted)
+	if elapsed < s.config.SpeculativeMinDuration {
+		return false
+	}
+	iface, ok := s.taskDurations.Get(s.durationKeyExtractorFn(task.TaskId))
+	if !ok {
+		return false
+	}
+	ad, ok := iface.(*averageDuration)
+	if !ok || ad.count == 0 {
+		return false
+	}
+	return elapsed > time.Duration(float64(ad.duration)*s.config.SpeculativeMultiple)
+}
+
+// Returns an idle node to run a speculative attempt of the task on, nil if there is none.
+// The node must not be running any task, and preferably last ran a task with the same snapshot.
+func (s *statefulScheduler) findSpeculativeNode(task *taskState) *nodeState {
+	var found *nodeState
+	for id, ns := range s.clusterState.nodes {
+		if id == task.TaskRunner.nodeSt.node.Id() || len(ns.running) > 0 || ns.suspended() ||
+			s.clusterState.isOfflined(ns) || !ns.matches(task.Def.RequiredLabels) {
+			continue
+		}
+		if ns.snapshotId == task.Def.SnapshotID {
+			return ns
+		}
+		if found == nil {
+			found = ns
+		}
+	}
+	return found
+}
+
+// Starts speculative attempts of the running tasks that straggle, while there are idle nodes.
+// Tasks of killed jobs, and runs that already finished, aren't speculated on.
+//
+// this function is part of the main scheduler loop
+func (s *statefulScheduler) speculateStragglers() {
+	if s.config.SpeculativeMultiple <= 0 {
+		return
+	}
+	now := time.Now()
+	for _, jobState := range s.inProgressJobs {
+		if jobState.JobKilled {
+			continue
+		}
+		for _, task := range jobState.Tasks {
+			if !s.isStraggler(task, now) {
+				continue
+			}
+			nodeSt := s.findSpeculativeNode(task)
+			if nodeSt == nil {
+				// other stragglers may match idle nodes this task's required labels don't
+				continue
+			}
+			s.runSpeculativeAttempt(jobState, task, nodeSt)
+		}
+	}
+}
+
+// Runs a duplicate of the task's current run on the given node.
+func (s *statefulScheduler) runSpeculativeAttempt(jobState *jobState, task *taskState, nodeSt *nodeState) {
+	orig := task.TaskRunner
+	taskDef := task.Def
+	taskDef.JobID = task.JobId
+	taskDef.Tag = jobState.Job.Def.Tag
+	rs := s.runnerFactory(nodeSt.node)
+	logFields := log.Fields{
+		"jobID":         task.JobId,
+		"taskID":        task.TaskId,
+		"node":          nodeSt.node,
+		"stragglerNode": orig.nodeSt.node,
+		"running":       time.Since(task.TimeStarted),
+		"requestor":     jobState.Job.Def.Requestor,
+		"jobType":       jobState.Job.Def.JobType,
+		"tag":           jobState.Job.Def.Tag,
+	}
+
+	tRunner := &taskRunner{
+		saga:   orig.saga,
+		runner: rs,
+		stat:   s.stat,
+
+		defaultTaskTimeout:    s.config.DefaultTaskTimeout,
+		taskTimeoutOverhead:   s.config.TaskTimeoutOverhead,
+		runnerRetryTimeout:    s.config.RunnerRetryTimeout,
+		runnerRetryInterval:   s.config.RunnerRetryInterval,
+		markCompleteOnFailure: orig.markCompleteOnFailure,
+		speculative:           true,
+
+		LogTags: orig.LogTags,
+
+		task:   taskDef,
+		nodeSt: nodeSt,
+
+		abortCh:      make(chan abortReq, 1),
+		queryAbortCh: make(chan interface{}, 1),
+
+		retryable:        orig.retryable,
+		previousAttempts: orig.previousAttempts,
+		race:             orig.race,
+
+		startTime: time.Now(),
+	}
+	if !orig.race.add(tRunner) {
+		// the run just finished, its callback hasn't been processed yet
+		rs.Release()
+		return
+	}
+	log.WithFields(logFields).Info("Starting speculative attempt of straggling task")
+	speculativeID := task.TaskId + speculativeTaskSuffix
+	s.clusterState.taskScheduled(nodeSt.node.Id(), task.JobId, speculativeID, taskDef.SnapshotID, taskDef.Resources)
+	task.SpeculativeRunner = tRunner
+	s.stat.Counter(stats.SchedSpeculativeTaskCounter).Inc(1)
+
+	s.asyncRunner.RunAsync(
+		tRunner.run,
+		func(err error) {
+			defer rs.Release()
+
+			// Free the node, using a fake id if it was lost or re-added so its new assignments aren't clobbered.
+			nodeId := nodeSt.node.Id()
+			if nodeStInstance, ok := s.clusterState.getNodeState(nodeId); !ok || nodeStInstance != nodeSt {
+				nodeId = nodeId + ":ERROR"
+			}
+			s.clusterState.taskCompleted(nodeId, task.JobId, speculativeID, false)
+			if task.SpeculativeRunner == tRunner {
+				task.SpeculativeRunner = nil
+			}
+
+			// The run's own callback takes care of the task, using this attempt's result if it won.
+			if tRunner.race.won(tRunner) {
+				logFields["err"] = err
+				log.WithFields(logFields).Info("Speculative attempt finished first, aborting the straggling run")
+				s.stat.Counter(stats.SchedSpeculativeWinsCounter).Inc(1)
+				tRunner.race.abortLosers(tRunner)
+			}
+		})
+}
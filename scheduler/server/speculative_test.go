@@ -0,0 +1,211 @@
+package server
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	cc "github.com/twitter/scoot/cloud/cluster"
+	"github.com/twitter/scoot/common/stats"
+	"github.com/twitter/scoot/runner"
+	"github.com/twitter/scoot/runner/execer/execers"
+	"github.com/twitter/scoot/runner/runners"
+	"github.com/twitter/scoot/scheduler/domain"
+	"github.com/twitter/scoot/snapshot"
+	"github.com/twitter/scoot/snapshot/snapshots"
+	workerdomain "github.com/twitter/scoot/worker/domain"
+)
+
+// Returns scheduler deps whose runners each have their own SimExecer, appended to the returned list as they're made.
+func getDepsWithPausingWorkers() (*schedulerDeps, func() []*execers.SimExecer) {
+	deps, _ := getDepsWithSimWorker()
+	var mu sync.Mutex
+	var exs []*execers.SimExecer
+	deps.rf = func(n cc.Node) runner.Service {
+		ex := execers.NewSimExecer()
+		mu.Lock()
+		exs = append(exs, ex)
+		mu.Unlock()
+		filerMap := runner.MakeRunTypeMap()
+		filerMap[runner.RunTypeScoot] = snapshot.FilerAndInitDoneCh{Filer: snapshots.MakeInvalidFiler(), IDC: nil}
+		return runners.NewSingleRunner(ex, filerMap, runners.NewNullOutputCreator(), nil, stats.NopDirsMonitor, runner.EmptyID, []func() error{}, []func() error{}, nil)
+	}
+	return deps, func() []*execers.SimExecer {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]*execers.SimExecer{}, exs...)
+	}
+}
+
+func Test_StatefulScheduler_SpeculativeAttemptWins(t *testing.T) {
+	deps, getExecers := getDepsWithPausingWorkers()
+	deps.config.SpeculativeMultiple = 2
+	s := makeStatefulSchedulerDeps(deps)
+
+	jobDef := domain.GenJobDef(1)
+	jobDef.Tasks[0].Argv = []string{"pause", "complete 0"}
+	taskId := jobDef.Tasks[0].TaskID
+	addOrUpdateTaskDuration(s.taskDurations, s.durationKeyExtractorFn(taskId), time.Millisecond)
+	jobId := scheduleJobDef(t, s, jobDef)
+
+	for s.getJob(jobId) == nil || s.getJob(jobId).getTask(taskId).Status == domain.NotStarted {
+		s.step()
+	}
+	task := s.getJob(jobId).getTask(taskId)
+	stragglerNode := task.TaskRunner.nodeSt.node.Id()
+
+	// the run has taken more than twice its average, a duplicate attempt starts on another node.
+	time.Sleep(10 * time.Millisecond)
+	s.step()
+	if task.SpeculativeRunner == nil {
+		t.Fatalf("Expected a speculative attempt of the straggling task")
+	}
+	if node := task.SpeculativeRunner.nodeSt.node.Id(); node == stragglerNode {
+		t.Fatalf("Expected the speculative attempt to run on another node than %s", stragglerNode)
+	}
+	s.step()
+	if exs := getExecers(); len(exs) != 2 {
+		t.Fatalf("Expected only one speculative attempt, got %d runs", len(exs))
+	}
+
+	// let the speculative attempt finish, the straggling run is aborted.
+	go getExecers()[1].Resume()
+	for s.getJob(jobId) != nil {
+		s.step()
+	}
+	if !task.Succeeded || task.SpeculativeRunner != nil || s.clusterState.numRunning != 0 {
+		t.Errorf("Expected the task to succeed and both attempts to be done, succeeded: %t, running: %d",
+			task.Succeeded, s.clusterState.numRunning)
+	}
+
+	state, err := deps.sc.GetSagaState(jobId)
+	if err != nil {
+		t.Fatalf("Unexpected error getting saga state: %v", err)
+	}
+	st, err := workerdomain.DeserializeProcessStatus(state.GetEndTaskData(taskId))
+	if err != nil || st.State != runner.COMPLETE || st.ExitCode != 0 {
+		t.Errorf("Expected the speculative attempt's result to be logged, got %s, %v", st, err)
+	}
+
+	if !stats.StatsOk("", deps.statsRegistry, t,
+		map[string]stats.Rule{
+			stats.SchedSpeculativeTaskCounter: {Checker: stats.Int64EqTest, Value: 1},
+			stats.SchedSpeculativeWinsCounter: {Checker: stats.Int64EqTest, Value: 1},
+		}) {
+		t.Fatal("stats check did not pass.")
+	}
+}
+
+func Test_StatefulScheduler_OriginalRunWins(t *testing.T) {
+	deps, getExecers := getDepsWithPausingWorkers()
+	deps.config.SpeculativeMultiple = 2
+	s := makeStatefulSchedulerDeps(deps)
+
+	jobDef := domain.GenJobDef(1)
+	jobDef.Tasks[0].Argv = []string{"pause", "complete 0"}
+	taskId := jobDef.Tasks[0].TaskID
+	addOrUpdateTaskDuration(s.taskDurations, s.durationKeyExtractorFn(taskId), time.Millisecond)
+	jobId := scheduleJobDef(t, s, jobDef)
+
+	for s.getJob(jobId) == nil || s.getJob(jobId).getTask(taskId).Status == domain.NotStarted {
+		s.step()
+	}
+	task := s.getJob(jobId).getTask(taskId)
+	time.Sleep(10 * time.Millisecond)
+	s.step()
+	if task.SpeculativeRunner == nil {
+		t.Fatalf("Expected a speculative attempt of the straggling task")
+	}
+
+	// let the straggling run finish first, the speculative attempt is aborted.
+	go getExecers()[0].Resume()
+	for start := time.Now(); s.getJob(jobId) != nil || s.clusterState.numRunning != 0; s.step() {
+		if time.Since(start) > 5*time.Second {
+			t.Fatalf("Timed out waiting for both attempts to be done, running: %d", s.clusterState.numRunning)
+		}
+	}
+	if !task.Succeeded || task.SpeculativeRunner != nil {
+		t.Errorf("Expected the task to succeed and the speculative attempt to be done")
+	}
+
+	state, err := deps.sc.GetSagaState(jobId)
+	if err != nil {
+		t.Fatalf("Unexpected error getting saga state: %v", err)
+	}
+	st, err := workerdomain.DeserializeProcessStatus(state.GetEndTaskData(taskId))
+	if err != nil || st.State != runner.COMPLETE || st.ExitCode != 0 {
+		t.Errorf("Expected the straggling run's result to be logged, got %s, %v", st, err)
+	}
+
+	if !stats.StatsOk("", deps.statsRegistry, t,
+		map[string]stats.Rule{
+			stats.SchedSpeculativeTaskCounter: {Checker: stats.Int64EqTest, Value: 1},
+			stats.SchedSpeculativeWinsCounter: {Checker: stats.DoesNotExistTest},
+		}) {
+		t.Fatal("stats check did not pass.")
+	}
+}
+
+func Test_StatefulScheduler_NoSpeculationByDefault(t *testing.T) {
+	deps, getExecers := getDepsWithPausingWorkers()
+	s := makeStatefulSchedulerDeps(deps)
+
+	jobDef := domain.GenJobDef(1)
+	jobDef.Tasks[0].Argv = []string{"pause", "complete 0"}
+	taskId := jobDef.Tasks[0].TaskID
+	addOrUpdateTaskDuration(s.taskDurations, s.durationKeyExtractorFn(taskId), time.Millisecond)
+	jobId := scheduleJobDef(t, s, jobDef)
+
+	for s.getJob(jobId) == nil || s.getJob(jobId).getTask(taskId).Status == domain.NotStarted {
+		s.step()
+	}
+	time.Sleep(10 * time.Millisecond)
+	s.step()
+	if task := s.getJob(jobId).getTask(taskId); task.SpeculativeRunner != nil || len(getExecers()) != 1 {
+		t.Errorf("Expected no speculative attempt unless SpeculativeMultiple is set")
+	}
+
+	go getExecers()[0].Resume()
+	for s.getJob(jobId) != nil {
+		s.step()
+	}
+}
+
+func Test_AttemptRace(t *testing.T) {
+	orig, spec := &taskRunner{}, &taskRunner{}
+	race := newAttemptRace(orig)
+	if !race.add(spec) {
+		t.Fatalf("Expected to add a speculative attempt before either attempt finished")
+	}
+	if !race.claim(spec) || race.claim(orig) {
+		t.Fatalf("Expected only the first attempt to finish to win")
+	}
+	spec.result = runner.RunStatus{RunID: "run2", State: runner.COMPLETE}
+	race.finish(nil)
+	if err := race.outcome(orig); err != nil || orig.result.RunID != "run2" {
+		t.Errorf("Expected the losing attempt to take on the winner's result, got %s, %v", orig.result, err)
+	}
+	if race.add(&taskRunner{}) {
+		t.Errorf("Expected no attempt to be added once one finished")
+	}
+}
+
+func Test_AbortAfterSuperseded(t *testing.T) {
+	r := &taskRunner{abortCh: make(chan abortReq, 1), queryAbortCh: make(chan interface{}, 1)}
+
+	// the losing attempt wasn't querying, so neither abort was consumed.
+	r.abortSuperseded()
+	done := make(chan struct{})
+	go func() {
+		r.Abort(true, UserRequestedErrStr)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("Timed out waiting for Abort with a superseded abort pending")
+	}
+	if req := <-r.abortCh; !req.endTask || req.err != UserRequestedErrStr {
+		t.Errorf("Expected the user's abort to replace the superseded one, got %+v", req)
+	}
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -29,6 +30,9 @@ const (
 	// Clients will check for this string to differentiate between scoot and user initiated actions.
 	UserRequestedErrStr = "UserRequested"
 
+	// Error prefix for tasks that were never run because one of their dependencies failed.
+	DependencyFailedErrStr = "DependencyFailed"
+
 	// Provide defaults for config settings that should never be uninitialized/zero.
 	// These are reasonable defaults for a small cluster of around a couple dozen nodes.
 
@@ -113,9 +117,22 @@ func nopDurationKeyExtractor(key string) string {
 //     how long to sleep between runner req retries.
 // ReadyFnBackoff -
 //     how long to wait between runner status queries to determine [init] status.
+// DrainCheckInterval -
+//     how long to wait between runner status queries to determine whether healthy nodes
+//     are draining, 0 disables the checks, see drain.go.
 // TaskThrottle -
 //	   requestors will try not to schedule jobs that make the scheduler exceed
 //     the TaskThrottle.  Note: Sickle may exceed it with retries.
+// SpeculativeMultiple -
+//     if > 0, a task running longer than this multiple of its average duration
+//     has a duplicate attempt started on an idle node, see speculative.go.
+// SpeculativeMinDuration -
+//     tasks that have run for less than this aren't speculated on.
+// SchedAlgName -
+//     name of the registered scheduling algorithm to use, DefaultSchedAlgName
+//     if empty, see sched_alg.go.
+// SchedAlgConfig -
+//     the scheduling algorithm's JSON config, its defaults are used if empty.
 type SchedulerConfiguration struct {
 	MaxRetriesPerTask    int
 	DebugMode            bool
@@ -130,16 +147,26 @@ type SchedulerConfiguration struct {
 	TaskThrottle         int
 	Admins               []string
 
-	SchedAlgConfig interface{}
+	SpeculativeMultiple    float64
+	SpeculativeMinDuration time.Duration
+
+	SchedAlgName   string
+	SchedAlgConfig json.RawMessage
 	SchedAlg       SchedulingAlgorithm
+
+	DrainCheckInterval time.Duration
+
+	// if set, the scheduler only schedules jobs while it is the elected leader
+	Elector LeaderElector
 }
 
 func (sc *SchedulerConfiguration) String() string {
 	return fmt.Sprintf("SchedulerConfiguration: MaxRetriesPerTask: %d, DebugMode: %t, RecoverJobsOnStartup: %t, DefaultTaskTimeout: %s, "+
 		"TaskTimeoutOverhead: %s, RunnerRetryTimeout: %s, RunnerRetryInterval: %s, MaxRequestors: %d, MaxJobsPerRequestor: %d, TaskThrottle: %d, "+
-		"Admins: %v",
+		"Admins: %v, SpeculativeMultiple: %g, SpeculativeMinDuration: %s, SchedAlgName: %s, SchedAlgConfig: %s, DrainCheckInterval: %s",
 		sc.MaxRetriesPerTask, sc.DebugMode, sc.RecoverJobsOnStartup, sc.DefaultTaskTimeout, sc.TaskTimeoutOverhead, sc.RunnerRetryTimeout,
-		sc.RunnerRetryInterval, sc.MaxRequestors, sc.MaxJobsPerRequestor, sc.TaskThrottle, sc.Admins)
+		sc.RunnerRetryInterval, sc.MaxRequestors, sc.MaxJobsPerRequestor, sc.TaskThrottle, sc.Admins, sc.SpeculativeMultiple,
+		sc.SpeculativeMinDuration, sc.SchedAlgName, sc.SchedAlgConfig, sc.DrainCheckInterval)
 }
 
 // Used to keep a running average of duration for a specific task.
@@ -173,6 +200,10 @@ type statefulScheduler struct {
 	checkJobCh    chan jobCheckMsg
 	addJobCh      chan jobAddedMsg
 	killJobCh     chan jobKillRequest
+	listJobsCh    chan jobListRequest
+	explainJobCh  chan jobExplainRequest
+	workersCh     chan workersRequest
+	offlineCh     chan workerOfflineRequest
 	stepTicker    *time.Ticker
 
 	// Scheduler State
@@ -185,16 +216,31 @@ type statefulScheduler struct {
 
 	tasksByJobClassAndStartTimeSec map[taskClassAndStartKey]taskStateByJobIDTaskID // map of tasks by their class and start time
 
+	// when the waiting tasks' WaitReasons were last recorded, see explain.go
+	waitReasonsUpdated time.Time
+
 	// stats
 	stat stats.StatsReceiver
 
 	persistor      Persistor
 	TaskThrottleMu sync.RWMutex // mutex must be here to avoid copying the lock when passing config to scheduler constructor
 
+	// copy of the offline records to persist, see offline.go
+	offlinedWorkersMu sync.RWMutex
+	offlinedWorkers   []OfflinedWorker
+
+	// copy of why the waiting tasks no node can run are unschedulable, by job id then task id,
+	// so job status can report them without going through the scheduler loop
+	unschedulableTasksMu sync.RWMutex
+	unschedulableTasks   map[string]map[string]string
+
 	// durationKeyExtractorFn - function to extract, from taskID, the key to use for tracking task average durations
 	durationKeyExtractorFn func(string) string
 
 	SchedAlg SchedulingAlgorithm
+
+	// called when the scheduler is deposed as leader
+	leadershipLostFn func()
 }
 
 func (s *statefulScheduler) String() string {
@@ -208,6 +254,17 @@ type jobKillRequest struct {
 	responseCh chan error
 }
 
+// contains the filter for listing jobs and callback for the matching jobs
+type jobListRequest struct {
+	filter     domain.JobFilter
+	responseCh chan []domain.JobSummary
+}
+
+// contains the callback for the state of the cluster's workers
+type workersRequest struct {
+	responseCh chan []domain.WorkerSummary
+}
+
 // Create a New StatefulScheduler that implements the Scheduler interface
 // cc.Cluster - cluster of worker nodes
 // saga.SagaCoordinator - the Saga Coordinator to log to and recover from
@@ -219,6 +276,10 @@ type jobKillRequest struct {
 // step(), intended for debugging and test cases
 // If recoverJobsOnStartup is true Active Sagas in the saga log will be recovered
 // and rescheduled, otherwise no recovery will be done on startup
+// If config.Elector is set the scheduler stands by until it is elected leader, then
+// recovers the Active Sagas regardless of recoverJobsOnStartup and starts the loop
+// config.SchedAlgName and config.SchedAlgConfig must have passed ValidateSchedulingAlgorithm,
+// as the configuration parsing does, NewStatefulScheduler panics if the algorithm can't be created
 func NewStatefulScheduler(
 	nodesUpdatesCh chan []cc.NodeUpdate,
 	sc saga.SagaCoordinator,
@@ -227,7 +288,7 @@ func NewStatefulScheduler(
 	stat stats.StatsReceiver,
 	persistor Persistor,
 	durationKeyExtractorFn func(string) string) *statefulScheduler {
-	nodeReadyFn := func(node cc.Node) (bool, time.Duration) {
+	nodeReadyFn := func(node cc.Node) (bool, NodeInfo, time.Duration) {
 		run := rf(node)
 		st, svc, err := run.StatusAll()
 		if err != nil || !svc.Initialized {
@@ -237,9 +298,9 @@ func NewStatefulScheduler(
 						"node": node,
 						"err":  svc.Error,
 					}).Info("received service err during init of new node")
-				return false, 0
+				return false, NodeInfo{}, 0
 			}
-			return false, config.ReadyFnBackoff
+			return false, NodeInfo{}, config.ReadyFnBackoff
 		}
 		for _, s := range st {
 			log.WithFields(
@@ -258,11 +319,15 @@ func NewStatefulScheduler(
 				}).Info("Aborting existing run on new node")
 			run.Abort(s.RunID)
 		}
-		return true, 0
+		return true, NodeInfo{Capacity: svc.Capacity, Labels: svc.Labels}, 0
 	}
 	if config.ReadyFnBackoff == 0 {
 		nodeReadyFn = nil
 	}
+	nodeDrainingFn := func(node cc.Node) (bool, error) {
+		_, svc, err := rf(node).StatusAll()
+		return svc.Draining, err
+	}
 
 	if config.DefaultTaskTimeout == 0 {
 		config.DefaultTaskTimeout = DefaultDefaultTaskTimeout
@@ -276,18 +341,15 @@ func NewStatefulScheduler(
 	if config.MaxJobsPerRequestor == 0 {
 		config.MaxJobsPerRequestor = DefaultMaxJobsPerRequestor
 	}
-	config.SchedAlgConfig = &LoadBasedAlgConfig{
-		stat: stat,
-	}
 
-	// create the load base scheduling algorithm
+	// create the configured scheduling algorithm, the load based one by default
 	tasksByClassAndStartMap := map[taskClassAndStartKey]taskStateByJobIDTaskID{}
-	sa := NewLoadBasedAlg(config.SchedAlgConfig.(*LoadBasedAlgConfig), tasksByClassAndStartMap)
-	sa.setClassLoadPercents(DefaultLoadBasedSchedulerClassPercents)
-	sa.setRequestorToClassMap(DefaultRequestorToClassMap)
-	sa.setRebalanceMinimumDuration(DefaultMinRebalanceTime)
+	sa, err := NewSchedulingAlgorithm(config.SchedAlgName, config.SchedAlgConfig,
+		SchedAlgDeps{Stat: stat, tasksByJobClassAndStartTimeSec: tasksByClassAndStartMap})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create scheduling algorithm, it must be checked with ValidateSchedulingAlgorithm: %v", err))
+	}
 	config.SchedAlg = sa
-	config.SchedAlgConfig = sa.config
 
 	requestorHistory, err := lru.New(DefaultMaxRequestorHistories)
 	if err != nil {
@@ -314,6 +376,10 @@ func NewStatefulScheduler(
 		checkJobCh:    make(chan jobCheckMsg, 1),
 		addJobCh:      make(chan jobAddedMsg, 1),
 		killJobCh:     make(chan jobKillRequest, 1), // TODO - what should this value be?
+		listJobsCh:    make(chan jobListRequest, 1),
+		explainJobCh:  make(chan jobExplainRequest, 1),
+		workersCh:     make(chan workersRequest, 1),
+		offlineCh:     make(chan workerOfflineRequest, 1),
 		stepTicker:    time.NewTicker(TickRate),
 
 		clusterState:     newClusterState(nodesUpdatesCh, nodeReadyFn, stat),
@@ -326,6 +392,10 @@ func NewStatefulScheduler(
 		tasksByJobClassAndStartTimeSec: tasksByClassAndStartMap,
 		persistor:                      persistor,
 		durationKeyExtractorFn:         dkef,
+		leadershipLostFn:               exitOnLeadershipLost,
+	}
+	if config.DrainCheckInterval > 0 {
+		sched.clusterState.setDrainingFn(nodeDrainingFn, config.DrainCheckInterval)
 	}
 
 	sched.setThrottle(-1)
@@ -337,22 +407,31 @@ func NewStatefulScheduler(
 
 	log.Info(sched)
 
-	if !config.DebugMode {
+	if config.Elector != nil {
+		go sched.runWhenElected()
+	} else {
+		sched.start(config.RecoverJobsOnStartup)
+	}
+	return sched
+}
+
+// Starts the scheduler loop unless in debug mode, and recovers the active sagas if recover is true.
+func (s *statefulScheduler) start(recover bool) {
+	if !s.config.DebugMode {
 		// start the scheduler loop
 		log.Info("Starting scheduler loop")
 		go func() {
-			sched.loop()
+			s.loop()
 		}()
 	}
 
 	// Recover Jobs in a separate go routine to allow the scheduler
 	// to accept new jobs while recovering old ones.
-	if config.RecoverJobsOnStartup {
+	if recover {
 		go func() {
-			recoverJobs(sched.sagaCoord, sched.addJobCh)
+			recoverJobs(s.sagaCoord, s.addJobCh)
 		}()
 	}
-	return sched
 }
 
 type jobCheckMsg struct {
@@ -400,6 +479,10 @@ func (s *statefulScheduler) ScheduleJob(jobDef domain.JobDefinition) (string, er
 			"numTasks":  len(jobDef.Tasks),
 		}).Info("New job request")
 
+	if err := s.checkLeader(); err != nil {
+		return "", err
+	}
+
 	checkResultCh := make(chan error, 1)
 	s.checkJobCh <- jobCheckMsg{
 		jobDef:   &jobDef,
@@ -510,6 +593,22 @@ func (s *statefulScheduler) loop() {
 			go func() {
 				s.killJobCh <- msg
 			}()
+		case msg := <-s.listJobsCh:
+			go func() {
+				s.listJobsCh <- msg
+			}()
+		case msg := <-s.explainJobCh:
+			go func() {
+				s.explainJobCh <- msg
+			}()
+		case msg := <-s.workersCh:
+			go func() {
+				s.workersCh <- msg
+			}()
+		case msg := <-s.offlineCh:
+			go func() {
+				s.offlineCh <- msg
+			}()
 		case <-s.stepTicker.C:
 		}
 	}
@@ -517,6 +616,11 @@ func (s *statefulScheduler) loop() {
 
 // run one loop iteration
 func (s *statefulScheduler) step() {
+	// once the leader's lease expired another scheduler may be running its jobs,
+	// so it stops scheduling right away instead of when it learns it was deposed
+	if s.config.Elector != nil && !s.config.Elector.IsLeader() {
+		return
+	}
 	defer s.stat.Latency(stats.SchedStepLatency_ms).Time().Stop()
 
 	// update scheduler state with messages received since last loop
@@ -524,6 +628,7 @@ func (s *statefulScheduler) step() {
 	// async functions completed & invoke callbacks
 	s.addJobs()
 	s.clusterState.updateCluster()
+	s.offlineWorkers()
 
 	procMessagesLatency := s.stat.Latency(stats.SchedProcessMessagesLatency_ms).Time()
 	s.asyncRunner.ProcessMessages()
@@ -535,7 +640,14 @@ func (s *statefulScheduler) step() {
 	// have occurred
 	s.checkForCompletedJobs()
 	s.killJobs()
+	s.skipTasksWithFailedDependencies()
+	s.markUnschedulableTasks()
+	s.runCleanupTasks()
 	s.scheduleTasks()
+	s.speculateStragglers()
+	s.listJobs()
+	s.explainJobs()
+	s.listWorkers()
 
 	s.updateStats()
 }
@@ -639,10 +751,10 @@ func (s *statefulScheduler) updateStats() {
 	s.stat.Gauge(stats.SchedNumAsyncRunnersGauge).Update(int64(s.asyncRunner.NumRunning())) //TODO remove when done debugging
 
 	// print internal data structure sizes
-	var lbs *LoadBasedAlg = s.config.SchedAlg.(*LoadBasedAlg)
-	lbsStats := lbs.GetDataStructureSizeStats()
-	for k, v := range lbsStats {
-		s.stat.Gauge(k).Update(int64(v))
+	if sa, ok := s.config.SchedAlg.(interface{ GetDataStructureSizeStats() map[string]int }); ok {
+		for k, v := range sa.GetDataStructureSizeStats() {
+			s.stat.Gauge(k).Update(int64(v))
+		}
 	}
 	s.stat.Gauge(stats.SchedTaskStartTimeMapSize).Update(int64(s.getSchedTaskStartTimeMapSize()))
 	s.stat.Gauge(stats.SchedInProgressJobsSize).Update(int64(len(s.inProgressJobs)))
@@ -844,12 +956,18 @@ func (s *statefulScheduler) getJob(jobId string) *jobState {
 }
 
 // checks if any of the in progress jobs are completed.  If a job is
-// completed log an EndSaga Message to the SagaLog asynchronously
+// completed log an EndSaga Message to the SagaLog asynchronously.
+// Jobs that need to be rolled back are first aborted, and only
+// ended once their cleanup tasks have completed, see rollback.go
 func (s *statefulScheduler) checkForCompletedJobs() {
 	defer s.stat.Latency(stats.SchedCheckForCompletedLatency_ms).Time().Stop()
 	// Check For Completed Jobs & Log EndSaga Message
 	for _, jobState := range s.inProgressJobs {
 		if jobState.getJobStatus() == domain.Completed && !jobState.EndingSaga {
+			if !s.rollbackCompleted(jobState) {
+				continue
+			}
+
 			// mark job as being completed
 			jobState.EndingSaga = true
 
@@ -865,11 +983,15 @@ func (s *statefulScheduler) checkForCompletedJobs() {
 					if err == nil {
 						log.WithFields(
 							log.Fields{
-								"jobID":     j.Job.Id,
-								"requestor": j.Job.Def.Requestor,
-								"jobType":   j.Job.Def.JobType,
-								"tag":       j.Job.Def.Tag,
+								"jobID":      j.Job.Id,
+								"rolledBack": j.RollingBack,
+								"requestor":  j.Job.Def.Requestor,
+								"jobType":    j.Job.Def.JobType,
+								"tag":        j.Job.Def.Tag,
 							}).Info("Job completed and logged")
+						if j.RollingBack {
+							s.stat.Counter(stats.SchedRolledBackJobsCounter).Inc(1)
+						}
 						// This job is fully processed remove from InProgressJobs
 						s.deleteJob(j.Job.Id)
 					} else {
@@ -914,7 +1036,9 @@ func (s *statefulScheduler) scheduleTasks() {
 		rs := s.runnerFactory(nodeSt.node)
 		durationID := s.durationKeyExtractorFn(taskID)
 
-		preventRetries := bool(task.NumTimesTried >= s.config.MaxRetriesPerTask)
+		policy := task.retryPolicy()
+		maxAttempts := s.maxAttempts(task)
+		preventRetries := bool(task.NumTimesTried >= maxAttempts-1)
 
 		avgDur := -1
 		iface, ok := s.taskDurations.Get(durationID)
@@ -963,9 +1087,16 @@ func (s *statefulScheduler) scheduleTasks() {
 			abortCh:      make(chan abortReq, 1),
 			queryAbortCh: make(chan interface{}, 1),
 
+			retryable:        policy.Retryable,
+			previousAttempts: task.Attempts,
+
 			startTime: time.Now(),
 		}
 
+		if s.config.SpeculativeMultiple > 0 {
+			tRunner.race = newAttemptRace(tRunner)
+		}
+
 		// mark the task as started in the jobState and record its taskRunner
 		jobState.taskStarted(taskID, tRunner)
 
@@ -973,6 +1104,10 @@ func (s *statefulScheduler) scheduleTasks() {
 			tRunner.run,
 			func(err error) {
 				defer rs.Release()
+				if tRunner.race != nil {
+					// stop the speculative attempt, if any, now that this run finished first
+					tRunner.race.abortLosers(tRunner)
+				}
 				// Update the average duration for this task so, for new jobs, we can schedule the likely long running tasks first.
 				if err == nil || err.(*taskError).st.State == runner.TIMEDOUT ||
 					(err.(*taskError).st.State == runner.COMPLETE && err.(*taskError).st.ExitCode == 0) {
@@ -1012,6 +1147,7 @@ func (s *statefulScheduler) scheduleTasks() {
 
 				flaky := false
 				aborted := (err != nil && err.(*taskError).st.State == runner.ABORTED)
+				succeeded := (err == nil && tRunner.result.State == runner.COMPLETE && tRunner.result.ExitCode == 0)
 				if err != nil {
 					// Get the type of error. Currently we only care to distinguish runner (ex: thrift) errors to mark flaky nodes.
 					// TODO - we no longer set a node as flaky on failed status.
@@ -1021,16 +1157,25 @@ func (s *statefulScheduler) scheduleTasks() {
 					taskErr := err.(*taskError)
 					flaky = (taskErr.runnerErr != nil && taskErr.st.State != runner.FAILED)
 
+					// Failed runs are retried if the task's retry policy allows it, failing to log to the saga always is.
+					retry := tRunner.retry || (taskErr.sagaErr != nil && !preventRetries)
 					msg := "Error running job (will be retried):"
 					if aborted {
 						msg = "Error running task, but job kill request received, (will not retry):"
 						err = nil
 					} else {
 						if preventRetries {
-							msg = fmt.Sprintf("Error running task (quitting, hit max retries of %d):", s.config.MaxRetriesPerTask)
+							msg = fmt.Sprintf("Error running task (quitting, hit max attempts of %d):", maxAttempts)
+							err = nil
+						} else if !retry {
+							msg = "Error running task (quitting, not retryable by its retry policy):"
 							err = nil
 						} else {
 							jobState.errorRunningTask(taskID, err, preempted)
+							if !preempted && tRunner.retry {
+								task.retryAfter(tRunner.result, policy)
+							}
+							s.stat.Counter(stats.SchedRetriedTaskCounter).Inc(1)
 						}
 					}
 					log.WithFields(
@@ -1077,7 +1222,7 @@ func (s *statefulScheduler) scheduleTasks() {
 							"jobType":   jobType,
 							"tag":       tag,
 						}).Info("Ending task.")
-					jobState.taskCompleted(taskID, true)
+					jobState.taskCompleted(taskID, true, succeeded)
 				}
 
 				// update cluster state that this node is now free and if we consider the runner to be flaky.
@@ -1091,7 +1236,7 @@ func (s *statefulScheduler) scheduleTasks() {
 						"jobType":   jobType,
 						"tag":       tag,
 					}).Info("Freeing node, removed job.")
-				s.clusterState.taskCompleted(nodeId, flaky)
+				s.clusterState.taskCompleted(nodeId, jobID, taskID, flaky)
 
 				total := 0
 				completed := 0
@@ -1133,6 +1278,9 @@ func (s *statefulScheduler) KillJob(jobID string) error {
 		log.Fields{
 			"jobID": jobID,
 		}).Info("KillJob requested")
+	if err := s.checkLeader(); err != nil {
+		return err
+	}
 	responseCh := make(chan error, 1)
 	req := jobKillRequest{jobId: jobID, responseCh: responseCh}
 	s.killJobCh <- req
@@ -1140,38 +1288,78 @@ func (s *statefulScheduler) KillJob(jobID string) error {
 	return <-req.responseCh
 }
 
-func (s *statefulScheduler) GetSagaCoord() saga.SagaCoordinator {
-	return s.sagaCoord
+// Put the list request on channel that is processed by the main
+// scheduler loop, and wait for the matching jobs
+func (s *statefulScheduler) ListJobs(filter domain.JobFilter) ([]domain.JobSummary, error) {
+	if err := s.checkLeader(); err != nil {
+		return nil, err
+	}
+	req := jobListRequest{filter: filter, responseCh: make(chan []domain.JobSummary, 1)}
+	s.listJobsCh <- req
+
+	return <-req.responseCh, nil
 }
 
-func (s *statefulScheduler) OfflineWorker(req domain.OfflineWorkerReq) error {
-	if !stringInSlice(req.Requestor, s.config.Admins) && len(s.config.Admins) != 0 {
-		return fmt.Errorf("requestor %s unauthorized to offline worker", req.Requestor)
+// answer all pending list requests with the in progress jobs matching their filter.
+// The requestor map is used to avoid scanning every job when a requestor is given.
+//
+// this function is part of the main scheduler loop
+func (s *statefulScheduler) listJobs() {
+	for {
+		select {
+		case req := <-s.listJobsCh:
+			jobs := s.inProgressJobs
+			if req.filter.ID != "" {
+				jobs = []*jobState{}
+				if job := s.getJob(req.filter.ID); job != nil {
+					jobs = append(jobs, job)
+				}
+			} else if req.filter.Requestor != "" {
+				jobs = s.requestorMap[req.filter.Requestor]
+			}
+			summaries := []domain.JobSummary{}
+			for _, job := range jobs {
+				if summary := job.getSummary(); req.filter.Matches(summary) {
+					summaries = append(summaries, summary)
+				}
+			}
+			req.responseCh <- summaries
+		default:
+			return
+		}
 	}
-	log.Infof("Offlining worker %s", req.ID)
-	n := cc.NodeId(req.ID)
-	if !s.clusterState.HasOnlineNode(n) {
-		return fmt.Errorf("node %s was not present in nodes. It can't be offlined", req.ID)
+}
+
+// Put the cluster state request on channel that is processed by the main
+// scheduler loop, and wait for the state of the workers
+func (s *statefulScheduler) GetClusterState() ([]domain.WorkerSummary, error) {
+	if err := s.checkLeader(); err != nil {
+		return nil, err
 	}
+	req := workersRequest{responseCh: make(chan []domain.WorkerSummary, 1)}
+	s.workersCh <- req
 
-	s.clusterState.OfflineNode(n)
-	return nil
+	return <-req.responseCh, nil
 }
 
-func (s *statefulScheduler) ReinstateWorker(req domain.ReinstateWorkerReq) error {
-	if !stringInSlice(req.Requestor, s.config.Admins) && len(s.config.Admins) != 0 {
-		return fmt.Errorf("requestor %s unauthorized to reinstate worker", req.Requestor)
-	}
-	n := cc.NodeId(req.ID)
-	if !s.clusterState.IsOfflined(n) {
-		return fmt.Errorf("node %s was not present in offlinedNodes. It can't be reinstated", req.ID)
-	} else {
-		log.Infof("Reinstating worker %s", req.ID)
-		s.clusterState.OnlineNode(n)
-		return nil
+// answer all pending cluster state requests with the state of every worker.
+//
+// this function is part of the main scheduler loop
+func (s *statefulScheduler) listWorkers() {
+	for {
+		select {
+		case req := <-s.workersCh:
+			req.responseCh <- s.clusterState.getWorkerSummaries()
+		default:
+			return
+		}
 	}
 }
 
+func (s *statefulScheduler) GetSagaCoord() saga.SagaCoordinator {
+	return s.sagaCoord
+}
+
 // process all requests verifying that the jobIds exist:  Send errors back
 // immediately on the request channel for jobId that don't exist, then
 // kill all the jobs with a valid ID
@@ -1258,11 +1446,57 @@ func (s *statefulScheduler) abortTask(jobState *jobState, task *taskState, logFi
 		s.stat.Counter(stats.SchedCompletedTaskCounter).Inc(1)
 		updateMessages = append(updateMessages, saga.MakeStartTaskMessage(jobState.Saga.ID(), task.TaskId, nil))
 		updateMessages = append(updateMessages, saga.MakeEndTaskMessage(jobState.Saga.ID(), task.TaskId, statusAsBytes))
-		jobState.taskCompleted(task.TaskId, false)
+		jobState.taskCompleted(task.TaskId, false, false)
 	}
 	return updateMessages
 }
 
+// Ends the tasks that can't run because a task they depend on failed, logging an aborted status
+// for each so they show up as skipped rather than waiting forever.
+//
+// this function is part of the main scheduler loop
+func (s *statefulScheduler) skipTasksWithFailedDependencies() {
+	for _, jobState := range s.inProgressJobs {
+		if jobState.JobKilled {
+			continue
+		}
+		skipped := jobState.skipTasksWithFailedDependencies()
+		if len(skipped) == 0 {
+			continue
+		}
+		logFields := log.Fields{
+			"jobID":     jobState.Job.Id,
+			"requestor": jobState.Job.Def.Requestor,
+			"jobType":   jobState.Job.Def.JobType,
+			"tag":       jobState.Job.Def.Tag,
+		}
+		var updateMessages []saga.SagaMessage
+		for _, task := range skipped {
+			dep := jobState.getFailedDependency(task)
+			logFields["taskID"] = task.TaskId
+			logFields["dependency"] = dep
+			log.WithFields(logFields).Info("Skipping task, dependency failed")
+
+			st := runner.AbortStatus("", tags.LogTags{JobID: jobState.Job.Id, TaskID: task.TaskId, Tag: jobState.Job.Def.Tag})
+			st.Error = fmt.Sprintf("%s: %s", DependencyFailedErrStr, dep)
+			statusAsBytes, err := worker.SerializeProcessStatus(st)
+			if err != nil {
+				s.stat.Counter(stats.SchedFailedTaskSerializeCounter).Inc(1) // TODO errata metric - remove if unused
+			}
+			s.stat.Counter(stats.SchedSkippedTaskCounter).Inc(1)
+			updateMessages = append(updateMessages, saga.MakeStartTaskMessage(jobState.Saga.ID(), task.TaskId, nil))
+			updateMessages = append(updateMessages, saga.MakeEndTaskMessage(jobState.Saga.ID(), task.TaskId, statusAsBytes))
+		}
+		delete(logFields, "taskID")
+		delete(logFields, "dependency")
+
+		if err := jobState.Saga.BulkMessage(updateMessages); err != nil {
+			logFields["err"] = err
+			log.WithFields(logFields).Error("skipTasksWithFailedDependencies saga.BulkMessage failure")
+		}
+	}
+}
+
 func getRequestorHistory(requestorHistory *lru.Cache, requestor string) []string {
 	var history []string
 	iface, ok := requestorHistory.Get(requestor)
@@ -1305,8 +1539,12 @@ func addOrUpdateTaskDuration(taskDurations *lru.Cache, durationKey string, d tim
 }
 
 // set the max schedulable tasks.   -1 = unlimited, 0 = don't accept any more requests, >0 = only accept job
-// requests when the number of running and waiting tasks won't exceed the limit
-func (s *statefulScheduler) SetSchedulerStatus(maxTasks int) error {
+// requests when the number of running and waiting tasks won't exceed the limit.
+// requestor is recorded as who changed the persisted settings.
+func (s *statefulScheduler) SetSchedulerStatus(maxTasks int, requestor string) error {
+	if err := s.checkLeader(); err != nil {
+		return err
+	}
 	err := domain.ValidateMaxTasks(maxTasks)
 	if err != nil {
 		return err
@@ -1314,7 +1552,7 @@ func (s *statefulScheduler) SetSchedulerStatus(maxTasks int) error {
 	s.setThrottle(maxTasks)
 	log.Infof("scheduler throttled to %d", maxTasks)
 
-	if err := s.persistSettings(); err != nil {
+	if err := s.persistSettings(requestor, fmt.Sprintf("throttle set to %d", maxTasks)); err != nil {
 		return fmt.Errorf("throttle setting not persisted, scheduler may use default when restarted. %s", err)
 	}
 	return nil
@@ -1344,14 +1582,17 @@ func (s *statefulScheduler) GetClassLoadPercents() (map[string]int32, error) {
 }
 
 // SetClassLoadPercents set the scheduler's class load pcts with a copy of the input class load pcts
-func (s *statefulScheduler) SetClassLoadPercents(classLoadPercents map[string]int32) error {
+func (s *statefulScheduler) SetClassLoadPercents(classLoadPercents map[string]int32, requestor string) error {
+	if err := s.checkLeader(); err != nil {
+		return err
+	}
 	sa, ok := s.config.SchedAlg.(*LoadBasedAlg)
 	if !ok {
 		return fmt.Errorf("not using load based scheduler, class load pcts ignored")
 	}
 	sa.setClassLoadPercents(classLoadPercents)
 
-	if err := s.persistSettings(); err != nil {
+	if err := s.persistSettings(requestor, fmt.Sprintf("class load percents set to %v", classLoadPercents)); err != nil {
 		return fmt.Errorf("load percents not persisted, scheduler may use default when restarted. %s", err)
 	}
 	return nil
@@ -1367,14 +1608,17 @@ func (s *statefulScheduler) GetRequestorToClassMap() (map[string]string, error)
 }
 
 // SetRequestorToClassMap set the scheduler's requestor to class map with a copy of the input map
-func (s *statefulScheduler) SetRequestorToClassMap(requestorToClassMap map[string]string) error {
+func (s *statefulScheduler) SetRequestorToClassMap(requestorToClassMap map[string]string, requestor string) error {
+	if err := s.checkLeader(); err != nil {
+		return err
+	}
 	sa, ok := s.config.SchedAlg.(*LoadBasedAlg)
 	if !ok {
 		return fmt.Errorf("not using load based scheduler, requestor to class map ignored")
 	}
 	sa.setRequestorToClassMap(requestorToClassMap)
 
-	if err := s.persistSettings(); err != nil {
+	if err := s.persistSettings(requestor, fmt.Sprintf("requestor to class map set to %v", requestorToClassMap)); err != nil {
 		return fmt.Errorf("RequestorToClassMap not persisted, scheduler may use default when restarted. %s", err)
 	}
 	return nil
@@ -1390,14 +1634,17 @@ func (s *statefulScheduler) GetRebalanceMinimumDuration() (time.Duration, error)
 }
 
 // GetRebalanceMinimumDuration
-func (s *statefulScheduler) SetRebalanceMinimumDuration(durationMin time.Duration) error {
+func (s *statefulScheduler) SetRebalanceMinimumDuration(durationMin time.Duration, requestor string) error {
+	if err := s.checkLeader(); err != nil {
+		return err
+	}
 	sa, ok := s.config.SchedAlg.(*LoadBasedAlg)
 	if !ok {
 		return fmt.Errorf("not using load based scheduler, requestor to rebalance min duration ignored")
 	}
 	sa.setRebalanceMinimumDuration(durationMin)
 
-	if err := s.persistSettings(); err != nil {
+	if err := s.persistSettings(requestor, fmt.Sprintf("rebalance minimum duration set to %s", durationMin)); err != nil {
 		return fmt.Errorf("RebalanceMinimumDuration not persisted, scheduler may use default when restarted. %s", err)
 	}
 	return nil
@@ -1413,14 +1660,17 @@ func (s *statefulScheduler) GetRebalanceThreshold() (int32, error) {
 }
 
 // SetRebalanceThreshold
-func (s *statefulScheduler) SetRebalanceThreshold(threshold int32) error {
+func (s *statefulScheduler) SetRebalanceThreshold(threshold int32, requestor string) error {
+	if err := s.checkLeader(); err != nil {
+		return err
+	}
 	sa, ok := s.config.SchedAlg.(*LoadBasedAlg)
 	if !ok {
 		return fmt.Errorf("not using load based scheduler, requestor to rebalance threshold ignored")
 	}
 	sa.setRebalanceThreshold(int(threshold))
 
-	if err := s.persistSettings(); err != nil {
+	if err := s.persistSettings(requestor, fmt.Sprintf("rebalance threshold set to %d", threshold)); err != nil {
 		return fmt.Errorf("RebalanceThreshold not persisted, scheduler may use default when restarted. %s", err)
 	}
 	return nil
@@ -26,6 +26,7 @@ import (
 	"github.com/twitter/scoot/snapshot"
 	"github.com/twitter/scoot/snapshot/snapshots"
 	"github.com/twitter/scoot/tests/testhelpers"
+	workerdomain "github.com/twitter/scoot/worker/domain"
 )
 
 //Mocks sometimes hang without useful output, this allows early exit with err msg.
@@ -394,6 +395,50 @@ func Test_StatefulScheduler_JobRunsToCompletion(t *testing.T) {
 	validateCompletionCounts(s, t)
 }
 
+func Test_StatefulScheduler_DependentTasksSkippedAfterFailure(t *testing.T) {
+	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	s, _, _ := initializeServices(sc, false)
+
+	// task0 fails, task1 depends on task0 and task2 depends on task1.
+	jobDef := domain.GenJobDef(3)
+	jobDef.Tasks[0].Argv = []string{"complete 1"}
+	for i := 1; i < len(jobDef.Tasks); i++ {
+		jobDef.Tasks[i].Argv = []string{"complete 0"}
+		jobDef.Tasks[i].Dependencies = []string{jobDef.Tasks[i-1].TaskID}
+	}
+	go func() {
+		checkJobMsg := <-s.checkJobCh
+		checkJobMsg.resultCh <- nil
+	}()
+	jobId, err := s.ScheduleJob(jobDef)
+	if err != nil {
+		t.Fatalf("Unexpected error scheduling job: %v", err)
+	}
+
+	s.step()
+	for s.getJob(jobId).getJobStatus() != domain.Completed {
+		s.step()
+	}
+
+	js := s.getJob(jobId)
+	sagaState := js.Saga.GetState()
+	for i, task := range jobDef.Tasks {
+		if js.getTask(task.TaskID).Succeeded {
+			t.Errorf("Expected task%d not to succeed", i)
+		}
+		if i == 0 {
+			continue
+		}
+		st, err := workerdomain.DeserializeProcessStatus(sagaState.GetEndTaskData(task.TaskID))
+		if err != nil {
+			t.Fatalf("Unexpected error deserializing task%d status: %v", i, err)
+		}
+		if st.State != runner.ABORTED || !strings.HasPrefix(st.Error, DependencyFailedErrStr) {
+			t.Errorf("Expected task%d to be skipped, got %v", i, st)
+		}
+	}
+}
+
 func Test_StatefulScheduler_KillStartedJob(t *testing.T) {
 	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
 	s, _, _ := initializeServices(sc, false)
@@ -420,6 +465,53 @@ func Test_StatefulScheduler_KillStartedJob(t *testing.T) {
 	validateCompletionCounts(s, t)
 }
 
+func Test_StatefulScheduler_ListJobs(t *testing.T) {
+	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
+	s, _, _ := initializeServices(sc, false)
+
+	landJobId, landTaskIds, _ := putJobInScheduler(1, s, "pause", "land", domain.P1)
+	// add the first job so the second can be queued
+	s.step()
+	otherJobId, _, _ := putJobInScheduler(2, s, "pause", "other", domain.P0)
+	s.step()
+	for s.getJob(landJobId).getTask(landTaskIds[0]).Status == domain.NotStarted {
+		s.step()
+	}
+
+	listJobs := func(filter domain.JobFilter) []domain.JobSummary {
+		respCh := make(chan []domain.JobSummary)
+		go func() {
+			jobs, _ := s.ListJobs(filter)
+			respCh <- jobs
+		}()
+		for {
+			select {
+			case jobs := <-respCh:
+				return jobs
+			default:
+				s.step()
+			}
+		}
+	}
+
+	if jobs := listJobs(domain.JobFilter{}); len(jobs) != 2 {
+		t.Fatalf("Expected jobs %s and %s, got %v", landJobId, otherJobId, jobs)
+	}
+
+	jobs := listJobs(domain.JobFilter{Requestor: "land"})
+	if len(jobs) != 1 || jobs[0].ID != landJobId {
+		t.Fatalf("Expected only job %s, got %v", landJobId, jobs)
+	}
+	if jobs[0].Status != domain.InProgress || jobs[0].NumRunningTasks != 1 || jobs[0].Priority != domain.P1 {
+		t.Errorf("Expected an in progress P1 job with 1 running task, got %+v", jobs[0])
+	}
+
+	p2 := domain.P2
+	if jobs := listJobs(domain.JobFilter{Priority: &p2}); len(jobs) != 0 {
+		t.Errorf("Expected no P2 jobs, got %v", jobs)
+	}
+}
+
 func Test_StatefulScheduler_KillNotFoundJob(t *testing.T) {
 	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
 	s, _, _ := initializeServices(sc, false)
@@ -550,7 +642,7 @@ func Test_StatefulScheduler_Throttle_Error(t *testing.T) {
 	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
 	s, _, _ := initializeServices(sc, false)
 
-	err := s.SetSchedulerStatus(-10)
+	err := s.SetSchedulerStatus(-10, "")
 	expected := "invalid tasks limit:-10. Must be >= -1."
 	if strings.Compare(expected, fmt.Sprintf("%s", err)) != 0 {
 		t.Fatalf("expected: %s, got: %s", expected, err)
@@ -560,7 +652,7 @@ func Test_StatefulScheduler_GetThrottledStatus(t *testing.T) {
 	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
 	s, _, _ := initializeServices(sc, false)
 
-	s.SetSchedulerStatus(0)
+	s.SetSchedulerStatus(0, "")
 
 	var num_tasks, max_tasks int
 	num_tasks, max_tasks = s.GetSchedulerStatus()
@@ -574,7 +666,7 @@ func Test_StatefulScheduler_GetNotThrottledStatus(t *testing.T) {
 	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
 	s, _, _ := initializeServices(sc, false)
 
-	s.SetSchedulerStatus(-1)
+	s.SetSchedulerStatus(-1, "")
 
 	var num_tasks, max_tasks int
 	num_tasks, max_tasks = s.GetSchedulerStatus()
@@ -588,7 +680,7 @@ func Test_StatefulScheduler_GetSomeThrottledStatus(t *testing.T) {
 	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
 	s, _, _ := initializeServices(sc, false)
 
-	s.SetSchedulerStatus(10)
+	s.SetSchedulerStatus(10, "")
 
 	var num_tasks, max_tasks int
 	num_tasks, max_tasks = s.GetSchedulerStatus()
@@ -839,8 +931,8 @@ func validateCompletionCounts(s *statefulScheduler, t *testing.T) {
 func Test_StatefulScheduler_RequestorCountsStats(t *testing.T) {
 	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
 	s, _, _ := initializeServices(sc, false)
-	s.SetClassLoadPercents(map[string]int32{"fake R1": 60, "fake R2": 40})
-	s.SetRequestorToClassMap(map[string]string{"fake R1": "fake R1", "fake R2": "fake R2"})
+	s.SetClassLoadPercents(map[string]int32{"fake R1": 60, "fake R2": 40}, "")
+	s.SetRequestorToClassMap(map[string]string{"fake R1": "fake R1", "fake R2": "fake R2"}, "")
 
 	requestors := []string{"fake R1", "fake R1", "fake R1", "fake R2", "fake R2"}
 	// put 5 jobs in the queue
@@ -881,8 +973,8 @@ func Test_StatefulScheduler_RequestorCountsStats(t *testing.T) {
 func Test_StatefulScheduler_TaskDurationOrdering_Durations(t *testing.T) {
 	sc := sagalogs.MakeInMemorySagaCoordinatorNoGC(nil)
 	s, _, _ := initializeServices(sc, true)
-	s.SetClassLoadPercents(map[string]int32{"fake R1": 100})
-	s.SetRequestorToClassMap(map[string]string{"fake R1": "fake R1"})
+	s.SetClassLoadPercents(map[string]int32{"fake R1": 100}, "")
+	s.SetRequestorToClassMap(map[string]string{"fake R1": "fake R1"}, "")
 
 	// validate when already have duration
 	for i := 1; i < 5; i++ {
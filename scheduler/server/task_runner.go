@@ -31,6 +31,8 @@ type taskRunner struct {
 	stat   stats.StatsReceiver
 
 	markCompleteOnFailure bool
+	compensating          bool          // Runs the task's cleanup, logged as its compensating task.
+	speculative           bool          // A duplicate attempt of a straggling run, see speculative.go.
 	taskTimeoutOverhead   time.Duration // How long to wait for a response after the task has timed out.
 	defaultTaskTimeout    time.Duration // Use this timeout as the default for any cmds that don't have one.
 	runnerRetryTimeout    time.Duration // How long to keep retrying a runner req
@@ -43,7 +45,16 @@ type taskRunner struct {
 	abortCh      chan abortReq    // Primary channel to check for aborts
 	queryAbortCh chan interface{} // Secondary channel to pass to blocking query.
 
+	// Returns true if a failed run may be retried, nil retries any run that fails or doesn't complete.
+	retryable func(runner.RunStatus, domain.FailureKind) bool
+	// The statuses of the task's earlier, failed runs, logged to the saga along with this run's status.
+	previousAttempts []runner.RunStatus
+	// Shared with a speculative attempt of the same run, the first attempt to finish is the task's outcome.
+	race *attemptRace
+
 	startTime time.Time
+	result    runner.RunStatus // The final status of the run, set before run() returns.
+	retry     bool             // True if the run failed and should be retried, set before run() returns.
 }
 
 // Return a custom error from run() so the scheduler has more context.
@@ -63,7 +74,7 @@ func (t *taskError) Error() string {
 // task's command errors when the command is run, this is not considered to be an error.)
 
 // This method blocks until all saga messages are logged and the task completes
-func (r *taskRunner) run() error {
+func (r *taskRunner) run() (runErr error) {
 	log.WithFields(
 		log.Fields{
 			"jobID":  r.JobID,
@@ -74,17 +85,34 @@ func (r *taskRunner) run() error {
 		}).Info("Starting task")
 	taskErr := &taskError{}
 
-	// Log StartTask Message to SagaLog
-	if err := r.logTaskStatus(nil, saga.StartTask); err != nil {
-		taskErr.sagaErr = err
-		r.stat.Counter(stats.SchedFailedTaskCounter).Inc(1)
-		return taskErr
+	// Log StartTask Message to SagaLog, a retry logs a pending status to carry over the earlier attempts.
+	// A speculative attempt's task was already started by the run it duplicates.
+	var startSt *runner.RunStatus
+	if len(r.previousAttempts) > 0 && !r.compensating {
+		pending := runner.PendingStatus("", r.LogTags)
+		startSt = &pending
+	}
+	if !r.speculative {
+		if err := r.logTaskStatus(startSt, saga.StartTask); err != nil {
+			taskErr.sagaErr = err
+			r.stat.Counter(stats.SchedFailedTaskCounter).Inc(1)
+			return taskErr
+		}
 	}
 
 	// Run and update taskErr with the results.
 	st, end, err := r.runAndWait()
+
+	// Only the first of the racing attempts to finish logs its result, the other takes on that result.
+	if r.race != nil {
+		if !r.race.claim(r) {
+			return r.race.outcome(r)
+		}
+		defer func() { r.race.finish(runErr) }()
+	}
 	taskErr.runnerErr = err
 	taskErr.st = st
+	kind := domain.ClassifyRun(st, err)
 
 	// We got a good message back, but it indicates an error. Update taskErr accordingly.
 	completed := (st.State == runner.COMPLETE)
@@ -101,8 +129,31 @@ func (r *taskRunner) run() error {
 		}
 	}
 
+	// Runs ended by the scheduler, i.e. killed, aren't counted as failures.
+	if !end {
+		switch kind {
+		case domain.InfrastructureFailure:
+			r.stat.Counter(stats.SchedInfraFailureTaskCounter).Inc(1)
+		case domain.UserFailure:
+			r.stat.Counter(stats.SchedUserFailureTaskCounter).Inc(1)
+		}
+	}
+
+	// The run is retried if it failed and the task's retry policy allows it, unless the scheduler
+	// ended the task or this was its last attempt.  A completed run that's retried is reported as an error.
+	retry := err != nil
+	if r.retryable != nil {
+		retry = r.retryable(st, kind)
+	}
+	retry = retry && !end && !r.markCompleteOnFailure
+	if retry && err == nil {
+		err = fmt.Errorf("exit code %d", st.ExitCode)
+		taskErr.resultErr = err
+	}
+	r.retry = retry
+
 	// We should write to sagalog if there's no error, or there's an error but the caller won't be retrying.
-	shouldDeadLetter := (err != nil && (end || r.markCompleteOnFailure))
+	shouldDeadLetter := (err != nil && !retry)
 	shouldLog := (err == nil) || shouldDeadLetter
 
 	// Update taskErr's Error to indicate we got an empty status back
@@ -110,6 +161,7 @@ func (r *taskRunner) run() error {
 		taskErr.st.State = runner.FAILED
 		taskErr.st.Error = emptyStatusError(r.JobID, r.TaskID, err)
 	}
+	r.result = taskErr.st
 	if shouldDeadLetter {
 		log.WithFields(
 			log.Fields{
@@ -140,6 +192,12 @@ func (r *taskRunner) run() error {
 		}).Info("End task")
 
 	if !shouldLog {
+		// Keep the failed attempt in the saga, the retry's StartTask carries it over along with the earlier ones.
+		if !r.compensating {
+			if err := r.logTaskStatus(&taskErr.st, saga.StartTask); err != nil {
+				taskErr.sagaErr = err
+			}
+		}
 		if taskErr != nil {
 			r.stat.Counter(stats.SchedFailedTaskCounter).Inc(1)
 		}
@@ -273,7 +331,9 @@ func (r *taskRunner) runAndWait() (runner.RunStatus, bool, error) {
 					"runStatus": st,
 					"tag":       r.Tag,
 				}).Debug("Update task")
-			r.logTaskStatus(&st, saga.StartTask)
+			if !r.speculative {
+				r.logTaskStatus(&st, saga.StartTask)
+			}
 			includeRunning = false
 		}
 	}
@@ -341,18 +401,35 @@ func (r *taskRunner) logTaskStatus(st *runner.RunStatus, msgType saga.SagaMessag
 	var statusAsBytes []byte
 	var err error
 	if st != nil {
-		statusAsBytes, err = worker.SerializeProcessStatus(*st)
+		if r.compensating {
+			statusAsBytes, err = worker.SerializeProcessStatus(*st)
+		} else {
+			statusAsBytes, err = worker.SerializeProcessStatusWithAttempts(*st, r.previousAttempts)
+		}
 		if err != nil {
 			r.stat.Counter(stats.SchedFailedTaskSerializeCounter).Inc(1) // TODO errata metric - remove if unused
 			return err
 		}
 	}
 
+	if r.compensating {
+		switch msgType {
+		case saga.StartTask:
+			msgType = saga.StartCompTask
+		case saga.EndTask:
+			msgType = saga.EndCompTask
+		}
+	}
+
 	switch msgType {
 	case saga.StartTask:
 		err = r.saga.StartTask(r.TaskID, statusAsBytes)
 	case saga.EndTask:
 		err = r.saga.EndTask(r.TaskID, statusAsBytes)
+	case saga.StartCompTask:
+		err = r.saga.StartCompensatingTask(r.TaskID, statusAsBytes)
+	case saga.EndCompTask:
+		err = r.saga.EndCompensatingTask(r.TaskID, statusAsBytes)
 	default:
 		err = fmt.Errorf("unexpected saga message type: %v", msgType)
 	}
@@ -377,7 +454,32 @@ func (r *taskRunner) abortRequested() (aborted bool, req abortReq) {
 	}
 }
 
+// Aborts the run, replacing any abort that's still pending, like one from a speculative attempt
+// that finished first while this run wasn't querying.  Doesn't block, as it's called from the scheduler loop.
 func (r *taskRunner) Abort(endTask bool, err string) {
-	r.abortCh <- abortReq{endTask, err}
-	r.queryAbortCh <- nil
+	select {
+	case <-r.abortCh:
+	default:
+	}
+	select {
+	case r.abortCh <- abortReq{endTask, err}:
+	default:
+	}
+	select {
+	case r.queryAbortCh <- nil:
+	default:
+	}
+}
+
+// Aborts the run without ending the task, unless an abort is already pending.
+// Used to stop the losing attempt once another attempt of the same run finished first.
+func (r *taskRunner) abortSuperseded() {
+	select {
+	case r.abortCh <- abortReq{false, SupersededErrStr}:
+	default:
+	}
+	select {
+	case r.queryAbortCh <- nil:
+	default:
+	}
 }
@@ -156,6 +156,8 @@ func Test_runTaskAndLog_TaskFailsToRun(t *testing.T) {
 	sagaLogMock := saga.NewMockSagaLog(mockCtrl)
 	sagaLogMock.EXPECT().StartSaga("job1", nil)
 	sagaLogMock.EXPECT().LogMessage(saga.MakeStartTaskMessage("job1", "task1", nil))
+	// the failed attempt is kept in the saga for the retry
+	sagaLogMock.EXPECT().LogMessage(gomock.Any())
 	sagaCoord := saga.MakeSagaCoordinator(sagaLogMock, nil)
 	s, _ := sagaCoord.MakeSaga("job1", nil)
 
@@ -1,12 +1,14 @@
 package server
 
 import (
+	"fmt"
 	"math"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/twitter/scoot/cloud/cluster"
 	"github.com/twitter/scoot/common/stats"
+	"github.com/twitter/scoot/scheduler/domain"
 )
 
 type taskAssignment struct {
@@ -40,6 +42,7 @@ func (s *statefulScheduler) getTaskAssignments() []taskAssignment {
 	}
 
 	tasks, stopTasks := s.config.SchedAlg.GetTasksToBeAssigned(s.inProgressJobs, s.stat, s.clusterState, s.requestorMap)
+	s.recordWaitReasons(tasks)
 	// Exit if no tasks qualify to be scheduled.
 	if len(tasks) == 0 {
 		if len(stopTasks) != 0 {
@@ -67,10 +70,7 @@ func (s *statefulScheduler) getTaskAssignments() []taskAssignment {
 		}
 	}
 
-	// Loop over all cluster snapshotIds looking for a usable node. Prefer, in order:
-	// - Hot node for the given snapshotId (one whose last task shared the same snapshotId).
-	// - New untouched node (or node whose last task used an empty snapshotId)
-	// - A random free node from the idle pools of nodes associated with other snapshotIds.
+	// Loop over all cluster snapshotIds looking for a usable node, see findIdleNode().
 	assignments := s.assign(tasks)
 	log.WithFields(
 		log.Fields{
@@ -86,6 +86,8 @@ type nodeStatesByNodeID map[cluster.NodeId]*nodeState
 
 // Helper fn, appends to 'assignments' and updates nodeGroups.
 // Should successfully assign all given tasks if caller invokes this with self-consistent params.
+// Tasks are packed onto nodes that advertise capacity while their resources fit, a task that
+// doesn't fit anywhere, or whose required labels no idle node matches, is skipped in favor of later tasks.
 // Note: there may be a race condition between recognizing idle nodes as available for assignment and
 // nodes becoming offlined or suspended. The code does the best it can, but it may assign a task to
 // a node that clusterState considers offlined/suspended before or as the task is actually being started
@@ -113,49 +115,47 @@ func (s *statefulScheduler) assign(tasks []*taskState) (assignments []taskAssign
 		}
 	}
 
-	for _, task := range tasks {
-		var nodeSt *nodeState
-
-		// is there a node group (with idle node) for this snapshot?
-		if nodeGroup, ok := idleNodesByGroupIDs[task.Def.SnapshotID]; ok {
-			nodeSt = s.findIdleNodeInGroup(nodeGroup)
-		}
-		if nodeSt == nil {
-			// could not find any free nodes in node group for the task's snapshot id.  Look for a free node in the other
-			// node groups, starting with the "" node group
-			if nodeGroup, ok := idleNodesByGroupIDs[""]; ok {
-				nodeSt = s.findIdleNodeInGroup(nodeGroup)
-			}
-			if nodeSt == nil {
-				// no free node was found in "" nor the task's node group, grab an idle node from another group
-				for groupID, nodeGroup := range idleNodesByGroupIDs {
-					if groupID == "" {
-						continue
-					}
-					nodeSt = s.findIdleNodeInGroup(nodeGroup)
-					if nodeSt != nil {
-						break
-					}
-				}
-			}
-		}
+	for i, task := range tasks {
+		resources := task.Def.Resources
+		nodeSt := s.findIdleNode(idleNodesByGroupIDs, task)
 
 		if nodeSt == nil {
-			// Could not find any more free nodes.  This may happen if nodes are suspended after the scheduling algorithm
-			// has built the list of tasks to schedule but before the tasks are actually assigned to a node.
-			// Skip the rest of the assignments, the skipped tasks should be picked up in the next scheduling run
+			// Could not find a free node that fits the task.  This may happen if nodes are suspended after the scheduling
+			// algorithm has built the list of tasks to schedule but before the tasks are actually assigned to a node,
+			// if the task needs more resources than are left on partially used nodes, or if the nodes matching
+			// the task's required labels are busy.
+			// Skip the task, it should be picked up in the next scheduling run.
 			log.WithFields(
 				log.Fields{
-					"jobID":  task.JobId,
-					"taskID": task.TaskId,
-					"tag":    task.Def.Tag,
+					"jobID":          task.JobId,
+					"taskID":         task.TaskId,
+					"tag":            task.Def.Tag,
+					"resources":      resources,
+					"requiredLabels": task.Def.RequiredLabels,
 				}).Warn("Unable to assign, no free node for task")
-			break
+			if noIdleNodes(idleNodesByGroupIDs) {
+				// No other task can be assigned either, skip the rest of the assignments.
+				reason, detail := s.explainNoNode()
+				for _, t := range tasks[i:] {
+					t.setWaitReason(reason, detail)
+				}
+				break
+			}
+			task.setWaitReason(domain.WaitNoFittingNode,
+				fmt.Sprintf("no idle node fits resources %v with labels %v", resources, task.Def.RequiredLabels))
+			continue
 		}
 		assignments = append(assignments, taskAssignment{nodeSt: nodeSt, task: task})
 
 		// Mark Task as Started in the cluster
-		s.clusterState.taskScheduled(nodeSt.node.Id(), task.JobId, task.Def.TaskID, task.Def.SnapshotID)
+		s.clusterState.taskScheduled(nodeSt.node.Id(), task.JobId, task.TaskId, task.Def.SnapshotID, resources)
+		if !nodeSt.full() {
+			// The node can take more tasks, it's now in the group for this task's snapshot.
+			if _, ok := idleNodesByGroupIDs[task.Def.SnapshotID]; !ok {
+				idleNodesByGroupIDs[task.Def.SnapshotID] = nodeStatesByNodeID{}
+			}
+			idleNodesByGroupIDs[task.Def.SnapshotID][nodeSt.node.Id()] = nodeSt
+		}
 
 		log.WithFields(
 			log.Fields{
@@ -170,18 +170,139 @@ func (s *statefulScheduler) assign(tasks []*taskState) (assignments []taskAssign
 	return assignments
 }
 
+// findIdleNode finds an idle node that can run the task, looking at the node groups in order:
+// - Hot node for the task's snapshotId (one whose last task shared the same snapshotId).
+// - New untouched node (or node whose last task used an empty snapshotId)
+// - A random free node from the idle pools of nodes associated with other snapshotIds.
+// A node matching more of the task's preferred labels is picked over one in an earlier group.
+// It also removes the node from its group's idle nodes list to prevent it from being assigned again.
+func (s *statefulScheduler) findIdleNode(idleNodesByGroupIDs map[string]nodeStatesByNodeID, task *taskState) *nodeState {
+	groupIDs := []string{task.Def.SnapshotID}
+	if task.Def.SnapshotID != "" {
+		groupIDs = append(groupIDs, "")
+	}
+	for groupID := range idleNodesByGroupIDs {
+		if groupID != "" && groupID != task.Def.SnapshotID {
+			groupIDs = append(groupIDs, groupID)
+		}
+	}
+
+	var best *nodeState
+	bestGroupID, bestScore := "", -1
+	for _, groupID := range groupIDs {
+		nodeGroup, ok := idleNodesByGroupIDs[groupID]
+		if !ok {
+			continue
+		}
+		nodeSt, score := s.findIdleNodeInGroup(nodeGroup, task.Def)
+		if nodeSt != nil && score > bestScore {
+			best, bestGroupID, bestScore = nodeSt, groupID, score
+			if score == len(task.Def.PreferredLabels) {
+				// no node can match more of the preferred labels
+				break
+			}
+		}
+	}
+	if best != nil {
+		delete(idleNodesByGroupIDs[bestGroupID], best.node.Id())
+	}
+	return best
+}
+
 // findIdleNodeInGroup finds a node in the group's idle nodes that is not suspended or offlined (this method will, pick
-// up nodes that have been suspended/offlined while the processing was assigning other tasks to nodes).
-// It also removes the node from the groups idle nodes list to prevent it from being assigned again.
-func (s *statefulScheduler) findIdleNodeInGroup(nodeGroup nodeStatesByNodeID) *nodeState {
+// up nodes that have been suspended/offlined while the processing was assigning other tasks to nodes), that can
+// run a task needing the given resources, and that matches the task's required labels.  Of those, it returns the
+// node matching the most preferred labels along with the number of preferred labels it matches.
+// It drops suspended or offlined nodes from the group's idle nodes since they can't be assigned.
+func (s *statefulScheduler) findIdleNodeInGroup(nodeGroup nodeStatesByNodeID, def domain.TaskDefinition) (*nodeState, int) {
+	var best *nodeState
+	bestScore := -1
 	for id, ns := range nodeGroup {
 		if ns.suspended() || s.clusterState.isOfflined(ns) {
+			delete(nodeGroup, id)
+			continue
+		}
+		if !ns.canRun(def.Resources) || !ns.matches(def.RequiredLabels) {
 			continue
 		}
-		delete(nodeGroup, id)
-		return ns
+		score := domain.NumMatchingLabels(def.PreferredLabels, ns.labels)
+		if score == len(def.PreferredLabels) {
+			return ns, score
+		}
+		if score > bestScore {
+			best, bestScore = ns, score
+		}
+	}
+	return best, bestScore
+}
+
+// Marks the waiting tasks whose required labels no node matches as unschedulable, so that the scheduling
+// algorithm skips them and job status reports them, and clears the mark once a matching node joins.
+func (s *statefulScheduler) markUnschedulableTasks() {
+	hasMatchingNode := map[string]bool{} // by required labels, which a job's tasks usually share
+	numUnschedulable := 0
+	unschedulableTasks := map[string]map[string]string{}
+	defer s.setUnschedulableTasks(unschedulableTasks)
+	for _, job := range s.inProgressJobs {
+		for _, task := range job.Tasks {
+			if task.Status != domain.NotStarted || len(task.Def.RequiredLabels) == 0 {
+				task.Unschedulable = ""
+				continue
+			}
+			key := fmt.Sprint(task.Def.RequiredLabels)
+			matched, ok := hasMatchingNode[key]
+			if !ok {
+				matched = s.clusterState.hasMatchingNode(task.Def.RequiredLabels)
+				hasMatchingNode[key] = matched
+			}
+			if matched {
+				task.Unschedulable = ""
+				continue
+			}
+			if task.Unschedulable == "" {
+				log.WithFields(
+					log.Fields{
+						"jobID":          task.JobId,
+						"taskID":         task.TaskId,
+						"tag":            task.Def.Tag,
+						"requiredLabels": task.Def.RequiredLabels,
+					}).Warn("No node matches the task's required labels, it can't be scheduled")
+			}
+			task.Unschedulable = fmt.Sprintf("no node matches required labels %v", task.Def.RequiredLabels)
+			numUnschedulable++
+			if unschedulableTasks[job.Job.Id] == nil {
+				unschedulableTasks[job.Job.Id] = map[string]string{}
+			}
+			unschedulableTasks[job.Job.Id][task.TaskId] = task.Unschedulable
+		}
+	}
+	s.stat.Gauge(stats.SchedNumUnschedulableTasksGauge).Update(int64(numUnschedulable))
+}
+
+// GetUnschedulableTasks returns why the job's waiting tasks that no node can run are unschedulable,
+// by task id, as of the last scheduler loop iteration.  Returns nil if there are none, or if the
+// scheduler is standing by.
+func (s *statefulScheduler) GetUnschedulableTasks(jobId string) map[string]string {
+	s.unschedulableTasksMu.RLock()
+	defer s.unschedulableTasksMu.RUnlock()
+	return s.unschedulableTasks[jobId]
+}
+
+// The maps are replaced rather than modified, so the ones returned by GetUnschedulableTasks aren't changed.
+func (s *statefulScheduler) setUnschedulableTasks(unschedulableTasks map[string]map[string]string) {
+	s.unschedulableTasksMu.Lock()
+	defer s.unschedulableTasksMu.Unlock()
+	s.unschedulableTasks = unschedulableTasks
+}
+
+// Returns true if none of the groups has an idle node left.
+func noIdleNodes(idleNodesByGroupIDs map[string]nodeStatesByNodeID) bool {
+	for _, nodeGroup := range idleNodesByGroupIDs {
+		if len(nodeGroup) > 0 {
+			return false
+		}
 	}
-	return nil
+	return true
 }
 
 // Helpers.
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
@@ -107,8 +108,8 @@ func Test_TaskAssignment_Affinity(t *testing.T) {
 		}
 		job.taskStarted(as.task.TaskId, &taskRunner{nodeSt: as.nodeSt})
 		if _, ok := completedTasksByJob[as.task.JobId]; !ok {
-			cs.taskCompleted(as.nodeSt.node.Id(), false)
-			job.taskCompleted(as.task.TaskId, true)
+			cs.taskCompleted(as.nodeSt.node.Id(), as.task.JobId, as.task.TaskId, false)
+			job.taskCompleted(as.task.TaskId, true, true)
 			completedTasksByJob[as.task.JobId] = as.task.TaskId
 		}
 	}
@@ -135,6 +136,117 @@ func Test_TaskAssignment_Affinity(t *testing.T) {
 	assert.True(t, ok, "didn't find '' nodeGroup")
 }
 
+func Test_TaskAssignment_PacksTasksByResources(t *testing.T) {
+	uc := initNodeUpdateChan("node1", "node2")
+	s := getDebugStatefulScheduler(uc)
+	for _, ns := range s.clusterState.nodes {
+		ns.capacity = runner.Resources{CPUMillis: 1000}
+	}
+
+	small := domain.TaskDefinition{Command: runner.Command{Resources: runner.Resources{CPUMillis: 300}}}
+	large := domain.TaskDefinition{Command: runner.Command{Resources: runner.Resources{CPUMillis: 800}}}
+	tasks := []*taskState{
+		{TaskId: "small1", JobId: "job1", Def: small},
+		{TaskId: "large1", JobId: "job1", Def: large},
+		{TaskId: "small2", JobId: "job1", Def: small},
+		{TaskId: "large2", JobId: "job1", Def: large},
+		{TaskId: "small3", JobId: "job1", Def: small},
+	}
+	for _, task := range tasks {
+		task.Def.TaskID = task.TaskId
+	}
+
+	assignments := s.assign(tasks)
+	assigned := map[string]cluster.NodeId{}
+	for _, as := range assignments {
+		assigned[as.task.TaskId] = as.nodeSt.node.Id()
+	}
+	// the small tasks fill one node and the first large task gets the other, the second large task doesn't fit.
+	if len(assignments) != 4 || assigned["small1"] != assigned["small2"] || assigned["large1"] == assigned["small1"] {
+		t.Fatalf("Expected the small tasks to be packed onto one node, got %v", assigned)
+	}
+	if _, ok := assigned["large2"]; ok {
+		t.Errorf("Expected the second large task not to fit, got %v", assigned)
+	}
+	if assigned["small3"] != assigned["small1"] {
+		t.Errorf("Expected the last small task to be packed after skipping the large one, got %v", assigned)
+	}
+	if s.clusterState.numRunning != 4 {
+		t.Errorf("Expected 4 running tasks, got %s", s.clusterState.status())
+	}
+}
+
+func Test_TaskAssignment_HonorsLabels(t *testing.T) {
+	uc := initNodeUpdateChan("node1", "node2", "node3")
+	s := getDebugStatefulScheduler(uc)
+	s.clusterState.nodes["node1"].labels = map[string]string{"rack": "r1"}
+	s.clusterState.nodes["node2"].labels = map[string]string{"rack": "r2", "gpu": ""}
+	s.clusterState.nodes["node3"].labels = map[string]string{"rack": "r3"}
+
+	selectors := func(ss ...string) []domain.LabelSelector {
+		sels, err := domain.ParseLabelSelectors(ss)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sels
+	}
+	tasks := []*taskState{
+		{TaskId: "gpu", JobId: "job1", Def: domain.TaskDefinition{RequiredLabels: selectors("gpu")}},
+		{TaskId: "r2", JobId: "job1", Def: domain.TaskDefinition{RequiredLabels: selectors("rack=r2")}},
+		{TaskId: "preferR3", JobId: "job1", Def: domain.TaskDefinition{PreferredLabels: selectors("rack=r3", "gpu")}},
+	}
+	for _, task := range tasks {
+		task.Def.TaskID = task.TaskId
+	}
+
+	assignments := s.assign(tasks)
+	assigned := map[string]cluster.NodeId{}
+	for _, as := range assignments {
+		assigned[as.task.TaskId] = as.nodeSt.node.Id()
+	}
+	expected := map[string]cluster.NodeId{"gpu": "node2", "preferR3": "node3"}
+	if !reflect.DeepEqual(assigned, expected) {
+		t.Errorf("Expected tasks to be assigned to the nodes matching their labels %v, got %v", expected, assigned)
+	}
+}
+
+func Test_TaskAssignment_MarksUnschedulableTasks(t *testing.T) {
+	uc := initNodeUpdateChan("node1")
+	s := getDebugStatefulScheduler(uc)
+	sdk, _ := domain.ParseLabelSelectors([]string{"android-sdk=28"})
+	js := &jobState{
+		Job: &domain.Job{Id: "job1"},
+		Tasks: []*taskState{
+			{TaskId: "android", JobId: "job1", Def: domain.TaskDefinition{RequiredLabels: sdk}},
+			{TaskId: "any", JobId: "job1"},
+		},
+	}
+	s.inProgressJobs = []*jobState{js}
+
+	s.markUnschedulableTasks()
+	if ids := getTaskIds(js.getUnScheduledTasks()); !reflect.DeepEqual(ids, []string{"any"}) {
+		t.Errorf("Expected the task no node can run to be skipped, got %v", ids)
+	}
+	if unschedulable := js.getSummary().UnschedulableTasks; len(unschedulable) != 1 || unschedulable["android"] == "" {
+		t.Errorf("Expected the job summary to report the unschedulable task, got %v", unschedulable)
+	}
+	if unschedulable := s.GetUnschedulableTasks("job1"); len(unschedulable) != 1 || unschedulable["android"] == "" {
+		t.Errorf("Expected the scheduler to report the unschedulable task, got %v", unschedulable)
+	}
+
+	s.clusterState.nodes["node1"].labels = map[string]string{"android-sdk": "28"}
+	s.markUnschedulableTasks()
+	if ids := getTaskIds(js.getUnScheduledTasks()); !reflect.DeepEqual(ids, []string{"android", "any"}) {
+		t.Errorf("Expected the task to be schedulable once a node matches, got %v", ids)
+	}
+	if unschedulable := js.getSummary().UnschedulableTasks; unschedulable != nil {
+		t.Errorf("Expected no unschedulable tasks, got %v", unschedulable)
+	}
+	if unschedulable := s.GetUnschedulableTasks("job1"); unschedulable != nil {
+		t.Errorf("Expected the scheduler to report no unschedulable tasks, got %v", unschedulable)
+	}
+}
+
 func getDebugStatefulScheduler(uc chan []cc.NodeUpdate) *statefulScheduler {
 	rfn := func() stats.StatsRegistry { return stats.NewFinagleStatsRegistry() }
 	statsReceiver, _ := stats.NewCustomStatsReceiver(rfn, 0)
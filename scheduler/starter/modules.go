@@ -3,6 +3,7 @@ package starter
 import (
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"golang.org/x/net/proxy"
 
 	"github.com/twitter/scoot/cloud/cluster"
+	"github.com/twitter/scoot/cloud/cluster/membership"
 	"github.com/twitter/scoot/common"
 	"github.com/twitter/scoot/common/dialer"
 	"github.com/twitter/scoot/runner"
@@ -150,23 +152,37 @@ func (s *socksSocket) Open() error {
 	return nil
 }
 
-func StartCluster(clusterJSON config.ClusterJSONConfig) (chan []cluster.NodeUpdate, error) {
+// StartCluster creates the configured cluster, returning its node updates channel and the http
+// handlers it needs served on the scheduler's http server, if any.
+func StartCluster(clusterJSON config.ClusterJSONConfig) (chan []cluster.NodeUpdate, map[string]http.Handler, error) {
 	var uc chan []cluster.NodeUpdate
+	var handlers map[string]http.Handler
 	var err error
 	if clusterJSON.Type == "inMemory" {
 		cmc := &config.ClusterMemoryConfig{
 			Count: clusterJSON.Count,
 		}
 		uc, err = cmc.Create()
+	} else if clusterJSON.Type == "membership" {
+		cmc := &config.ClusterMembershipConfig{}
+		if clusterJSON.HeartbeatExpiry != "" {
+			if cmc.Expiry, err = time.ParseDuration(clusterJSON.HeartbeatExpiry); err != nil {
+				return nil, nil, fmt.Errorf("error parsing HeartbeatExpiry.  Scheduler not started. %s", err)
+			}
+		}
+		var registry *membership.Registry
+		if uc, registry, err = cmc.Create(); err == nil {
+			handlers = registry.Handlers()
+		}
 	} else {
 		clc := &config.ClusterLocalConfig{}
 		uc, err = clc.Create()
 	}
 	if err != nil {
-		return nil, fmt.Errorf("error creating cluster.  Scheduler not started. %s", err)
+		return nil, nil, fmt.Errorf("error creating cluster.  Scheduler not started. %s", err)
 	}
 
-	return uc, nil
+	return uc, handlers, nil
 }
 
 // MakeSagaLog - TODO remove saga or refactor it so this function can be moved into saga or sagalog
@@ -178,6 +194,25 @@ func MakeSagaLog(config config.SagaLogJSONConfig) (saga.SagaLog, error) {
 	if config.Type == "file" {
 		return sagalogs.MakeFileSagaLog(config.Directory)
 	}
+	if config.Type == "kv" {
+		return sagalogs.MakeReplicatedKVSagaLog(config.Directory, config.ReplicaDirectories, time.Duration(config.ExpirationSec)*time.Second, time.Duration(config.GCIntervalSec)*time.Second)
+	}
 
 	return nil, fmt.Errorf("unsupported sagalog type: %s.  No sagalog created", config.Type)
 }
+
+// MakeStandbySagaLog makes the saga log of a scheduler that stands by until it's elected leader.
+// Each new leader recovers the sagas logged by the previous one, so the log must be stored in
+// directories the schedulers share.  A memory log isn't shared, and a kv log is only loaded once
+// the scheduler is elected, as it indexes the sagas in memory when it's loaded.
+func MakeStandbySagaLog(config config.SagaLogJSONConfig) (saga.SagaLog, error) {
+	if config.Type == "file" {
+		return sagalogs.MakeFileSagaLog(config.Directory)
+	}
+	if config.Type == "kv" {
+		return sagalogs.MakeStandbyKVSagaLog(config.Directory, config.ReplicaDirectories, time.Duration(config.ExpirationSec)*time.Second, time.Duration(config.GCIntervalSec)*time.Second)
+	}
+
+	return nil, fmt.Errorf("leader election requires a file or kv saga log stored in directories the schedulers share, "+
+		"a %s sagalog can't be read by the next leader.  Scheduler not started", config.Type)
+}
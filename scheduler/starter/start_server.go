@@ -47,7 +47,12 @@ func StartServer(schedulerConfig server.SchedulerConfiguration,
 
 	var err error
 	var sl saga.SagaLog
-	if sl, err = MakeSagaLog(sagaLogConfig); err != nil {
+	if schedulerConfig.Elector != nil {
+		sl, err = MakeStandbySagaLog(sagaLogConfig)
+	} else {
+		sl, err = MakeSagaLog(sagaLogConfig)
+	}
+	if err != nil {
 		return err
 	}
 	sagaCoordinator := saga.MakeSagaCoordinator(sl, *statsReceiver)
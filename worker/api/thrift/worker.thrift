@@ -30,12 +30,28 @@ struct RunStatus {
   8: optional string jobId
   9: optional string taskId
   10: optional string tag
+  11: optional list<RunStatus> previousAttempts  # Earlier failed runs of the same task, set by the scheduler.
+}
+
+enum EnvMode {
+  INHERIT = 0      # The worker's whole environment
+  ALLOWLIST = 1    # Only the worker's variables listed in envAllowlist
+  CLEAN = 2        # None of the worker's variables
+}
+
+struct Resources {
+  1: optional i64 cpuMillis     # thousandths of a CPU core
+  2: optional i64 memoryBytes
+  3: optional i64 diskBytes
 }
 
 struct WorkerStatus {
   1: required list<RunStatus> runs  # All runs
   2: required bool initialized      # True if the worker has finished with any long-running init tasks.
   3: required string error          # Set when a general worker error unrelated to a specific run has occurred.
+  4: optional Resources capacity    # Resources shared by concurrent runs, unset if the worker runs one command at a time.
+  5: optional map<string, string> labels  # Metadata the scheduler matches against tasks' label selectors.
+  6: optional bool draining         # The worker is shutting down, it takes no new runs and finishes or hands back its current ones.
 }
 
 struct RunCommand {
@@ -46,6 +62,11 @@ struct RunCommand {
   5: optional string jobId
   6: optional string taskId
   7: optional string tag
+  8: optional list<string> outputPaths  # Checkout-relative paths to ingest as an output snapshot; "." for the whole checkout.
+  9: optional Resources resources        # Resources the command needs, unset if it needs the whole worker.
+  10: optional EnvMode envMode           # How much of the worker's environment the command inherits, INHERIT if unset.
+  11: optional list<string> envAllowlist # The variables an ALLOWLIST command inherits.
+  12: optional map<string,string> secretEnv  # Mapping of env name to the name of a secret in the worker's secrets file.
 }
 
 service Worker {
@@ -8,6 +8,7 @@ import (
 	"github.com/twitter/scoot/common/log/tags"
 	"github.com/twitter/scoot/common/thrifthelpers"
 	"github.com/twitter/scoot/runner"
+	"github.com/twitter/scoot/runner/execer"
 	"github.com/twitter/scoot/worker/domain/gen-go/worker"
 )
 
@@ -20,6 +21,9 @@ type WorkerStatus struct {
 	Runs        []runner.RunStatus
 	Initialized bool
 	Error       string
+	Capacity    runner.Resources
+	Labels      map[string]string
+	Draining    bool
 }
 
 func ThriftWorkerStatusToDomain(thrift *worker.WorkerStatus) WorkerStatus {
@@ -27,7 +31,7 @@ func ThriftWorkerStatusToDomain(thrift *worker.WorkerStatus) WorkerStatus {
 	for _, r := range thrift.Runs {
 		runs = append(runs, ThriftRunStatusToDomain(r))
 	}
-	return WorkerStatus{runs, thrift.Initialized, thrift.Error}
+	return WorkerStatus{runs, thrift.Initialized, thrift.Error, ThriftResourcesToDomain(thrift.Capacity), thrift.Labels, thrift.GetDraining()}
 }
 
 func DomainWorkerStatusToThrift(domain WorkerStatus) *worker.WorkerStatus {
@@ -38,6 +42,34 @@ func DomainWorkerStatusToThrift(domain WorkerStatus) *worker.WorkerStatus {
 		thrift.Initialized = domain.Initialized
 		thrift.Error = domain.Error
 	}
+	thrift.Capacity = DomainResourcesToThrift(domain.Capacity)
+	thrift.Labels = domain.Labels
+	if domain.Draining {
+		thrift.Draining = &domain.Draining
+	}
+	return thrift
+}
+
+func ThriftResourcesToDomain(thrift *worker.Resources) runner.Resources {
+	if thrift == nil {
+		return runner.Resources{}
+	}
+	return runner.Resources{
+		CPUMillis:   thrift.GetCpuMillis(),
+		MemoryBytes: thrift.GetMemoryBytes(),
+		DiskBytes:   thrift.GetDiskBytes(),
+	}
+}
+
+// Returns nil for zero resources, so they're left unset.
+func DomainResourcesToThrift(domain runner.Resources) *worker.Resources {
+	if domain.IsZero() {
+		return nil
+	}
+	thrift := worker.NewResources()
+	thrift.CpuMillis = &domain.CPUMillis
+	thrift.MemoryBytes = &domain.MemoryBytes
+	thrift.DiskBytes = &domain.DiskBytes
 	return thrift
 }
 
@@ -71,15 +103,20 @@ func ThriftRunCommandToDomain(thrift *worker.RunCommand) *runner.Command {
 		tag = *thrift.Tag
 	}
 	return &runner.Command{
-		Argv:       argv,
-		EnvVars:    env,
-		Timeout:    timeout,
-		SnapshotID: snapshotID,
+		Argv:        argv,
+		EnvVars:     env,
+		Timeout:     timeout,
+		SnapshotID:  snapshotID,
+		OutputPaths: thrift.OutputPaths,
+		Resources:   ThriftResourcesToDomain(thrift.Resources),
 		LogTags: tags.LogTags{
 			JobID:  jobID,
 			TaskID: taskID,
 			Tag:    tag,
 		},
+		EnvMode:      execer.EnvMode(thrift.GetEnvMode()),
+		EnvAllowlist: thrift.EnvAllowlist,
+		SecretEnv:    thrift.SecretEnv,
 	}
 }
 
@@ -97,6 +134,14 @@ func DomainRunCommandToThrift(domain *runner.Command) *worker.RunCommand {
 	thrift.TaskId = &taskID
 	tag := domain.Tag
 	thrift.Tag = &tag
+	thrift.OutputPaths = domain.OutputPaths
+	thrift.Resources = DomainResourcesToThrift(domain.Resources)
+	if domain.EnvMode != execer.EnvInherit {
+		envMode := worker.EnvMode(domain.EnvMode)
+		thrift.EnvMode = &envMode
+	}
+	thrift.EnvAllowlist = domain.EnvAllowlist
+	thrift.SecretEnv = domain.SecretEnv
 	return thrift
 }
 
@@ -189,3 +234,35 @@ func SerializeProcessStatus(processStatus runner.RunStatus) ([]byte, error) {
 
 	return asBytes, err
 }
+
+func DeserializeProcessStatus(asBytes []byte) (runner.RunStatus, error) {
+	runStatus := worker.NewRunStatus()
+
+	if err := thrifthelpers.JsonDeserialize(runStatus, asBytes); err != nil {
+		return runner.RunStatus{}, err
+	}
+
+	return ThriftRunStatusToDomain(runStatus), nil
+}
+
+// Serializes the status of a task's latest run along with the statuses of its earlier, failed runs.
+func SerializeProcessStatusWithAttempts(processStatus runner.RunStatus, previous []runner.RunStatus) ([]byte, error) {
+	runStatus := DomainRunStatusToThrift(processStatus)
+	for _, st := range previous {
+		runStatus.PreviousAttempts = append(runStatus.PreviousAttempts, DomainRunStatusToThrift(st))
+	}
+	return thrifthelpers.JsonSerialize(runStatus)
+}
+
+// Returns the statuses of the earlier runs serialized along with a task's latest run, oldest first.
+func DeserializePreviousAttempts(asBytes []byte) ([]runner.RunStatus, error) {
+	runStatus := worker.NewRunStatus()
+	if err := thrifthelpers.JsonDeserialize(runStatus, asBytes); err != nil {
+		return nil, err
+	}
+	var previous []runner.RunStatus
+	for _, st := range runStatus.PreviousAttempts {
+		previous = append(previous, ThriftRunStatusToDomain(st))
+	}
+	return previous, nil
+}
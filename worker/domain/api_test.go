@@ -18,6 +18,7 @@ var nonzero = int32(12345)
 var emptystr = ""
 var nonemptystr = "abcdef"
 var deadbeefID = "snap-id-deadbeef"
+var draining = true
 
 var cmdFromThrift = func(x interface{}) interface{} { return ThriftRunCommandToDomain(x.(*worker.RunCommand)) }
 var cmdToThrift = func(x interface{}) interface{} { return DomainRunCommandToThrift(x.(*runner.Command)) }
@@ -62,17 +63,19 @@ var tests = []struct {
 		cmdFromThrift,
 		cmdToThrift,
 		&worker.RunCommand{
-			Argv:       []string{},
-			Env:        map[string]string{},
-			SnapshotId: &emptystr,
-			TimeoutMs:  &zero,
-			JobId:      &nonemptystr,
-			TaskId:     &emptystr,
-			Tag:        &emptystr},
+			Argv:        []string{},
+			Env:         map[string]string{},
+			SnapshotId:  &emptystr,
+			TimeoutMs:   &zero,
+			JobId:       &nonemptystr,
+			TaskId:      &emptystr,
+			Tag:         &emptystr,
+			OutputPaths: []string{"out", "logs/test.xml"}},
 		&runner.Command{
-			Argv:    []string{},
-			EnvVars: map[string]string{},
-			Timeout: time.Duration(zero),
+			Argv:        []string{},
+			EnvVars:     map[string]string{},
+			Timeout:     time.Duration(zero),
+			OutputPaths: []string{"out", "logs/test.xml"},
 			LogTags: tags.LogTags{
 				JobID:  nonemptystr,
 				TaskID: emptystr,
@@ -339,6 +342,19 @@ var tests = []struct {
 			},
 		},
 	},
+	{
+		14,
+		wsFromThrift,
+		wsToThrift,
+		&worker.WorkerStatus{
+			Runs:     []*worker.RunStatus{},
+			Draining: &draining,
+		},
+		WorkerStatus{
+			Runs:     []runner.RunStatus{},
+			Draining: true,
+		},
+	},
 }
 
 func TestTranslation(t *testing.T) {
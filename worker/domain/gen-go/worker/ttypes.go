@@ -83,6 +83,53 @@ func (p *Status) UnmarshalText(text []byte) error {
 	return nil
 }
 
+type EnvMode int64
+
+const (
+	EnvMode_INHERIT   EnvMode = 0
+	EnvMode_ALLOWLIST EnvMode = 1
+	EnvMode_CLEAN     EnvMode = 2
+)
+
+func (p EnvMode) String() string {
+	switch p {
+	case EnvMode_INHERIT:
+		return "INHERIT"
+	case EnvMode_ALLOWLIST:
+		return "ALLOWLIST"
+	case EnvMode_CLEAN:
+		return "CLEAN"
+	}
+	return "<UNSET>"
+}
+
+func EnvModeFromString(s string) (EnvMode, error) {
+	switch s {
+	case "INHERIT":
+		return EnvMode_INHERIT, nil
+	case "ALLOWLIST":
+		return EnvMode_ALLOWLIST, nil
+	case "CLEAN":
+		return EnvMode_CLEAN, nil
+	}
+	return EnvMode(0), fmt.Errorf("not a valid EnvMode string")
+}
+
+func EnvModePtr(v EnvMode) *EnvMode { return &v }
+
+func (p EnvMode) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *EnvMode) UnmarshalText(text []byte) error {
+	q, err := EnvModeFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
 // Attributes:
 //  - Status
 //  - RunId
@@ -94,17 +141,19 @@ func (p *Status) UnmarshalText(text []byte) error {
 //  - JobId
 //  - TaskId
 //  - Tag
+//  - PreviousAttempts
 type RunStatus struct {
-	Status     Status  `thrift:"status,1,required" json:"status"`
-	RunId      string  `thrift:"runId,2,required" json:"runId"`
-	OutUri     *string `thrift:"outUri,3" json:"outUri,omitempty"`
-	ErrUri     *string `thrift:"errUri,4" json:"errUri,omitempty"`
-	Error      *string `thrift:"error,5" json:"error,omitempty"`
-	ExitCode   *int32  `thrift:"exitCode,6" json:"exitCode,omitempty"`
-	SnapshotId *string `thrift:"snapshotId,7" json:"snapshotId,omitempty"`
-	JobId      *string `thrift:"jobId,8" json:"jobId,omitempty"`
-	TaskId     *string `thrift:"taskId,9" json:"taskId,omitempty"`
-	Tag        *string `thrift:"tag,10" json:"tag,omitempty"`
+	Status           Status       `thrift:"status,1,required" json:"status"`
+	RunId            string       `thrift:"runId,2,required" json:"runId"`
+	OutUri           *string      `thrift:"outUri,3" json:"outUri,omitempty"`
+	ErrUri           *string      `thrift:"errUri,4" json:"errUri,omitempty"`
+	Error            *string      `thrift:"error,5" json:"error,omitempty"`
+	ExitCode         *int32       `thrift:"exitCode,6" json:"exitCode,omitempty"`
+	SnapshotId       *string      `thrift:"snapshotId,7" json:"snapshotId,omitempty"`
+	JobId            *string      `thrift:"jobId,8" json:"jobId,omitempty"`
+	TaskId           *string      `thrift:"taskId,9" json:"taskId,omitempty"`
+	Tag              *string      `thrift:"tag,10" json:"tag,omitempty"`
+	PreviousAttempts []*RunStatus `thrift:"previousAttempts,11" json:"previousAttempts,omitempty"`
 }
 
 func NewRunStatus() *RunStatus {
@@ -190,6 +239,12 @@ func (p *RunStatus) GetTag() string {
 	}
 	return *p.Tag
 }
+
+var RunStatus_PreviousAttempts_DEFAULT []*RunStatus
+
+func (p *RunStatus) GetPreviousAttempts() []*RunStatus {
+	return p.PreviousAttempts
+}
 func (p *RunStatus) IsSetOutUri() bool {
 	return p.OutUri != nil
 }
@@ -222,6 +277,10 @@ func (p *RunStatus) IsSetTag() bool {
 	return p.Tag != nil
 }
 
+func (p *RunStatus) IsSetPreviousAttempts() bool {
+	return p.PreviousAttempts != nil
+}
+
 func (p *RunStatus) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -281,6 +340,10 @@ func (p *RunStatus) Read(iprot thrift.TProtocol) error {
 			if err := p.readField10(iprot); err != nil {
 				return err
 			}
+		case 11:
+			if err := p.readField11(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -393,6 +456,26 @@ func (p *RunStatus) readField10(iprot thrift.TProtocol) error {
 	return nil
 }
 
+func (p *RunStatus) readField11(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*RunStatus, 0, size)
+	p.PreviousAttempts = tSlice
+	for i := 0; i < size; i++ {
+		_elem0 := &RunStatus{}
+		if err := _elem0.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem0), err)
+		}
+		p.PreviousAttempts = append(p.PreviousAttempts, _elem0)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
 func (p *RunStatus) Write(oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin("RunStatus"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -427,6 +510,9 @@ func (p *RunStatus) Write(oprot thrift.TProtocol) error {
 	if err := p.writeField10(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField11(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -582,6 +668,29 @@ func (p *RunStatus) writeField10(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
+func (p *RunStatus) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPreviousAttempts() {
+		if err := oprot.WriteFieldBegin("previousAttempts", thrift.LIST, 11); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:previousAttempts: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.PreviousAttempts)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.PreviousAttempts {
+			if err := v.Write(oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 11:previousAttempts: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *RunStatus) String() string {
 	if p == nil {
 		return "<nil>"
@@ -589,14 +698,214 @@ func (p *RunStatus) String() string {
 	return fmt.Sprintf("RunStatus(%+v)", *p)
 }
 
+// Attributes:
+//  - CpuMillis
+//  - MemoryBytes
+//  - DiskBytes
+type Resources struct {
+	CpuMillis   *int64 `thrift:"cpuMillis,1" json:"cpuMillis,omitempty"`
+	MemoryBytes *int64 `thrift:"memoryBytes,2" json:"memoryBytes,omitempty"`
+	DiskBytes   *int64 `thrift:"diskBytes,3" json:"diskBytes,omitempty"`
+}
+
+func NewResources() *Resources {
+	return &Resources{}
+}
+
+var Resources_CpuMillis_DEFAULT int64
+
+func (p *Resources) GetCpuMillis() int64 {
+	if !p.IsSetCpuMillis() {
+		return Resources_CpuMillis_DEFAULT
+	}
+	return *p.CpuMillis
+}
+
+var Resources_MemoryBytes_DEFAULT int64
+
+func (p *Resources) GetMemoryBytes() int64 {
+	if !p.IsSetMemoryBytes() {
+		return Resources_MemoryBytes_DEFAULT
+	}
+	return *p.MemoryBytes
+}
+
+var Resources_DiskBytes_DEFAULT int64
+
+func (p *Resources) GetDiskBytes() int64 {
+	if !p.IsSetDiskBytes() {
+		return Resources_DiskBytes_DEFAULT
+	}
+	return *p.DiskBytes
+}
+func (p *Resources) IsSetCpuMillis() bool {
+	return p.CpuMillis != nil
+}
+
+func (p *Resources) IsSetMemoryBytes() bool {
+	return p.MemoryBytes != nil
+}
+
+func (p *Resources) IsSetDiskBytes() bool {
+	return p.DiskBytes != nil
+}
+
+func (p *Resources) Read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Resources) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.CpuMillis = &v
+	}
+	return nil
+}
+
+func (p *Resources) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.MemoryBytes = &v
+	}
+	return nil
+}
+
+func (p *Resources) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.DiskBytes = &v
+	}
+	return nil
+}
+
+func (p *Resources) Write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("Resources"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Resources) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCpuMillis() {
+		if err := oprot.WriteFieldBegin("cpuMillis", thrift.I64, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cpuMillis: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.CpuMillis)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.cpuMillis (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cpuMillis: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Resources) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMemoryBytes() {
+		if err := oprot.WriteFieldBegin("memoryBytes", thrift.I64, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:memoryBytes: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.MemoryBytes)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.memoryBytes (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:memoryBytes: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Resources) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDiskBytes() {
+		if err := oprot.WriteFieldBegin("diskBytes", thrift.I64, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:diskBytes: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.DiskBytes)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.diskBytes (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:diskBytes: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Resources) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Resources(%+v)", *p)
+}
+
 // Attributes:
 //  - Runs
 //  - Initialized
 //  - Error
+//  - Capacity
+//  - Labels
+//  - Draining
 type WorkerStatus struct {
-	Runs        []*RunStatus `thrift:"runs,1,required" json:"runs"`
-	Initialized bool         `thrift:"initialized,2,required" json:"initialized"`
-	Error       string       `thrift:"error,3,required" json:"error"`
+	Runs        []*RunStatus      `thrift:"runs,1,required" json:"runs"`
+	Initialized bool              `thrift:"initialized,2,required" json:"initialized"`
+	Error       string            `thrift:"error,3,required" json:"error"`
+	Capacity    *Resources        `thrift:"capacity,4" json:"capacity,omitempty"`
+	Labels      map[string]string `thrift:"labels,5" json:"labels,omitempty"`
+	Draining    *bool             `thrift:"draining,6" json:"draining,omitempty"`
 }
 
 func NewWorkerStatus() *WorkerStatus {
@@ -614,6 +923,42 @@ func (p *WorkerStatus) GetInitialized() bool {
 func (p *WorkerStatus) GetError() string {
 	return p.Error
 }
+
+var WorkerStatus_Capacity_DEFAULT *Resources
+
+func (p *WorkerStatus) GetCapacity() *Resources {
+	if !p.IsSetCapacity() {
+		return WorkerStatus_Capacity_DEFAULT
+	}
+	return p.Capacity
+}
+
+var WorkerStatus_Labels_DEFAULT map[string]string
+
+func (p *WorkerStatus) GetLabels() map[string]string {
+	return p.Labels
+}
+
+var WorkerStatus_Draining_DEFAULT bool
+
+func (p *WorkerStatus) GetDraining() bool {
+	if !p.IsSetDraining() {
+		return WorkerStatus_Draining_DEFAULT
+	}
+	return *p.Draining
+}
+func (p *WorkerStatus) IsSetCapacity() bool {
+	return p.Capacity != nil
+}
+
+func (p *WorkerStatus) IsSetLabels() bool {
+	return p.Labels != nil
+}
+
+func (p *WorkerStatus) IsSetDraining() bool {
+	return p.Draining != nil
+}
+
 func (p *WorkerStatus) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -647,6 +992,18 @@ func (p *WorkerStatus) Read(iprot thrift.TProtocol) error {
 				return err
 			}
 			issetError = true
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -679,11 +1036,11 @@ func (p *WorkerStatus) readField1(iprot thrift.TProtocol) error {
 	tSlice := make([]*RunStatus, 0, size)
 	p.Runs = tSlice
 	for i := 0; i < size; i++ {
-		_elem0 := &RunStatus{}
-		if err := _elem0.Read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem0), err)
+		_elem1 := &RunStatus{}
+		if err := _elem1.Read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem1), err)
 		}
-		p.Runs = append(p.Runs, _elem0)
+		p.Runs = append(p.Runs, _elem1)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -709,6 +1066,51 @@ func (p *WorkerStatus) readField3(iprot thrift.TProtocol) error {
 	return nil
 }
 
+func (p *WorkerStatus) readField4(iprot thrift.TProtocol) error {
+	p.Capacity = &Resources{}
+	if err := p.Capacity.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Capacity), err)
+	}
+	return nil
+}
+
+func (p *WorkerStatus) readField5(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.PrependError("error reading map begin: ", err)
+	}
+	tMap := make(map[string]string, size)
+	p.Labels = tMap
+	for i := 0; i < size; i++ {
+		var _key2 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_key2 = v
+		}
+		var _val3 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_val3 = v
+		}
+		p.Labels[_key2] = _val3
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return thrift.PrependError("error reading map end: ", err)
+	}
+	return nil
+}
+
+func (p *WorkerStatus) readField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.Draining = &v
+	}
+	return nil
+}
+
 func (p *WorkerStatus) Write(oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin("WorkerStatus"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -722,6 +1124,15 @@ func (p *WorkerStatus) Write(oprot thrift.TProtocol) error {
 	if err := p.writeField3(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -778,6 +1189,62 @@ func (p *WorkerStatus) writeField3(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
+func (p *WorkerStatus) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCapacity() {
+		if err := oprot.WriteFieldBegin("capacity", thrift.STRUCT, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:capacity: ", p), err)
+		}
+		if err := p.Capacity.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Capacity), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:capacity: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *WorkerStatus) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLabels() {
+		if err := oprot.WriteFieldBegin("labels", thrift.MAP, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:labels: ", p), err)
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.Labels)); err != nil {
+			return thrift.PrependError("error writing map begin: ", err)
+		}
+		for k, v := range p.Labels {
+			if err := oprot.WriteString(string(k)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return thrift.PrependError("error writing map end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:labels: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *WorkerStatus) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDraining() {
+		if err := oprot.WriteFieldBegin("draining", thrift.BOOL, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:draining: ", p), err)
+		}
+		if err := oprot.WriteBool(bool(*p.Draining)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.draining (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:draining: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *WorkerStatus) String() string {
 	if p == nil {
 		return "<nil>"
@@ -793,14 +1260,24 @@ func (p *WorkerStatus) String() string {
 //  - JobId
 //  - TaskId
 //  - Tag
+//  - OutputPaths
+//  - Resources
+//  - EnvMode
+//  - EnvAllowlist
+//  - SecretEnv
 type RunCommand struct {
-	Argv       []string          `thrift:"argv,1,required" json:"argv"`
-	Env        map[string]string `thrift:"env,2" json:"env,omitempty"`
-	SnapshotId *string           `thrift:"snapshotId,3" json:"snapshotId,omitempty"`
-	TimeoutMs  *int32            `thrift:"timeoutMs,4" json:"timeoutMs,omitempty"`
-	JobId      *string           `thrift:"jobId,5" json:"jobId,omitempty"`
-	TaskId     *string           `thrift:"taskId,6" json:"taskId,omitempty"`
-	Tag        *string           `thrift:"tag,7" json:"tag,omitempty"`
+	Argv         []string          `thrift:"argv,1,required" json:"argv"`
+	Env          map[string]string `thrift:"env,2" json:"env,omitempty"`
+	SnapshotId   *string           `thrift:"snapshotId,3" json:"snapshotId,omitempty"`
+	TimeoutMs    *int32            `thrift:"timeoutMs,4" json:"timeoutMs,omitempty"`
+	JobId        *string           `thrift:"jobId,5" json:"jobId,omitempty"`
+	TaskId       *string           `thrift:"taskId,6" json:"taskId,omitempty"`
+	Tag          *string           `thrift:"tag,7" json:"tag,omitempty"`
+	OutputPaths  []string          `thrift:"outputPaths,8" json:"outputPaths,omitempty"`
+	Resources    *Resources        `thrift:"resources,9" json:"resources,omitempty"`
+	EnvMode      *EnvMode          `thrift:"envMode,10" json:"envMode,omitempty"`
+	EnvAllowlist []string          `thrift:"envAllowlist,11" json:"envAllowlist,omitempty"`
+	SecretEnv    map[string]string `thrift:"secretEnv,12" json:"secretEnv,omitempty"`
 }
 
 func NewRunCommand() *RunCommand {
@@ -861,6 +1338,42 @@ func (p *RunCommand) GetTag() string {
 	}
 	return *p.Tag
 }
+
+var RunCommand_OutputPaths_DEFAULT []string
+
+func (p *RunCommand) GetOutputPaths() []string {
+	return p.OutputPaths
+}
+
+var RunCommand_Resources_DEFAULT *Resources
+
+func (p *RunCommand) GetResources() *Resources {
+	if !p.IsSetResources() {
+		return RunCommand_Resources_DEFAULT
+	}
+	return p.Resources
+}
+
+var RunCommand_EnvMode_DEFAULT EnvMode
+
+func (p *RunCommand) GetEnvMode() EnvMode {
+	if !p.IsSetEnvMode() {
+		return RunCommand_EnvMode_DEFAULT
+	}
+	return *p.EnvMode
+}
+
+var RunCommand_EnvAllowlist_DEFAULT []string
+
+func (p *RunCommand) GetEnvAllowlist() []string {
+	return p.EnvAllowlist
+}
+
+var RunCommand_SecretEnv_DEFAULT map[string]string
+
+func (p *RunCommand) GetSecretEnv() map[string]string {
+	return p.SecretEnv
+}
 func (p *RunCommand) IsSetEnv() bool {
 	return p.Env != nil
 }
@@ -885,6 +1398,26 @@ func (p *RunCommand) IsSetTag() bool {
 	return p.Tag != nil
 }
 
+func (p *RunCommand) IsSetOutputPaths() bool {
+	return p.OutputPaths != nil
+}
+
+func (p *RunCommand) IsSetResources() bool {
+	return p.Resources != nil
+}
+
+func (p *RunCommand) IsSetEnvMode() bool {
+	return p.EnvMode != nil
+}
+
+func (p *RunCommand) IsSetEnvAllowlist() bool {
+	return p.EnvAllowlist != nil
+}
+
+func (p *RunCommand) IsSetSecretEnv() bool {
+	return p.SecretEnv != nil
+}
+
 func (p *RunCommand) Read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -930,6 +1463,26 @@ func (p *RunCommand) Read(iprot thrift.TProtocol) error {
 			if err := p.readField7(iprot); err != nil {
 				return err
 			}
+		case 8:
+			if err := p.readField8(iprot); err != nil {
+				return err
+			}
+		case 9:
+			if err := p.readField9(iprot); err != nil {
+				return err
+			}
+		case 10:
+			if err := p.readField10(iprot); err != nil {
+				return err
+			}
+		case 11:
+			if err := p.readField11(iprot); err != nil {
+				return err
+			}
+		case 12:
+			if err := p.readField12(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -956,13 +1509,13 @@ func (p *RunCommand) readField1(iprot thrift.TProtocol) error {
 	tSlice := make([]string, 0, size)
 	p.Argv = tSlice
 	for i := 0; i < size; i++ {
-		var _elem1 string
+		var _elem4 string
 		if v, err := iprot.ReadString(); err != nil {
 			return thrift.PrependError("error reading field 0: ", err)
 		} else {
-			_elem1 = v
+			_elem4 = v
 		}
-		p.Argv = append(p.Argv, _elem1)
+		p.Argv = append(p.Argv, _elem4)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -978,19 +1531,19 @@ func (p *RunCommand) readField2(iprot thrift.TProtocol) error {
 	tMap := make(map[string]string, size)
 	p.Env = tMap
 	for i := 0; i < size; i++ {
-		var _key2 string
+		var _key5 string
 		if v, err := iprot.ReadString(); err != nil {
 			return thrift.PrependError("error reading field 0: ", err)
 		} else {
-			_key2 = v
+			_key5 = v
 		}
-		var _val3 string
+		var _val6 string
 		if v, err := iprot.ReadString(); err != nil {
 			return thrift.PrependError("error reading field 0: ", err)
 		} else {
-			_val3 = v
+			_val6 = v
 		}
-		p.Env[_key2] = _val3
+		p.Env[_key5] = _val6
 	}
 	if err := iprot.ReadMapEnd(); err != nil {
 		return thrift.PrependError("error reading map end: ", err)
@@ -1043,6 +1596,96 @@ func (p *RunCommand) readField7(iprot thrift.TProtocol) error {
 	return nil
 }
 
+func (p *RunCommand) readField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.OutputPaths = tSlice
+	for i := 0; i < size; i++ {
+		var _elem7 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem7 = v
+		}
+		p.OutputPaths = append(p.OutputPaths, _elem7)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *RunCommand) readField9(iprot thrift.TProtocol) error {
+	p.Resources = &Resources{}
+	if err := p.Resources.Read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Resources), err)
+	}
+	return nil
+}
+
+func (p *RunCommand) readField10(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 10: ", err)
+	} else {
+		temp := EnvMode(v)
+		p.EnvMode = &temp
+	}
+	return nil
+}
+
+func (p *RunCommand) readField11(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.EnvAllowlist = tSlice
+	for i := 0; i < size; i++ {
+		var _elem8 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem8 = v
+		}
+		p.EnvAllowlist = append(p.EnvAllowlist, _elem8)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *RunCommand) readField12(iprot thrift.TProtocol) error {
+	_, _, size, err := iprot.ReadMapBegin()
+	if err != nil {
+		return thrift.PrependError("error reading map begin: ", err)
+	}
+	tMap := make(map[string]string, size)
+	p.SecretEnv = tMap
+	for i := 0; i < size; i++ {
+		var _key9 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_key9 = v
+		}
+		var _val10 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_val10 = v
+		}
+		p.SecretEnv[_key9] = _val10
+	}
+	if err := iprot.ReadMapEnd(); err != nil {
+		return thrift.PrependError("error reading map end: ", err)
+	}
+	return nil
+}
+
 func (p *RunCommand) Write(oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin("RunCommand"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -1068,6 +1711,21 @@ func (p *RunCommand) Write(oprot thrift.TProtocol) error {
 	if err := p.writeField7(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField8(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField9(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField10(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField11(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField12(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -1199,6 +1857,108 @@ func (p *RunCommand) writeField7(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
+func (p *RunCommand) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOutputPaths() {
+		if err := oprot.WriteFieldBegin("outputPaths", thrift.LIST, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:outputPaths: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.OutputPaths)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.OutputPaths {
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:outputPaths: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *RunCommand) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetResources() {
+		if err := oprot.WriteFieldBegin("resources", thrift.STRUCT, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:resources: ", p), err)
+		}
+		if err := p.Resources.Write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Resources), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:resources: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *RunCommand) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnvMode() {
+		if err := oprot.WriteFieldBegin("envMode", thrift.I32, 10); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:envMode: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.EnvMode)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.envMode (10) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:envMode: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *RunCommand) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEnvAllowlist() {
+		if err := oprot.WriteFieldBegin("envAllowlist", thrift.LIST, 11); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:envAllowlist: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRING, len(p.EnvAllowlist)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.EnvAllowlist {
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 11:envAllowlist: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *RunCommand) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSecretEnv() {
+		if err := oprot.WriteFieldBegin("secretEnv", thrift.MAP, 12); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:secretEnv: ", p), err)
+		}
+		if err := oprot.WriteMapBegin(thrift.STRING, thrift.STRING, len(p.SecretEnv)); err != nil {
+			return thrift.PrependError("error writing map begin: ", err)
+		}
+		for k, v := range p.SecretEnv {
+			if err := oprot.WriteString(string(k)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+			if err := oprot.WriteString(string(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteMapEnd(); err != nil {
+			return thrift.PrependError("error writing map end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 12:secretEnv: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *RunCommand) String() string {
 	if p == nil {
 		return "<nil>"
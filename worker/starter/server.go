@@ -42,17 +42,83 @@ type handler struct {
 	mu           sync.RWMutex
 	currentCmd   *runner.Command
 	currentRunID runner.RunID
+	labels       map[string]string
+
+	// Set once the worker starts shutting down, see Drain().
+	draining bool
 }
 
-// Creates a new Handler which combines a runner.Service to do work and a StatsReceiver
-func NewHandler(stat stats.StatsReceiver, run runner.Service) worker.Worker {
+// How often a draining worker checks whether its runs finished.
+const drainPollInterval = time.Second
+
+// How long a drained worker keeps serving after handing back its runs, so the
+// scheduler can see they were aborted instead of losing touch with the worker.
+const drainLinger = 5 * time.Second
+
+// Creates a new Handler which combines a runner.Service to do work and a StatsReceiver.
+// Labels are reported to the scheduler, which matches them against tasks' label selectors.
+func NewHandler(stat stats.StatsReceiver, run runner.Service, labels map[string]string) worker.Worker {
+	return newHandler(stat, run, labels)
+}
+
+func newHandler(stat stats.StatsReceiver, run runner.Service, labels map[string]string) *handler {
 	scopedStat := stat.Scope("handler")
-	h := &handler{stat: scopedStat, run: run, timeLastRpc: time.Now()}
+	h := &handler{stat: scopedStat, run: run, timeLastRpc: time.Now(), labels: labels}
 	stats.ReportServerRestart(scopedStat, stats.WorkerServerStartedGauge, stats.DefaultStartupGaugeSpikeLen)
 	go h.stats()
 	return h
 }
 
+// Drain stops the worker from taking new runs and reports it as draining to the scheduler, which stops
+// assigning it tasks.  It waits up to gracePeriod for the active runs to finish, then aborts the ones
+// left so the scheduler retries them on other workers, and returns once the scheduler could see that.
+func (h *handler) Drain(gracePeriod time.Duration) {
+	h.mu.Lock()
+	h.draining = true
+	h.mu.Unlock()
+	log.Infof("Worker draining, waiting up to %s for its runs to finish", gracePeriod)
+
+	deadline := time.Now().Add(gracePeriod)
+	active := h.activeRuns()
+	for len(active) > 0 && time.Now().Before(deadline) {
+		time.Sleep(drainPollInterval)
+		active = h.activeRuns()
+	}
+	if len(active) == 0 {
+		log.Info("Worker drained, all runs finished")
+		return
+	}
+	for _, id := range active {
+		log.Infof("Worker drain grace period expired, handing back runID: %s", id)
+		if _, err := h.run.Abort(id); err != nil {
+			log.Errorf("Worker couldn't abort runID %s while draining: %s", id, err)
+		}
+	}
+	time.Sleep(drainLinger)
+}
+
+func (h *handler) isDraining() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.draining
+}
+
+// Returns the ids of the runs that haven't ended.
+func (h *handler) activeRuns() []runner.RunID {
+	st, _, err := h.run.StatusAll()
+	if err != nil {
+		log.Errorf("Worker couldn't get the status of its runs: %s", err)
+		return nil
+	}
+	active := []runner.RunID{}
+	for _, status := range st {
+		if !status.State.IsDone() {
+			active = append(active, status.RunID)
+		}
+	}
+	return active
+}
+
 // Periodically output stats
 //TODO: runner should eventually be extended to support stats, multiple runs, etc. (replacing loop here).
 func (h *handler) stats() {
@@ -128,6 +194,12 @@ func (h *handler) QueryWorker() (*worker.WorkerStatus, error) {
 		ws.Error = err.Error()
 	}
 	ws.Initialized = svc.Initialized
+	ws.Capacity = domain.DomainResourcesToThrift(svc.Capacity)
+	ws.Labels = h.labels
+	if h.isDraining() {
+		draining := true
+		ws.Draining = &draining
+	}
 
 	for _, status := range st {
 		if status.State.IsDone() {
@@ -160,6 +232,12 @@ func (h *handler) Run(cmd *worker.RunCommand) (*worker.RunStatus, error) {
 
 	h.updateTimeLastRpc()
 	c := domain.ThriftRunCommandToDomain(cmd)
+	if h.isDraining() {
+		// Set invalid status and nil err to indicate handleable internal err, the scheduler retries the task elsewhere.
+		status := runner.RunStatus{State: runner.FAILED, Error: "worker is draining, not accepting new runs"}
+		log.Info("Worker draining, rejecting cmd")
+		return domain.DomainRunStatusToThrift(status), nil
+	}
 	status, err := h.run.Run(c)
 	//Check if this is a dup retry for an already running command and if so get its status.
 	//TODO(jschiller): accept a cmd.Nonce field so we can be precise about hiccups with dup cmd resends?
@@ -3,6 +3,9 @@ package starter
 import (
 	"fmt"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/apache/thrift/lib/go/thrift"
@@ -45,7 +48,19 @@ func makeServers(
 	return servers{thrift, http}
 }
 
+// DefaultDrainGracePeriod how long a worker sent SIGTERM waits for its runs to finish before handing them back.
+const DefaultDrainGracePeriod = 2 * time.Minute
+
 // StartServer construct and start scheduler service (without using ice)
+// A worker with non-zero capacity runs tasks concurrently as long as their resources fit in it.
+// Labels describe the worker to the scheduler, for tasks that require or prefer certain workers.
+// If cgroupConfig is set, each command runs in its own cgroup with the memCap and the command's declared
+// resources as its limits, see osexec.NewCgroupExecer.
+// If sandboxConfig is set, each command runs in its own namespaces, see osexec.SandboxConfig.
+// baseEnv is set in every command's environment, whatever its EnvMode, under the command's own env vars.
+// secretsFile holds the named secrets commands reference in their SecretEnv, a JSON object of names to values.
+// On SIGTERM the worker drains: it takes no new runs and waits up to drainGracePeriod for its runs
+// to finish before handing them back to the scheduler, then returns.
 func StartServer(
 	thriftAddr string,
 	httpAddr string,
@@ -54,16 +69,34 @@ func StartServer(
 	rID runner.RunnerID,
 	dirMonitor *stats.DirsMonitor,
 	memCap uint64,
+	capacity runner.Resources,
+	labels map[string]string,
 	stat *stats.StatsReceiver,
 	preprocessors []func() error,
 	postprocessors []func() error,
 	memUtilizationFunc func() (int64, error),
 	uploader runners.LogUploader,
+	drainGracePeriod time.Duration,
+	cgroupConfig *osexec.CgroupConfig,
+	sandboxConfig *osexec.SandboxConfig,
+	baseEnv map[string]string,
+	secretsFile string,
 ) {
 	// create worker object:
 	// worker support objects
 	memory := execer.Memory(memCap)
-	execer := execers.MakeSimExecerInterceptor(execers.NewSimExecer(), osexec.NewBoundedExecer(memory, memUtilizationFunc, *stat))
+	osExecer := osexec.NewBoundedExecer(memory, memUtilizationFunc, *stat)
+	if cgroupConfig != nil {
+		osExecer = osexec.NewCgroupExecer(memory, *cgroupConfig, memUtilizationFunc, *stat)
+	}
+	if sandboxConfig != nil {
+		if err := osExecer.EnableSandbox(*sandboxConfig); err != nil {
+			log.Fatalf("couldn't sandbox the commands: %s", err)
+		}
+	}
+	osExecer.SetBaseEnv(baseEnv)
+	osExecer.SetSecretsFile(secretsFile)
+	execer := execers.MakeSimExecerInterceptor(execers.NewSimExecer(), osExecer)
 
 	var filerMap runner.RunTypeMap = runner.MakeRunTypeMap()
 	if db != nil {
@@ -71,7 +104,12 @@ func StartServer(
 		filerMap[runner.RunTypeScoot] = snapshot.FilerAndInitDoneCh{Filer: gitFiler, IDC: db.InitDoneCh}
 	}
 	// the worker object
-	worker := runners.NewSingleRunner(execer, filerMap, oc, *stat, dirMonitor, rID, preprocessors, postprocessors, uploader)
+	var worker runner.Service
+	if capacity.IsZero() {
+		worker = runners.NewSingleRunner(execer, filerMap, oc, *stat, dirMonitor, rID, preprocessors, postprocessors, uploader)
+	} else {
+		worker = runners.NewPackingRunner(execer, filerMap, oc, capacity, *stat, dirMonitor, rID, preprocessors, postprocessors, uploader)
+	}
 
 	// add service wrappers
 	// thrift wrapper
@@ -81,7 +119,7 @@ func StartServer(
 	}
 	thriftTransportFactory := thrift.NewTTransportFactory()
 	binaryProtocolFactory := thrift.NewTBinaryProtocolFactoryDefault()
-	handler := NewHandler(*stat, worker)
+	handler := newHandler(*stat, worker, labels)
 	thriftServer := MakeServer(handler, transport, thriftTransportFactory, binaryProtocolFactory)
 
 	// http wrapper
@@ -100,7 +138,15 @@ func StartServer(
 	go func() {
 		errCh <- servers.thrift.Serve()
 	}()
-	log.Fatal("Error serving: ", <-errCh)
+	termCh := make(chan os.Signal, 1)
+	signal.Notify(termCh, syscall.SIGTERM)
+	select {
+	case err := <-errCh:
+		log.Fatal("Error serving: ", err)
+	case <-termCh:
+		handler.Drain(drainGracePeriod)
+		log.Info("Worker drained, exiting")
+	}
 }
 
 func GetStatsReceiver() stats.StatsReceiver {
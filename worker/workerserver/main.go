@@ -11,9 +11,12 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/twitter/scoot/cloud/cluster/local"
+	"github.com/twitter/scoot/cloud/cluster/membership"
 	"github.com/twitter/scoot/common/log/hooks"
 	"github.com/twitter/scoot/common/stats"
 	"github.com/twitter/scoot/runner"
+	"github.com/twitter/scoot/runner/execer"
+	osexec "github.com/twitter/scoot/runner/execer/os"
 	"github.com/twitter/scoot/runner/runners"
 	"github.com/twitter/scoot/snapshot/git/gitdb"
 	"github.com/twitter/scoot/snapshot/store"
@@ -23,6 +26,8 @@ import (
 )
 
 func main() {
+	// when re-executed to start a command in its cgroup or sandbox, runs the command instead of the worker
+	osexec.MaybeRunExecerInit()
 	log.AddHook(hooks.NewContextHook())
 
 	thriftAddr := flag.String("thrift_addr", domain.DefaultWorker_Thrift, "addr to serve thrift on")
@@ -30,6 +35,23 @@ func main() {
 	memCapFlag := flag.Uint64("mem_cap", 0, "Kill runs that exceed this amount of memory, in bytes. Zero means no limit.")
 	storeHandle := flag.String("bundlestore", "", "Abs file path or an http 'host:port' to store/get bundles.")
 	logLevelFlag := flag.String("log_level", "info", "Log everything at this level and above (error|info|debug)")
+	cpuCapacity := flag.Int64("capacity_cpu_millis", 0, "Thousandths of a CPU core shared by concurrent tasks. Zero capacity runs one task at a time.")
+	memCapacity := flag.Int64("capacity_memory_bytes", 0, "Memory shared by concurrent tasks, in bytes.")
+	diskCapacity := flag.Int64("capacity_disk_bytes", 0, "Disk space shared by concurrent tasks, in bytes.")
+	labelsFlag := flag.String("labels", "", "Comma separated key=value labels the scheduler matches against tasks' label selectors, ex: 'rack=r1,android-sdk=28'")
+	registryAddr := flag.String("registry_addr", "", "If set, comma separated http 'host:port's of the membership registries to register with and send heartbeats to, "+
+		"typically the scheduler's http_addr, or the http_addr of every scheduler electing a leader")
+	advertiseAddr := flag.String("advertise_addr", "", "Thrift address registered with the membership registry, defaults to thrift_addr")
+	heartbeatInterval := flag.Duration("heartbeat_interval", membership.DefaultHeartbeatInterval, "How often to send heartbeats to the membership registry")
+	cgroupParent := flag.String("cgroup_parent", "", "If set, run each command in its own cgroup v2 under this one, ex: '/sys/fs/cgroup/scoot'. Falls back to polling memory usage if cgroups can't be used.")
+	cgroupCPUMillis := flag.Int64("cgroup_cpu_millis", 0, "Thousandths of a CPU core each command's cgroup can use. Zero means no limit.")
+	cgroupPidsMax := flag.Int64("cgroup_pids_max", 0, "Processes and threads each command's cgroup can have. Zero means no limit.")
+	sandbox := flag.Bool("sandbox", false, "Run each command in new mount, PID, IPC and UTS namespaces, seeing only its dir, /dev and the read-only paths of the host's filesystem")
+	sandboxReadOnlyPaths := flag.String("sandbox_read_only_paths", strings.Join(osexec.DefaultSandboxReadOnlyPaths, ","), "Comma separated host paths mounted read-only in the sandboxes")
+	sandboxIsolateNetwork := flag.Bool("sandbox_isolate_network", false, "Also run sandboxed commands in a new network namespace, without network access")
+	baseEnvFlag := flag.String("base_env", "", "Comma separated KEY=value env vars set for every command, even ones that don't inherit the worker's env, ex: 'PATH=/usr/bin:/bin,LANG=C'")
+	secretsFile := flag.String("secrets_file", "", "JSON file of secret names to values, resolved for commands' secret env vars. Should only be readable by the worker.")
+	drainGracePeriod := flag.Duration("drain_grace_period", starter.DefaultDrainGracePeriod, "On SIGTERM, how long to wait for the current runs to finish before handing them back to the scheduler")
 	flag.Parse()
 
 	level, err := log.ParseLevel(*logLevelFlag)
@@ -39,6 +61,21 @@ func main() {
 	}
 	log.SetLevel(level)
 
+	capacity := runner.Resources{CPUMillis: *cpuCapacity, MemoryBytes: *memCapacity, DiskBytes: *diskCapacity}
+	if err := capacity.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	labels, err := parseLabels(*labelsFlag)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	baseEnv, err := parseEnv(*baseEnvFlag)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	stat := starter.GetStatsReceiver()
 
 	store, err := getStore(*storeHandle)
@@ -58,6 +95,35 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	var cgroupConfig *osexec.CgroupConfig
+	if *cgroupParent != "" {
+		cgroupConfig = &osexec.CgroupConfig{
+			Parent: *cgroupParent,
+			Limits: execer.Limits{CPUMillis: *cgroupCPUMillis, Pids: *cgroupPidsMax},
+		}
+	}
+	var sandboxConfig *osexec.SandboxConfig
+	if *sandbox {
+		sandboxConfig = &osexec.SandboxConfig{ReadOnlyPaths: []string{}, IsolateNetwork: *sandboxIsolateNetwork}
+		for _, p := range strings.Split(*sandboxReadOnlyPaths, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				sandboxConfig.ReadOnlyPaths = append(sandboxConfig.ReadOnlyPaths, p)
+			}
+		}
+	}
+	registryAddrs := []string{}
+	for _, addr := range strings.Split(*registryAddr, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			registryAddrs = append(registryAddrs, addr)
+		}
+	}
+	var heartbeater *membership.Heartbeater
+	if len(registryAddrs) > 0 {
+		if *advertiseAddr == "" {
+			*advertiseAddr = *thriftAddr
+		}
+		heartbeater = membership.StartHeartbeats(registryAddrs, membership.Heartbeat{Addr: *advertiseAddr, Labels: labels}, *heartbeatInterval)
+	}
 	starter.StartServer(
 		*thriftAddr,
 		*httpAddr,
@@ -66,12 +132,26 @@ func main() {
 		getRunnerID(),
 		stats.NopDirsMonitor,
 		*memCapFlag,
+		capacity,
+		labels,
 		&stat,
 		[]func() error{},
 		[]func() error{},
 		nil,
 		nil,
+		*drainGracePeriod,
+		cgroupConfig,
+		sandboxConfig,
+		baseEnv,
+		*secretsFile,
 	)
+
+	// the worker drained, leave the cluster instead of waiting to expire
+	if heartbeater != nil {
+		if err := heartbeater.Leave(); err != nil {
+			log.Errorf("Couldn't leave the membership registry: %s", err)
+		}
+	}
 }
 
 // Use storeHandle if provided, else try Fetching, then GetScootApiAddr(), then fallback to tmp file store.
@@ -100,6 +180,42 @@ func getStore(storeHandle string) (store.Store, error) {
 	return store.MakeFileStoreInTemp()
 }
 
+// Parses comma separated key=value labels, a label without a value is set to "".
+func parseLabels(s string) (map[string]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	labels := map[string]string{}
+	for _, kv := range strings.Split(s, ",") {
+		parts := strings.SplitN(kv, "=", 2)
+		key := strings.TrimSpace(parts[0])
+		if key == "" {
+			return nil, fmt.Errorf("invalid label %q in %q, must be key=value", kv, s)
+		}
+		labels[key] = ""
+		if len(parts) == 2 {
+			labels[key] = strings.TrimSpace(parts[1])
+		}
+	}
+	return labels, nil
+}
+
+// Parses comma separated KEY=value env vars, values can't contain commas.
+func parseEnv(s string) (map[string]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	env := map[string]string{}
+	for _, kv := range strings.Split(s, ",") {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid env var %q in %q, must be KEY=value", kv, s)
+		}
+		env[parts[0]] = parts[1]
+	}
+	return env, nil
+}
+
 func getRunnerID() runner.RunnerID {
 	// suitable local testing purposes, but a production implementation would supply a unique ID
 	hostname, _ := os.Hostname()